@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"taskmanager/internal/usecase/board"
+)
+
+// runBoardToken prints a viewer token for the board channel, valid for the configured TTL
+// Usage: board-token -name "Ada Lovelace"
+// Returns the process exit code
+func runBoardToken(args []string) int {
+	flags := flag.NewFlagSet("board-token", flag.ContinueOnError)
+	name := flags.String("name", "", "name shown to the other viewers of the boards")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *name == "" {
+		fmt.Fprintln(os.Stderr, "board-token: -name is required")
+		flags.Usage()
+		return 2
+	}
+
+	token, err := board.IssueToken(*name, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "board-token: %s\n", err)
+		return 2
+	}
+
+	fmt.Println(token)
+	return 0
+}
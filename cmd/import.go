@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	importEntity "taskmanager/internal/entity/importjob"
+	"taskmanager/internal/platform/database"
+	"taskmanager/internal/transport/dto"
+	"taskmanager/internal/usecase/importjob"
+)
+
+// runImport imports tasks from a CSV or NDJSON file synchronously and prints the report as JSON
+// Usage: import -file tasks.csv [-format csv|ndjson] [-map title=Col,description=Col,team=Col] [-team name|uuid] [-dry-run]
+// Returns the process exit code: 1 when the import fails or any row is rejected
+func runImport(dbConnector database.Connector, args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	file := flags.String("file", "", "path of the CSV or NDJSON file to import")
+	format := flags.String("format", "", "file format (csv or ndjson), defaults to the file extension")
+	mapping := flags.String("map", "", "column mapping, e.g. title=Name,description=Details,team=Squad")
+	team := flags.String("team", "", "team name or UUID assigned to rows without their own team")
+	dryRun := flags.Bool("dry-run", false, "validate rows without creating tasks")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *file == "" {
+		fmt.Fprintln(os.Stderr, "import: -file is required")
+		flags.Usage()
+		return 2
+	}
+
+	content, err := os.ReadFile(*file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %s\n", err)
+		return 1
+	}
+
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(*file)), ".")
+	}
+
+	parsedMapping, err := parseMapping(*mapping)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import: %s\n", err)
+		return 2
+	}
+
+	now := time.Now()
+	j := &importEntity.ImportJob{
+		Format:    importEntity.Format(*format),
+		Status:    importEntity.StatusCompleted,
+		DryRun:    *dryRun,
+		Mapping:   parsedMapping,
+		Team:      *team,
+		Content:   string(content),
+		StartedAt: &now,
+	}
+
+	if err := runImportJob(dbConnector, j); err != nil {
+		fmt.Fprintf(os.Stderr, "import: %s\n", err)
+		return 1
+	}
+
+	finishedAt := time.Now()
+	j.FinishedAt = &finishedAt
+	j.CreatedAt = now
+	j.UpdatedAt = finishedAt
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(dto.ToImportResponse(*j)); err != nil {
+		fmt.Fprintf(os.Stderr, "import: %s\n", err)
+		return 1
+	}
+
+	if j.FailedRows > 0 {
+		return 1
+	}
+	return 0
+}
+
+// runImportJob runs an import job in a single transaction, rolling back on failure
+func runImportJob(dbConnector database.Connector, j *importEntity.ImportJob) error {
+	ctx, err := dbConnector.InjectDBsIntoContext(context.Background(), database.WithDBTransaction())
+	if err != nil {
+		return err
+	}
+
+	if err := importjob.Run(ctx, j); err != nil {
+		_ = dbConnector.Rollback(ctx)
+		return err
+	}
+
+	return dbConnector.Commit(ctx)
+}
+
+// parseMapping parses a comma separated list of field=column pairs
+func parseMapping(value string) (importEntity.Mapping, error) {
+	mapping := importEntity.Mapping{}
+	if value == "" {
+		return mapping, nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		field, column, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(field) == "" {
+			return nil, fmt.Errorf("invalid mapping %q, expected field=column", pair)
+		}
+		mapping[strings.TrimSpace(field)] = strings.TrimSpace(column)
+	}
+
+	return mapping, nil
+}
@@ -1,29 +1,52 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
 
 	"taskmanager/internal/config"
 	"taskmanager/internal/paths"
 	"taskmanager/internal/platform/cache"
 	"taskmanager/internal/platform/database"
 	"taskmanager/internal/platform/logger"
+	"taskmanager/internal/platform/publisher"
 	"taskmanager/internal/platform/server"
+	analyticsRepo "taskmanager/internal/repository/analytics"
 	taskRepo "taskmanager/internal/repository/task"
 	"taskmanager/internal/transport"
+	"taskmanager/internal/usecase/analytics"
+	"taskmanager/internal/usecase/board"
+	"taskmanager/internal/usecase/eventstream"
+	"taskmanager/internal/usecase/idempotency"
+	"taskmanager/internal/usecase/importjob"
+	"taskmanager/internal/usecase/outbox"
+	"taskmanager/internal/usecase/project"
+	"taskmanager/internal/usecase/sprint"
 	"taskmanager/internal/usecase/task"
 	"taskmanager/internal/usecase/team"
+	"taskmanager/internal/usecase/webhook"
+	"taskmanager/internal/worker"
 )
 
 func main() {
 	appConfig := struct {
-		Server   server.Configuration   `toml:"server"`
-		Database database.Configuration `toml:"database"`
-		Logger   logger.Configuration   `toml:"logger"`
-		Task     task.Configuration     `toml:"task"`
-		Team     team.Configuration     `toml:"team"`
-		Cache    cache.Configuration    `toml:"cache"`
+		Server      server.Configuration      `toml:"server"`
+		Database    database.Configuration    `toml:"database"`
+		Logger      logger.Configuration      `toml:"logger"`
+		Task        task.Configuration        `toml:"task"`
+		Team        team.Configuration        `toml:"team"`
+		Sprint      sprint.Configuration      `toml:"sprint"`
+		Project     project.Configuration     `toml:"project"`
+		Analytics   analytics.Configuration   `toml:"analytics"`
+		Import      importjob.Configuration   `toml:"import"`
+		Webhook     webhook.Configuration     `toml:"webhook"`
+		Outbox      outbox.Configuration      `toml:"outbox"`
+		Events      eventstream.Configuration `toml:"events"`
+		Board       board.Configuration       `toml:"board"`
+		Idempotency idempotency.Configuration `toml:"idempotency"`
+		Cache       cache.Configuration       `toml:"cache"`
 	}{}
 
 	// Load configuration from file with environment variable expansion
@@ -44,6 +67,36 @@ func main() {
 		log.Fatal("Error on load team config", "error", err)
 	}
 
+	// Load sprint config
+	if err := sprint.LoadConfig(&appConfig.Sprint); err != nil {
+		log.Fatal("Error on load sprint config", "error", err)
+	}
+
+	// Load project config
+	if err := project.LoadConfig(&appConfig.Project); err != nil {
+		log.Fatal("Error on load project config", "error", err)
+	}
+
+	// Load analytics config
+	if err := analytics.LoadConfig(&appConfig.Analytics); err != nil {
+		log.Fatal("Error on load analytics config", "error", err)
+	}
+
+	// Load import config
+	if err := importjob.LoadConfig(&appConfig.Import); err != nil {
+		log.Fatal("Error on load import config", "error", err)
+	}
+
+	// Load board config
+	if err := board.LoadConfig(&appConfig.Board); err != nil {
+		log.Fatal("Error on load board config", "error", err)
+	}
+
+	// Load idempotency config
+	if err := idempotency.LoadConfig(&appConfig.Idempotency); err != nil {
+		log.Fatal("Error on load idempotency config", "error", err)
+	}
+
 	// Connect to database
 	dbConnector, err := database.Open(appConfig.Database)
 	if err != nil {
@@ -55,6 +108,15 @@ func main() {
 		}
 	}()
 
+	// Check the search language against the search_vector column
+	checkCtx, err := dbConnector.InjectDBsIntoContext(context.Background(), database.WithDBWithoutTransaction())
+	if err != nil {
+		log.Fatal("Error on inject database into context", "error", err)
+	}
+	if err := task.CheckSearchLanguage(checkCtx); err != nil {
+		log.Fatal("Error on check search language", "error", err)
+	}
+
 	// Connect to cache
 	if err := cache.Open(appConfig.Cache); err != nil {
 		log.Fatal("Error on open cache connection", "error", err)
@@ -73,6 +135,65 @@ func main() {
 		appConfig.Cache.DefaultTTL(),
 	))
 
+	// Wrap analytics repository with cache-aside decorator
+	analyticsRepo.SetPersist(analyticsRepo.NewCachedPersist(
+		analyticsRepo.Persist(),
+		cacheClient,
+		appConfig.Cache.DefaultTTL(),
+	))
+
+	// Run subcommand instead of the http server when one is given
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import":
+			os.Exit(runImport(dbConnector, os.Args[2:]))
+		case "board-token":
+			os.Exit(runBoardToken(os.Args[2:]))
+		default:
+			log.Fatalf("Unknown command: %s", os.Args[1])
+		}
+	}
+
+	// Load webhook config
+	if err := webhook.LoadConfig(&appConfig.Webhook); err != nil {
+		log.Fatal("Error on load webhook config", "error", err)
+	}
+
+	// Load outbox config; events recorded by subcommands are relayed by the server
+	if err := outbox.LoadConfig(&appConfig.Outbox); err != nil {
+		log.Fatal("Error on load outbox config", "error", err)
+	}
+
+	// Load event stream config
+	if err := eventstream.LoadConfig(&appConfig.Events); err != nil {
+		log.Fatal("Error on load event stream config", "error", err)
+	}
+
+	// Relay outbox events to the webhook subscriptions, to the team metrics cache, to the
+	// Redis stream and, through Redis pub/sub, to the event stream subscribers of every replica
+	eventsPubSub := publisher.NewRedisPubSub(cacheClient, eventstream.Config.Channel)
+	outbox.SetPublishers(
+		webhook.Publisher{},
+		analytics.Invalidator{},
+		publisher.NewRedisStream(cacheClient, outbox.Config.Stream, outbox.Config.StreamMaxLen),
+		eventsPubSub,
+	)
+
+	// Announce board presence changes to the board sessions of every replica
+	boardPresencePubSub := publisher.NewRedisPubSub(cacheClient, board.Config.PresenceChannel)
+	board.SetPresencePublisher(boardPresencePubSub)
+
+	// Start import, outbox, webhook, event stream, board presence, snapshot and idempotency workers
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	defer stopWorker()
+	go worker.NewImportWorker(dbConnector, importjob.Config.WorkerPollInterval()).Run(workerCtx)
+	go worker.NewOutboxWorker(dbConnector, outbox.Config.WorkerPollInterval()).Run(workerCtx)
+	go worker.NewWebhookWorker(dbConnector, webhook.Config.WorkerPollInterval()).Run(workerCtx)
+	go worker.NewEventStreamWorker(eventsPubSub).Run(workerCtx)
+	go worker.NewBoardPresenceWorker(boardPresencePubSub).Run(workerCtx)
+	go worker.NewSnapshotWorker(dbConnector, analytics.Config.SnapshotInterval()).Run(workerCtx)
+	go worker.NewIdempotencyWorker(dbConnector, idempotency.Config.PurgeInterval()).Run(workerCtx)
+
 	// Start http server
 	address := fmt.Sprintf("%s:%d", appConfig.Server.Host, appConfig.Server.Port)
 	server.ListenAndServe(address, transport.Routes(dbConnector))
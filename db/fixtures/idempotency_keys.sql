@@ -0,0 +1,4 @@
+-- Insert seed idempotency keys, live and expired
+INSERT INTO idempotency_keys (key, request_hash, status_code, response_headers, response_body, created_at, expires_at) VALUES
+('key-live', 'f0e1d2c3b4a5968778695a4b3c2d1e0ff0e1d2c3b4a5968778695a4b3c2d1e0f', 200, '{"ETag":"\"1\""}', '{"uuid":"123e4567-e89b-12d3-a456-426614174000"}', '2025-12-01 18:00:00', '2025-12-02 18:00:00'),
+('key-expired', '0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9', 200, '{"ETag":"\"1\""}', '{"uuid":"123e4567-e89b-12d3-a456-426614174001"}', '2025-11-30 18:00:00', '2025-12-01 12:00:00');
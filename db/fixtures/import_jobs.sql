@@ -0,0 +1,5 @@
+-- Insert seed import jobs with various statuses
+INSERT INTO import_jobs (uuid, format, status, dry_run, mapping, team, content, total_rows, imported_rows, failed_rows, report, error_message, started_at, finished_at, created_at, updated_at) VALUES
+('aaae4567-e89b-12d3-a456-426614174000', 'csv', 'completed', false, '{}', '', E'title,description\nImplementar autenticação,Criar sistema JWT', 1, 1, 0, '[]', '', '2025-12-01 18:22:00', '2025-12-01 18:22:01', '2025-12-01 18:21:00', '2025-12-01 18:22:01'),
+('aaae4567-e89b-12d3-a456-426614174001', 'ndjson', 'pending', true, '{"title": "name"}', 'Time de QA', '{"name":"Revisar testes","description":"Revisar cobertura"}', 0, 0, 0, '[]', '', NULL, NULL, '2025-12-01 18:23:00', '2025-12-01 18:23:00'),
+('aaae4567-e89b-12d3-a456-426614174002', 'csv', 'pending', false, '{}', '', E'title,description\nConfigurar CI/CD,Pipeline', 0, 0, 0, '[]', '', NULL, NULL, '2025-12-01 18:24:00', '2025-12-01 18:24:00');
@@ -0,0 +1,8 @@
+-- Insert seed outbox messages, published, pending and failed
+INSERT INTO outbox (uuid, event_type, payload, status, attempts, last_error, next_attempt_at, published_at, created_at, updated_at) VALUES
+('eeee4567-e89b-12d3-a456-426614174000', 'task.created', '{"id":"eeee4567-e89b-12d3-a456-426614174000","type":"task.created","occurred_at":"2025-12-01T18:30:00Z","data":{"uuid":"123e4567-e89b-12d3-a456-426614174000"}}', 'published', 0, '', '2025-12-01 18:30:00', '2025-12-01 18:30:01', '2025-12-01 18:30:00', '2025-12-01 18:30:01'),
+('eeee4567-e89b-12d3-a456-426614174001', 'task.updated', '{"id":"eeee4567-e89b-12d3-a456-426614174001","type":"task.updated","occurred_at":"2025-12-01T18:31:00Z","data":{"uuid":"123e4567-e89b-12d3-a456-426614174001"}}', 'pending', 0, '', '2025-12-01 18:31:00', NULL, '2025-12-01 18:31:00', '2025-12-01 18:31:00'),
+('eeee4567-e89b-12d3-a456-426614174002', 'task.deleted', '{"id":"eeee4567-e89b-12d3-a456-426614174002","type":"task.deleted","occurred_at":"2025-12-01T18:32:00Z","data":{"uuid":"123e4567-e89b-12d3-a456-426614174002"}}', 'pending', 2, 'connection refused', '2025-12-01 18:40:00', NULL, '2025-12-01 18:32:00', '2025-12-01 18:34:00'),
+('eeee4567-e89b-12d3-a456-426614174003', 'team.task_associated', '{"id":"eeee4567-e89b-12d3-a456-426614174003","type":"team.task_associated","occurred_at":"2025-11-01T12:00:00Z","data":{"team_uuid":"111e4567-e89b-12d3-a456-426614174000","task_uuid":"123e4567-e89b-12d3-a456-426614174001"}}', 'published', 0, '', '2025-11-01 12:00:00', '2025-11-01 12:00:01', '2025-11-01 12:00:00', '2025-11-01 12:00:01'),
+('eeee4567-e89b-12d3-a456-426614174004', 'task.updated', '{"id":"eeee4567-e89b-12d3-a456-426614174004","type":"task.updated","occurred_at":"2025-12-01T18:33:00Z","data":{"uuid":"123e4567-e89b-12d3-a456-426614174000"}}', 'failed', 3, 'connection refused', '2025-12-01 18:33:00', NULL, '2025-12-01 18:33:00', '2025-12-01 18:34:00'),
+('eeee4567-e89b-12d3-a456-426614174005', 'task.status_changed', '{"id":"eeee4567-e89b-12d3-a456-426614174005","type":"task.status_changed","occurred_at":"2025-12-01T18:33:30Z","data":{"uuid":"123e4567-e89b-12d3-a456-426614174002"}}', 'pending', 0, '', '2025-12-01 18:33:30', NULL, '2025-12-01 18:33:30', '2025-12-01 18:33:30');
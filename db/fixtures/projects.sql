@@ -0,0 +1,19 @@
+-- Insert seed projects
+INSERT INTO projects (uuid, name, description, created_at, updated_at) VALUES
+('666e4567-e89b-12d3-a456-426614174000', 'Lançamento v2', 'Nova versão do produto com autenticação e métricas', '2025-11-20 10:00:00', '2025-11-20 10:00:00'),
+('666e4567-e89b-12d3-a456-426614174001', 'Migração de infraestrutura', 'Centralizar logs e monitoramento da aplicação', '2025-11-21 10:00:00', '2025-11-21 10:00:00');
+
+-- Insert seed milestones
+INSERT INTO milestones (uuid, project_id, name, description, target_date, created_at, updated_at) VALUES
+-- Lançamento v2 milestones (project_id = 1)
+('777e4567-e89b-12d3-a456-426614174000', 1, 'Beta', 'Versão beta para clientes selecionados', '2025-12-15', '2025-11-20 10:00:00', '2025-11-20 10:00:00'),
+('777e4567-e89b-12d3-a456-426614174001', 1, 'Lançamento', 'Versão disponível para todos os clientes', '2026-01-30', '2025-11-20 10:00:00', '2025-11-20 10:00:00');
+
+-- Add seed tasks of several teams to projects and plan them in milestones
+UPDATE tasks SET project_id = 1, milestone_id = 1 WHERE uuid = '123e4567-e89b-12d3-a456-426614174001';
+UPDATE tasks SET project_id = 1, milestone_id = 1 WHERE uuid = '123e4567-e89b-12d3-a456-426614174002';
+UPDATE tasks SET project_id = 1, milestone_id = 1 WHERE uuid = '123e4567-e89b-12d3-a456-426614174003';
+UPDATE tasks SET project_id = 1, milestone_id = 2 WHERE uuid = '123e4567-e89b-12d3-a456-426614174006';
+UPDATE tasks SET project_id = 1, milestone_id = 2 WHERE uuid = '123e4567-e89b-12d3-a456-426614174000';
+UPDATE tasks SET project_id = 1 WHERE uuid = '423e4567-e89b-12d3-a456-426614174002';
+UPDATE tasks SET project_id = 2 WHERE uuid = '323e4567-e89b-12d3-a456-426614174000';
@@ -0,0 +1,26 @@
+-- Insert seed team status snapshots
+INSERT INTO team_status_snapshots (team_id, snapshot_date, to_do, in_progress, done, canceled) VALUES
+-- Development Team snapshots (team_id = 1)
+(1, '2025-11-28', 3, 2, 0, 0),
+(1, '2025-11-30', 2, 3, 0, 0),
+
+-- DevOps Team snapshots (team_id = 2), none taken on 2025-11-27
+(2, '2025-11-24', 3, 2, 0, 0),
+(2, '2025-11-25', 2, 3, 0, 0),
+(2, '2025-11-26', 2, 3, 0, 0),
+(2, '2025-11-28', 1, 2, 0, 1),
+(2, '2025-11-29', 1, 1, 1, 1),
+(2, '2025-11-30', 1, 1, 2, 1);
+
+-- Insert seed sprint snapshots
+INSERT INTO sprint_snapshots (sprint_id, snapshot_date, total_tasks, remaining_tasks, total_points, remaining_points) VALUES
+-- Sprint 2 snapshots (sprint_id = 2)
+(2, '2025-11-17', 2, 2, 5, 5),
+(2, '2025-11-18', 3, 3, 8, 8),
+(2, '2025-11-19', 2, 2, 5, 5),
+
+-- Sprint DevOps 1 snapshots (sprint_id = 4)
+(4, '2025-11-24', 3, 3, 10, 10),
+(4, '2025-11-28', 2, 2, 8, 8),
+(4, '2025-11-29', 2, 1, 8, 5),
+(4, '2025-11-30', 2, 0, 8, 0);
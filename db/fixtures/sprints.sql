@@ -0,0 +1,17 @@
+-- Insert seed sprints
+INSERT INTO sprints (uuid, team_id, name, goal, start_date, end_date, state, started_at, completed_at, created_at, updated_at) VALUES
+-- Development Team sprints (team_id = 1)
+('555e4567-e89b-12d3-a456-426614174000', 1, 'Sprint 1', 'Documentar a API', '2025-11-03', '2025-11-14', 'completed', '2025-11-03 09:00:00', '2025-11-14 18:00:00', '2025-10-31 10:00:00', '2025-11-14 18:00:00'),
+('555e4567-e89b-12d3-a456-426614174001', 1, 'Sprint 2', 'Melhorar performance', '2025-11-17', '2025-11-28', 'active', '2025-11-17 09:00:00', NULL, '2025-11-14 10:00:00', '2025-11-17 09:00:00'),
+('555e4567-e89b-12d3-a456-426614174002', 1, 'Sprint 3', 'Notificações em tempo real', '2025-12-01', '2025-12-12', 'planned', NULL, NULL, '2025-11-28 10:00:00', '2025-11-28 10:00:00'),
+
+-- DevOps Team sprints (team_id = 2)
+('555e4567-e89b-12d3-a456-426614174003', 2, 'Sprint DevOps 1', 'Automatizar o deploy', '2025-11-24', '2025-12-05', 'completed', '2025-11-24 09:00:00', '2025-12-01 12:00:00', '2025-11-21 10:00:00', '2025-12-01 12:00:00');
+
+-- Estimate seed tasks and plan them in sprints
+UPDATE tasks SET estimate = 3, sprint_id = 2 WHERE uuid = '123e4567-e89b-12d3-a456-426614174001';
+UPDATE tasks SET estimate = 2, sprint_id = 2 WHERE uuid = '123e4567-e89b-12d3-a456-426614174004';
+UPDATE tasks SET estimate = 5 WHERE uuid = '123e4567-e89b-12d3-a456-426614174005';
+UPDATE tasks SET estimate = 5, sprint_id = 4 WHERE uuid = '123e4567-e89b-12d3-a456-426614174002';
+UPDATE tasks SET estimate = 2, sprint_id = 4 WHERE uuid = '123e4567-e89b-12d3-a456-426614174003';
+UPDATE tasks SET estimate = 3, sprint_id = 4 WHERE uuid = '123e4567-e89b-12d3-a456-426614174006';
@@ -29,3 +29,11 @@ INSERT INTO tasks (uuid, title, description, status, started_at, finished_at, te
 ('423e4567-e89b-12d3-a456-426614174000', 'Criar testes de integração', 'Desenvolver suite completa de testes de integração', 'to_do', NULL, NULL, 3, '2025-12-01 18:21:06', '2025-12-01 18:21:06'),
 ('423e4567-e89b-12d3-a456-426614174001', 'Executar testes de carga', 'Realizar testes de performance e carga na aplicação', 'in_progress', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '1 day', NULL, 3, TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '2 days', '2025-12-01 18:21:06'),
 ('423e4567-e89b-12d3-a456-426614174002', 'Revisar cobertura de testes', 'Auditar e melhorar cobertura de testes do projeto', 'done', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '3 days', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '1 day', 3, TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '5 days', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '1 day');
+
+-- Rank seed tasks in creation order, as migration 000010 does
+UPDATE tasks SET rank = ranked.rank
+FROM (
+    SELECT id, rtrim(lpad((row_number() OVER (ORDER BY created_at, id) * 1000)::text, 12, '0'), '0') AS rank
+    FROM tasks
+) AS ranked
+WHERE tasks.id = ranked.id;
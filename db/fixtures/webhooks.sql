@@ -0,0 +1,11 @@
+-- Insert seed webhook subscriptions
+INSERT INTO webhook_subscriptions (uuid, url, secret, events, created_at, updated_at) VALUES
+('bbbe4567-e89b-12d3-a456-426614174000', 'https://example.com/hooks/tasks', 'whsec_0123456789abcdef', '["task.created", "task.updated", "task.status_changed", "task.deleted"]', '2025-12-01 18:25:00', '2025-12-01 18:25:00'),
+('bbbe4567-e89b-12d3-a456-426614174001', 'https://example.com/hooks/teams', 'whsec_fedcba9876543210', '["team.task_associated"]', '2025-12-01 18:26:00', '2025-12-01 18:26:00');
+
+-- Insert seed webhook deliveries with various statuses
+INSERT INTO webhook_deliveries (uuid, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, response_status, last_error, created_at, updated_at) VALUES
+('ccce4567-e89b-12d3-a456-426614174000', 1, 'ddde4567-e89b-12d3-a456-426614174000', 'task.created', '{"id":"ddde4567-e89b-12d3-a456-426614174000","type":"task.created","occurred_at":"2025-12-01T18:30:00Z","data":{"uuid":"123e4567-e89b-12d3-a456-426614174000","title":"Implementar autenticação"}}', 'succeeded', 1, NULL, '2025-12-01 18:30:01', 200, '', '2025-12-01 18:30:00', '2025-12-01 18:30:01'),
+('ccce4567-e89b-12d3-a456-426614174001', 1, 'ddde4567-e89b-12d3-a456-426614174001', 'task.status_changed', '{"id":"ddde4567-e89b-12d3-a456-426614174001","type":"task.status_changed","occurred_at":"2025-12-01T18:31:00Z","data":{"from":"to_do","to":"in_progress"}}', 'failed', 3, NULL, '2025-12-01 18:36:00', 500, 'unexpected response status 500', '2025-12-01 18:31:00', '2025-12-01 18:36:00'),
+('ccce4567-e89b-12d3-a456-426614174002', 1, 'ddde4567-e89b-12d3-a456-426614174002', 'task.updated', '{"id":"ddde4567-e89b-12d3-a456-426614174002","type":"task.updated","occurred_at":"2025-12-01T18:32:00Z","data":{"uuid":"123e4567-e89b-12d3-a456-426614174001"}}', 'pending', 1, '2025-12-01 18:33:00', '2025-12-01 18:32:50', 503, 'unexpected response status 503', '2025-12-01 18:32:00', '2025-12-01 18:32:50'),
+('ccce4567-e89b-12d3-a456-426614174003', 2, 'ddde4567-e89b-12d3-a456-426614174003', 'team.task_associated', '{"id":"ddde4567-e89b-12d3-a456-426614174003","type":"team.task_associated","occurred_at":"2025-12-01T18:34:00Z","data":{"team_uuid":"111e4567-e89b-12d3-a456-426614174000","task_uuid":"123e4567-e89b-12d3-a456-426614174000"}}', 'pending', 0, '2025-12-01 18:45:00', NULL, 0, '', '2025-12-01 18:34:00', '2025-12-01 18:34:00');
@@ -0,0 +1,6 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_tasks_search_vector;
+
+-- Remove search_vector column from tasks table
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,10 @@
+-- Add generated full-text search column to tasks table
+-- The text search configuration must match [task] search_language in config.toml, checked at startup
+ALTER TABLE tasks
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('portuguese', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('portuguese', coalesce(description, '')), 'B')
+) STORED;
+
+-- Create GIN index for full-text search
+CREATE INDEX idx_tasks_search_vector ON tasks USING GIN (search_vector);
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_import_jobs_deleted_at;
+DROP INDEX IF EXISTS idx_import_jobs_status;
+DROP INDEX IF EXISTS idx_import_jobs_uuid;
+
+-- Drop import_jobs table
+DROP TABLE IF EXISTS import_jobs;
@@ -0,0 +1,3 @@
+-- Remove calendar_token_hash column from teams table
+ALTER TABLE teams
+DROP COLUMN IF EXISTS calendar_token_hash;
@@ -0,0 +1,4 @@
+-- Add calendar_token_hash column to teams table
+-- Stores the SHA-256 hash of the secret token of the team's calendar feed; empty disables the feed
+ALTER TABLE teams
+ADD COLUMN calendar_token_hash VARCHAR(64) NOT NULL DEFAULT '';
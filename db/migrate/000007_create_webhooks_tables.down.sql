@@ -0,0 +1,12 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_webhook_deliveries_deleted_at;
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_id;
+DROP INDEX IF EXISTS idx_webhook_deliveries_uuid;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_deleted_at;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_events;
+DROP INDEX IF EXISTS idx_webhook_subscriptions_uuid;
+
+-- Drop webhook tables
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,11 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_tasks_team_id_status;
+DROP INDEX IF EXISTS idx_wip_limit_overrides_deleted_at;
+DROP INDEX IF EXISTS idx_wip_limit_overrides_team_id;
+
+-- Drop wip_limit_overrides table
+DROP TABLE IF EXISTS wip_limit_overrides;
+
+-- Remove wip_limits column from teams table
+ALTER TABLE teams
+DROP COLUMN IF EXISTS wip_limits;
@@ -0,0 +1,26 @@
+-- Add wip_limits column to teams table
+-- Maps a task status to the most tasks of the team allowed in it; statuses absent have no limit
+ALTER TABLE teams
+ADD COLUMN wip_limits JSONB NOT NULL DEFAULT '{}';
+
+-- Create wip_limit_overrides table
+-- Each row records a status change that exceeded the WIP limit of the team with the override flag
+CREATE TABLE wip_limit_overrides (
+    id SERIAL PRIMARY KEY,
+    uuid UUID NOT NULL UNIQUE DEFAULT uuidv7(),
+    team_id INTEGER NOT NULL REFERENCES teams(id),
+    task_id INTEGER NOT NULL REFERENCES tasks(id),
+    status VARCHAR(20) NOT NULL,
+    wip_limit INTEGER NOT NULL,
+    task_count INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes
+CREATE INDEX idx_wip_limit_overrides_team_id ON wip_limit_overrides(team_id, id);
+CREATE INDEX idx_wip_limit_overrides_deleted_at ON wip_limit_overrides(deleted_at);
+
+-- Index the board columns of a team, read in list order (created_at DESC, id DESC)
+CREATE INDEX idx_tasks_team_id_status ON tasks(team_id, status, created_at DESC, id DESC);
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_tasks_team_id_status_rank;
+DROP INDEX IF EXISTS idx_tasks_rank;
+
+-- Remove rank column from tasks table
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS rank;
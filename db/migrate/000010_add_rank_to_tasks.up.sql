@@ -0,0 +1,17 @@
+-- Add rank column to tasks table
+-- Orders the tasks of a status column manually; ranks compare as plain strings (LexoRank-style)
+ALTER TABLE tasks
+ADD COLUMN rank VARCHAR(64) NOT NULL DEFAULT '';
+
+-- Rank existing tasks in creation order, leaving room between neighbors
+-- Decimal digits are valid rank digits; trailing zeros are trimmed as the application does
+UPDATE tasks SET rank = ranked.rank
+FROM (
+    SELECT id, rtrim(lpad((row_number() OVER (ORDER BY created_at, id) * 1000)::text, 12, '0'), '0') AS rank
+    FROM tasks
+) AS ranked
+WHERE tasks.id = ranked.id;
+
+-- Create indexes
+CREATE INDEX idx_tasks_rank ON tasks(rank, id);
+CREATE INDEX idx_tasks_team_id_status_rank ON tasks(team_id, status, rank, id);
@@ -0,0 +1,14 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_tasks_sprint_id;
+DROP INDEX IF EXISTS idx_sprints_team_id_active;
+DROP INDEX IF EXISTS idx_sprints_deleted_at;
+DROP INDEX IF EXISTS idx_sprints_team_id;
+DROP INDEX IF EXISTS idx_sprints_uuid;
+
+-- Remove estimate and sprint_id columns from tasks table
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS sprint_id,
+DROP COLUMN IF EXISTS estimate;
+
+-- Drop sprints table
+DROP TABLE IF EXISTS sprints;
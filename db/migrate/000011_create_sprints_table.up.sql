@@ -0,0 +1,30 @@
+-- Create sprints table
+CREATE TABLE sprints (
+    id SERIAL PRIMARY KEY,
+    uuid UUID NOT NULL UNIQUE DEFAULT uuidv7(),
+    team_id INTEGER NOT NULL REFERENCES teams(id),
+    name VARCHAR(255) NOT NULL,
+    goal TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    state VARCHAR(20) NOT NULL DEFAULT 'planned',
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Add estimate and sprint_id columns to tasks table
+-- Tasks without a sprint are in the backlog of their team
+ALTER TABLE tasks
+ADD COLUMN estimate INTEGER,
+ADD COLUMN sprint_id INTEGER REFERENCES sprints(id);
+
+-- Create indexes
+CREATE INDEX idx_sprints_uuid ON sprints(uuid);
+CREATE INDEX idx_sprints_team_id ON sprints(team_id, start_date, id);
+CREATE INDEX idx_sprints_deleted_at ON sprints(deleted_at);
+-- A team has at most one active sprint
+CREATE UNIQUE INDEX idx_sprints_team_id_active ON sprints(team_id) WHERE state = 'active' AND deleted_at IS NULL;
+CREATE INDEX idx_tasks_sprint_id ON tasks(sprint_id);
@@ -0,0 +1,17 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_tasks_milestone_id;
+DROP INDEX IF EXISTS idx_tasks_project_id;
+DROP INDEX IF EXISTS idx_milestones_deleted_at;
+DROP INDEX IF EXISTS idx_milestones_project_id;
+DROP INDEX IF EXISTS idx_milestones_uuid;
+DROP INDEX IF EXISTS idx_projects_deleted_at;
+DROP INDEX IF EXISTS idx_projects_uuid;
+
+-- Remove project_id and milestone_id columns from tasks table
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS milestone_id,
+DROP COLUMN IF EXISTS project_id;
+
+-- Drop milestones and projects tables
+DROP TABLE IF EXISTS milestones;
+DROP TABLE IF EXISTS projects;
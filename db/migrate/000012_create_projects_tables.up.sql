@@ -0,0 +1,38 @@
+-- Create projects table
+CREATE TABLE projects (
+    id SERIAL PRIMARY KEY,
+    uuid UUID NOT NULL UNIQUE DEFAULT uuidv7(),
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create milestones table
+CREATE TABLE milestones (
+    id SERIAL PRIMARY KEY,
+    uuid UUID NOT NULL UNIQUE DEFAULT uuidv7(),
+    project_id INTEGER NOT NULL REFERENCES projects(id),
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    target_date DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Add project_id and milestone_id columns to tasks table
+-- A task belongs to at most one project, whatever its team; its milestone is one of that project
+ALTER TABLE tasks
+ADD COLUMN project_id INTEGER REFERENCES projects(id),
+ADD COLUMN milestone_id INTEGER REFERENCES milestones(id);
+
+-- Create indexes
+CREATE INDEX idx_projects_uuid ON projects(uuid);
+CREATE INDEX idx_projects_deleted_at ON projects(deleted_at);
+CREATE INDEX idx_milestones_uuid ON milestones(uuid);
+CREATE INDEX idx_milestones_project_id ON milestones(project_id, target_date, id);
+CREATE INDEX idx_milestones_deleted_at ON milestones(deleted_at);
+CREATE INDEX idx_tasks_project_id ON tasks(project_id, status);
+CREATE INDEX idx_tasks_milestone_id ON tasks(milestone_id);
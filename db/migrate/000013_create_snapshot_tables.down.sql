@@ -0,0 +1,3 @@
+-- Drop snapshot tables
+DROP TABLE IF EXISTS sprint_snapshots;
+DROP TABLE IF EXISTS team_status_snapshots;
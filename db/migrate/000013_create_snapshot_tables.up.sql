@@ -0,0 +1,29 @@
+-- Create team_status_snapshots table
+-- One row per team and day with its task counts per status, refreshed by the snapshot worker during the day
+CREATE TABLE team_status_snapshots (
+    id SERIAL PRIMARY KEY,
+    team_id INTEGER NOT NULL REFERENCES teams(id),
+    snapshot_date DATE NOT NULL,
+    to_do INTEGER NOT NULL DEFAULT 0,
+    in_progress INTEGER NOT NULL DEFAULT 0,
+    done INTEGER NOT NULL DEFAULT 0,
+    canceled INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (team_id, snapshot_date)
+);
+
+-- Create sprint_snapshots table
+-- One row per active sprint and day with its scope and remaining work; canceled tasks are out of scope
+CREATE TABLE sprint_snapshots (
+    id SERIAL PRIMARY KEY,
+    sprint_id INTEGER NOT NULL REFERENCES sprints(id),
+    snapshot_date DATE NOT NULL,
+    total_tasks INTEGER NOT NULL DEFAULT 0,
+    remaining_tasks INTEGER NOT NULL DEFAULT 0,
+    total_points INTEGER NOT NULL DEFAULT 0,
+    remaining_points INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (sprint_id, snapshot_date)
+);
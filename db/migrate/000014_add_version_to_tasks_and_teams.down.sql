@@ -0,0 +1,7 @@
+-- Remove version column from teams table
+ALTER TABLE teams
+DROP COLUMN IF EXISTS version;
+
+-- Remove version column from tasks table
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS version;
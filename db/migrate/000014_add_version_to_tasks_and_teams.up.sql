@@ -0,0 +1,7 @@
+-- Add version column to tasks and teams tables
+-- Incremented by every change to the representation, exposed as the ETag and checked against If-Match
+ALTER TABLE tasks
+ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE teams
+ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,15 @@
+-- Create idempotency keys table; a key is written in the transaction of the first request that
+-- used it, with a hash of that request and its response (status, headers such as ETag and body),
+-- and replayed to retries until expires_at
+CREATE TABLE idempotency_keys (
+    key VARCHAR(255) PRIMARY KEY,
+    request_hash VARCHAR(64) NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    response_headers JSONB NOT NULL DEFAULT '{}',
+    response_body BYTEA NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Create indexes
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
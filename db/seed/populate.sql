@@ -28,4 +28,77 @@ INSERT INTO tasks (uuid, title, description, status, started_at, finished_at, te
 -- QA Team tasks (team_id = 3)
 ('423e4567-e89b-12d3-a456-426614174000', 'Criar testes de integração', 'Desenvolver suite completa de testes de integração', 'to_do', NULL, NULL, 3, '2025-12-01 18:21:06', '2025-12-01 18:21:06'),
 ('423e4567-e89b-12d3-a456-426614174001', 'Executar testes de carga', 'Realizar testes de performance e carga na aplicação', 'in_progress', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '1 day', NULL, 3, TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '2 days', '2025-12-01 18:21:06'),
-('423e4567-e89b-12d3-a456-426614174002', 'Revisar cobertura de testes', 'Auditar e melhorar cobertura de testes do projeto', 'done', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '3 days', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '1 day', 3, TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '5 days', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '1 day');
\ No newline at end of file
+('423e4567-e89b-12d3-a456-426614174002', 'Revisar cobertura de testes', 'Auditar e melhorar cobertura de testes do projeto', 'done', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '3 days', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '1 day', 3, TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '5 days', TIMESTAMP '2025-12-01 18:21:06' - INTERVAL '1 day');
+
+-- Rank seed tasks in creation order, as migration 000010 does
+UPDATE tasks SET rank = ranked.rank
+FROM (
+    SELECT id, rtrim(lpad((row_number() OVER (ORDER BY created_at, id) * 1000)::text, 12, '0'), '0') AS rank
+    FROM tasks
+) AS ranked
+WHERE tasks.id = ranked.id;
+
+-- Insert seed sprints
+INSERT INTO sprints (uuid, team_id, name, goal, start_date, end_date, state, started_at, completed_at, created_at, updated_at) VALUES
+-- Development Team sprints (team_id = 1)
+('555e4567-e89b-12d3-a456-426614174000', 1, 'Sprint 1', 'Documentar a API', '2025-11-03', '2025-11-14', 'completed', '2025-11-03 09:00:00', '2025-11-14 18:00:00', '2025-10-31 10:00:00', '2025-11-14 18:00:00'),
+('555e4567-e89b-12d3-a456-426614174001', 1, 'Sprint 2', 'Melhorar performance', '2025-11-17', '2025-11-28', 'active', '2025-11-17 09:00:00', NULL, '2025-11-14 10:00:00', '2025-11-17 09:00:00'),
+('555e4567-e89b-12d3-a456-426614174002', 1, 'Sprint 3', 'Notificações em tempo real', '2025-12-01', '2025-12-12', 'planned', NULL, NULL, '2025-11-28 10:00:00', '2025-11-28 10:00:00'),
+
+-- DevOps Team sprints (team_id = 2)
+('555e4567-e89b-12d3-a456-426614174003', 2, 'Sprint DevOps 1', 'Automatizar o deploy', '2025-11-24', '2025-12-05', 'completed', '2025-11-24 09:00:00', '2025-12-01 12:00:00', '2025-11-21 10:00:00', '2025-12-01 12:00:00');
+
+-- Estimate seed tasks and plan them in sprints
+UPDATE tasks SET estimate = 3, sprint_id = 2 WHERE uuid = '123e4567-e89b-12d3-a456-426614174001';
+UPDATE tasks SET estimate = 2, sprint_id = 2 WHERE uuid = '123e4567-e89b-12d3-a456-426614174004';
+UPDATE tasks SET estimate = 5 WHERE uuid = '123e4567-e89b-12d3-a456-426614174005';
+UPDATE tasks SET estimate = 5, sprint_id = 4 WHERE uuid = '123e4567-e89b-12d3-a456-426614174002';
+UPDATE tasks SET estimate = 2, sprint_id = 4 WHERE uuid = '123e4567-e89b-12d3-a456-426614174003';
+UPDATE tasks SET estimate = 3, sprint_id = 4 WHERE uuid = '123e4567-e89b-12d3-a456-426614174006';
+
+-- Insert seed projects
+INSERT INTO projects (uuid, name, description, created_at, updated_at) VALUES
+('666e4567-e89b-12d3-a456-426614174000', 'Lançamento v2', 'Nova versão do produto com autenticação e métricas', '2025-11-20 10:00:00', '2025-11-20 10:00:00'),
+('666e4567-e89b-12d3-a456-426614174001', 'Migração de infraestrutura', 'Centralizar logs e monitoramento da aplicação', '2025-11-21 10:00:00', '2025-11-21 10:00:00');
+
+-- Insert seed milestones
+INSERT INTO milestones (uuid, project_id, name, description, target_date, created_at, updated_at) VALUES
+-- Lançamento v2 milestones (project_id = 1)
+('777e4567-e89b-12d3-a456-426614174000', 1, 'Beta', 'Versão beta para clientes selecionados', '2025-12-15', '2025-11-20 10:00:00', '2025-11-20 10:00:00'),
+('777e4567-e89b-12d3-a456-426614174001', 1, 'Lançamento', 'Versão disponível para todos os clientes', '2026-01-30', '2025-11-20 10:00:00', '2025-11-20 10:00:00');
+
+-- Add seed tasks of several teams to projects and plan them in milestones
+UPDATE tasks SET project_id = 1, milestone_id = 1 WHERE uuid = '123e4567-e89b-12d3-a456-426614174001';
+UPDATE tasks SET project_id = 1, milestone_id = 1 WHERE uuid = '123e4567-e89b-12d3-a456-426614174002';
+UPDATE tasks SET project_id = 1, milestone_id = 1 WHERE uuid = '123e4567-e89b-12d3-a456-426614174003';
+UPDATE tasks SET project_id = 1, milestone_id = 2 WHERE uuid = '123e4567-e89b-12d3-a456-426614174006';
+UPDATE tasks SET project_id = 1, milestone_id = 2 WHERE uuid = '123e4567-e89b-12d3-a456-426614174000';
+UPDATE tasks SET project_id = 1 WHERE uuid = '423e4567-e89b-12d3-a456-426614174002';
+UPDATE tasks SET project_id = 2 WHERE uuid = '323e4567-e89b-12d3-a456-426614174000';
+
+-- Insert seed team status snapshots
+INSERT INTO team_status_snapshots (team_id, snapshot_date, to_do, in_progress, done, canceled) VALUES
+-- Development Team snapshots (team_id = 1)
+(1, '2025-11-28', 3, 2, 0, 0),
+(1, '2025-11-30', 2, 3, 0, 0),
+
+-- DevOps Team snapshots (team_id = 2), none taken on 2025-11-27
+(2, '2025-11-24', 3, 2, 0, 0),
+(2, '2025-11-25', 2, 3, 0, 0),
+(2, '2025-11-26', 2, 3, 0, 0),
+(2, '2025-11-28', 1, 2, 0, 1),
+(2, '2025-11-29', 1, 1, 1, 1),
+(2, '2025-11-30', 1, 1, 2, 1);
+
+-- Insert seed sprint snapshots
+INSERT INTO sprint_snapshots (sprint_id, snapshot_date, total_tasks, remaining_tasks, total_points, remaining_points) VALUES
+-- Sprint 2 snapshots (sprint_id = 2)
+(2, '2025-11-17', 2, 2, 5, 5),
+(2, '2025-11-18', 3, 3, 8, 8),
+(2, '2025-11-19', 2, 2, 5, 5),
+
+-- Sprint DevOps 1 snapshots (sprint_id = 4)
+(4, '2025-11-24', 3, 3, 10, 10),
+(4, '2025-11-28', 2, 2, 8, 8),
+(4, '2025-11-29', 2, 1, 8, 5),
+(4, '2025-11-30', 2, 0, 8, 0);
@@ -0,0 +1,156 @@
+package analytics
+
+import (
+	"time"
+
+	"taskmanager/internal/platform/errors"
+)
+
+// DateLayout is the layout of the dates bounding a period
+const DateLayout = "2006-01-02"
+
+// Bucket is the length of the intervals a period is split into
+type Bucket string
+
+const (
+	BucketDay   Bucket = "day"
+	BucketWeek  Bucket = "week"
+	BucketMonth Bucket = "month"
+)
+
+// IsValid reports whether the bucket is day, week or month
+func (b Bucket) IsValid() bool {
+	return b == BucketDay || b == BucketWeek || b == BucketMonth
+}
+
+// Period is the window of finish dates covered by the metrics of a team, From and To included
+// Buckets start on the first day of the day, week (Monday) or month holding From, in UTC
+type Period struct {
+	From   time.Time
+	To     time.Time
+	Bucket Bucket
+}
+
+// End returns the exclusive upper bound of the period, the day after To
+func (p Period) End() time.Time {
+	return p.To.AddDate(0, 0, 1)
+}
+
+// Validate validates the period bounds, the period spanning at most maxDays days
+func (p Period) Validate(maxDays int) *errors.ValidationErrors {
+	var errs []errors.ValidationError
+
+	if p.To.Before(p.From) {
+		errs = append(errs, errors.NewValidationError("to", errors.CodeNotBefore, map[string]any{"other": "from"}))
+	} else if p.End().Sub(p.From) > time.Duration(maxDays)*24*time.Hour {
+		errs = append(errs, errors.NewValidationError("from", errors.CodeMaxPeriod, map[string]any{"max": maxDays}))
+	}
+
+	if len(errs) > 0 {
+		return &errors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
+
+// Percentiles summarizes durations in hours; they are nil when Count is zero
+type Percentiles struct {
+	Count int
+	P50   *float64
+	P85   *float64
+	P95   *float64
+}
+
+// Throughput is the number of tasks done within a bucket starting at Start
+type Throughput struct {
+	Start time.Time
+	Done  int
+}
+
+// TeamMetrics aggregates the tasks of a team finished within a period
+// Lead time runs from creation to finish and cycle time from start to finish, over done tasks;
+// tasks done without being started are left out of the cycle time
+type TeamMetrics struct {
+	Period     Period
+	LeadTime   Percentiles
+	CycleTime  Percentiles
+	Throughput []Throughput
+	Done       int
+	Canceled   int
+}
+
+// CanceledRatio returns the share of canceled tasks among the tasks finished in the period,
+// done or canceled; nil when none was finished
+func (m TeamMetrics) CanceledRatio() *float64 {
+	finished := m.Done + m.Canceled
+	if finished == 0 {
+		return nil
+	}
+
+	ratio := float64(m.Canceled) / float64(finished)
+	return &ratio
+}
+
+// StatusSnapshot is the number of tasks of a team in each status at the end of a day
+type StatusSnapshot struct {
+	Date       time.Time
+	ToDo       int
+	InProgress int
+	Done       int
+	Canceled   int
+}
+
+// CFD is the cumulative flow of a team: its daily status snapshots within a period, in date order
+// Days without a snapshot are left out
+type CFD struct {
+	Period Period
+	Days   []StatusSnapshot
+}
+
+// SprintSnapshot is the scope and the remaining work of a sprint at the end of a day
+// Canceled tasks are out of scope; tasks without estimate count as zero points
+type SprintSnapshot struct {
+	Date            time.Time
+	TotalTasks      int
+	RemainingTasks  int
+	TotalPoints     int
+	RemainingPoints int
+}
+
+// IdealPoint is the remaining points of the ideal burndown at the end of a day
+type IdealPoint struct {
+	Date            time.Time
+	RemainingPoints float64
+}
+
+// Burndown is the remaining work of a sprint per day against the ideal line
+// The ideal line burns the points committed on the first snapshot evenly down to zero on the end date
+type Burndown struct {
+	CommittedPoints int
+	Ideal           []IdealPoint
+	Actual          []SprintSnapshot
+}
+
+// NewBurndown builds the burndown of a sprint running from start to end out of its daily snapshots
+func NewBurndown(start, end time.Time, snapshots []SprintSnapshot) Burndown {
+	b := Burndown{Actual: snapshots}
+	if len(snapshots) > 0 {
+		b.CommittedPoints = snapshots[0].TotalPoints
+	}
+
+	days := int(end.Sub(start).Hours()/24) + 1
+	if days < 1 {
+		return b
+	}
+
+	b.Ideal = make([]IdealPoint, days)
+	for i := range b.Ideal {
+		remaining := 0.0
+		if days > 1 {
+			remaining = float64(b.CommittedPoints) * float64(days-1-i) / float64(days-1)
+		}
+		b.Ideal[i] = IdealPoint{Date: start.AddDate(0, 0, i), RemainingPoints: remaining}
+	}
+
+	return b
+}
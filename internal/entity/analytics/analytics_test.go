@@ -0,0 +1,150 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	errors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPeriod_Validate(t *testing.T) {
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		period  Period
+		maxDays int
+		wantErr *errors.ValidationErrors
+	}{
+		{"Validate period with success", Period{From: from, To: from.AddDate(0, 0, 29), Bucket: BucketWeek}, 30, nil},
+		{"Validate period of a single day", Period{From: from, To: from, Bucket: BucketDay}, 30, nil},
+		{
+			"Validate period ending before it starts",
+			Period{From: from, To: from.AddDate(0, 0, -1), Bucket: BucketWeek},
+			30,
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "to", Type: errors.TypeInvalid, Code: errors.CodeNotBefore, Message: "to must not be before from", Params: map[string]any{"other": "from"}},
+			}},
+		},
+		{
+			"Validate period exceeding the maximum",
+			Period{From: from, To: from.AddDate(0, 0, 30), Bucket: BucketWeek},
+			30,
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "from", Type: errors.TypeMaxLength, Code: errors.CodeMaxPeriod, Message: "period must not exceed 30 days", Params: map[string]any{"max": 30}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.period.Validate(tt.maxDays)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Period.Validate() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBucket_IsValid(t *testing.T) {
+	tests := []struct {
+		bucket Bucket
+		want   bool
+	}{
+		{BucketDay, true},
+		{BucketWeek, true},
+		{BucketMonth, true},
+		{Bucket("year"), false},
+		{Bucket(""), false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.bucket), func(t *testing.T) {
+			if got := tt.bucket.IsValid(); got != tt.want {
+				t.Errorf("Bucket.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTeamMetrics_CanceledRatio(t *testing.T) {
+	quarter := 0.25
+
+	tests := []struct {
+		name    string
+		metrics TeamMetrics
+		want    *float64
+	}{
+		{"Canceled ratio of done and canceled tasks", TeamMetrics{Done: 3, Canceled: 1}, &quarter},
+		{"Canceled ratio without finished tasks", TeamMetrics{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, tt.metrics.CanceledRatio()); diff != "" {
+				t.Errorf("TeamMetrics.CanceledRatio() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewBurndown(t *testing.T) {
+	start := time.Date(2025, 11, 17, 0, 0, 0, 0, time.UTC)
+	snapshots := []SprintSnapshot{
+		{Date: start, TotalTasks: 3, RemainingTasks: 3, TotalPoints: 8, RemainingPoints: 8},
+		{Date: start.AddDate(0, 0, 1), TotalTasks: 4, RemainingTasks: 2, TotalPoints: 10, RemainingPoints: 5},
+	}
+
+	tests := []struct {
+		name      string
+		end       time.Time
+		snapshots []SprintSnapshot
+		want      Burndown
+	}{
+		{
+			"Burndown commits the points of the first snapshot",
+			start.AddDate(0, 0, 4),
+			snapshots,
+			Burndown{
+				CommittedPoints: 8,
+				Ideal: []IdealPoint{
+					{Date: start, RemainingPoints: 8},
+					{Date: start.AddDate(0, 0, 1), RemainingPoints: 6},
+					{Date: start.AddDate(0, 0, 2), RemainingPoints: 4},
+					{Date: start.AddDate(0, 0, 3), RemainingPoints: 2},
+					{Date: start.AddDate(0, 0, 4), RemainingPoints: 0},
+				},
+				Actual: snapshots,
+			},
+		},
+		{
+			"Burndown of a sprint without snapshots",
+			start.AddDate(0, 0, 1),
+			nil,
+			Burndown{
+				Ideal: []IdealPoint{
+					{Date: start, RemainingPoints: 0},
+					{Date: start.AddDate(0, 0, 1), RemainingPoints: 0},
+				},
+			},
+		},
+		{
+			"Burndown of a single day sprint",
+			start,
+			snapshots[:1],
+			Burndown{
+				CommittedPoints: 8,
+				Ideal:           []IdealPoint{{Date: start, RemainingPoints: 0}},
+				Actual:          snapshots[:1],
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewBurndown(start, tt.end, tt.snapshots)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("NewBurndown() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
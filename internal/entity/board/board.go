@@ -0,0 +1,82 @@
+package board
+
+import (
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/entity/webhook"
+	"taskmanager/internal/platform/errors"
+)
+
+type CommandType string
+
+const (
+	CommandJoin          CommandType = "join"
+	CommandLeave         CommandType = "leave"
+	CommandMoveTask      CommandType = "move_task"
+	CommandAssociateTask CommandType = "associate_task"
+)
+
+type MessageType string
+
+const (
+	MessagePresence MessageType = "presence"
+	MessageEvent    MessageType = "event"
+)
+
+// Viewer is a user connected to the board channel
+// ConnectionID tells apart the connections of one user, such as two open tabs
+type Viewer struct {
+	ConnectionID uuid.UUID
+	Name         string
+}
+
+// Command is a request sent by a viewer over the board channel
+// ID is chosen by the client to match the acknowledgement or error of the command.
+// OverrideWIPLimit lets move_task exceed the WIP limit of the target status
+type Command struct {
+	ID               string
+	Type             CommandType
+	TeamUUID         uuid.UUID
+	TaskUUID         uuid.UUID
+	Status           task.TaskStatus
+	OverrideWIPLimit bool
+}
+
+// Message is a notification pushed to the viewers of a team board
+// Viewers is set for presence messages and Event for event messages
+type Message struct {
+	Type     MessageType
+	TeamUUID uuid.UUID
+	Viewers  []string
+	Event    *webhook.Event
+}
+
+// Validate validates the fields required by the command type
+func (c Command) Validate() *errors.ValidationErrors {
+	var errs []errors.ValidationError
+
+	switch c.Type {
+	case CommandJoin, CommandLeave, CommandMoveTask, CommandAssociateTask:
+	default:
+		errs = append(errs, errors.NewValidationError("type", errors.CodeInvalidCommand, nil))
+	}
+
+	if c.TeamUUID == uuid.Nil {
+		errs = append(errs, errors.NewValidationError("team_uuid", errors.CodeRequired, nil))
+	}
+
+	if (c.Type == CommandMoveTask || c.Type == CommandAssociateTask) && c.TaskUUID == uuid.Nil {
+		errs = append(errs, errors.NewValidationError("task_uuid", errors.CodeRequired, nil))
+	}
+
+	if c.Type == CommandMoveTask && c.Status == "" {
+		errs = append(errs, errors.NewValidationError("status", errors.CodeRequired, nil))
+	}
+
+	if len(errs) > 0 {
+		return &errors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/errors"
+)
+
+func TestCommand_Validate(t *testing.T) {
+	teamUUID := uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+	taskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name    string
+		command Command
+		want    *errors.ValidationErrors
+	}{
+		{
+			"Validate join command",
+			Command{Type: CommandJoin, TeamUUID: teamUUID},
+			nil,
+		},
+		{
+			"Validate move task command",
+			Command{Type: CommandMoveTask, TeamUUID: teamUUID, TaskUUID: taskUUID, Status: task.StatusInProgress},
+			nil,
+		},
+		{
+			"Validate associate task command",
+			Command{Type: CommandAssociateTask, TeamUUID: teamUUID, TaskUUID: taskUUID},
+			nil,
+		},
+		{
+			"Validate command with invalid type and no team",
+			Command{Type: "delete_board"},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "type", Type: errors.TypeInvalid, Code: errors.CodeInvalidCommand, Message: "invalid command type"},
+				{Field: "team_uuid", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "team_uuid is required"},
+			}},
+		},
+		{
+			"Validate move task command without task and status",
+			Command{Type: CommandMoveTask, TeamUUID: teamUUID},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "task_uuid", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "task_uuid is required"},
+				{Field: "status", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "status is required"},
+			}},
+		},
+		{
+			"Validate associate task command without task",
+			Command{Type: CommandAssociateTask, TeamUUID: teamUUID},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "task_uuid", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "task_uuid is required"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.command.Validate(), tt.want); diff != "" {
+				t.Errorf("Command.Validate() diff: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"taskmanager/internal/platform/errors"
+)
+
+// MaxKeyLength is the longest Idempotency-Key accepted
+const MaxKeyLength = 255
+
+// Key is an Idempotency-Key with a hash of the request that first used it and the response replayed to its retries,
+// with the headers of the response that identify the resource, such as ETag
+// It is written in the transaction of that request, so it only persists when the request succeeds
+type Key struct {
+	Key             string            `gorm:"primaryKey;type:varchar(255)" json:"-"`
+	RequestHash     string            `gorm:"type:varchar(64);not null" json:"-"`
+	StatusCode      int               `gorm:"not null" json:"-"`
+	ResponseHeaders map[string]string `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"-"`
+	ResponseBody    []byte            `gorm:"not null" json:"-"`
+	CreatedAt       time.Time         `json:"-"`
+	ExpiresAt       time.Time         `gorm:"not null" json:"-"`
+}
+
+// TableName maps Key to the idempotency_keys table
+func (Key) TableName() string {
+	return "idempotency_keys"
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (k *Key) AfterFind(tx *gorm.DB) (err error) {
+	if !k.CreatedAt.IsZero() {
+		k.CreatedAt = k.CreatedAt.UTC()
+	}
+	if !k.ExpiresAt.IsZero() {
+		k.ExpiresAt = k.ExpiresAt.UTC()
+	}
+	return nil
+}
+
+// ValidateKey checks the Idempotency-Key sent by the client
+func ValidateKey(key string) error {
+	if len(key) > MaxKeyLength {
+		return &errors.BadRequestError{
+			Message: fmt.Sprintf("Idempotency-Key must not exceed %d characters", MaxKeyLength),
+			Field:   "Idempotency-Key",
+		}
+	}
+	return nil
+}
+
+// HashRequest returns the hex-encoded SHA-256 hash identifying a request by its method, path and body
+func HashRequest(method, path string, body []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", method, path)
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// CheckRequest refuses to replay the key for a request other than the one that first used it
+func (k *Key) CheckRequest(requestHash string) error {
+	if k.RequestHash == requestHash {
+		return nil
+	}
+
+	return &errors.ValidationErrors{Errors: []errors.ValidationError{
+		errors.NewValidationError("Idempotency-Key", errors.CodeIdempotencyKeyReused, nil),
+	}}
+}
@@ -0,0 +1,110 @@
+package idempotency
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr error
+	}{
+		{"Valid key", "4f6c1c0e-6f1b-4a8e-9a47-3f0c2a1d9b7e", nil},
+		{"Key at the maximum length", strings.Repeat("k", MaxKeyLength), nil},
+		{
+			"Key over the maximum length",
+			strings.Repeat("k", MaxKeyLength+1),
+			&errors.BadRequestError{Message: "Idempotency-Key must not exceed 255 characters", Field: "Idempotency-Key"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKey(tt.key)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("ValidateKey() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHashRequest(t *testing.T) {
+	base := HashRequest("POST", "/api/tasks", []byte(`{"title":"a"}`))
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     []byte
+		wantSame bool
+	}{
+		{"Same request", "POST", "/api/tasks", []byte(`{"title":"a"}`), true},
+		{"Different body", "POST", "/api/tasks", []byte(`{"title":"b"}`), false},
+		{"Different path", "POST", "/api/teams", []byte(`{"title":"a"}`), false},
+		{"Different method", "PUT", "/api/tasks", []byte(`{"title":"a"}`), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HashRequest(tt.method, tt.path, tt.body)
+			if len(got) != 64 {
+				t.Errorf("HashRequest() length = %d, want 64", len(got))
+			}
+			if (got == base) != tt.wantSame {
+				t.Errorf("HashRequest() = %s, same as base = %t, want %t", got, got == base, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestKey_CheckRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestHash string
+		wantErr     error
+	}{
+		{"Same request", "abc", nil},
+		{
+			"Different request",
+			"def",
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "Idempotency-Key", Type: errors.TypeConflict, Code: errors.CodeIdempotencyKeyReused, Message: "Idempotency-Key was already used for a different request"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &Key{Key: "key", RequestHash: "abc"}
+			err := k.CheckRequest(tt.requestHash)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Key.CheckRequest() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestKey_AfterFind(t *testing.T) {
+	brt := time.FixedZone("BRT", -3*60*60)
+
+	k := &Key{
+		CreatedAt: time.Date(2025, 12, 1, 15, 30, 0, 0, brt),
+		ExpiresAt: time.Date(2025, 12, 2, 15, 30, 0, 0, brt),
+	}
+	if err := k.AfterFind(nil); err != nil {
+		t.Fatalf("Key.AfterFind() error: %v", err)
+	}
+
+	want := &Key{
+		CreatedAt: time.Date(2025, 12, 1, 18, 30, 0, 0, time.UTC),
+		ExpiresAt: time.Date(2025, 12, 2, 18, 30, 0, 0, time.UTC),
+	}
+	if diff := cmp.Diff(k, want); diff != "" {
+		t.Errorf("Key.AfterFind() diff: %s", diff)
+	}
+}
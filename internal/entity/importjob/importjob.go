@@ -0,0 +1,134 @@
+package importjob
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"taskmanager/internal/platform/errors"
+)
+
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+type Status string
+
+const (
+	StatusPending    Status = "pending"
+	StatusProcessing Status = "processing"
+	StatusCompleted  Status = "completed"
+	StatusFailed     Status = "failed"
+)
+
+// Task fields that can be filled from an imported row
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldTeam        = "team"
+)
+
+// Mapping maps a task field to the CSV column or NDJSON key holding its value
+type Mapping map[string]string
+
+// ImportJob represents a task import from a CSV or NDJSON file
+// Team is an optional team name or UUID assigned to every row without its own team
+type ImportJob struct {
+	gorm.Model
+
+	UUID         uuid.UUID  `gorm:"type:uuid;uniqueIndex;not null" json:"-"`
+	Format       Format     `gorm:"type:varchar(20);not null" json:"-"`
+	Status       Status     `gorm:"type:varchar(20);not null;default:'pending'" json:"-"`
+	DryRun       bool       `gorm:"not null" json:"-"`
+	Mapping      Mapping    `gorm:"type:jsonb;serializer:json;not null" json:"-"`
+	Team         string     `gorm:"not null" json:"-"`
+	Content      string     `gorm:"not null" json:"-"`
+	TotalRows    int        `gorm:"not null" json:"-"`
+	ImportedRows int        `gorm:"not null" json:"-"`
+	FailedRows   int        `gorm:"not null" json:"-"`
+	Report       []RowError `gorm:"type:jsonb;serializer:json;not null" json:"-"`
+	ErrorMessage string     `gorm:"not null" json:"-"`
+	StartedAt    *time.Time `json:"-"`
+	FinishedAt   *time.Time `json:"-"`
+}
+
+// RowError reports the validation errors of a single imported row
+// Row is 1-based and does not count the CSV header
+type RowError struct {
+	Row    int                      `json:"row"`
+	Errors []errors.ValidationError `json:"errors"`
+}
+
+// BeforeCreate is a GORM hook to generate UUID v7 before creating
+func (j *ImportJob) BeforeCreate(tx *gorm.DB) (err error) {
+	if j.UUID == (uuid.UUID{}) {
+		j.UUID, err = uuid.NewV7()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (j *ImportJob) AfterFind(tx *gorm.DB) (err error) {
+	if !j.CreatedAt.IsZero() {
+		j.CreatedAt = j.CreatedAt.UTC()
+	}
+	if !j.UpdatedAt.IsZero() {
+		j.UpdatedAt = j.UpdatedAt.UTC()
+	}
+	if j.StartedAt != nil {
+		startedAt := j.StartedAt.UTC()
+		j.StartedAt = &startedAt
+	}
+	if j.FinishedAt != nil {
+		finishedAt := j.FinishedAt.UTC()
+		j.FinishedAt = &finishedAt
+	}
+	return nil
+}
+
+// Validate validates the import job fields
+func (j *ImportJob) Validate() *errors.ValidationErrors {
+	var errs []errors.ValidationError
+
+	if j.Format != FormatCSV && j.Format != FormatNDJSON {
+		errs = append(errs, errors.NewValidationError("format", errors.CodeInvalidValue, nil))
+	}
+
+	if j.Content == "" {
+		errs = append(errs, errors.NewValidationError("content", errors.CodeRequired, nil))
+	}
+
+	fields := make([]string, 0, len(j.Mapping))
+	for field := range j.Mapping {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		if field != FieldTitle && field != FieldDescription && field != FieldTeam {
+			errs = append(errs, errors.NewValidationError("mapping."+field, errors.CodeUnknownMappingField, nil))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &errors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
+
+// Column returns the source column of a task field, defaulting to the field name
+func (m Mapping) Column(field string) string {
+	if column, ok := m[field]; ok && column != "" {
+		return column
+	}
+	return field
+}
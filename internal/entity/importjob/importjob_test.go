@@ -0,0 +1,110 @@
+package importjob
+
+import (
+	"testing"
+
+	errors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+)
+
+func TestImportJob_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		job     *ImportJob
+		wantErr *errors.ValidationErrors
+	}{
+		{
+			"Validate CSV import with success",
+			&ImportJob{
+				Format:  FormatCSV,
+				Content: "title,description\nTask,Description",
+				Mapping: Mapping{FieldTitle: "Name", FieldDescription: "Details", FieldTeam: "Squad"},
+			},
+			nil,
+		},
+		{
+			"Validate NDJSON import without mapping with success",
+			&ImportJob{
+				Format:  FormatNDJSON,
+				Content: `{"title":"Task","description":"Description"}`,
+			},
+			nil,
+		},
+		{
+			"Validate import with invalid format and empty content",
+			&ImportJob{
+				Format: "xlsx",
+			},
+			&errors.ValidationErrors{
+				Errors: []errors.ValidationError{
+					{
+						Field:   "format",
+						Type:    errors.TypeInvalid,
+						Code:    errors.CodeInvalidValue,
+						Message: "invalid format value",
+					},
+					{
+						Field:   "content",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
+						Message: "content is required",
+					},
+				},
+			},
+		},
+		{
+			"Validate import with unknown mapping fields",
+			&ImportJob{
+				Format:  FormatCSV,
+				Content: "title,description\nTask,Description",
+				Mapping: Mapping{"status": "State", FieldTitle: "Name", "priority": "Priority"},
+			},
+			&errors.ValidationErrors{
+				Errors: []errors.ValidationError{
+					{
+						Field:   "mapping.priority",
+						Type:    errors.TypeInvalid,
+						Code:    errors.CodeUnknownMappingField,
+						Message: "unknown mapping field",
+					},
+					{
+						Field:   "mapping.status",
+						Type:    errors.TypeInvalid,
+						Code:    errors.CodeUnknownMappingField,
+						Message: "unknown mapping field",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.job.Validate()
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("ImportJob.Validate() error diff: %s", diff)
+				return
+			}
+		})
+	}
+}
+
+func TestMapping_Column(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping Mapping
+		field   string
+		want    string
+	}{
+		{"Mapped field", Mapping{FieldTitle: "Name"}, FieldTitle, "Name"},
+		{"Unmapped field", Mapping{FieldTitle: "Name"}, FieldDescription, FieldDescription},
+		{"Empty column", Mapping{FieldTeam: ""}, FieldTeam, FieldTeam},
+		{"Nil mapping", nil, FieldTitle, FieldTitle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mapping.Column(tt.field); got != tt.want {
+				t.Errorf("Mapping.Column() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
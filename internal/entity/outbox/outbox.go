@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MessageStatus string
+
+const (
+	MessageStatusPending   MessageStatus = "pending"
+	MessageStatusPublished MessageStatus = "published"
+	MessageStatusFailed    MessageStatus = "failed"
+)
+
+// Message is a domain event written to the outbox in the transaction of the mutation that raised it
+// UUID is the event ID handed to the publishers, so consumers can deduplicate redelivered events
+type Message struct {
+	ID            uint          `gorm:"primarykey" json:"-"`
+	UUID          uuid.UUID     `gorm:"type:uuid;uniqueIndex;not null" json:"-"`
+	EventType     string        `gorm:"type:varchar(50);not null" json:"-"`
+	Payload       string        `gorm:"not null" json:"-"`
+	Status        MessageStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"-"`
+	Attempts      int           `gorm:"not null" json:"-"`
+	LastError     string        `gorm:"not null" json:"-"`
+	NextAttemptAt time.Time     `gorm:"not null" json:"-"`
+	PublishedAt   *time.Time    `json:"-"`
+	CreatedAt     time.Time     `json:"-"`
+	UpdatedAt     time.Time     `json:"-"`
+}
+
+// TableName maps Message to the outbox table
+func (Message) TableName() string {
+	return "outbox"
+}
+
+// BeforeCreate is a GORM hook to generate UUID v7 before creating
+func (m *Message) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.UUID == (uuid.UUID{}) {
+		m.UUID, err = uuid.NewV7()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (m *Message) AfterFind(tx *gorm.DB) (err error) {
+	if !m.CreatedAt.IsZero() {
+		m.CreatedAt = m.CreatedAt.UTC()
+	}
+	if !m.UpdatedAt.IsZero() {
+		m.UpdatedAt = m.UpdatedAt.UTC()
+	}
+	if !m.NextAttemptAt.IsZero() {
+		m.NextAttemptAt = m.NextAttemptAt.UTC()
+	}
+	if m.PublishedAt != nil {
+		publishedAt := m.PublishedAt.UTC()
+		m.PublishedAt = &publishedAt
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestMessage_BeforeCreate(t *testing.T) {
+	eventUUID := uuid.MustParse("ddde4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name     string
+		message  *Message
+		wantUUID *uuid.UUID
+	}{
+		{"Keep the event UUID", &Message{UUID: eventUUID}, &eventUUID},
+		{"Generate UUID when empty", &Message{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.message.BeforeCreate(nil); err != nil {
+				t.Fatalf("Message.BeforeCreate() error: %v", err)
+			}
+			if tt.wantUUID != nil && tt.message.UUID != *tt.wantUUID {
+				t.Errorf("Message.BeforeCreate() uuid = %v, want %v", tt.message.UUID, *tt.wantUUID)
+			}
+			if tt.wantUUID == nil && tt.message.UUID.Version() != 7 {
+				t.Errorf("Message.BeforeCreate() uuid version = %d, want 7", tt.message.UUID.Version())
+			}
+		})
+	}
+}
+
+func TestMessage_AfterFind(t *testing.T) {
+	brt := time.FixedZone("BRT", -3*60*60)
+	publishedAt := time.Date(2025, 12, 1, 15, 31, 0, 0, brt)
+
+	m := &Message{
+		NextAttemptAt: time.Date(2025, 12, 1, 15, 30, 0, 0, brt),
+		PublishedAt:   &publishedAt,
+		CreatedAt:     time.Date(2025, 12, 1, 15, 30, 0, 0, brt),
+		UpdatedAt:     time.Date(2025, 12, 1, 15, 31, 0, 0, brt),
+	}
+	if err := m.AfterFind(nil); err != nil {
+		t.Fatalf("Message.AfterFind() error: %v", err)
+	}
+
+	wantPublishedAt := time.Date(2025, 12, 1, 18, 31, 0, 0, time.UTC)
+	want := &Message{
+		NextAttemptAt: time.Date(2025, 12, 1, 18, 30, 0, 0, time.UTC),
+		PublishedAt:   &wantPublishedAt,
+		CreatedAt:     time.Date(2025, 12, 1, 18, 30, 0, 0, time.UTC),
+		UpdatedAt:     time.Date(2025, 12, 1, 18, 31, 0, 0, time.UTC),
+	}
+	if diff := cmp.Diff(m, want); diff != "" {
+		t.Errorf("Message.AfterFind() diff: %s", diff)
+	}
+}
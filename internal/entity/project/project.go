@@ -0,0 +1,240 @@
+package project
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	"taskmanager/internal/platform/errors"
+)
+
+// DateLayout is the layout of the target date of a milestone
+const DateLayout = "2006-01-02"
+
+// Project groups tasks of several teams towards a shared outcome
+type Project struct {
+	gorm.Model
+
+	UUID        uuid.UUID   `gorm:"type:uuid;uniqueIndex;not null" json:"-"`
+	Name        string      `gorm:"not null" json:"-"`
+	Description string      `gorm:"not null" json:"-"`
+	Milestones  []Milestone `gorm:"foreignKey:ProjectID;references:ID" json:"-"`
+}
+
+// Milestone is a target of a project with the date it is due
+// Tasks of the project may be planned in one of its milestones
+type Milestone struct {
+	gorm.Model
+
+	UUID        uuid.UUID `gorm:"type:uuid;uniqueIndex;not null" json:"-"`
+	ProjectID   uint      `gorm:"not null;index" json:"-"`
+	Name        string    `gorm:"not null" json:"-"`
+	Description string    `gorm:"not null" json:"-"`
+	TargetDate  time.Time `gorm:"type:date;not null" json:"-"`
+}
+
+// ListProjects contains paginated projects and total count
+type ListProjects struct {
+	Projects   []Project
+	TotalItems int
+	Limit      int
+	Page       int
+}
+
+// TaskFilter narrows the tasks listed for a project; nil fields do not filter
+type TaskFilter struct {
+	Status        *taskEntity.TaskStatus
+	TeamUUID      *uuid.UUID
+	MilestoneUUID *uuid.UUID
+}
+
+// TaskCount is the number of tasks of a project with the same milestone, team and status
+// MilestoneID and TeamID are nil for the tasks without one
+type TaskCount struct {
+	MilestoneID *uint
+	TeamID      *uint
+	Status      taskEntity.TaskStatus
+	Count       int
+}
+
+// Count is the number of done tasks out of the total
+type Count struct {
+	Done  int
+	Total int
+}
+
+// MilestoneProgress is the progress of the tasks planned in a milestone
+type MilestoneProgress struct {
+	Milestone Milestone
+	Count
+}
+
+// TeamProgress is the progress of the tasks of a team in a project
+type TeamProgress struct {
+	Team teamEntity.Team
+	Count
+}
+
+// Progress rolls up the tasks of a project, per milestone and per team
+// Canceled tasks are left out: they will never be done
+type Progress struct {
+	Count
+
+	Project          Project
+	Milestones       []MilestoneProgress
+	WithoutMilestone Count
+	Teams            []TeamProgress
+	WithoutTeam      Count
+}
+
+// BeforeCreate is a GORM hook to generate UUID v7 before creating
+func (p *Project) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.UUID == (uuid.UUID{}) {
+		p.UUID, err = uuid.NewV7()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (p *Project) AfterFind(tx *gorm.DB) (err error) {
+	if !p.CreatedAt.IsZero() {
+		p.CreatedAt = p.CreatedAt.UTC()
+	}
+	if !p.UpdatedAt.IsZero() {
+		p.UpdatedAt = p.UpdatedAt.UTC()
+	}
+	if p.DeletedAt.Valid && !p.DeletedAt.Time.IsZero() {
+		p.DeletedAt.Time = p.DeletedAt.Time.UTC()
+	}
+	return nil
+}
+
+// BeforeCreate is a GORM hook to generate UUID v7 before creating
+func (m *Milestone) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.UUID == (uuid.UUID{}) {
+		m.UUID, err = uuid.NewV7()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (m *Milestone) AfterFind(tx *gorm.DB) (err error) {
+	if !m.CreatedAt.IsZero() {
+		m.CreatedAt = m.CreatedAt.UTC()
+	}
+	if !m.UpdatedAt.IsZero() {
+		m.UpdatedAt = m.UpdatedAt.UTC()
+	}
+	if m.DeletedAt.Valid && !m.DeletedAt.Time.IsZero() {
+		m.DeletedAt.Time = m.DeletedAt.Time.UTC()
+	}
+	return nil
+}
+
+// Validate validates the project fields
+func (p *Project) Validate() *errors.ValidationErrors {
+	var errs []errors.ValidationError
+
+	name := strings.TrimSpace(p.Name)
+	if name == "" {
+		errs = append(errs, errors.NewValidationError("name", errors.CodeRequired, nil))
+	} else if len(name) > 255 {
+		errs = append(errs, errors.NewValidationError("name", errors.CodeMaxLength, map[string]any{"max": 255}))
+	}
+
+	description := strings.TrimSpace(p.Description)
+	if description == "" {
+		errs = append(errs, errors.NewValidationError("description", errors.CodeRequired, nil))
+	}
+
+	if len(errs) > 0 {
+		return &errors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
+
+// Validate validates the milestone fields
+func (m *Milestone) Validate() *errors.ValidationErrors {
+	var errs []errors.ValidationError
+
+	name := strings.TrimSpace(m.Name)
+	if name == "" {
+		errs = append(errs, errors.NewValidationError("name", errors.CodeRequired, nil))
+	} else if len(name) > 255 {
+		errs = append(errs, errors.NewValidationError("name", errors.CodeMaxLength, map[string]any{"max": 255}))
+	}
+
+	if m.TargetDate.IsZero() {
+		errs = append(errs, errors.NewValidationError("target_date", errors.CodeRequired, nil))
+	}
+
+	if len(errs) > 0 {
+		return &errors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
+
+// add counts tasks in the given status
+func (c *Count) add(status taskEntity.TaskStatus, n int) {
+	if status == taskEntity.StatusCanceled {
+		return
+	}
+
+	c.Total += n
+	if status == taskEntity.StatusDone {
+		c.Done += n
+	}
+}
+
+// NewProgress rolls up the task counts of a project into its milestones, in the order of p.Milestones,
+// and into the given teams, in their order
+// Counts of milestones or teams not given are only added to the project totals
+func NewProgress(p Project, teams []teamEntity.Team, counts []TaskCount) Progress {
+	progress := Progress{
+		Project:    p,
+		Milestones: make([]MilestoneProgress, len(p.Milestones)),
+		Teams:      make([]TeamProgress, len(teams)),
+	}
+
+	milestoneIndex := make(map[uint]int, len(p.Milestones))
+	for i, m := range p.Milestones {
+		progress.Milestones[i].Milestone = m
+		milestoneIndex[m.ID] = i
+	}
+
+	teamIndex := make(map[uint]int, len(teams))
+	for i, t := range teams {
+		progress.Teams[i].Team = t
+		teamIndex[t.ID] = i
+	}
+
+	for _, c := range counts {
+		progress.add(c.Status, c.Count)
+
+		if c.MilestoneID == nil {
+			progress.WithoutMilestone.add(c.Status, c.Count)
+		} else if i, ok := milestoneIndex[*c.MilestoneID]; ok {
+			progress.Milestones[i].add(c.Status, c.Count)
+		}
+
+		if c.TeamID == nil {
+			progress.WithoutTeam.add(c.Status, c.Count)
+		} else if i, ok := teamIndex[*c.TeamID]; ok {
+			progress.Teams[i].add(c.Status, c.Count)
+		}
+	}
+
+	return progress
+}
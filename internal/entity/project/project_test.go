@@ -0,0 +1,154 @@
+package project
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	errors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+
+	"github.com/google/go-cmp/cmp"
+	"gorm.io/gorm"
+)
+
+func TestProject_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		project *Project
+		wantErr *errors.ValidationErrors
+	}{
+		{
+			"Validate project with success",
+			&Project{Name: "Lançamento v2", Description: "Nova versão do produto"},
+			nil,
+		},
+		{
+			"Validate project with empty name and description",
+			&Project{Name: "  ", Description: "  "},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "name", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "name is required"},
+				{Field: "description", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "description is required"},
+			}},
+		},
+		{
+			"Validate project with too long name",
+			&Project{Name: strings.Repeat("a", 256), Description: "Nova versão do produto"},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "name", Type: errors.TypeMaxLength, Code: errors.CodeMaxLength, Message: "name must not exceed 255 characters", Params: map[string]any{"max": 255}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.project.Validate()
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Project.Validate() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMilestone_Validate(t *testing.T) {
+	targetDate := time.Date(2026, 1, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		milestone *Milestone
+		wantErr   *errors.ValidationErrors
+	}{
+		{
+			"Validate milestone with success",
+			&Milestone{Name: "Beta", TargetDate: targetDate},
+			nil,
+		},
+		{
+			"Validate milestone without name and target date",
+			&Milestone{Name: ""},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "name", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "name is required"},
+				{Field: "target_date", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "target_date is required"},
+			}},
+		},
+		{
+			"Validate milestone with too long name",
+			&Milestone{Name: strings.Repeat("a", 256), TargetDate: targetDate},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "name", Type: errors.TypeMaxLength, Code: errors.CodeMaxLength, Message: "name must not exceed 255 characters", Params: map[string]any{"max": 255}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.milestone.Validate()
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Milestone.Validate() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewProgress(t *testing.T) {
+	beta := Milestone{Model: gorm.Model{ID: 1}, Name: "Beta"}
+	launch := Milestone{Model: gorm.Model{ID: 2}, Name: "Lançamento"}
+	project := Project{Name: "Lançamento v2", Milestones: []Milestone{beta, launch}}
+
+	development := teamEntity.Team{Model: gorm.Model{ID: 1}, Name: "Time de Desenvolvimento"}
+	devOps := teamEntity.Team{Model: gorm.Model{ID: 2}, Name: "Time de DevOps"}
+
+	betaID, launchID := beta.ID, launch.ID
+	developmentID, devOpsID := development.ID, devOps.ID
+
+	tests := []struct {
+		name   string
+		teams  []teamEntity.Team
+		counts []TaskCount
+		want   Progress
+	}{
+		{
+			"Progress of a project without tasks",
+			nil,
+			nil,
+			Progress{
+				Project:    project,
+				Milestones: []MilestoneProgress{{Milestone: beta}, {Milestone: launch}},
+				Teams:      []TeamProgress{},
+			},
+		},
+		{
+			"Progress rolls up per milestone and per team leaving canceled tasks out",
+			[]teamEntity.Team{development, devOps},
+			[]TaskCount{
+				{MilestoneID: &betaID, TeamID: &developmentID, Status: taskEntity.StatusDone, Count: 2},
+				{MilestoneID: &betaID, TeamID: &devOpsID, Status: taskEntity.StatusInProgress, Count: 1},
+				{MilestoneID: &betaID, TeamID: &devOpsID, Status: taskEntity.StatusCanceled, Count: 3},
+				{MilestoneID: &launchID, TeamID: nil, Status: taskEntity.StatusTodo, Count: 1},
+				{MilestoneID: nil, TeamID: &devOpsID, Status: taskEntity.StatusDone, Count: 1},
+			},
+			Progress{
+				Project: project,
+				Count:   Count{Done: 3, Total: 5},
+				Milestones: []MilestoneProgress{
+					{Milestone: beta, Count: Count{Done: 2, Total: 3}},
+					{Milestone: launch, Count: Count{Done: 0, Total: 1}},
+				},
+				WithoutMilestone: Count{Done: 1, Total: 1},
+				Teams: []TeamProgress{
+					{Team: development, Count: Count{Done: 2, Total: 2}},
+					{Team: devOps, Count: Count{Done: 1, Total: 2}},
+				},
+				WithoutTeam: Count{Done: 0, Total: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewProgress(project, tt.teams, tt.counts)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("NewProgress() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
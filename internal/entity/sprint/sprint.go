@@ -0,0 +1,167 @@
+package sprint
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	"taskmanager/internal/platform/errors"
+)
+
+type State string
+
+const (
+	StatePlanned   State = "planned"
+	StateActive    State = "active"
+	StateCompleted State = "completed"
+)
+
+// DateLayout is the layout of the start and end dates of a sprint
+const DateLayout = "2006-01-02"
+
+// Sprint represents an iteration of a team, planned from StartDate to EndDate
+// A team has at most one active sprint; StartedAt and CompletedAt record when it was started and completed
+type Sprint struct {
+	gorm.Model
+
+	UUID        uuid.UUID         `gorm:"type:uuid;uniqueIndex;not null" json:"-"`
+	TeamID      uint              `gorm:"not null;index" json:"-"`
+	Team        *teamEntity.Team  `gorm:"foreignKey:TeamID" json:"-"`
+	Name        string            `gorm:"not null" json:"-"`
+	Goal        string            `gorm:"not null" json:"-"`
+	StartDate   time.Time         `gorm:"type:date;not null" json:"-"`
+	EndDate     time.Time         `gorm:"type:date;not null" json:"-"`
+	State       State             `gorm:"type:varchar(20);not null;default:'planned'" json:"-"`
+	StartedAt   *time.Time        `json:"-"`
+	CompletedAt *time.Time        `json:"-"`
+	Tasks       []taskEntity.Task `gorm:"foreignKey:SprintID;references:ID" json:"-"`
+}
+
+// ListSprints contains paginated sprints and total count
+type ListSprints struct {
+	Sprints    []Sprint
+	TotalItems int
+	Limit      int
+	Page       int
+}
+
+// Completion is the outcome of completing a sprint
+// Next is the sprint the unfinished tasks rolled into; nil when they went back to the backlog
+type Completion struct {
+	Sprint     Sprint
+	Next       *Sprint
+	RolledOver int
+}
+
+// SprintVelocity is the work a completed sprint finished
+// CompletedPoints sums the estimates of the tasks done between the start and the completion of the sprint
+type SprintVelocity struct {
+	Sprint          Sprint
+	CompletedPoints int
+	CompletedTasks  int
+}
+
+// Velocity is the work finished by the last completed sprints of a team, most recent first
+// Average is the mean of their completed points, zero without completed sprints
+type Velocity struct {
+	Sprints []SprintVelocity
+	Average float64
+}
+
+// BeforeCreate is a GORM hook to generate UUID v7 before creating
+func (s *Sprint) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.UUID == (uuid.UUID{}) {
+		s.UUID, err = uuid.NewV7()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (s *Sprint) AfterFind(tx *gorm.DB) (err error) {
+	if !s.CreatedAt.IsZero() {
+		s.CreatedAt = s.CreatedAt.UTC()
+	}
+	if !s.UpdatedAt.IsZero() {
+		s.UpdatedAt = s.UpdatedAt.UTC()
+	}
+	if s.DeletedAt.Valid && !s.DeletedAt.Time.IsZero() {
+		s.DeletedAt.Time = s.DeletedAt.Time.UTC()
+	}
+	return nil
+}
+
+// Validate validates the sprint fields
+func (s *Sprint) Validate() *errors.ValidationErrors {
+	var errs []errors.ValidationError
+
+	name := strings.TrimSpace(s.Name)
+	if name == "" {
+		errs = append(errs, errors.NewValidationError("name", errors.CodeRequired, nil))
+	} else if len(name) > 255 {
+		errs = append(errs, errors.NewValidationError("name", errors.CodeMaxLength, map[string]any{"max": 255}))
+	}
+
+	if s.StartDate.IsZero() {
+		errs = append(errs, errors.NewValidationError("start_date", errors.CodeRequired, nil))
+	}
+
+	if s.EndDate.IsZero() {
+		errs = append(errs, errors.NewValidationError("end_date", errors.CodeRequired, nil))
+	} else if !s.StartDate.IsZero() && s.EndDate.Before(s.StartDate) {
+		errs = append(errs, errors.NewValidationError("end_date", errors.CodeNotBefore, map[string]any{"other": "start_date"}))
+	}
+
+	if len(errs) > 0 {
+		return &errors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
+
+// Start moves a planned sprint to active, recording when it started
+func (s *Sprint) Start(now time.Time) error {
+	if s.State != StatePlanned {
+		return &errors.ValidationErrors{Errors: []errors.ValidationError{
+			errors.NewValidationError("state", errors.CodeSprintNotPlanned, nil),
+		}}
+	}
+
+	s.State = StateActive
+	s.StartedAt = &now
+	return nil
+}
+
+// Complete moves an active sprint to completed, recording when it completed
+func (s *Sprint) Complete(now time.Time) error {
+	if s.State != StateActive {
+		return &errors.ValidationErrors{Errors: []errors.ValidationError{
+			errors.NewValidationError("state", errors.CodeSprintNotActive, nil),
+		}}
+	}
+
+	s.State = StateCompleted
+	s.CompletedAt = &now
+	return nil
+}
+
+// NewVelocity computes the average completed points of the given sprints
+func NewVelocity(sprints []SprintVelocity) Velocity {
+	v := Velocity{Sprints: sprints}
+	if len(sprints) == 0 {
+		return v
+	}
+
+	total := 0
+	for _, s := range sprints {
+		total += s.CompletedPoints
+	}
+	v.Average = float64(total) / float64(len(sprints))
+	return v
+}
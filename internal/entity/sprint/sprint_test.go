@@ -0,0 +1,169 @@
+package sprint
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	errors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSprint_Validate(t *testing.T) {
+	startDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 12, 12, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		sprint  *Sprint
+		wantErr *errors.ValidationErrors
+	}{
+		{
+			"Validate sprint with success",
+			&Sprint{Name: "Sprint 1", StartDate: startDate, EndDate: endDate},
+			nil,
+		},
+		{
+			"Validate sprint ending on the day it starts",
+			&Sprint{Name: "Sprint 1", StartDate: startDate, EndDate: startDate},
+			nil,
+		},
+		{
+			"Validate sprint with empty name",
+			&Sprint{Name: "   ", StartDate: startDate, EndDate: endDate},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "name", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "name is required"},
+			}},
+		},
+		{
+			"Validate sprint with too long name",
+			&Sprint{Name: strings.Repeat("a", 256), StartDate: startDate, EndDate: endDate},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "name", Type: errors.TypeMaxLength, Code: errors.CodeMaxLength, Message: "name must not exceed 255 characters", Params: map[string]any{"max": 255}},
+			}},
+		},
+		{
+			"Validate sprint without dates",
+			&Sprint{Name: "Sprint 1"},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "start_date", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "start_date is required"},
+				{Field: "end_date", Type: errors.TypeRequired, Code: errors.CodeRequired, Message: "end_date is required"},
+			}},
+		},
+		{
+			"Validate sprint ending before it starts",
+			&Sprint{Name: "Sprint 1", StartDate: endDate, EndDate: startDate},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "end_date", Type: errors.TypeInvalid, Code: errors.CodeNotBefore, Message: "end_date must not be before start_date", Params: map[string]any{"other": "start_date"}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sprint.Validate()
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Sprint.Validate() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSprint_Start(t *testing.T) {
+	now := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		state     State
+		wantState State
+		wantErr   error
+	}{
+		{"Start planned sprint", StatePlanned, StateActive, nil},
+		{"Start active sprint", StateActive, StateActive, &errors.ValidationErrors{Errors: []errors.ValidationError{
+			{Field: "state", Type: errors.TypeState, Code: errors.CodeSprintNotPlanned, Message: "only a planned sprint can be started"},
+		}}},
+		{"Start completed sprint", StateCompleted, StateCompleted, &errors.ValidationErrors{Errors: []errors.ValidationError{
+			{Field: "state", Type: errors.TypeState, Code: errors.CodeSprintNotPlanned, Message: "only a planned sprint can be started"},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Sprint{State: tt.state}
+			err := s.Start(now)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Sprint.Start() error diff: %s", diff)
+			}
+			if s.State != tt.wantState {
+				t.Errorf("Sprint.Start() state = %s, want %s", s.State, tt.wantState)
+			}
+			if tt.wantErr == nil && (s.StartedAt == nil || !s.StartedAt.Equal(now)) {
+				t.Errorf("Sprint.Start() started_at = %v, want %v", s.StartedAt, now)
+			}
+		})
+	}
+}
+
+func TestSprint_Complete(t *testing.T) {
+	now := time.Date(2025, 12, 12, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		state     State
+		wantState State
+		wantErr   error
+	}{
+		{"Complete active sprint", StateActive, StateCompleted, nil},
+		{"Complete planned sprint", StatePlanned, StatePlanned, &errors.ValidationErrors{Errors: []errors.ValidationError{
+			{Field: "state", Type: errors.TypeState, Code: errors.CodeSprintNotActive, Message: "only an active sprint can be completed"},
+		}}},
+		{"Complete completed sprint", StateCompleted, StateCompleted, &errors.ValidationErrors{Errors: []errors.ValidationError{
+			{Field: "state", Type: errors.TypeState, Code: errors.CodeSprintNotActive, Message: "only an active sprint can be completed"},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Sprint{State: tt.state}
+			err := s.Complete(now)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Sprint.Complete() error diff: %s", diff)
+			}
+			if s.State != tt.wantState {
+				t.Errorf("Sprint.Complete() state = %s, want %s", s.State, tt.wantState)
+			}
+			if tt.wantErr == nil && (s.CompletedAt == nil || !s.CompletedAt.Equal(now)) {
+				t.Errorf("Sprint.Complete() completed_at = %v, want %v", s.CompletedAt, now)
+			}
+		})
+	}
+}
+
+func TestNewVelocity(t *testing.T) {
+	tests := []struct {
+		name    string
+		sprints []SprintVelocity
+		want    Velocity
+	}{
+		{
+			"Velocity without completed sprints",
+			nil,
+			Velocity{},
+		},
+		{
+			"Velocity averages the completed points",
+			[]SprintVelocity{{CompletedPoints: 8}, {CompletedPoints: 5}, {CompletedPoints: 0}},
+			Velocity{
+				Sprints: []SprintVelocity{{CompletedPoints: 8}, {CompletedPoints: 5}, {CompletedPoints: 0}},
+				Average: 13.0 / 3.0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewVelocity(tt.sprints)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("NewVelocity() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
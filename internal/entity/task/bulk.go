@@ -0,0 +1,47 @@
+package task
+
+import (
+	"github.com/google/uuid"
+
+	"taskmanager/internal/platform/errors"
+)
+
+// BulkMode defines how a bulk request handles failing operations
+type BulkMode string
+
+const (
+	// BulkModeAllOrNothing aborts the whole batch on the first failing operation
+	BulkModeAllOrNothing BulkMode = "all_or_nothing"
+	// BulkModeBestEffort applies every operation it can and reports failures per item
+	BulkModeBestEffort BulkMode = "best_effort"
+)
+
+// BulkOperationType identifies the action of a bulk operation
+type BulkOperationType string
+
+const (
+	BulkOpUpdateStatus     BulkOperationType = "update_status"
+	BulkOpAssociateTeam    BulkOperationType = "associate_team"
+	BulkOpDisassociateTeam BulkOperationType = "disassociate_team"
+	BulkOpDelete           BulkOperationType = "delete"
+	BulkOpUpdate           BulkOperationType = "update"
+)
+
+// BulkOperation is a single operation of a bulk request
+// Status and OverrideWIPLimit are used by update_status, TeamUUID by the team operations and Updates by update
+type BulkOperation struct {
+	Type             BulkOperationType
+	TaskUUID         uuid.UUID
+	Status           TaskStatus
+	OverrideWIPLimit bool
+	TeamUUID         uuid.UUID
+	Updates          map[string]any
+}
+
+// BulkResult is the outcome of a single operation of a bulk request
+// Errors is nil when the operation succeeded
+type BulkResult struct {
+	Index     int
+	Operation BulkOperation
+	Errors    *errors.ValidationErrors
+}
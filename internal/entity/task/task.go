@@ -1,6 +1,7 @@
 package task
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"gorm.io/gorm"
 
 	"taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 )
 
 type TaskStatus string
@@ -19,6 +21,19 @@ const (
 	StatusDone       TaskStatus = "done"
 )
 
+// ListSort is the order of a task list
+type ListSort string
+
+const (
+	// SortCreatedAt lists the newest tasks first
+	SortCreatedAt ListSort = "created_at"
+	// SortRank lists tasks by their manual rank, lowest first
+	SortRank ListSort = "rank"
+)
+
+// Statuses lists every task status in board column order
+var Statuses = []TaskStatus{StatusTodo, StatusInProgress, StatusDone, StatusCanceled}
+
 type Task struct {
 	gorm.Model
 
@@ -29,14 +44,66 @@ type Task struct {
 	FinishedAt  *time.Time `json:"-"`
 	StartedAt   *time.Time `json:"-"`
 	TeamID      *uint      `gorm:"index" json:"-"`
+	Rank        string     `gorm:"type:varchar(64);not null;default:''" json:"-"`
+
+	// Estimate is the size of the task in story points; nil when it was not estimated
+	Estimate *int `json:"-"`
+	// SprintID is the sprint the task is planned in; nil keeps it in the team backlog
+	SprintID *uint `gorm:"index" json:"-"`
+	// ProjectID is the project the task contributes to, whatever its team; MilestoneID is a milestone of that project
+	ProjectID   *uint `gorm:"index" json:"-"`
+	MilestoneID *uint `gorm:"index" json:"-"`
+
+	// Version is incremented by every change to the fields of the task and its rank; exposed as the ETag
+	Version int `gorm:"not null;default:1" json:"-"`
 }
 
 // ListTasks contains paginated tasks and total count
+// NextCursor and PrevCursor are set only for keyset (cursor) pagination
 type ListTasks struct {
 	Tasks      []Task
 	TotalItems int
 	Limit      int
 	Page       int
+	NextCursor *pagination.Cursor
+	PrevCursor *pagination.Cursor
+
+	// ModifiedAt is the last change to any task known when the list was read, beyond the
+	// updated_at of its tasks (deletes and rank changes); zero when unknown
+	ModifiedAt time.Time
+}
+
+// LastModified returns the latest of ModifiedAt and the updated_at of the listed tasks
+func (l *ListTasks) LastModified() time.Time {
+	last := l.ModifiedAt
+	for _, t := range l.Tasks {
+		if t.UpdatedAt.After(last) {
+			last = t.UpdatedAt
+		}
+	}
+	return last
+}
+
+// SearchResult contains a task matched by full-text search with its rank and highlighted snippets
+type SearchResult struct {
+	Task                 Task
+	Rank                 float64
+	TitleHighlight       string
+	DescriptionHighlight string
+}
+
+// ExportItem contains a task with the name of its team, empty when it has none
+type ExportItem struct {
+	Task     Task
+	TeamName string
+}
+
+// ListSearchResults contains paginated search results and total count
+type ListSearchResults struct {
+	Results    []SearchResult
+	TotalItems int
+	Limit      int
+	Page       int
 }
 
 // BeforeCreate is a GORM hook to generate UUID v7 before creating
@@ -70,23 +137,18 @@ func (t *Task) Validate() *errors.ValidationErrors {
 
 	title := strings.TrimSpace(t.Title)
 	if title == "" {
-		errs = append(errs, errors.ValidationError{
-			Field:   "title",
-			Message: "title is required",
-		})
+		errs = append(errs, errors.NewValidationError("title", errors.CodeRequired, nil))
 	} else if len(title) > 255 {
-		errs = append(errs, errors.ValidationError{
-			Field:   "title",
-			Message: "title must not exceed 255 characters",
-		})
+		errs = append(errs, errors.NewValidationError("title", errors.CodeMaxLength, map[string]any{"max": 255}))
 	}
 
 	description := strings.TrimSpace(t.Description)
 	if description == "" {
-		errs = append(errs, errors.ValidationError{
-			Field:   "description",
-			Message: "description is required",
-		})
+		errs = append(errs, errors.NewValidationError("description", errors.CodeRequired, nil))
+	}
+
+	if t.Estimate != nil && *t.Estimate < 0 {
+		errs = append(errs, errors.NewValidationError("estimate", errors.CodeNegative, nil))
 	}
 
 	if len(errs) > 0 {
@@ -100,7 +162,7 @@ func (t *Task) Validate() *errors.ValidationErrors {
 func (status TaskStatus) ValidateTransitionTo(new TaskStatus) error {
 	if !new.validate() {
 		return &errors.ValidationErrors{Errors: []errors.ValidationError{
-			{Field: "status", Message: "invalid status value"},
+			errors.NewValidationError("status", errors.CodeInvalidValue, nil),
 		}}
 	}
 
@@ -112,7 +174,7 @@ func (status TaskStatus) ValidateTransitionTo(new TaskStatus) error {
 	allowed, exists := allowedTransitions[status]
 	if !exists {
 		return &errors.ValidationErrors{Errors: []errors.ValidationError{
-			{Field: "status", Message: "invalid status transition"},
+			errors.NewValidationError("status", errors.CodeInvalidTransition, nil),
 		}}
 	}
 
@@ -123,7 +185,7 @@ func (status TaskStatus) ValidateTransitionTo(new TaskStatus) error {
 	}
 
 	return &errors.ValidationErrors{Errors: []errors.ValidationError{
-		{Field: "status", Message: "invalid status transition"},
+		errors.NewValidationError("status", errors.CodeInvalidTransition, nil),
 	}}
 }
 
@@ -142,6 +204,22 @@ func (t *Task) EnsureTimestampsForStatus(newStatus TaskStatus, timestamp *time.T
 	}
 }
 
+// CheckVersion refuses a change expecting another version of the task; nil expects any version
+func (t *Task) CheckVersion(expected *int) error {
+	if expected == nil || *expected == t.Version {
+		return nil
+	}
+
+	return &errors.PreconditionFailedError{
+		Message: fmt.Sprintf("task is at version %d, not %d", t.Version, *expected),
+	}
+}
+
+// IsValid reports whether the status is one of Statuses
+func (status TaskStatus) IsValid() bool {
+	return status.validate()
+}
+
 // validate validates if the status is valid
 func (status TaskStatus) validate() bool {
 	validStatuses := []TaskStatus{
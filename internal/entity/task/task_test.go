@@ -9,9 +9,12 @@ import (
 	"taskmanager/internal/platform/testing/assert"
 
 	"github.com/google/go-cmp/cmp"
+	"gorm.io/gorm"
 )
 
 func TestTask_Validate(t *testing.T) {
+	zeroEstimate, negativeEstimate := 0, -1
+
 	tests := []struct {
 		name    string
 		task    *Task
@@ -35,6 +38,8 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "title",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -50,6 +55,8 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -65,6 +72,8 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "title",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -80,6 +89,8 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -95,10 +106,14 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "title",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "title is required",
 					},
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -114,10 +129,14 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "title",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "title is required",
 					},
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -133,6 +152,8 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "title",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -148,6 +169,8 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -163,7 +186,10 @@ func TestTask_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "title",
+						Type:    errors.TypeMaxLength,
+						Code:    errors.CodeMaxLength,
 						Message: "title must not exceed 255 characters",
+						Params:  map[string]any{"max": 255},
 					},
 				},
 			},
@@ -192,6 +218,33 @@ func TestTask_Validate(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"Validate task with zero estimate",
+			&Task{
+				Title:       "Valid title",
+				Description: "Valid description",
+				Estimate:    &zeroEstimate,
+			},
+			nil,
+		},
+		{
+			"Validate task with negative estimate",
+			&Task{
+				Title:       "Valid title",
+				Description: "Valid description",
+				Estimate:    &negativeEstimate,
+			},
+			&errors.ValidationErrors{
+				Errors: []errors.ValidationError{
+					{
+						Field:   "estimate",
+						Type:    errors.TypeMin,
+						Code:    errors.CodeNegative,
+						Message: "estimate must not be negative",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -243,6 +296,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -256,6 +311,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -269,6 +326,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -282,6 +341,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -295,6 +356,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -308,6 +371,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -321,6 +386,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -334,6 +401,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -347,6 +416,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -360,6 +431,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeState,
+						Code:    errors.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -373,6 +446,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeInvalid,
+						Code:    errors.CodeInvalidValue,
 						Message: "invalid status value",
 					},
 				},
@@ -386,6 +461,8 @@ func TestTaskStatus_ValidateTransitionTo(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "status",
+						Type:    errors.TypeInvalid,
+						Code:    errors.CodeInvalidValue,
 						Message: "invalid status value",
 					},
 				},
@@ -558,3 +635,49 @@ func TestTask_EnsureTimestampsForStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestTask_CheckVersion(t *testing.T) {
+	current, stale := 3, 2
+
+	tests := []struct {
+		name     string
+		expected *int
+		wantErr  error
+	}{
+		{"Check version without expectation", nil, nil},
+		{"Check the current version", &current, nil},
+		{"Check a stale version", &stale, &errors.PreconditionFailedError{Message: "task is at version 3, not 2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{Version: current}
+			err := task.CheckVersion(tt.expected)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Task.CheckVersion() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListTasks_LastModified(t *testing.T) {
+	older := time.Date(2025, 12, 1, 18, 21, 6, 0, time.UTC)
+	newer := time.Date(2025, 12, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		list *ListTasks
+		want time.Time
+	}{
+		{"Empty list without modification", &ListTasks{}, time.Time{}},
+		{"Latest updated_at of the tasks", &ListTasks{Tasks: []Task{{Model: gorm.Model{UpdatedAt: older}}, {Model: gorm.Model{UpdatedAt: newer}}}}, newer},
+		{"Modification after the tasks", &ListTasks{Tasks: []Task{{Model: gorm.Model{UpdatedAt: older}}}, ModifiedAt: newer}, newer},
+		{"Modification before the tasks", &ListTasks{Tasks: []Task{{Model: gorm.Model{UpdatedAt: newer}}}, ModifiedAt: older}, newer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.list.LastModified(); !got.Equal(tt.want) {
+				t.Errorf("ListTasks.LastModified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,13 +1,21 @@
 package team
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	taskEntity "taskmanager/internal/entity/task"
 	"taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 )
 
 // Team represents a team entity
@@ -18,14 +26,61 @@ type Team struct {
 	Name        string            `gorm:"not null" json:"-"`
 	Description string            `gorm:"not null" json:"-"`
 	Tasks       []taskEntity.Task `gorm:"foreignKey:TeamID;references:ID" json:"-"`
+
+	// CalendarTokenHash is the SHA-256 hash of the calendar feed token; empty disables the feed
+	CalendarTokenHash string `gorm:"type:varchar(64);not null;default:''" json:"-"`
+
+	// WIPLimits caps the tasks of the team per status
+	WIPLimits WIPLimits `gorm:"type:jsonb;serializer:json;not null;default:'{}'" json:"-"`
+
+	// Version is incremented by every change to the name, description or WIP limits of the team; exposed as the ETag
+	Version int `gorm:"not null;default:1" json:"-"`
+}
+
+// WIPLimits maps a task status to the most tasks of a team allowed in it
+// Statuses absent from the map have no limit
+type WIPLimits map[taskEntity.TaskStatus]int
+
+// WIPLimitOverride records a status change that exceeded the WIP limit of a team
+// TaskCount is the number of tasks already in the status when the limit was overridden
+type WIPLimitOverride struct {
+	gorm.Model
+
+	UUID      uuid.UUID             `gorm:"type:uuid;uniqueIndex;not null" json:"-"`
+	TeamID    uint                  `gorm:"not null" json:"-"`
+	TaskID    uint                  `gorm:"not null" json:"-"`
+	Status    taskEntity.TaskStatus `gorm:"type:varchar(20);not null" json:"-"`
+	WIPLimit  int                   `gorm:"column:wip_limit;not null" json:"-"`
+	TaskCount int                   `gorm:"not null" json:"-"`
+}
+
+// Board is the kanban board of a team: its tasks grouped into one column per status
+type Board struct {
+	Team    Team
+	Columns []BoardColumn
+}
+
+// BoardColumn is a page of the tasks of a team in one status
+// Count is the total of tasks in the status; WIPLimit is nil when the status has no limit
+type BoardColumn struct {
+	Status     taskEntity.TaskStatus
+	Count      int
+	WIPLimit   *int
+	Tasks      []taskEntity.Task
+	Limit      int
+	NextCursor *pagination.Cursor
+	PrevCursor *pagination.Cursor
 }
 
 // ListTeams contains paginated teams and total count
+// NextCursor and PrevCursor are set only for keyset (cursor) pagination
 type ListTeams struct {
 	Teams      []Team
 	TotalItems int
 	Limit      int
 	Page       int
+	NextCursor *pagination.Cursor
+	PrevCursor *pagination.Cursor
 }
 
 // BeforeCreate is a GORM hook to generate UUID v7 before creating
@@ -59,23 +114,14 @@ func (t *Team) Validate() *errors.ValidationErrors {
 
 	name := strings.TrimSpace(t.Name)
 	if name == "" {
-		errs = append(errs, errors.ValidationError{
-			Field:   "name",
-			Message: "name is required",
-		})
+		errs = append(errs, errors.NewValidationError("name", errors.CodeRequired, nil))
 	} else if len(name) > 255 {
-		errs = append(errs, errors.ValidationError{
-			Field:   "name",
-			Message: "name must not exceed 255 characters",
-		})
+		errs = append(errs, errors.NewValidationError("name", errors.CodeMaxLength, map[string]any{"max": 255}))
 	}
 
 	description := strings.TrimSpace(t.Description)
 	if description == "" {
-		errs = append(errs, errors.ValidationError{
-			Field:   "description",
-			Message: "description is required",
-		})
+		errs = append(errs, errors.NewValidationError("description", errors.CodeRequired, nil))
 	}
 
 	if len(errs) > 0 {
@@ -84,3 +130,95 @@ func (t *Team) Validate() *errors.ValidationErrors {
 
 	return nil
 }
+
+// Validate validates the statuses and limits of the WIP limits
+func (l WIPLimits) Validate() *errors.ValidationErrors {
+	var errs []errors.ValidationError
+
+	// Statuses are sorted so the errors come in a stable order
+	for _, status := range slices.Sorted(maps.Keys(l)) {
+		switch {
+		case !status.IsValid():
+			errs = append(errs, errors.NewValidationError(fmt.Sprintf("wip_limits.%s", status), errors.CodeInvalidStatus, nil))
+		case l[status] < 1:
+			errs = append(errs, errors.NewValidationError(fmt.Sprintf("wip_limits.%s", status), errors.CodeWIPLimitNotPositive, nil))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &errors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
+
+// Limit returns the WIP limit of a status, or nil when it has none
+func (l WIPLimits) Limit(status taskEntity.TaskStatus) *int {
+	limit, ok := l[status]
+	if !ok {
+		return nil
+	}
+	return &limit
+}
+
+// BeforeCreate is a GORM hook to generate UUID v7 before creating
+func (o *WIPLimitOverride) BeforeCreate(tx *gorm.DB) (err error) {
+	if o.UUID == (uuid.UUID{}) {
+		o.UUID, err = uuid.NewV7()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (o *WIPLimitOverride) AfterFind(tx *gorm.DB) (err error) {
+	if !o.CreatedAt.IsZero() {
+		o.CreatedAt = o.CreatedAt.UTC()
+	}
+	if !o.UpdatedAt.IsZero() {
+		o.UpdatedAt = o.UpdatedAt.UTC()
+	}
+	if o.DeletedAt.Valid && !o.DeletedAt.Time.IsZero() {
+		o.DeletedAt.Time = o.DeletedAt.Time.UTC()
+	}
+	return nil
+}
+
+// CheckVersion refuses a change expecting another version of the team; nil expects any version
+func (t *Team) CheckVersion(expected *int) error {
+	if expected == nil || *expected == t.Version {
+		return nil
+	}
+
+	return &errors.PreconditionFailedError{
+		Message: fmt.Sprintf("team is at version %d, not %d", t.Version, *expected),
+	}
+}
+
+// LastModified returns the latest updated_at of the team and its loaded tasks
+func (t *Team) LastModified() time.Time {
+	last := t.UpdatedAt
+	for _, task := range t.Tasks {
+		if task.UpdatedAt.After(last) {
+			last = task.UpdatedAt
+		}
+	}
+	return last
+}
+
+// HashCalendarToken returns the hex-encoded SHA-256 hash stored for a calendar feed token
+func HashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CalendarTokenMatches reports whether token grants access to the team's calendar feed
+// Always false when the feed has no token; the comparison runs in constant time
+func (t *Team) CalendarTokenMatches(token string) bool {
+	if t.CalendarTokenHash == "" || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(t.CalendarTokenHash), []byte(HashCalendarToken(token))) == 1
+}
@@ -3,9 +3,13 @@ package team
 import (
 	"strings"
 	"testing"
+	"time"
 
+	taskEntity "taskmanager/internal/entity/task"
 	errors "taskmanager/internal/platform/errors"
 	"taskmanager/internal/platform/testing/assert"
+
+	"gorm.io/gorm"
 )
 
 func TestTeam_Validate(t *testing.T) {
@@ -32,6 +36,8 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "name",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "name is required",
 					},
 				},
@@ -47,6 +53,8 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -62,6 +70,8 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "name",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "name is required",
 					},
 				},
@@ -77,6 +87,8 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -92,10 +104,14 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "name",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "name is required",
 					},
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -111,10 +127,14 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "name",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "name is required",
 					},
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -130,6 +150,8 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "name",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "name is required",
 					},
 				},
@@ -145,6 +167,8 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "description",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -160,7 +184,10 @@ func TestTeam_Validate(t *testing.T) {
 				Errors: []errors.ValidationError{
 					{
 						Field:   "name",
+						Type:    errors.TypeMaxLength,
+						Code:    errors.CodeMaxLength,
 						Message: "name must not exceed 255 characters",
+						Params:  map[string]any{"max": 255},
 					},
 				},
 			},
@@ -200,3 +227,161 @@ func TestTeam_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestHashCalendarToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{
+			"Hash calendar token",
+			"secret",
+			"2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b",
+		},
+		{
+			"Hash empty calendar token",
+			"",
+			"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HashCalendarToken(tt.token); got != tt.want {
+				t.Errorf("HashCalendarToken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTeam_CalendarTokenMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		team  *Team
+		token string
+		want  bool
+	}{
+		{
+			"Calendar token matches",
+			&Team{CalendarTokenHash: HashCalendarToken("secret")},
+			"secret",
+			true,
+		},
+		{
+			"Calendar token does not match",
+			&Team{CalendarTokenHash: HashCalendarToken("secret")},
+			"other",
+			false,
+		},
+		{
+			"Calendar token empty",
+			&Team{CalendarTokenHash: HashCalendarToken("")},
+			"",
+			false,
+		},
+		{
+			"Calendar feed without token",
+			&Team{},
+			"secret",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.team.CalendarTokenMatches(tt.token); got != tt.want {
+				t.Errorf("Team.CalendarTokenMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTeam_CheckVersion(t *testing.T) {
+	current, stale := 2, 1
+
+	tests := []struct {
+		name     string
+		expected *int
+		wantErr  error
+	}{
+		{"Check version without expectation", nil, nil},
+		{"Check the current version", &current, nil},
+		{"Check a stale version", &stale, &errors.PreconditionFailedError{Message: "team is at version 2, not 1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			team := &Team{Version: current}
+			err := team.CheckVersion(tt.expected)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Team.CheckVersion() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWIPLimits_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		limits  WIPLimits
+		wantErr *errors.ValidationErrors
+	}{
+		{
+			"Validate WIP limits with success",
+			WIPLimits{taskEntity.StatusInProgress: 3, taskEntity.StatusDone: 10},
+			nil,
+		},
+		{
+			"Validate empty WIP limits",
+			WIPLimits{},
+			nil,
+		},
+		{
+			"Validate WIP limits with invalid status and zero limit",
+			WIPLimits{"archived": 2, taskEntity.StatusInProgress: 0},
+			&errors.ValidationErrors{Errors: []errors.ValidationError{
+				{Field: "wip_limits.archived", Type: errors.TypeInvalid, Code: errors.CodeInvalidStatus, Message: "invalid status value"},
+				{Field: "wip_limits.in_progress", Type: errors.TypeMin, Code: errors.CodeWIPLimitNotPositive, Message: "wip limit must be greater than zero"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limits.Validate()
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("WIPLimits.Validate() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWIPLimits_Limit(t *testing.T) {
+	limits := WIPLimits{taskEntity.StatusInProgress: 3}
+
+	if got := limits.Limit(taskEntity.StatusInProgress); got == nil || *got != 3 {
+		t.Errorf("WIPLimits.Limit(in_progress) = %v, want 3", got)
+	}
+	if got := limits.Limit(taskEntity.StatusDone); got != nil {
+		t.Errorf("WIPLimits.Limit(done) = %v, want nil", *got)
+	}
+}
+
+func TestTeam_LastModified(t *testing.T) {
+	older := time.Date(2025, 12, 1, 18, 21, 6, 0, time.UTC)
+	newer := time.Date(2025, 12, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		team *Team
+		want time.Time
+	}{
+		{"Team without tasks", &Team{Model: gorm.Model{UpdatedAt: older}}, older},
+		{"Task updated after the team", &Team{Model: gorm.Model{UpdatedAt: older}, Tasks: []taskEntity.Task{{Model: gorm.Model{UpdatedAt: newer}}}}, newer},
+		{"Team updated after its tasks", &Team{Model: gorm.Model{UpdatedAt: newer}, Tasks: []taskEntity.Task{{Model: gorm.Model{UpdatedAt: older}}}}, newer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.team.LastModified(); !got.Equal(tt.want) {
+				t.Errorf("Team.LastModified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/errors"
+)
+
+type EventType string
+
+const (
+	EventTaskCreated           EventType = "task.created"
+	EventTaskUpdated           EventType = "task.updated"
+	EventTaskStatusChanged     EventType = "task.status_changed"
+	EventTaskDeleted           EventType = "task.deleted"
+	EventTeamTaskAssociated    EventType = "team.task_associated"
+	EventTeamTaskDisassociated EventType = "team.task_disassociated"
+)
+
+// EventTypes lists the events a subscription can receive
+var EventTypes = []EventType{
+	EventTaskCreated,
+	EventTaskUpdated,
+	EventTaskStatusChanged,
+	EventTaskDeleted,
+	EventTeamTaskAssociated,
+	EventTeamTaskDisassociated,
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+// MinSecretLength is the shortest secret accepted for signing payloads
+const MinSecretLength = 16
+
+// Subscription represents an endpoint receiving the given events
+// Secret is the HMAC key used to sign the payloads sent to URL; an empty secret is generated on creation
+type Subscription struct {
+	gorm.Model
+
+	UUID   uuid.UUID   `gorm:"type:uuid;uniqueIndex;not null" json:"-"`
+	URL    string      `gorm:"not null" json:"-"`
+	Secret string      `gorm:"not null" json:"-"`
+	Events []EventType `gorm:"type:jsonb;serializer:json;not null" json:"-"`
+}
+
+// ListSubscriptions contains paginated subscriptions and total count
+type ListSubscriptions struct {
+	Subscriptions []Subscription
+	TotalItems    int
+	Limit         int
+	Page          int
+}
+
+// Delivery represents an event sent, or to be sent, to a subscription
+// Payload holds the exact JSON body so retries and redeliveries keep the same signature input
+type Delivery struct {
+	gorm.Model
+
+	UUID           uuid.UUID      `gorm:"type:uuid;uniqueIndex;not null" json:"-"`
+	SubscriptionID uint           `gorm:"not null;index" json:"-"`
+	Subscription   *Subscription  `gorm:"foreignKey:SubscriptionID" json:"-"`
+	EventID        uuid.UUID      `gorm:"type:uuid;not null" json:"-"`
+	EventType      EventType      `gorm:"type:varchar(50);not null" json:"-"`
+	Payload        string         `gorm:"not null" json:"-"`
+	Status         DeliveryStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"-"`
+	Attempts       int            `gorm:"not null" json:"-"`
+	NextAttemptAt  *time.Time     `json:"-"`
+	LastAttemptAt  *time.Time     `json:"-"`
+	ResponseStatus int            `gorm:"not null" json:"-"`
+	LastError      string         `gorm:"not null" json:"-"`
+}
+
+// ListDeliveries contains paginated deliveries and total count
+type ListDeliveries struct {
+	Deliveries []Delivery
+	TotalItems int
+	Limit      int
+	Page       int
+}
+
+// Event is a domain event serialized for delivery
+type Event struct {
+	ID      uuid.UUID
+	Type    EventType
+	Payload string
+}
+
+// envelope is the JSON body sent to webhook receivers
+type envelope struct {
+	ID         uuid.UUID `json:"id"`
+	Type       EventType `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// TaskData is the representation of a task in event payloads
+// TeamUUID is null when the task belongs to no team
+type TaskData struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	TeamUUID    *uuid.UUID `json:"team_uuid"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	Rank        string     `json:"rank"`
+	Estimate    *int       `json:"estimate"`
+	StartedAt   *time.Time `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TaskStatusChangedData is the payload data of task.status_changed
+// WIPLimitOverridden is set when the task entered a status already at the WIP limit of its team
+type TaskStatusChangedData struct {
+	Task               TaskData `json:"task"`
+	From               string   `json:"from"`
+	To                 string   `json:"to"`
+	WIPLimitOverridden bool     `json:"wip_limit_overridden"`
+}
+
+// TaskDeletedData is the payload data of task.deleted
+type TaskDeletedData struct {
+	UUID     uuid.UUID  `json:"uuid"`
+	TeamUUID *uuid.UUID `json:"team_uuid"`
+}
+
+// TeamTaskData is the payload data of team.task_associated and team.task_disassociated
+type TeamTaskData struct {
+	TeamUUID uuid.UUID `json:"team_uuid"`
+	TaskUUID uuid.UUID `json:"task_uuid"`
+}
+
+// NewTaskData converts a task of the given team to its event payload representation
+func NewTaskData(t task.Task, teamUUID *uuid.UUID) TaskData {
+	return TaskData{
+		UUID:        t.UUID,
+		TeamUUID:    teamUUID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      string(t.Status),
+		Rank:        t.Rank,
+		Estimate:    t.Estimate,
+		StartedAt:   t.StartedAt,
+		FinishedAt:  t.FinishedAt,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+// NewEvent creates an event with a new UUID v7 and serializes its payload
+func NewEvent(eventType EventType, data any, occurredAt time.Time) (Event, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return Event{}, err
+	}
+
+	payload, err := json.Marshal(envelope{
+		ID:         id,
+		Type:       eventType,
+		OccurredAt: occurredAt.UTC(),
+		Data:       data,
+	})
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{ID: id, Type: eventType, Payload: string(payload)}, nil
+}
+
+// TeamUUID returns the team the event refers to, read from the team_uuid of its data or of its task
+// Returns nil for events of tasks without a team
+func (e Event) TeamUUID() *uuid.UUID {
+	var body struct {
+		Data struct {
+			TeamUUID *uuid.UUID `json:"team_uuid"`
+			Task     *struct {
+				TeamUUID *uuid.UUID `json:"team_uuid"`
+			} `json:"task"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(e.Payload), &body); err != nil {
+		return nil
+	}
+
+	if body.Data.TeamUUID != nil {
+		return body.Data.TeamUUID
+	}
+	if body.Data.Task != nil {
+		return body.Data.Task.TeamUUID
+	}
+	return nil
+}
+
+// IsValid reports whether the event type is one a subscription can receive
+func (e EventType) IsValid() bool {
+	for _, eventType := range EventTypes {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// TableName maps Subscription to the webhook_subscriptions table
+func (Subscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// BeforeCreate is a GORM hook to generate UUID v7 before creating
+func (s *Subscription) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.UUID == (uuid.UUID{}) {
+		s.UUID, err = uuid.NewV7()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (s *Subscription) AfterFind(tx *gorm.DB) (err error) {
+	if !s.CreatedAt.IsZero() {
+		s.CreatedAt = s.CreatedAt.UTC()
+	}
+	if !s.UpdatedAt.IsZero() {
+		s.UpdatedAt = s.UpdatedAt.UTC()
+	}
+	return nil
+}
+
+// Validate validates the subscription fields
+func (s *Subscription) Validate() *errors.ValidationErrors {
+	var errs []errors.ValidationError
+
+	if strings.TrimSpace(s.URL) == "" {
+		errs = append(errs, errors.NewValidationError("url", errors.CodeRequired, nil))
+	} else if u, err := url.Parse(strings.TrimSpace(s.URL)); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, errors.NewValidationError("url", errors.CodeInvalidFormat, nil))
+	}
+
+	if s.Secret != "" && len(s.Secret) < MinSecretLength {
+		errs = append(errs, errors.NewValidationError("secret", errors.CodeMinLength, map[string]any{"min": MinSecretLength}))
+	}
+
+	if len(s.Secret) > 255 {
+		errs = append(errs, errors.NewValidationError("secret", errors.CodeMaxLength, map[string]any{"max": 255}))
+	}
+
+	if len(s.Events) == 0 {
+		errs = append(errs, errors.NewValidationError("events", errors.CodeRequired, nil))
+	}
+
+	for i, eventType := range s.Events {
+		if !eventType.IsValid() {
+			errs = append(errs, errors.NewValidationError(fmt.Sprintf("events[%d]", i), errors.CodeInvalidEvent, nil))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &errors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
+
+// TableName maps Delivery to the webhook_deliveries table
+func (Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// BeforeCreate is a GORM hook to generate UUID v7 before creating
+func (d *Delivery) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.UUID == (uuid.UUID{}) {
+		d.UUID, err = uuid.NewV7()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFind is a GORM hook to normalize timestamps
+func (d *Delivery) AfterFind(tx *gorm.DB) (err error) {
+	if !d.CreatedAt.IsZero() {
+		d.CreatedAt = d.CreatedAt.UTC()
+	}
+	if !d.UpdatedAt.IsZero() {
+		d.UpdatedAt = d.UpdatedAt.UTC()
+	}
+	if d.NextAttemptAt != nil {
+		nextAttemptAt := d.NextAttemptAt.UTC()
+		d.NextAttemptAt = &nextAttemptAt
+	}
+	if d.LastAttemptAt != nil {
+		lastAttemptAt := d.LastAttemptAt.UTC()
+		d.LastAttemptAt = &lastAttemptAt
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	errors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestSubscription_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		subscription *Subscription
+		wantErr      *errors.ValidationErrors
+	}{
+		{
+			"Validate subscription with success",
+			&Subscription{
+				URL:    "https://example.com/hooks",
+				Secret: "whsec_0123456789abcdef",
+				Events: []EventType{EventTaskCreated, EventTeamTaskAssociated},
+			},
+			nil,
+		},
+		{
+			"Validate subscription without secret with success",
+			&Subscription{
+				URL:    "http://localhost:9000/hooks",
+				Events: []EventType{EventTaskDeleted},
+			},
+			nil,
+		},
+		{
+			"Validate subscription with empty fields",
+			&Subscription{},
+			&errors.ValidationErrors{
+				Errors: []errors.ValidationError{
+					{
+						Field:   "url",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
+						Message: "url is required",
+					},
+					{
+						Field:   "events",
+						Type:    errors.TypeRequired,
+						Code:    errors.CodeRequired,
+						Message: "events is required",
+					},
+				},
+			},
+		},
+		{
+			"Validate subscription with invalid url, short secret and unknown event",
+			&Subscription{
+				URL:    "ftp://example.com/hooks",
+				Secret: "short",
+				Events: []EventType{EventTaskCreated, "task.archived"},
+			},
+			&errors.ValidationErrors{
+				Errors: []errors.ValidationError{
+					{
+						Field:   "url",
+						Type:    errors.TypeFormat,
+						Code:    errors.CodeInvalidFormat,
+						Message: "invalid url format",
+					},
+					{
+						Field:   "secret",
+						Type:    errors.TypeMinLength,
+						Code:    errors.CodeMinLength,
+						Message: "secret must have at least 16 characters",
+						Params:  map[string]any{"min": 16},
+					},
+					{
+						Field:   "events[1]",
+						Type:    errors.TypeInvalid,
+						Code:    errors.CodeInvalidEvent,
+						Message: "invalid event type",
+					},
+				},
+			},
+		},
+		{
+			"Validate subscription with url without host and long secret",
+			&Subscription{
+				URL:    "https://",
+				Secret: strings.Repeat("s", 256),
+				Events: []EventType{EventTaskUpdated},
+			},
+			&errors.ValidationErrors{
+				Errors: []errors.ValidationError{
+					{
+						Field:   "url",
+						Type:    errors.TypeFormat,
+						Code:    errors.CodeInvalidFormat,
+						Message: "invalid url format",
+					},
+					{
+						Field:   "secret",
+						Type:    errors.TypeMaxLength,
+						Code:    errors.CodeMaxLength,
+						Message: "secret must not exceed 255 characters",
+						Params:  map[string]any{"max": 255},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.subscription.Validate()
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Subscription.Validate() error diff: %s", diff)
+				return
+			}
+		})
+	}
+}
+
+func TestEventType_IsValid(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType EventType
+		want      bool
+	}{
+		{"task.created is valid", EventTaskCreated, true},
+		{"team.task_disassociated is valid", EventTeamTaskDisassociated, true},
+		{"unknown event is invalid", "task.archived", false},
+		{"empty event is invalid", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.eventType.IsValid(); got != tt.want {
+				t.Errorf("EventType.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	occurredAt := time.Date(2025, 12, 1, 15, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	event, err := NewEvent(EventTeamTaskAssociated, TeamTaskData{
+		TeamUUID: uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+		TaskUUID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+	}, occurredAt)
+	if err != nil {
+		t.Fatalf("NewEvent() error: %v", err)
+	}
+	if event.Type != EventTeamTaskAssociated {
+		t.Errorf("NewEvent() type = %v, want %v", event.Type, EventTeamTaskAssociated)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(event.Payload), &got); err != nil {
+		t.Fatalf("NewEvent() payload is not JSON: %v", err)
+	}
+
+	want := map[string]any{
+		"id":          event.ID.String(),
+		"type":        "team.task_associated",
+		"occurred_at": "2025-12-01T18:30:00Z",
+		"data": map[string]any{
+			"team_uuid": "111e4567-e89b-12d3-a456-426614174000",
+			"task_uuid": "123e4567-e89b-12d3-a456-426614174000",
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("NewEvent() payload diff: %s", diff)
+	}
+}
+
+func TestEvent_TeamUUID(t *testing.T) {
+	teamUUID := uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+	taskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	occurredAt := time.Date(2025, 12, 1, 18, 30, 0, 0, time.UTC)
+
+	newEvent := func(eventType EventType, data any) Event {
+		event, err := NewEvent(eventType, data, occurredAt)
+		if err != nil {
+			t.Fatalf("NewEvent() error: %v", err)
+		}
+		return event
+	}
+
+	tests := []struct {
+		name  string
+		event Event
+		want  *uuid.UUID
+	}{
+		{
+			"TeamUUID of task event with team",
+			newEvent(EventTaskCreated, TaskData{UUID: taskUUID, TeamUUID: &teamUUID}),
+			&teamUUID,
+		},
+		{
+			"TeamUUID of task event without team",
+			newEvent(EventTaskUpdated, TaskData{UUID: taskUUID}),
+			nil,
+		},
+		{
+			"TeamUUID of status changed event",
+			newEvent(EventTaskStatusChanged, TaskStatusChangedData{Task: TaskData{UUID: taskUUID, TeamUUID: &teamUUID}}),
+			&teamUUID,
+		},
+		{
+			"TeamUUID of deleted event",
+			newEvent(EventTaskDeleted, TaskDeletedData{UUID: taskUUID, TeamUUID: &teamUUID}),
+			&teamUUID,
+		},
+		{
+			"TeamUUID of team event",
+			newEvent(EventTeamTaskAssociated, TeamTaskData{TeamUUID: teamUUID, TaskUUID: taskUUID}),
+			&teamUUID,
+		},
+		{
+			"TeamUUID of invalid payload",
+			Event{Payload: "not json"},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.event.TeamUUID(), tt.want); diff != "" {
+				t.Errorf("Event.TeamUUID() diff: %s", diff)
+			}
+		})
+	}
+}
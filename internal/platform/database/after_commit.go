@@ -0,0 +1,61 @@
+package database
+
+import "context"
+
+// afterCommitKey is the context key for the hooks run after the transaction commits.
+const afterCommitKey contextKey = "after_commit_hooks"
+
+// afterCommitHooks holds the functions registered while a transaction is open.
+type afterCommitHooks struct {
+	fns []func()
+}
+
+// AfterCommit registers fn to run once the transaction held by the context commits.
+// The hooks are discarded when the transaction, or the savepoint open at registration,
+// is rolled back. Without a transaction in the context the work is already committed
+// and fn runs immediately.
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks := afterCommitHooksFromContext(ctx)
+	if hooks == nil {
+		fn()
+		return
+	}
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// afterCommitHooksFromContext returns the hooks of the transaction in the context, or nil.
+func afterCommitHooksFromContext(ctx context.Context) *afterCommitHooks {
+	if ctx == nil {
+		return nil
+	}
+	hooks, _ := ctx.Value(afterCommitKey).(*afterCommitHooks)
+	return hooks
+}
+
+// runAfterCommit runs and clears the hooks registered in the context.
+func runAfterCommit(ctx context.Context) {
+	hooks := afterCommitHooksFromContext(ctx)
+	if hooks == nil {
+		return
+	}
+	fns := hooks.fns
+	hooks.fns = nil
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// discardAfterCommit drops the hooks registered in the context after the first n.
+func discardAfterCommit(ctx context.Context, n int) {
+	if hooks := afterCommitHooksFromContext(ctx); hooks != nil && len(hooks.fns) > n {
+		hooks.fns = hooks.fns[:n]
+	}
+}
+
+// afterCommitCount returns how many hooks are registered in the context.
+func afterCommitCount(ctx context.Context) int {
+	if hooks := afterCommitHooksFromContext(ctx); hooks != nil {
+		return len(hooks.fns)
+	}
+	return 0
+}
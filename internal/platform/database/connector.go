@@ -49,7 +49,12 @@ func (p *connector) Commit(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return conn.Commit().Error
+	if err := conn.Commit().Error; err != nil {
+		discardAfterCommit(ctx, 0)
+		return err
+	}
+	runAfterCommit(ctx)
+	return nil
 }
 
 func (p *connector) Rollback(ctx context.Context) error {
@@ -57,6 +62,7 @@ func (p *connector) Rollback(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	discardAfterCommit(ctx, 0)
 	return conn.Rollback().Error
 }
 
@@ -21,6 +21,7 @@ func WithDBWithoutTransaction() Option {
 }
 
 // WithDBTransaction injects the database connection into the context with a transaction.
+// Functions registered with AfterCommit run when Commit succeeds.
 func WithDBTransaction() Option {
 	return func(ctx context.Context, conn *gorm.DB) (context.Context, error) {
 		if hasDBInContext(ctx, databaseWithoutTransactionKey) {
@@ -30,6 +31,7 @@ func WithDBTransaction() Option {
 		if tx.Error != nil {
 			return nil, tx.Error
 		}
+		ctx = context.WithValue(ctx, afterCommitKey, &afterCommitHooks{})
 		return context.WithValue(ctx, databaseWithTransactionKey, tx), nil
 	}
 }
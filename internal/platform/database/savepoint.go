@@ -0,0 +1,33 @@
+package database
+
+import (
+	"context"
+	"errors"
+)
+
+// Savepoint runs fn inside a savepoint of the transaction held by the context.
+// When fn fails the transaction is rolled back to the savepoint, keeping the work
+// done before it, the AfterCommit hooks registered by fn are discarded and the error
+// of fn is returned.
+// Without a transaction in the context fn runs as is.
+func Savepoint(ctx context.Context, name string, fn func() error) error {
+	tx, err := dbFromContext(ctx, databaseWithTransactionKey)
+	if err != nil {
+		return fn()
+	}
+
+	if err := tx.SavePoint(name).Error; err != nil {
+		return err
+	}
+
+	hooks := afterCommitCount(ctx)
+	if err := fn(); err != nil {
+		discardAfterCommit(ctx, hooks)
+		if rollbackErr := tx.RollbackTo(name).Error; rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+		return err
+	}
+
+	return nil
+}
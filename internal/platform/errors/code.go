@@ -0,0 +1,160 @@
+package errors
+
+import "taskmanager/internal/platform/i18n"
+
+// Types of validation errors
+const (
+	TypeRequired  = "required"
+	TypeMaxLength = "max_length"
+	TypeMinLength = "min_length"
+	TypeMin       = "min"
+	TypeInvalid   = "invalid"
+	TypeFormat    = "format"
+	TypeNotFound  = "not_found"
+	TypeConflict  = "conflict"
+	TypeState     = "state"
+	TypeInternal  = "internal"
+)
+
+// Codes of validation errors
+// Each code has a message in every language of the i18n catalog, rendered with the field and params
+const (
+	CodeRequired             = "REQUIRED"
+	CodeRequiredSingleLine   = "REQUIRED_SINGLE_LINE"
+	CodeNeighborRequired     = "NEIGHBOR_REQUIRED"
+	CodeMaxLength            = "MAX_LENGTH"
+	CodeMinLength            = "MIN_LENGTH"
+	CodeMaxItems             = "MAX_ITEMS"
+	CodeMaxRows              = "MAX_ROWS"
+	CodeMaxPeriod            = "MAX_PERIOD"
+	CodeNegative             = "NEGATIVE"
+	CodeWIPLimitNotPositive  = "WIP_LIMIT_NOT_POSITIVE"
+	CodeWIPLimitReached      = "WIP_LIMIT_REACHED"
+	CodeNotBefore            = "NOT_BEFORE"
+	CodeInvalidValue         = "INVALID_VALUE"
+	CodeInvalidStatus        = "INVALID_STATUS"
+	CodeInvalidFormat        = "INVALID_FORMAT"
+	CodeInvalidOperation     = "INVALID_OPERATION"
+	CodeInvalidCommand       = "INVALID_COMMAND"
+	CodeInvalidEvent         = "INVALID_EVENT"
+	CodeInvalidTransition    = "INVALID_TRANSITION"
+	CodeInvalidJSON          = "INVALID_JSON"
+	CodeInvalidContent       = "INVALID_CONTENT"
+	CodeUnknownMappingField  = "UNKNOWN_MAPPING_FIELD"
+	CodeColumnNotFound       = "COLUMN_NOT_FOUND"
+	CodeNotPatchable         = "NOT_PATCHABLE"
+	CodePathNotFound         = "PATH_NOT_FOUND"
+	CodeTestFailed           = "TEST_FAILED"
+	CodeTaskNotFound         = "TASK_NOT_FOUND"
+	CodeTeamNotFound         = "TEAM_NOT_FOUND"
+	CodeMilestoneNotFound    = "MILESTONE_NOT_FOUND"
+	CodeTaskNotInTeam        = "TASK_NOT_IN_TEAM"
+	CodeTaskNotOnBoard       = "TASK_NOT_ON_BOARD"
+	CodeTaskInAnotherTeam    = "TASK_IN_ANOTHER_TEAM"
+	CodeBoardNotJoined       = "BOARD_NOT_JOINED"
+	CodeSelfNeighbor         = "SELF_NEIGHBOR"
+	CodeNeighborsOutOfOrder  = "NEIGHBORS_OUT_OF_ORDER"
+	CodeNeighborNotInColumn  = "NEIGHBOR_NOT_IN_COLUMN"
+	CodeSprintCompleted      = "SPRINT_COMPLETED"
+	CodeSprintNotPlanned     = "SPRINT_NOT_PLANNED"
+	CodeSprintNotActive      = "SPRINT_NOT_ACTIVE"
+	CodeActiveSprintExists   = "ACTIVE_SPRINT_EXISTS"
+	CodeTaskNotInSprintTeam  = "TASK_NOT_IN_SPRINT_TEAM"
+	CodeTaskNotInSprint      = "TASK_NOT_IN_SPRINT"
+	CodeTaskInAnotherProject = "TASK_IN_ANOTHER_PROJECT"
+	CodeTaskNotInProject     = "TASK_NOT_IN_PROJECT"
+	CodeIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+	CodeBadRequest           = "BAD_REQUEST"
+	CodeNotFound             = "NOT_FOUND"
+	CodeInternalError        = "INTERNAL_ERROR"
+)
+
+// codeTypes maps each code to the type of its errors
+var codeTypes = map[string]string{
+	CodeRequired:             TypeRequired,
+	CodeRequiredSingleLine:   TypeRequired,
+	CodeNeighborRequired:     TypeRequired,
+	CodeMaxLength:            TypeMaxLength,
+	CodeMinLength:            TypeMinLength,
+	CodeMaxItems:             TypeMaxLength,
+	CodeMaxRows:              TypeMaxLength,
+	CodeMaxPeriod:            TypeMaxLength,
+	CodeNegative:             TypeMin,
+	CodeWIPLimitNotPositive:  TypeMin,
+	CodeWIPLimitReached:      TypeConflict,
+	CodeNotBefore:            TypeInvalid,
+	CodeInvalidValue:         TypeInvalid,
+	CodeInvalidStatus:        TypeInvalid,
+	CodeInvalidFormat:        TypeFormat,
+	CodeInvalidOperation:     TypeInvalid,
+	CodeInvalidCommand:       TypeInvalid,
+	CodeInvalidEvent:         TypeInvalid,
+	CodeInvalidTransition:    TypeState,
+	CodeInvalidJSON:          TypeFormat,
+	CodeInvalidContent:       TypeFormat,
+	CodeUnknownMappingField:  TypeInvalid,
+	CodeColumnNotFound:       TypeNotFound,
+	CodeNotPatchable:         TypeInvalid,
+	CodePathNotFound:         TypeNotFound,
+	CodeTestFailed:           TypeInvalid,
+	CodeTaskNotFound:         TypeNotFound,
+	CodeTeamNotFound:         TypeNotFound,
+	CodeMilestoneNotFound:    TypeNotFound,
+	CodeTaskNotInTeam:        TypeState,
+	CodeTaskNotOnBoard:       TypeState,
+	CodeTaskInAnotherTeam:    TypeConflict,
+	CodeBoardNotJoined:       TypeState,
+	CodeSelfNeighbor:         TypeInvalid,
+	CodeNeighborsOutOfOrder:  TypeInvalid,
+	CodeNeighborNotInColumn:  TypeInvalid,
+	CodeSprintCompleted:      TypeState,
+	CodeSprintNotPlanned:     TypeState,
+	CodeSprintNotActive:      TypeState,
+	CodeActiveSprintExists:   TypeConflict,
+	CodeTaskNotInSprintTeam:  TypeState,
+	CodeTaskNotInSprint:      TypeState,
+	CodeTaskInAnotherProject: TypeConflict,
+	CodeTaskNotInProject:     TypeState,
+	CodeIdempotencyKeyReused: TypeConflict,
+	CodeBadRequest:           TypeInvalid,
+	CodeNotFound:             TypeNotFound,
+	CodeInternalError:        TypeInternal,
+}
+
+// NewBadRequestValidationError returns the validation error of a malformed request, for the
+// entries of the responses that report BadRequestError with the shape of ValidationErrors
+// The reason is the message of the BadRequestError, kept as is in every language
+func NewBadRequestValidationError(badReqErr *BadRequestError) ValidationError {
+	return NewValidationError(badReqErr.Field, CodeBadRequest, map[string]any{"reason": badReqErr.Message})
+}
+
+// NewValidationError returns the validation error of code for field, with the message in
+// i18n.DefaultLanguage rendered from the catalog
+func NewValidationError(field, code string, params map[string]any) ValidationError {
+	message, _ := i18n.Message(i18n.DefaultLanguage, code, field, params)
+	return ValidationError{
+		Field:   field,
+		Type:    codeTypes[code],
+		Code:    code,
+		Message: message,
+		Params:  params,
+	}
+}
+
+// Localize returns the errors with the messages of their codes in lang
+// Messages are already in i18n.DefaultLanguage, so they are kept for it, as are those of errors
+// without a catalog code
+func (v *ValidationErrors) Localize(lang string) *ValidationErrors {
+	if lang == i18n.DefaultLanguage {
+		return v
+	}
+
+	localized := make([]ValidationError, len(v.Errors))
+	for i, e := range v.Errors {
+		if message, ok := i18n.Message(lang, e.Code, e.Field, e.Params); ok {
+			e.Message = message
+		}
+		localized[i] = e
+	}
+	return &ValidationErrors{Errors: localized}
+}
@@ -0,0 +1,79 @@
+package errors
+
+import (
+	"reflect"
+	"testing"
+
+	"taskmanager/internal/platform/i18n"
+)
+
+func TestCodeTypes(t *testing.T) {
+	for code, typ := range codeTypes {
+		if typ == "" {
+			t.Errorf("code %s has no type", code)
+		}
+		if _, ok := i18n.Message(i18n.DefaultLanguage, code, "field", nil); !ok {
+			t.Errorf("code %s has no message in the catalog", code)
+		}
+	}
+}
+
+func TestNewValidationError(t *testing.T) {
+	got := NewValidationError("title", CodeMaxLength, map[string]any{"max": 255})
+	want := ValidationError{
+		Field:   "title",
+		Type:    TypeMaxLength,
+		Code:    CodeMaxLength,
+		Message: "title must not exceed 255 characters",
+		Params:  map[string]any{"max": 255},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewValidationError() = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewBadRequestValidationError(t *testing.T) {
+	got := NewBadRequestValidationError(&BadRequestError{Message: "invalid cursor", Field: "cursor"})
+	want := ValidationError{
+		Field:   "cursor",
+		Type:    TypeInvalid,
+		Code:    CodeBadRequest,
+		Message: "invalid cursor",
+		Params:  map[string]any{"reason": "invalid cursor"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewBadRequestValidationError() = %+v, want %+v", got, want)
+	}
+}
+
+func TestValidationErrors_Localize(t *testing.T) {
+	errs := &ValidationErrors{Errors: []ValidationError{
+		NewValidationError("description", CodeRequired, nil),
+		{Field: "uuid", Message: "invalid uuid format"},
+	}}
+
+	tests := []struct {
+		name string
+		lang string
+		want []string
+	}{
+		{"Localize in the default language", i18n.English, []string{"description is required", "invalid uuid format"}},
+		{"Localize in Portuguese", i18n.PortugueseBR, []string{"description é obrigatório", "invalid uuid format"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localized := errs.Localize(tt.lang)
+
+			var got []string
+			for _, e := range localized.Errors {
+				got = append(got, e.Message)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Localize() messages = %v, want %v", got, tt.want)
+			}
+			if errs.Errors[0].Message != "description is required" {
+				t.Errorf("Localize() changed the original errors")
+			}
+		})
+	}
+}
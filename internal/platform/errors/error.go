@@ -7,7 +7,9 @@ import (
 )
 
 var (
-	ErrNotFound = errors.New("not found")
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
 )
 
 // ValidationError represents a single validation error for a specific field.
@@ -44,3 +46,14 @@ type BadRequestError struct {
 func (b *BadRequestError) Error() string {
 	return fmt.Sprintf("field: %s, error: %s", b.Field, b.Message)
 }
+
+// PreconditionFailedError represents a write refused because the resource no longer
+// matches the version the client expected (If-Match)
+type PreconditionFailedError struct {
+	Message string `json:"message"`
+}
+
+// Error implements Go's error interface, returning a string with the error message.
+func (p *PreconditionFailedError) Error() string {
+	return p.Message
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	apperrors "taskmanager/internal/platform/errors"
+)
+
+// ETag formats the version of a resource as a strong entity tag
+func ETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// SetETag sets the ETag response header to the version of the resource
+func SetETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", ETag(version))
+}
+
+// ContentETag formats a digest of a response body as a strong entity tag
+func ContentETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return strconv.Quote(hex.EncodeToString(sum[:16]))
+}
+
+// SetContentETag sets the ETag response header to a digest of the response body
+func SetContentETag(w http.ResponseWriter, body []byte) {
+	w.Header().Set("ETag", ContentETag(body))
+}
+
+// SetVersionedContentETag sets the ETag response header to the version of the resource followed
+// by a digest of the response body, for representations that embed other resources.
+// If-Match compares only the version part (see IfMatchVersion)
+func SetVersionedContentETag(w http.ResponseWriter, version int, body []byte) {
+	sum := sha256.Sum256(body)
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)+"-"+hex.EncodeToString(sum[:16])))
+}
+
+// SetLastModified sets the Last-Modified response header, skipping unknown (zero) times
+func SetLastModified(w http.ResponseWriter, t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	w.Header().Set("Last-Modified", t.UTC().Format(http.TimeFormat))
+}
+
+// NotModified reports whether a GET or HEAD request can be answered with 304 Not Modified,
+// given the ETag and Last-Modified headers of the response. If-None-Match is evaluated with
+// the weak comparison and, when present, takes precedence over If-Modified-Since (RFC 9110)
+func NotModified(r *http.Request, header http.Header) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if values := r.Header.Values("If-None-Match"); len(values) > 0 {
+		etag := header.Get("ETag")
+		if etag == "" {
+			return false
+		}
+		for _, tag := range strings.Split(strings.Join(values, ","), ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	lastModified, err := http.ParseTime(header.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(since)
+}
+
+// IfMatchVersion extracts the version required by the If-Match header of the request
+// Returns nil when the header is missing or "*", which any current version matches.
+// Tags written by SetVersionedContentETag match by their version part.
+// Weak or unknown entity tags never match a version, so they return PreconditionFailedError;
+// a list of entity tags returns BadRequestError
+func IfMatchVersion(r *http.Request) (*int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	if strings.Contains(value, ",") {
+		return nil, &apperrors.BadRequestError{
+			Message: "If-Match must hold a single entity tag",
+			Field:   "If-Match",
+		}
+	}
+
+	// Only the tags written by ETag and SetVersionedContentETag match: quoted decimal versions
+	// without leading zeros, optionally followed by "-" and the digest of the representation
+	precondition := &apperrors.PreconditionFailedError{Message: "If-Match does not match the current version"}
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return nil, precondition
+	}
+
+	tag, _, _ := strings.Cut(value[1:len(value)-1], "-")
+	version, err := strconv.Atoi(tag)
+	if err != nil || strconv.Itoa(version) != tag {
+		return nil, precondition
+	}
+
+	return &version, nil
+}
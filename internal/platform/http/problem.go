@@ -0,0 +1,168 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+
+	appErrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/i18n"
+)
+
+const (
+	// ProblemContentType is the media type of error responses (RFC 9457)
+	ProblemContentType = "application/problem+json"
+
+	// LegacyErrorsPreference is the Prefer header value that opts a client back into the error
+	// bodies used before problem details
+	LegacyErrorsPreference = "legacy-errors"
+
+	// problemTypeBlank is the problem type whose meaning is that of the status code
+	problemTypeBlank = "about:blank"
+)
+
+// Problem represents an error response as RFC 9457 problem details
+// Errors carries the fields that failed validation, or the field of a bad request
+type Problem struct {
+	Type      string                      `json:"type"`
+	Title     string                      `json:"title"`
+	Status    int                         `json:"status"`
+	Detail    string                      `json:"detail,omitempty"`
+	Instance  string                      `json:"instance,omitempty"`
+	RequestID string                      `json:"request_id,omitempty"`
+	Errors    []appErrors.ValidationError `json:"errors,omitempty"`
+}
+
+// NewProblem returns problem details for the status code with the given detail, which may be empty
+func NewProblem(statusCode int, detail string) *Problem {
+	return &Problem{
+		Type:   problemTypeBlank,
+		Title:  http.StatusText(statusCode),
+		Status: statusCode,
+		Detail: detail,
+	}
+}
+
+// problemFromError maps an error returned by a use case to problem details
+// Unknown errors become a 500 without detail, so internal messages are not exposed
+func problemFromError(err error) *Problem {
+	if validErr, ok := err.(*appErrors.ValidationErrors); ok {
+		p := NewProblem(http.StatusUnprocessableEntity, validErr.Error())
+		p.Errors = validErr.Errors
+		return p
+	}
+	if errors.Is(err, appErrors.ErrNotFound) {
+		return NewProblem(http.StatusNotFound, "")
+	}
+	if errors.Is(err, appErrors.ErrUnauthorized) {
+		return NewProblem(http.StatusUnauthorized, "")
+	}
+	if errors.Is(err, appErrors.ErrForbidden) {
+		return NewProblem(http.StatusForbidden, "")
+	}
+	if badReqErr, ok := err.(*appErrors.BadRequestError); ok {
+		return badRequestProblem(badReqErr.Message, badReqErr.Field)
+	}
+	if preconditionErr, ok := err.(*appErrors.PreconditionFailedError); ok {
+		return NewProblem(http.StatusPreconditionFailed, preconditionErr.Message)
+	}
+	return NewProblem(http.StatusInternalServerError, "")
+}
+
+// badRequestProblem returns 400 problem details, with the field in errors when there is one
+func badRequestProblem(message, field string) *Problem {
+	p := NewProblem(http.StatusBadRequest, message)
+	if field != "" {
+		p.Errors = []appErrors.ValidationError{appErrors.NewBadRequestValidationError(&appErrors.BadRequestError{Message: message, Field: field})}
+	}
+	return p
+}
+
+// legacy returns the error body used before problem details: ValidationErrors for 422,
+// BadRequestError for 400, a message for other errors with a detail and null otherwise
+func (p *Problem) legacy() any {
+	switch {
+	case p.Status == http.StatusUnprocessableEntity:
+		return appErrors.ValidationErrors{Errors: p.Errors}
+	case p.Status == http.StatusBadRequest:
+		resp := appErrors.BadRequestError{Message: p.Detail}
+		if len(p.Errors) > 0 {
+			resp.Field = p.Errors[0].Field
+		}
+		return resp
+	case p.Detail != "":
+		return map[string]string{"message": p.Detail}
+	}
+	return nil
+}
+
+// localize renders the messages of the validation errors in lang, and the detail from them
+func (p *Problem) localize(lang string) {
+	localized := (&appErrors.ValidationErrors{Errors: p.Errors}).Localize(lang)
+	p.Errors = localized.Errors
+	p.Detail = localized.Error()
+}
+
+// WriteResponse writes the status code and body returned by a handler as the response to r
+// Error responses are sent as problem details completed with the instance and request ID, or
+// in the legacy shape when the request has Prefer: legacy-errors. Messages of the errors are
+// rendered in the language negotiated from Accept-Language
+func WriteResponse(w http.ResponseWriter, r *http.Request, statusCode int, body []byte) {
+	if statusCode < http.StatusBadRequest {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		w.Write(body)
+		return
+	}
+
+	var p Problem
+	isProblem := json.Unmarshal(body, &p) == nil && p.Type != "" && p.Status == statusCode
+	if isProblem && p.Status == http.StatusUnprocessableEntity {
+		lang := i18n.Negotiate(r.Header.Get("Accept-Language"))
+		p.localize(lang)
+		w.Header().Set("Content-Language", lang)
+		w.Header().Add("Vary", "Accept-Language")
+	}
+
+	if prefersLegacyErrors(r) {
+		if isProblem {
+			body, _ = json.Marshal(p.legacy())
+		}
+		w.Header().Set("Preference-Applied", LegacyErrorsPreference)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		w.Write(body)
+		return
+	}
+
+	if !isProblem {
+		p = *NewProblem(statusCode, "")
+	}
+	p.Instance = r.URL.Path
+	p.RequestID = chimw.GetReqID(r.Context())
+
+	body, err := json.Marshal(p)
+	if err != nil {
+		statusCode, body = http.StatusInternalServerError, []byte{}
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
+
+// prefersLegacyErrors reports whether the Prefer header of r asks for legacy error bodies
+func prefersLegacyErrors(r *http.Request) bool {
+	for _, value := range r.Header.Values("Prefer") {
+		for _, preference := range strings.Split(value, ",") {
+			token, _, _ := strings.Cut(preference, ";")
+			if strings.EqualFold(strings.TrimSpace(token), LegacyErrorsPreference) {
+				return true
+			}
+		}
+	}
+	return false
+}
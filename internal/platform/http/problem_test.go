@@ -0,0 +1,176 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+
+	appErrors "taskmanager/internal/platform/errors"
+)
+
+func TestWriteResponse(t *testing.T) {
+	validationErr := &appErrors.ValidationErrors{Errors: []appErrors.ValidationError{
+		appErrors.NewValidationError("title", appErrors.CodeRequired, nil),
+		appErrors.NewValidationError("title", appErrors.CodeMaxLength, map[string]any{"max": 255}),
+		{Field: "rows", Message: "rows must be uploaded first"},
+	}}
+
+	tests := []struct {
+		name            string
+		prefer          string
+		acceptLanguage  string
+		response        func() (int, []byte)
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			"WriteResponse with success",
+			"",
+			"",
+			func() (int, []byte) { return HandleErrorResponse(nil, map[string]string{"uuid": "1"}) },
+			http.StatusOK,
+			"application/json",
+			`{"uuid":"1"}`,
+		},
+		{
+			"WriteResponse with validation errors",
+			"",
+			"",
+			func() (int, []byte) { return HandleErrorResponse(validationErr, nil) },
+			http.StatusUnprocessableEntity,
+			ProblemContentType,
+			`{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"title is required;title must not exceed 255 characters;rows must be uploaded first","instance":"/api/tasks","request_id":"req-1","errors":[{"field":"title","type":"required","code":"REQUIRED","message":"title is required"},{"field":"title","type":"max_length","code":"MAX_LENGTH","message":"title must not exceed 255 characters","params":{"max":255}},{"field":"rows","type":"","code":"","message":"rows must be uploaded first"}]}`,
+		},
+		{
+			"WriteResponse with a bad request field",
+			"",
+			"",
+			func() (int, []byte) { return BadRequest("invalid uuid format", "uuid") },
+			http.StatusBadRequest,
+			ProblemContentType,
+			`{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid uuid format","instance":"/api/tasks","request_id":"req-1","errors":[{"field":"uuid","type":"invalid","code":"BAD_REQUEST","message":"invalid uuid format","params":{"reason":"invalid uuid format"}}]}`,
+		},
+		{
+			"WriteResponse with an internal error",
+			"",
+			"",
+			func() (int, []byte) { return HandleErrorResponse(context.Canceled, nil) },
+			http.StatusInternalServerError,
+			ProblemContentType,
+			`{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/api/tasks","request_id":"req-1"}`,
+		},
+		{
+			"WriteResponse with an error without body",
+			"",
+			"",
+			func() (int, []byte) { return http.StatusMethodNotAllowed, nil },
+			http.StatusMethodNotAllowed,
+			ProblemContentType,
+			`{"type":"about:blank","title":"Method Not Allowed","status":405,"instance":"/api/tasks","request_id":"req-1"}`,
+		},
+		{
+			"WriteResponse with legacy validation errors",
+			"legacy-errors",
+			"",
+			func() (int, []byte) { return HandleErrorResponse(validationErr, nil) },
+			http.StatusUnprocessableEntity,
+			"application/json",
+			`{"errors":[{"field":"title","type":"required","code":"REQUIRED","message":"title is required"},{"field":"title","type":"max_length","code":"MAX_LENGTH","message":"title must not exceed 255 characters","params":{"max":255}},{"field":"rows","type":"","code":"","message":"rows must be uploaded first"}]}`,
+		},
+		{
+			"WriteResponse with a legacy bad request",
+			"respond-async, Legacy-Errors",
+			"",
+			func() (int, []byte) {
+				return HandleErrorResponse(&appErrors.BadRequestError{Message: "invalid JSON syntax"}, nil)
+			},
+			http.StatusBadRequest,
+			"application/json",
+			`{"message":"invalid JSON syntax"}`,
+		},
+		{
+			"WriteResponse with a legacy precondition failure",
+			"legacy-errors",
+			"",
+			func() (int, []byte) {
+				return HandleErrorResponse(&appErrors.PreconditionFailedError{Message: "task is at version 2, not 1"}, nil)
+			},
+			http.StatusPreconditionFailed,
+			"application/json",
+			`{"message":"task is at version 2, not 1"}`,
+		},
+		{
+			"WriteResponse with a legacy not found",
+			"legacy-errors",
+			"",
+			func() (int, []byte) { return HandleErrorResponse(appErrors.ErrNotFound, nil) },
+			http.StatusNotFound,
+			"application/json",
+			`null`,
+		},
+		{
+			"WriteResponse with validation errors in Portuguese",
+			"",
+			"en;q=0.5, pt-BR",
+			func() (int, []byte) { return HandleErrorResponse(validationErr, nil) },
+			http.StatusUnprocessableEntity,
+			ProblemContentType,
+			`{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"title é obrigatório;title não pode exceder 255 caracteres;rows must be uploaded first","instance":"/api/tasks","request_id":"req-1","errors":[{"field":"title","type":"required","code":"REQUIRED","message":"title é obrigatório"},{"field":"title","type":"max_length","code":"MAX_LENGTH","message":"title não pode exceder 255 caracteres","params":{"max":255}},{"field":"rows","type":"","code":"","message":"rows must be uploaded first"}]}`,
+		},
+		{
+			"WriteResponse with a bad request in Portuguese",
+			"",
+			"pt-BR",
+			func() (int, []byte) { return BadRequest("invalid uuid format", "uuid") },
+			http.StatusBadRequest,
+			ProblemContentType,
+			`{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid uuid format","instance":"/api/tasks","request_id":"req-1","errors":[{"field":"uuid","type":"invalid","code":"BAD_REQUEST","message":"invalid uuid format","params":{"reason":"invalid uuid format"}}]}`,
+		},
+		{
+			"WriteResponse with legacy validation errors in Portuguese",
+			"legacy-errors",
+			"pt",
+			func() (int, []byte) {
+				return HandleErrorResponse(&appErrors.ValidationErrors{Errors: []appErrors.ValidationError{
+					appErrors.NewValidationError("status", appErrors.CodeInvalidTransition, nil),
+				}}, nil)
+			},
+			http.StatusUnprocessableEntity,
+			"application/json",
+			`{"errors":[{"field":"status","type":"state","code":"INVALID_TRANSITION","message":"transição de status inválida"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/api/tasks?page=1", nil)
+			r = r.WithContext(context.WithValue(r.Context(), chimw.RequestIDKey, "req-1"))
+			if tt.prefer != "" {
+				r.Header.Set("Prefer", tt.prefer)
+			}
+			if tt.acceptLanguage != "" {
+				r.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+
+			statusCode, body := tt.response()
+			WriteResponse(w, r, statusCode, body)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("WriteResponse() status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("WriteResponse() Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+			if got := w.Body.String(); got != tt.wantBody {
+				t.Errorf("WriteResponse() body = %s, want %s", got, tt.wantBody)
+			}
+			if applied := w.Header().Get("Preference-Applied"); (applied != "") != (tt.prefer != "") {
+				t.Errorf("WriteResponse() Preference-Applied = %q", applied)
+			}
+		})
+	}
+}
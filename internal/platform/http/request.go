@@ -4,9 +4,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 
 	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
+	"taskmanager/internal/platform/patch"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 // DecodeJSONBody decodes JSON request body into the provided struct
@@ -39,7 +46,85 @@ func DecodeJSONBody(r *http.Request, v interface{}) error {
 	return err
 }
 
+// DecodePatchBody decodes the request body as a patch of the media type given by Content-Type
+// Returns BadRequestError for malformed patches
+// Returns other errors for internal server errors
+func DecodePatchBody(r *http.Request) (patch.Patch, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return patch.Parse(r.Header.Get("Content-Type"), body)
+}
+
 // QueryParam extracts a query parameter value by key from the request
 func QueryParam(r *http.Request, key string) string {
 	return r.URL.Query().Get(key)
 }
+
+// URLParamUUID extracts a UUID path parameter by key from the request
+// The format is validated against the OpenAPI document by middleware.ValidateRequest before the
+// handler runs; returns uuid.Nil when the parameter is not a UUID
+func URLParamUUID(r *http.Request, key string) uuid.UUID {
+	id, err := uuid.Parse(chi.URLParam(r, key))
+	if err != nil {
+		return uuid.Nil
+	}
+	return id
+}
+
+// PaginationParams extracts the page and limit query parameters from the request
+// Both are validated as positive integers by middleware.ValidateRequest before the handler runs
+// Returns page 1 when page is missing, and limit 0 (use default) when limit is missing
+func PaginationParams(r *http.Request) (page, limit int) {
+	page = 1
+	if pageParam := QueryParam(r, "page"); pageParam != "" {
+		page, _ = strconv.Atoi(pageParam)
+	}
+
+	if limitParam := QueryParam(r, "limit"); limitParam != "" {
+		limit, _ = strconv.Atoi(limitParam)
+	}
+
+	return page, limit
+}
+
+// CursorQuery holds the keyset pagination parameters of a list request
+type CursorQuery struct {
+	Enabled   bool
+	Cursor    *pagination.Cursor
+	WithTotal bool
+}
+
+// CursorParams extracts the keyset pagination parameters from the request
+// Cursor mode is enabled by pagination=cursor or by a non-empty cursor parameter
+// include_total defaults to true; returns BadRequestError for malformed values
+func CursorParams(r *http.Request) (CursorQuery, error) {
+	query := CursorQuery{WithTotal: true}
+
+	cursorParam := QueryParam(r, "cursor")
+	query.Enabled = QueryParam(r, "pagination") == "cursor" || cursorParam != ""
+	if !query.Enabled {
+		return query, nil
+	}
+
+	cursor, err := pagination.DecodeCursor(cursorParam)
+	if err != nil {
+		return query, err
+	}
+	query.Cursor = cursor
+
+	if includeTotalParam := QueryParam(r, "include_total"); includeTotalParam != "" {
+		withTotal, err := strconv.ParseBool(includeTotalParam)
+		if err != nil {
+			return query, &apperrors.BadRequestError{
+				Message: "invalid include_total value",
+				Field:   "include_total",
+			}
+		}
+		query.WithTotal = withTotal
+	}
+
+	return query, nil
+}
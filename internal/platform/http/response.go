@@ -2,10 +2,7 @@ package http
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
-
-	appErrors "taskmanager/internal/platform/errors"
 )
 
 // writeResponse marshals a response object and returns HTTP status code and body
@@ -18,29 +15,31 @@ func writeResponse(statusCode int, v interface{}) (int, []byte) {
 }
 
 // HandleErrorResponse handles errors and responses, returning appropriate HTTP status code and body
+// Errors are returned as problem details, completed by WriteResponse
 func HandleErrorResponse(err error, resp any) (int, []byte) {
 	if err == nil {
 		return writeResponse(http.StatusOK, resp)
 	}
-	if validErr, ok := err.(*appErrors.ValidationErrors); ok {
-		return writeResponse(http.StatusUnprocessableEntity, validErr)
-	}
-	if errors.Is(err, appErrors.ErrNotFound) {
-		return writeResponse(http.StatusNotFound, nil)
-	}
-	if badReqErr, ok := err.(*appErrors.BadRequestError); ok {
-		return writeResponse(http.StatusBadRequest, badReqErr)
-	}
-	return writeResponse(http.StatusInternalServerError, nil)
+	p := problemFromError(err)
+	return writeResponse(p.Status, p)
+}
+
+// Accepted returns an accepted response for work that is processed asynchronously
+func Accepted(resp any) (int, []byte) {
+	return writeResponse(http.StatusAccepted, resp)
 }
 
 // BadRequest returns a bad request error response with HTTP status code and body
 func BadRequest(message, field string) (int, []byte) {
-	resp := appErrors.BadRequestError{
-		Message: message,
-	}
-	if field != "" {
-		resp.Field = field
-	}
-	return writeResponse(http.StatusBadRequest, resp)
+	return writeResponse(http.StatusBadRequest, badRequestProblem(message, field))
+}
+
+// Conflict returns a conflict error response with HTTP status code and body
+func Conflict(message string) (int, []byte) {
+	return writeResponse(http.StatusConflict, NewProblem(http.StatusConflict, message))
+}
+
+// UnsupportedMediaType returns an unsupported media type error response with HTTP status code and body
+func UnsupportedMediaType(message string) (int, []byte) {
+	return writeResponse(http.StatusUnsupportedMediaType, NewProblem(http.StatusUnsupportedMediaType, message))
 }
@@ -0,0 +1,106 @@
+package i18n
+
+// catalog holds the message templates of the validation error codes by language
+// Templates reference the field as {field} and params by name, as {max}
+var catalog = map[string]map[string]string{
+	English: {
+		"REQUIRED":                "{field} is required",
+		"REQUIRED_SINGLE_LINE":    "{field} is required and must be a single line",
+		"NEIGHBOR_REQUIRED":       "previous_uuid or next_uuid is required",
+		"MAX_LENGTH":              "{field} must not exceed {max} characters",
+		"MIN_LENGTH":              "{field} must have at least {min} characters",
+		"MAX_ITEMS":               "{field} must not exceed {max} items",
+		"MAX_ROWS":                "{field} must not exceed {max} rows",
+		"MAX_PERIOD":              "period must not exceed {max} days",
+		"NEGATIVE":                "{field} must not be negative",
+		"WIP_LIMIT_NOT_POSITIVE":  "wip limit must be greater than zero",
+		"WIP_LIMIT_REACHED":       "status {status} has reached its wip limit of {limit} tasks",
+		"NOT_BEFORE":              "{field} must not be before {other}",
+		"INVALID_VALUE":           "invalid {field} value",
+		"INVALID_STATUS":          "invalid status value",
+		"INVALID_FORMAT":          "invalid {field} format",
+		"INVALID_OPERATION":       "invalid operation type",
+		"INVALID_COMMAND":         "invalid command type",
+		"INVALID_EVENT":           "invalid event type",
+		"INVALID_TRANSITION":      "invalid status transition",
+		"INVALID_JSON":            "invalid JSON object",
+		"INVALID_CONTENT":         "invalid content: {reason}",
+		"UNKNOWN_MAPPING_FIELD":   "unknown mapping field",
+		"COLUMN_NOT_FOUND":        `column "{column}" not found`,
+		"NOT_PATCHABLE":           "{field} cannot be patched",
+		"PATH_NOT_FOUND":          "path does not exist",
+		"TEST_FAILED":             "test operation failed",
+		"TASK_NOT_FOUND":          "task not found",
+		"TEAM_NOT_FOUND":          "team not found",
+		"MILESTONE_NOT_FOUND":     "milestone not found in the project",
+		"TASK_NOT_IN_TEAM":        "task is not associated with this team",
+		"TASK_NOT_ON_BOARD":       "task does not belong to the team",
+		"TASK_IN_ANOTHER_TEAM":    "task is already associated with another team",
+		"BOARD_NOT_JOINED":        "team board not joined",
+		"SELF_NEIGHBOR":           "task cannot be its own neighbor",
+		"NEIGHBORS_OUT_OF_ORDER":  "previous task must sort before next task",
+		"NEIGHBOR_NOT_IN_COLUMN":  "task must be in the same team and status",
+		"SPRINT_COMPLETED":        "sprint is already completed",
+		"SPRINT_NOT_PLANNED":      "only a planned sprint can be started",
+		"SPRINT_NOT_ACTIVE":       "only an active sprint can be completed",
+		"ACTIVE_SPRINT_EXISTS":    "team already has an active sprint",
+		"TASK_NOT_IN_SPRINT_TEAM": "task is not associated with the sprint team",
+		"TASK_NOT_IN_SPRINT":      "task is not in this sprint",
+		"TASK_IN_ANOTHER_PROJECT": "task already belongs to another project",
+		"TASK_NOT_IN_PROJECT":     "task is not in this project",
+		"IDEMPOTENCY_KEY_REUSED":  "Idempotency-Key was already used for a different request",
+		"BAD_REQUEST":             "{reason}",
+		"NOT_FOUND":               "not found",
+		"INTERNAL_ERROR":          "internal server error",
+	},
+	PortugueseBR: {
+		"REQUIRED":                "{field} é obrigatório",
+		"REQUIRED_SINGLE_LINE":    "{field} é obrigatório e deve ter uma única linha",
+		"NEIGHBOR_REQUIRED":       "previous_uuid ou next_uuid é obrigatório",
+		"MAX_LENGTH":              "{field} não pode exceder {max} caracteres",
+		"MIN_LENGTH":              "{field} deve ter pelo menos {min} caracteres",
+		"MAX_ITEMS":               "{field} não pode exceder {max} itens",
+		"MAX_ROWS":                "{field} não pode exceder {max} linhas",
+		"MAX_PERIOD":              "o período não pode exceder {max} dias",
+		"NEGATIVE":                "{field} não pode ser negativo",
+		"WIP_LIMIT_NOT_POSITIVE":  "o limite de WIP deve ser maior que zero",
+		"WIP_LIMIT_REACHED":       "o status {status} atingiu seu limite de WIP de {limit} tasks",
+		"NOT_BEFORE":              "{field} não pode ser anterior a {other}",
+		"INVALID_VALUE":           "valor inválido para {field}",
+		"INVALID_STATUS":          "valor inválido para status",
+		"INVALID_FORMAT":          "formato inválido para {field}",
+		"INVALID_OPERATION":       "tipo de operação inválido",
+		"INVALID_COMMAND":         "tipo de comando inválido",
+		"INVALID_EVENT":           "tipo de evento inválido",
+		"INVALID_TRANSITION":      "transição de status inválida",
+		"INVALID_JSON":            "objeto JSON inválido",
+		"INVALID_CONTENT":         "conteúdo inválido: {reason}",
+		"UNKNOWN_MAPPING_FIELD":   "campo de mapeamento desconhecido",
+		"COLUMN_NOT_FOUND":        `coluna "{column}" não encontrada`,
+		"NOT_PATCHABLE":           "{field} não pode ser alterado",
+		"PATH_NOT_FOUND":          "o caminho não existe",
+		"TEST_FAILED":             "a operação test falhou",
+		"TASK_NOT_FOUND":          "task não encontrada",
+		"TEAM_NOT_FOUND":          "time não encontrado",
+		"MILESTONE_NOT_FOUND":     "marco não encontrado no projeto",
+		"TASK_NOT_IN_TEAM":        "a task não está associada a este time",
+		"TASK_NOT_ON_BOARD":       "a task não pertence ao time",
+		"TASK_IN_ANOTHER_TEAM":    "a task já está associada a outro time",
+		"BOARD_NOT_JOINED":        "o quadro do time não foi acessado com join",
+		"SELF_NEIGHBOR":           "a task não pode ser vizinha de si mesma",
+		"NEIGHBORS_OUT_OF_ORDER":  "a task anterior deve vir antes da próxima",
+		"NEIGHBOR_NOT_IN_COLUMN":  "a task deve estar no mesmo time e status",
+		"SPRINT_COMPLETED":        "a sprint já foi concluída",
+		"SPRINT_NOT_PLANNED":      "só uma sprint planejada pode ser iniciada",
+		"SPRINT_NOT_ACTIVE":       "só uma sprint ativa pode ser concluída",
+		"ACTIVE_SPRINT_EXISTS":    "o time já tem uma sprint ativa",
+		"TASK_NOT_IN_SPRINT_TEAM": "a task não está associada ao time da sprint",
+		"TASK_NOT_IN_SPRINT":      "a task não está nesta sprint",
+		"TASK_IN_ANOTHER_PROJECT": "a task já pertence a outro projeto",
+		"TASK_NOT_IN_PROJECT":     "a task não está neste projeto",
+		"IDEMPOTENCY_KEY_REUSED":  "Idempotency-Key já foi usada em outra requisição",
+		"BAD_REQUEST":             "{reason}",
+		"NOT_FOUND":               "não encontrado",
+		"INTERNAL_ERROR":          "erro interno do servidor",
+	},
+}
@@ -0,0 +1,100 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Languages of the message catalog
+const (
+	English      = "en"
+	PortugueseBR = "pt-BR"
+
+	// DefaultLanguage is the language of the messages set by the domain and the fallback of Negotiate
+	DefaultLanguage = English
+)
+
+// Message renders the catalog message of code in lang, replacing {field} with field and {name} with
+// params["name"]
+// Falls back to DefaultLanguage when lang has no message for code; ok is false when neither has one
+func Message(lang, code, field string, params map[string]any) (message string, ok bool) {
+	template, ok := catalog[lang][code]
+	if !ok {
+		template, ok = catalog[DefaultLanguage][code]
+		if !ok {
+			return "", false
+		}
+	}
+
+	replacements := []string{"{field}", field}
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(replacements...).Replace(template), true
+}
+
+// Negotiate picks the catalog language that best matches an Accept-Language header (RFC 9110)
+// Tags match exactly or by their primary subtag (pt-PT and pt match pt-BR); ties keep the header
+// order and no match falls back to DefaultLanguage
+func Negotiate(acceptLanguage string) string {
+	type candidate struct {
+		lang    string
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, item := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(item, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			q, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = q
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		if lang, ok := match(tag); ok {
+			candidates = append(candidates, candidate{lang, quality})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	if len(candidates) == 0 {
+		return DefaultLanguage
+	}
+	return candidates[0].lang
+}
+
+// match returns the catalog language of a language tag; * matches DefaultLanguage
+func match(tag string) (string, bool) {
+	if tag == "*" {
+		return DefaultLanguage, true
+	}
+
+	primary, _, _ := strings.Cut(tag, "-")
+	for lang := range catalog {
+		if strings.EqualFold(lang, tag) {
+			return lang, true
+		}
+	}
+	for lang := range catalog {
+		langPrimary, _, _ := strings.Cut(lang, "-")
+		if strings.EqualFold(langPrimary, primary) {
+			return lang, true
+		}
+	}
+	return "", false
+}
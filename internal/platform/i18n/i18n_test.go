@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           string
+	}{
+		{"Negotiate without header", "", English},
+		{"Negotiate exact tag", "pt-BR", PortugueseBR},
+		{"Negotiate tag case-insensitively", "PT-br", PortugueseBR},
+		{"Negotiate by primary subtag", "pt-PT", PortugueseBR},
+		{"Negotiate primary subtag alone", "pt", PortugueseBR},
+		{"Negotiate regional English", "en-US,en;q=0.9", English},
+		{"Negotiate highest quality", "en;q=0.4, pt-BR;q=0.8", PortugueseBR},
+		{"Negotiate keeps header order on ties", "en, pt-BR", English},
+		{"Negotiate skips unsupported languages", "fr-FR, de;q=0.9, pt;q=0.1", PortugueseBR},
+		{"Negotiate refuses quality zero", "pt-BR;q=0", English},
+		{"Negotiate skips invalid quality", "pt-BR;q=high, en;q=0.1", English},
+		{"Negotiate wildcard", "fr, *;q=0.5", English},
+		{"Negotiate only unsupported languages", "fr, de", English},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Negotiate(tt.acceptLanguage); got != tt.want {
+				t.Errorf("Negotiate(%q) = %q, want %q", tt.acceptLanguage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		lang   string
+		code   string
+		field  string
+		params map[string]any
+		want   string
+		wantOk bool
+	}{
+		{"Message with field", English, "REQUIRED", "title", nil, "title is required", true},
+		{"Message with field and params", PortugueseBR, "MAX_LENGTH", "name", map[string]any{"max": 255}, "name não pode exceder 255 caracteres", true},
+		{"Message with params decoded from JSON", PortugueseBR, "WIP_LIMIT_REACHED", "status", map[string]any{"status": "in_progress", "limit": float64(3), "count": float64(3)}, "o status in_progress atingiu seu limite de WIP de 3 tasks", true},
+		{"Message of unknown language falls back to English", "fr", "TASK_NOT_FOUND", "task", nil, "task not found", true},
+		{"Message of unknown code", PortugueseBR, "UNKNOWN", "task", nil, "", false},
+		{"Message without code", PortugueseBR, "", "task", nil, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Message(tt.lang, tt.code, tt.field, tt.params)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Message() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	placeholder := regexp.MustCompile(`\{\w+\}`)
+
+	for lang, messages := range catalog {
+		for code, template := range catalog[DefaultLanguage] {
+			translation, ok := messages[code]
+			if !ok {
+				t.Errorf("catalog[%q] has no message for %s", lang, code)
+				continue
+			}
+
+			want := placeholder.FindAllString(template, -1)
+			got := placeholder.FindAllString(translation, -1)
+			slices.Sort(want)
+			slices.Sort(got)
+			if !slices.Equal(got, want) {
+				t.Errorf("catalog[%q][%s] has placeholders %v, want %v", lang, code, got, want)
+			}
+		}
+		if len(messages) != len(catalog[DefaultLanguage]) {
+			t.Errorf("catalog[%q] has %d messages, want %d", lang, len(messages), len(catalog[DefaultLanguage]))
+		}
+	}
+}
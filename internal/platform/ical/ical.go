@@ -0,0 +1,91 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the maximum length of a content line before folding (RFC 5545 section 3.1)
+const maxLineOctets = 75
+
+// dateTimeFormat is the UTC date-time form of RFC 5545 section 3.3.5
+const dateTimeFormat = "20060102T150405Z"
+
+// Calendar is an iCalendar object (VCALENDAR) with its components
+type Calendar struct {
+	ProdID     string
+	Properties []Property
+	Components []Component
+}
+
+// Component is a calendar component such as VEVENT or VTODO
+type Component struct {
+	Name       string
+	Properties []Property
+}
+
+// Property is a content line; Value must already be encoded for its type
+type Property struct {
+	Name  string
+	Value string
+}
+
+// Text returns a TEXT property, escaping backslashes, semicolons, commas and line breaks
+func Text(name, value string) Property {
+	return Property{Name: name, Value: textEscaper.Replace(value)}
+}
+
+// DateTime returns a DATE-TIME property in UTC
+func DateTime(name string, t time.Time) Property {
+	return Property{Name: name, Value: t.UTC().Format(dateTimeFormat)}
+}
+
+// Raw returns a property whose value is written as is
+func Raw(name, value string) Property {
+	return Property{Name: name, Value: value}
+}
+
+// textEscaper escapes TEXT values (RFC 5545 section 3.3.11)
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// Marshal encodes the calendar with CRLF line endings and folded lines
+func (c Calendar) Marshal() []byte {
+	var buf bytes.Buffer
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+c.ProdID)
+	for _, p := range c.Properties {
+		writeLine(&buf, p.Name+":"+p.Value)
+	}
+	for _, component := range c.Components {
+		writeLine(&buf, "BEGIN:"+component.Name)
+		for _, p := range component.Properties {
+			writeLine(&buf, p.Name+":"+p.Value)
+		}
+		writeLine(&buf, "END:"+component.Name)
+	}
+	writeLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+// writeLine writes a content line folded at maxLineOctets without splitting UTF-8 characters
+// Continuation lines start with a single space, which counts towards their length
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
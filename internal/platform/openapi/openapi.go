@@ -0,0 +1,251 @@
+package openapi
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Version is the OpenAPI version of the documents built by Build
+const Version = "3.1.0"
+
+// JSONContentType is the default media type of request and response bodies
+const JSONContentType = "application/json"
+
+// pathParamPattern matches the {name} path parameters of a route pattern
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+	Tags       []Tag               `json:"tags,omitempty"`
+}
+
+// Info describes the API of a document
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Tag groups the operations of a resource
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// PathItem holds the operations of a path by lower-case HTTP method
+type PathItem map[string]*Operation
+
+// Operation describes a route of the API
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is a path, query or header parameter of an operation
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request by media type
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response describes a response of an operation
+type Response struct {
+	Description string               `json:"description"`
+	Headers     map[string]Header    `json:"headers,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// Header describes a header of a response
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// MediaType holds the schema of a body in a media type
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas referenced by the document
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Route is the code-first annotation of an API route the document is built from
+// Path is the chi pattern of the route, whose {name} segments are documented as required path
+// parameters. Request and Response are values of the body types, nil for routes without a body;
+// bodies default to JSON and the status to 200
+type Route struct {
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+	Tag         string
+
+	Parameters         []Parameter
+	Request            any
+	RequestMediaTypes  []string
+	Status             int
+	Response           any
+	ResponseMediaTypes []string
+	ResponseHeaders    map[string]string
+	Errors             []int
+}
+
+// OneOf is a response body of one of several types, such as the page of a list in either pagination mode
+type OneOf []any
+
+// Spec holds what a document is built from
+// Path parameters are strings in PathFormat, and every operation answers its Errors with ErrorBody
+// in ErrorMediaType
+type Spec struct {
+	Info           Info
+	PathFormat     string
+	ErrorBody      any
+	ErrorMediaType string
+	Routes         []Route
+}
+
+// Build returns the document of the routes of spec
+// Schemas of named structs are generated by reflection into the components (see Generator)
+func (s Spec) Build() *Document {
+	g := NewGenerator()
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    s.Info,
+		Paths:   map[string]PathItem{},
+	}
+
+	tags := map[string]bool{}
+	for _, route := range s.Routes {
+		item, ok := doc.Paths[route.Path]
+		if !ok {
+			item = PathItem{}
+			doc.Paths[route.Path] = item
+		}
+		item[strings.ToLower(route.Method)] = s.operation(g, route)
+
+		if route.Tag != "" && !tags[route.Tag] {
+			tags[route.Tag] = true
+			doc.Tags = append(doc.Tags, Tag{Name: route.Tag})
+		}
+	}
+
+	doc.Components.Schemas = g.Components()
+	return doc
+}
+
+// operation returns the operation of route, generating the schemas of its bodies with g
+func (s Spec) operation(g *Generator, route Route) *Operation {
+	op := &Operation{
+		OperationID: route.OperationID,
+		Summary:     route.Summary,
+		Responses:   map[string]*Response{},
+	}
+	if route.Tag != "" {
+		op.Tags = []string{route.Tag}
+	}
+
+	for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   StringSchema(s.PathFormat),
+		})
+	}
+	op.Parameters = append(op.Parameters, route.Parameters...)
+
+	if route.Request != nil {
+		schema := g.RequestSchema(route.Request)
+		op.RequestBody = &RequestBody{Required: true, Content: content(route.RequestMediaTypes, schema)}
+	}
+
+	status := route.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	resp := &Response{Description: http.StatusText(status)}
+	if route.Response != nil {
+		resp.Content = content(route.ResponseMediaTypes, responseSchema(g, route.Response))
+	}
+	for name, description := range route.ResponseHeaders {
+		if resp.Headers == nil {
+			resp.Headers = map[string]Header{}
+		}
+		resp.Headers[name] = Header{Description: description, Schema: StringSchema("")}
+	}
+	op.Responses[strconv.Itoa(status)] = resp
+
+	if len(route.Errors) > 0 {
+		errorSchema := g.ResponseSchema(s.ErrorBody)
+		for _, errStatus := range route.Errors {
+			op.Responses[strconv.Itoa(errStatus)] = &Response{
+				Description: http.StatusText(errStatus),
+				Content:     content([]string{s.ErrorMediaType}, errorSchema),
+			}
+		}
+	}
+
+	return op
+}
+
+// responseSchema returns the schema of a response body, one of those of the types of a OneOf body
+func responseSchema(g *Generator, body any) *Schema {
+	oneOf, ok := body.(OneOf)
+	if !ok {
+		return g.ResponseSchema(body)
+	}
+
+	s := &Schema{}
+	for _, v := range oneOf {
+		s.OneOf = append(s.OneOf, g.ResponseSchema(v))
+	}
+	return s
+}
+
+// content returns the body of schema in each media type, or in JSON without media types
+// Only JSON media types (such as application/x-ndjson, whose lines hold the schema) are described
+// by schema; the others are documented as strings
+func content(mediaTypes []string, schema *Schema) map[string]MediaType {
+	if len(mediaTypes) == 0 {
+		mediaTypes = []string{JSONContentType}
+	}
+
+	c := make(map[string]MediaType, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		if strings.HasSuffix(mediaType, "json") {
+			c[mediaType] = MediaType{Schema: schema}
+		} else {
+			c[mediaType] = MediaType{Schema: StringSchema("")}
+		}
+	}
+	return c
+}
+
+// QueryParam returns an optional query parameter
+func QueryParam(name, description string, schema *Schema) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+// HeaderParam returns an optional header parameter
+func HeaderParam(name, description string) Parameter {
+	return Parameter{Name: name, In: "header", Description: description, Schema: StringSchema("")}
+}
@@ -0,0 +1,139 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type testEmbedded struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type testItem struct {
+	testEmbedded
+	UUID     uuid.UUID       `json:"uuid"`
+	Parent   *uuid.UUID      `json:"parent,omitempty"`
+	Estimate *int            `json:"estimate"`
+	Labels   []string        `json:"labels"`
+	Limits   map[string]int  `json:"limits"`
+	Payload  json.RawMessage `json:"payload"`
+	Next     *testItem       `json:"next"`
+	Secret   string          `json:"-"`
+	internal string
+}
+
+type testError struct {
+	Status int `json:"status"`
+}
+
+func TestGenerator_ResponseSchema(t *testing.T) {
+	g := NewGenerator()
+	ref := g.ResponseSchema([]testItem{})
+
+	assertJSON(t, "ResponseSchema()", ref, `{"type":"array","items":{"$ref":"#/components/schemas/testItem"}}`)
+	assertJSON(t, "Components()[testItem]", g.Components()["testItem"], `{
+		"type": "object",
+		"properties": {
+			"created_at": {"type": "string", "format": "date-time"},
+			"uuid": {"type": "string", "format": "uuid"},
+			"parent": {"type": ["string", "null"], "format": "uuid"},
+			"estimate": {"type": ["integer", "null"]},
+			"labels": {"type": "array", "items": {"type": "string"}},
+			"limits": {"type": "object", "additionalProperties": {"type": "integer"}},
+			"payload": {},
+			"next": {"anyOf": [{"$ref": "#/components/schemas/testItem"}, {"type": "null"}]}
+		},
+		"required": ["created_at", "uuid", "estimate", "labels", "limits", "payload", "next"]
+	}`)
+}
+
+func TestGenerator_RequestSchema(t *testing.T) {
+	g := NewGenerator()
+	g.RequestSchema(testError{})
+
+	assertJSON(t, "Components()[testError]", g.Components()["testError"], `{
+		"type": "object",
+		"properties": {"status": {"type": "integer"}}
+	}`)
+}
+
+func TestSpec_Build(t *testing.T) {
+	spec := Spec{
+		Info:           Info{Title: "Test API", Version: "1.0.0"},
+		PathFormat:     "uuid",
+		ErrorBody:      testError{},
+		ErrorMediaType: "application/problem+json",
+		Routes: []Route{
+			{Method: http.MethodPost, Path: "/items/{uuid}/copy", OperationID: "CopyItem", Tag: "Items",
+				Parameters: []Parameter{QueryParam("deep", "", BooleanSchema())},
+				Request:    testError{}, Status: http.StatusAccepted, Response: OneOf{testError{}, ""},
+				ResponseHeaders: map[string]string{"Location": "Copy"}, Errors: []int{http.StatusNotFound}},
+			{Method: http.MethodGet, Path: "/items/{uuid}/copy", OperationID: "RetrieveCopy", Tag: "Items",
+				Response: "", ResponseMediaTypes: []string{"text/plain"}},
+		},
+	}
+
+	doc := spec.Build()
+
+	assertJSON(t, "Build().Paths", doc.Paths, `{
+		"/items/{uuid}/copy": {
+			"get": {
+				"operationId": "RetrieveCopy",
+				"tags": ["Items"],
+				"parameters": [{"name": "uuid", "in": "path", "required": true, "schema": {"type": "string", "format": "uuid"}}],
+				"responses": {"200": {"description": "OK", "content": {"text/plain": {"schema": {"type": "string"}}}}}
+			},
+			"post": {
+				"operationId": "CopyItem",
+				"tags": ["Items"],
+				"parameters": [
+					{"name": "uuid", "in": "path", "required": true, "schema": {"type": "string", "format": "uuid"}},
+					{"name": "deep", "in": "query", "schema": {"type": "boolean"}}
+				],
+				"requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/testError"}}}},
+				"responses": {
+					"202": {
+						"description": "Accepted",
+						"headers": {"Location": {"description": "Copy", "schema": {"type": "string"}}},
+						"content": {"application/json": {"schema": {"oneOf": [{"$ref": "#/components/schemas/testError"}, {"type": "string"}]}}}
+					},
+					"404": {"description": "Not Found", "content": {"application/problem+json": {"schema": {"$ref": "#/components/schemas/testError"}}}}
+				}
+			}
+		}
+	}`)
+	if doc.OpenAPI != Version {
+		t.Errorf("Build().OpenAPI = %q, want %q", doc.OpenAPI, Version)
+	}
+	if len(doc.Tags) != 1 || doc.Tags[0].Name != "Items" {
+		t.Errorf("Build().Tags = %v, want [Items]", doc.Tags)
+	}
+}
+
+// assertJSON compares the JSON encoding of got to the JSON document want
+func assertJSON(t *testing.T, name string, got any, want string) {
+	t.Helper()
+
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("%s: marshal: %v", name, err)
+	}
+
+	var gotValue, wantValue any
+	if err := json.Unmarshal(gotJSON, &gotValue); err != nil {
+		t.Fatalf("%s: unmarshal: %v", name, err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("%s: unmarshal want: %v", name, err)
+	}
+
+	gotNormalized, _ := json.Marshal(gotValue)
+	wantNormalized, _ := json.Marshal(wantValue)
+	if string(gotNormalized) != string(wantNormalized) {
+		t.Errorf("%s =\n%s\nwant\n%s", name, gotNormalized, wantNormalized)
+	}
+}
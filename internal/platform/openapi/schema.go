@@ -0,0 +1,235 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	uuidType          = reflect.TypeFor[uuid.UUID]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// Schema is a JSON Schema (draft 2020-12), the dialect of OpenAPI 3.1
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 Types              `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Minimum              *int               `json:"minimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+// Types is the type keyword of a schema: a single type, or a type and "null" for nullable schemas
+type Types []string
+
+// MarshalJSON marshals a single type as a string and several types as an array
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// StringSchema returns the schema of strings in format, which may be empty
+func StringSchema(format string) *Schema {
+	return &Schema{Type: Types{"string"}, Format: format}
+}
+
+// EnumSchema returns the schema of strings restricted to values
+func EnumSchema[T ~string](values ...T) *Schema {
+	enum := make([]string, len(values))
+	for i, v := range values {
+		enum[i] = string(v)
+	}
+	return &Schema{Type: Types{"string"}, Enum: enum}
+}
+
+// IntegerSchema returns the schema of integers not lower than minimum
+func IntegerSchema(minimum int) *Schema {
+	return &Schema{Type: Types{"integer"}, Minimum: &minimum}
+}
+
+// BooleanSchema returns the schema of booleans
+func BooleanSchema() *Schema {
+	return &Schema{Type: Types{"boolean"}}
+}
+
+// Generator generates the schemas of Go types by reflection, following encoding/json
+// Named structs are generated once into the components and referenced; members are named by
+// their json tags, embedded structs are flattened and pointers are nullable. time.Time is a
+// date-time string, uuid.UUID a uuid string, other text marshalers strings and other JSON
+// marshalers any value
+type Generator struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+// NewGenerator returns a Generator without components
+func NewGenerator() *Generator {
+	return &Generator{
+		components: map[string]*Schema{},
+		names:      map[reflect.Type]string{},
+	}
+}
+
+// Components returns the schemas of the named structs generated so far by name
+func (g *Generator) Components() map[string]*Schema {
+	return g.components
+}
+
+// ResponseSchema returns the schema of the type of v as a response body
+// Members without omitempty are required, since they are always marshaled
+func (g *Generator) ResponseSchema(v any) *Schema {
+	return g.schema(reflect.TypeOf(v), true)
+}
+
+// RequestSchema returns the schema of the type of v as a request body
+// No member is required: the use cases validate presence, with the code of each missing field
+func (g *Generator) RequestSchema(v any) *Schema {
+	return g.schema(reflect.TypeOf(v), false)
+}
+
+// schema returns the schema of t; withRequired lists the required members of its structs
+func (g *Generator) schema(t reflect.Type, withRequired bool) *Schema {
+	switch {
+	case t.Kind() == reflect.Pointer:
+		return nullable(g.schema(t.Elem(), withRequired))
+	case t == timeType:
+		return StringSchema("date-time")
+	case t == uuidType:
+		return StringSchema("uuid")
+	case t.Implements(jsonMarshalerType):
+		return &Schema{}
+	case t.Implements(textMarshalerType):
+		return StringSchema("")
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return BooleanSchema()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: Types{"integer"}}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Types{"number"}}
+	case reflect.String:
+		return StringSchema("")
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return StringSchema("byte")
+		}
+		return &Schema{Type: Types{"array"}, Items: g.schema(t.Elem(), withRequired)}
+	case reflect.Map:
+		return &Schema{Type: Types{"object"}, AdditionalProperties: g.schema(t.Elem(), withRequired)}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t, withRequired)
+		}
+		return &Schema{Ref: "#/components/schemas/" + g.component(t, withRequired)}
+	default:
+		return &Schema{}
+	}
+}
+
+// component generates the schema of the named struct t into the components once, returning its name
+// The name is that of the type, qualified by its package when another type already took it
+func (g *Generator) component(t reflect.Type, withRequired bool) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := g.components[name]; taken {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+
+	// Registered before its members are generated, so recursive types reference it
+	g.names[t] = name
+	g.components[name] = &Schema{}
+	*g.components[name] = *g.object(t, withRequired)
+	return name
+}
+
+// object returns the object schema of the members of struct t
+func (g *Generator) object(t reflect.Type, withRequired bool) *Schema {
+	s := &Schema{Type: Types{"object"}, Properties: map[string]*Schema{}}
+	for field := range fields(t) {
+		name, omitempty := jsonName(field)
+		if name == "" {
+			continue
+		}
+
+		s.Properties[name] = g.schema(field.Type, withRequired)
+		if withRequired && !omitempty {
+			s.Required = append(s.Required, name)
+		}
+	}
+	return s
+}
+
+// fields yields the marshaled fields of struct t, those of embedded structs without a json name included
+func fields(t reflect.Type) func(yield func(reflect.StructField) bool) {
+	return func(yield func(reflect.StructField) bool) {
+		for i := range t.NumField() {
+			field := t.Field(i)
+			if field.Anonymous && field.Tag.Get("json") == "" && field.Type.Kind() == reflect.Struct {
+				for embedded := range fields(field.Type) {
+					if !yield(embedded) {
+						return
+					}
+				}
+				continue
+			}
+			if field.IsExported() && !yield(field) {
+				return
+			}
+		}
+	}
+}
+
+// jsonName returns the member name of field and whether it has omitempty; the name is empty for
+// fields that are not marshaled
+func jsonName(field reflect.StructField) (name string, omitempty bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	for option := range strings.SplitSeq(options, ",") {
+		if option == "omitempty" || option == "omitzero" {
+			omitempty = true
+		}
+	}
+	return name, omitempty
+}
+
+// nullable returns s allowing null as well
+func nullable(s *Schema) *Schema {
+	switch {
+	case len(s.Type) == 1:
+		s.Type = append(s.Type, "null")
+		return s
+	case s.Ref != "":
+		return &Schema{AnyOf: []*Schema{s, {Type: Types{"null"}}}}
+	default:
+		return s
+	}
+}
@@ -0,0 +1,246 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	apperrors "taskmanager/internal/platform/errors"
+)
+
+// dateLayout is the layout of the date format (RFC 3339 full-date)
+const dateLayout = "2006-01-02"
+
+// Operation returns the operation of the route with method and path pattern, nil when there is none
+func (d *Document) Operation(method, pattern string) *Operation {
+	return d.Paths[pattern][strings.ToLower(method)]
+}
+
+// ValidateRequest validates the path params, query params and JSON body of r against op
+// pathParams holds the values of the path parameters of the route of r. Empty query params are
+// absent, as for http.Request.URL.Query().Get; only the first value of a query param is validated.
+// The body is validated only when it is application/json and not empty, and is left readable
+// Returns a BadRequestError with the parameter, or the path of the body member (as operations[0].op),
+// of the first violation: "invalid <name> format" for formats and "invalid <name> value" otherwise
+func (d *Document) ValidateRequest(op *Operation, r *http.Request, pathParams map[string]string) error {
+	query := r.URL.Query()
+	for _, param := range op.Parameters {
+		var value string
+		switch param.In {
+		case "path":
+			value = pathParams[param.Name]
+		case "query":
+			value = query.Get(param.Name)
+		default:
+			continue
+		}
+		if value == "" {
+			continue
+		}
+		if err := validateParam(param, value); err != nil {
+			return err
+		}
+	}
+
+	if op.RequestBody == nil || r.Header.Get("Content-Type") != JSONContentType {
+		return nil
+	}
+	mediaType, ok := op.RequestBody.Content[JSONContentType]
+	if !ok {
+		return nil
+	}
+	return d.validateBody(mediaType.Schema, r)
+}
+
+// validateParam validates the value of a path or query param against its schema
+func validateParam(param Parameter, value string) error {
+	s := param.Schema
+	invalid := &apperrors.BadRequestError{Message: fmt.Sprintf("invalid %s value", param.Name), Field: param.Name}
+
+	switch {
+	case s.Type.Has("integer"):
+		n, err := strconv.Atoi(value)
+		if err != nil || (s.Minimum != nil && n < *s.Minimum) {
+			return invalid
+		}
+	case s.Type.Has("boolean"):
+		if _, err := strconv.ParseBool(value); err != nil {
+			return invalid
+		}
+	case len(s.Enum) > 0:
+		if !slices.Contains(s.Enum, value) {
+			return invalid
+		}
+	case !validFormat(s.Format, value):
+		return &apperrors.BadRequestError{Message: fmt.Sprintf("invalid %s format", param.Name), Field: param.Name}
+	}
+	return nil
+}
+
+// validateBody validates the JSON body of r against schema and restores it for the handler
+func (d *Document) validateBody(schema *Schema, r *http.Request) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return &apperrors.BadRequestError{Message: "invalid JSON syntax"}
+		}
+		return err
+	}
+
+	return d.validateValue(schema, value, "", "")
+}
+
+// validateValue validates a decoded JSON value against schema
+// path locates the value in the body and name is its member name, both empty for the body itself
+func (d *Document) validateValue(schema *Schema, value any, path, name string) error {
+	s := d.resolve(schema)
+
+	if len(s.AnyOf) > 0 || len(s.OneOf) > 0 {
+		var firstErr error
+		for _, alternative := range slices.Concat(s.AnyOf, s.OneOf) {
+			err := d.validateValue(alternative, value, path, name)
+			if err == nil {
+				return nil
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		return firstErr
+	}
+
+	if len(s.Type) > 0 && !s.Type.Has(jsonType(value)) && !(jsonType(value) == "integer" && s.Type.Has("number")) {
+		field := fmt.Sprintf("field %q", path)
+		if path == "" {
+			field = "request body"
+		}
+		return &apperrors.BadRequestError{
+			Message: fmt.Sprintf("invalid type for %s: expected %s, got %s", field, s.Type[0], jsonType(value)),
+			Field:   path,
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, v) {
+			return &apperrors.BadRequestError{Message: fmt.Sprintf("invalid %s value", name), Field: path}
+		}
+		if v != "" && !validFormat(s.Format, v) {
+			return &apperrors.BadRequestError{Message: fmt.Sprintf("invalid %s format", name), Field: path}
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil && s.Minimum != nil && n < int64(*s.Minimum) {
+			return &apperrors.BadRequestError{Message: fmt.Sprintf("invalid %s value", name), Field: path}
+		}
+	case []any:
+		if s.Items == nil {
+			return nil
+		}
+		for i, item := range v {
+			if err := d.validateValue(s.Items, item, fmt.Sprintf("%s[%d]", path, i), name); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for _, member := range s.Required {
+			if _, ok := v[member]; !ok {
+				return &apperrors.BadRequestError{Message: member + " is required", Field: memberPath(path, member)}
+			}
+		}
+		// Members are validated in order, so the first violation reported does not vary
+		for _, member := range slices.Sorted(maps.Keys(v)) {
+			memberValue := v[member]
+			memberSchema, ok := s.Properties[member]
+			if !ok {
+				memberSchema = s.AdditionalProperties
+			}
+			if memberSchema == nil {
+				continue
+			}
+			if err := d.validateValue(memberSchema, memberValue, memberPath(path, member), member); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolve returns the component schema referenced by s, or s when it is not a reference
+func (d *Document) resolve(s *Schema) *Schema {
+	if name, ok := strings.CutPrefix(s.Ref, "#/components/schemas/"); ok {
+		if component, ok := d.Components.Schemas[name]; ok {
+			return component
+		}
+	}
+	return s
+}
+
+// Has reports whether t includes the type name
+func (t Types) Has(name string) bool {
+	return slices.Contains(t, name)
+}
+
+// jsonType returns the JSON Schema type of a value decoded with json.Decoder.UseNumber
+func jsonType(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// validFormat reports whether a string is in format; unknown formats accept any string
+func validFormat(format, value string) bool {
+	var err error
+	switch format {
+	case "uuid":
+		_, err = uuid.Parse(value)
+	case "date":
+		_, err = time.Parse(dateLayout, value)
+	case "date-time":
+		_, err = time.Parse(time.RFC3339, value)
+	}
+	return err == nil
+}
+
+// memberPath returns the path of a member of the object at path
+func memberPath(path, member string) string {
+	if path == "" {
+		return member
+	}
+	return path + "." + member
+}
@@ -0,0 +1,114 @@
+package openapi
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	apperrors "taskmanager/internal/platform/errors"
+)
+
+type testBatch struct {
+	Items []testItem `json:"items"`
+	Kind  string     `json:"kind"`
+	Size  int        `json:"size"`
+}
+
+func TestDocument_ValidateRequest(t *testing.T) {
+	doc := Spec{
+		PathFormat: "uuid",
+		Routes: []Route{
+			{Method: http.MethodPost, Path: "/items/{uuid}", OperationID: "CreateBatch", Request: testBatch{},
+				Parameters: []Parameter{
+					QueryParam("page", "", IntegerSchema(1)),
+					QueryParam("deep", "", BooleanSchema()),
+					QueryParam("order", "", EnumSchema("asc", "desc")),
+					QueryParam("from", "", StringSchema("date")),
+				}},
+		},
+	}.Build()
+	op := doc.Operation(http.MethodPost, "/items/{uuid}")
+	if op == nil {
+		t.Fatal("Operation(POST, /items/{uuid}) = nil")
+	}
+	validUUID := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		pathUUID    string
+		query       string
+		contentType string
+		body        string
+		wantMessage string
+		wantField   string
+	}{
+		{"ValidateRequest with a valid request", validUUID, "page=2&deep=true&order=asc&from=2025-11-24", "application/json",
+			`{"items":[{"uuid":"` + validUUID + `","estimate":null,"labels":["a"]}],"kind":"x","size":3}`, "", ""},
+		{"ValidateRequest with empty query params", validUUID, "page=&order=", "application/json", `{}`, "", ""},
+		{"ValidateRequest with an invalid path UUID", "not-a-uuid", "", "", "", "invalid uuid format", "uuid"},
+		{"ValidateRequest with a non-integer query param", validUUID, "page=abc", "", "", "invalid page value", "page"},
+		{"ValidateRequest with a query param below the minimum", validUUID, "page=0", "", "", "invalid page value", "page"},
+		{"ValidateRequest with a non-boolean query param", validUUID, "deep=maybe", "", "", "invalid deep value", "deep"},
+		{"ValidateRequest with a query param out of the enum", validUUID, "order=up", "", "", "invalid order value", "order"},
+		{"ValidateRequest with a query param out of the format", validUUID, "from=24/11/2025", "", "", "invalid from format", "from"},
+		{"ValidateRequest with invalid JSON syntax", validUUID, "", "application/json", `{"kind":`, "invalid JSON syntax", ""},
+		{"ValidateRequest with a body member of the wrong type", validUUID, "", "application/json", `{"size":"3"}`,
+			`invalid type for field "size": expected integer, got string`, "size"},
+		{"ValidateRequest with a nested body member of the wrong type", validUUID, "", "application/json", `{"items":[{},{"labels":[1]}]}`,
+			`invalid type for field "items[1].labels[0]": expected string, got integer`, "items[1].labels[0]"},
+		{"ValidateRequest with a body member out of the format", validUUID, "", "application/json", `{"items":[{"uuid":"x"}]}`,
+			"invalid uuid format", "items[0].uuid"},
+		{"ValidateRequest with a body of the wrong type", validUUID, "", "application/json", `[]`,
+			"invalid type for request body: expected object, got array", ""},
+		{"ValidateRequest without a JSON body", validUUID, "", "text/plain", `{"size":"3"}`, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/items/"+tt.pathUUID+"?"+tt.query, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+
+			err := doc.ValidateRequest(op, r, map[string]string{"uuid": tt.pathUUID})
+
+			if tt.wantMessage == "" {
+				if err != nil {
+					t.Fatalf("ValidateRequest() error = %v, want nil", err)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tt.body {
+					t.Errorf("ValidateRequest() left body %q, want %q", body, tt.body)
+				}
+				return
+			}
+
+			var badReqErr *apperrors.BadRequestError
+			if !errors.As(err, &badReqErr) {
+				t.Fatalf("ValidateRequest() error = %v, want BadRequestError", err)
+			}
+			if badReqErr.Message != tt.wantMessage || badReqErr.Field != tt.wantField {
+				t.Errorf("ValidateRequest() error = %q on %q, want %q on %q", badReqErr.Message, badReqErr.Field, tt.wantMessage, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestDocument_Operation(t *testing.T) {
+	doc := Spec{Routes: []Route{{Method: http.MethodGet, Path: "/items", OperationID: "ListItems"}}}.Build()
+
+	if op := doc.Operation(http.MethodGet, "/items"); op == nil || op.OperationID != "ListItems" {
+		t.Errorf("Operation(GET, /items) = %v, want ListItems", op)
+	}
+	if op := doc.Operation(http.MethodPost, "/items"); op != nil {
+		t.Errorf("Operation(POST, /items) = %v, want nil", op)
+	}
+	if op := doc.Operation(http.MethodGet, ""); op != nil {
+		t.Errorf("Operation(GET, \"\") = %v, want nil", op)
+	}
+}
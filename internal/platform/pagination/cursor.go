@@ -0,0 +1,90 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"taskmanager/internal/platform/errors"
+)
+
+// Direction indicates which side of the cursor a page is read from.
+type Direction string
+
+const (
+	// DirectionNext reads the items that come after the cursor in the list order.
+	DirectionNext Direction = "next"
+	// DirectionPrev reads the items that come before the cursor in the list order.
+	DirectionPrev Direction = "prev"
+)
+
+// Cursor identifies a position in a list ordered by (created_at DESC, id DESC),
+// or by (rank ASC, id ASC) when Rank is set.
+type Cursor struct {
+	CreatedAt time.Time `json:"c,omitzero"`
+	Rank      string    `json:"r,omitempty"`
+	ID        uint      `json:"i"`
+	Direction Direction `json:"d"`
+}
+
+// NewCursor creates a cursor positioned at the given sort key.
+func NewCursor(createdAt time.Time, id uint, direction Direction) *Cursor {
+	return &Cursor{
+		CreatedAt: createdAt.UTC(),
+		ID:        id,
+		Direction: direction,
+	}
+}
+
+// NewRankCursor creates a cursor positioned at the given rank sort key.
+func NewRankCursor(rank string, id uint, direction Direction) *Cursor {
+	return &Cursor{
+		Rank:      rank,
+		ID:        id,
+		Direction: direction,
+	}
+}
+
+// IsRank reports whether the cursor belongs to a list ordered by rank.
+func (c Cursor) IsRank() bool {
+	return c.Rank != ""
+}
+
+// Encode returns the opaque, URL-safe representation of the cursor.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses an opaque cursor produced by Encode.
+// Returns nil when the value is empty and BadRequestError when it is malformed.
+func DecodeCursor(value string) (*Cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	invalid := &errors.BadRequestError{
+		Message: "invalid cursor",
+		Field:   "cursor",
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, invalid
+	}
+
+	if c.CreatedAt.IsZero() == (c.Rank == "") || c.ID == 0 {
+		return nil, invalid
+	}
+
+	if c.Direction != DirectionNext && c.Direction != DirectionPrev {
+		return nil, invalid
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,88 @@
+package pagination
+
+import (
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Scope applies the keyset condition and ordering for the given cursor.
+// The list order is (created_at DESC, id DESC); pages before the cursor are read
+// in ascending order and restored by Window. One extra row is fetched to detect
+// whether more items exist beyond the page.
+func Scope(cursor *Cursor, limit int) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if cursor != nil && cursor.Direction == DirectionPrev {
+			db = db.Where("(created_at, id) > (?, ?)", cursor.CreatedAt, cursor.ID).
+				Order("created_at ASC").Order("id ASC")
+		} else {
+			if cursor != nil {
+				db = db.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+			}
+			db = db.Order("created_at DESC").Order("id DESC")
+		}
+		return db.Limit(limit + 1)
+	}
+}
+
+// RankScope is the Scope of a list ordered by (rank ASC, id ASC); pages before
+// the cursor are read in descending order and restored by RankWindow.
+func RankScope(cursor *Cursor, limit int) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if cursor != nil && cursor.Direction == DirectionPrev {
+			db = db.Where("(rank, id) < (?, ?)", cursor.Rank, cursor.ID).
+				Order("rank DESC").Order("id DESC")
+		} else {
+			if cursor != nil {
+				db = db.Where("(rank, id) > (?, ?)", cursor.Rank, cursor.ID)
+			}
+			db = db.Order("rank ASC").Order("id ASC")
+		}
+		return db.Limit(limit + 1)
+	}
+}
+
+// Window trims the extra row fetched by Scope, restores the list order and
+// returns the cursors for the next and previous pages (nil when there are none).
+func Window[T any](items []T, cursor *Cursor, limit int, key func(T) (time.Time, uint)) ([]T, *Cursor, *Cursor) {
+	return window(items, cursor, limit, func(item T, direction Direction) *Cursor {
+		createdAt, id := key(item)
+		return NewCursor(createdAt, id, direction)
+	})
+}
+
+// RankWindow is the Window of a list read with RankScope.
+func RankWindow[T any](items []T, cursor *Cursor, limit int, key func(T) (string, uint)) ([]T, *Cursor, *Cursor) {
+	return window(items, cursor, limit, func(item T, direction Direction) *Cursor {
+		rank, id := key(item)
+		return NewRankCursor(rank, id, direction)
+	})
+}
+
+func window[T any](items []T, cursor *Cursor, limit int, newCursor func(T, Direction) *Cursor) ([]T, *Cursor, *Cursor) {
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+
+	hasNext, hasPrev := hasMore, cursor != nil
+	if cursor != nil && cursor.Direction == DirectionPrev {
+		slices.Reverse(items)
+		hasNext, hasPrev = true, hasMore
+	}
+
+	if len(items) == 0 {
+		return items, nil, nil
+	}
+
+	var next, prev *Cursor
+	if hasNext {
+		next = newCursor(items[len(items)-1], DirectionNext)
+	}
+	if hasPrev {
+		prev = newCursor(items[0], DirectionPrev)
+	}
+
+	return items, next, prev
+}
@@ -0,0 +1,421 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	apperrors "taskmanager/internal/platform/errors"
+)
+
+// Media types of the patch documents accepted by PATCH routes
+const (
+	MergePatchType = "application/merge-patch+json"
+	JSONPatchType  = "application/json-patch+json"
+)
+
+// Patch changes a JSON document decoded into maps, slices and scalars
+type Patch interface {
+	Apply(doc any) (any, error)
+}
+
+// MergePatch is a JSON Merge Patch (RFC 7396): members replace those of the document, objects merge
+// recursively and null removes a member
+type MergePatch struct {
+	value map[string]any
+}
+
+// JSONPatch is a JSON Patch (RFC 6902): operations applied in order, all or none
+type JSONPatch []Operation
+
+// Operation is one operation of a JSON Patch
+// Value is nil when absent, so it is told apart from a JSON null
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Parse parses a patch document of the given media type
+// Returns BadRequestError for malformed documents and unknown media types
+func Parse(mediaType string, body []byte) (Patch, error) {
+	switch mediaType {
+	case MergePatchType:
+		var value any
+		if err := json.Unmarshal(body, &value); err != nil {
+			return nil, &apperrors.BadRequestError{Message: "invalid JSON syntax"}
+		}
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, &apperrors.BadRequestError{Message: "merge patch must be a JSON object"}
+		}
+		return MergePatch{value: object}, nil
+	case JSONPatchType:
+		var operations JSONPatch
+		if err := json.Unmarshal(body, &operations); err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				return nil, &apperrors.BadRequestError{Message: "invalid JSON syntax"}
+			}
+			return nil, &apperrors.BadRequestError{Message: "JSON patch must be an array of operations"}
+		}
+		if err := operations.validate(); err != nil {
+			return nil, err
+		}
+		return operations, nil
+	}
+
+	return nil, &apperrors.BadRequestError{Message: fmt.Sprintf("unsupported patch media type %q", mediaType)}
+}
+
+// ApplyTo applies p to the JSON encoding of doc and decodes the result into a new T
+// Members unknown to T are refused with ValidationErrors and values of the wrong type with BadRequestError
+func ApplyTo[T any](p Patch, doc T) (T, error) {
+	var result T
+
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return result, err
+	}
+
+	var value any
+	if err := json.Unmarshal(encoded, &value); err != nil {
+		return result, err
+	}
+
+	patched, err := p.Apply(value)
+	if err != nil {
+		return result, err
+	}
+
+	encoded, err = json.Marshal(patched)
+	if err != nil {
+		return result, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&result); err != nil {
+		return result, decodeError(err)
+	}
+
+	return result, nil
+}
+
+// Apply merges the patch into doc
+func (p MergePatch) Apply(doc any) (any, error) {
+	return merge(doc, p.value), nil
+}
+
+// merge applies a merge patch value to target as defined by RFC 7396
+func merge(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = merge(targetObject[key], value)
+	}
+
+	return targetObject
+}
+
+// Apply applies the operations in order, stopping at the first one that fails
+func (p JSONPatch) Apply(doc any) (any, error) {
+	var err error
+	for _, op := range p {
+		doc, err = op.apply(doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// validate checks the members each operation requires
+func (p JSONPatch) validate() error {
+	for i, op := range p {
+		field := fmt.Sprintf("[%d]", i)
+
+		if _, err := parsePointer(op.Path); err != nil {
+			return &apperrors.BadRequestError{Message: err.Error(), Field: field + ".path"}
+		}
+
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				return &apperrors.BadRequestError{Message: fmt.Sprintf("%s operation requires a value", op.Op), Field: field + ".value"}
+			}
+		case "move", "copy":
+			if _, err := parsePointer(op.From); err != nil {
+				return &apperrors.BadRequestError{Message: err.Error(), Field: field + ".from"}
+			}
+			if op.Op == "move" && strings.HasPrefix(op.Path, op.From+"/") {
+				return &apperrors.BadRequestError{Message: "move operation cannot move a value into itself", Field: field + ".from"}
+			}
+		case "remove":
+		default:
+			return &apperrors.BadRequestError{Message: fmt.Sprintf("unknown operation %q", op.Op), Field: field + ".op"}
+		}
+	}
+	return nil
+}
+
+// apply applies one operation to doc
+func (op Operation) apply(doc any) (any, error) {
+	path, _ := parsePointer(op.Path)
+
+	switch op.Op {
+	case "add":
+		return add(doc, path, op.value(), op.Path)
+	case "remove":
+		doc, _, err := remove(doc, path, op.Path)
+		return doc, err
+	case "replace":
+		doc, _, err := remove(doc, path, op.Path)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, op.value(), op.Path)
+	case "move":
+		from, _ := parsePointer(op.From)
+		doc, value, err := remove(doc, from, op.From)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value, op.Path)
+	case "copy":
+		from, _ := parsePointer(op.From)
+		value, err := get(doc, from, op.From)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, clone(value), op.Path)
+	case "test":
+		value, err := get(doc, path, op.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, op.value()) {
+			return nil, pathError(op.Path, apperrors.CodeTestFailed)
+		}
+		return doc, nil
+	}
+
+	return nil, &apperrors.BadRequestError{Message: fmt.Sprintf("unknown operation %q", op.Op), Field: "op"}
+}
+
+// value decodes the value of the operation; validate has already checked it is present
+func (op Operation) value() any {
+	var value any
+	_ = json.Unmarshal(op.Value, &value)
+	return value
+}
+
+// parsePointer splits a JSON Pointer (RFC 6901) into its unescaped reference tokens
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// get returns the value at path
+func get(node any, path []string, pointer string) (any, error) {
+	for _, token := range path {
+		switch n := node.(type) {
+		case map[string]any:
+			value, ok := n[token]
+			if !ok {
+				return nil, pathError(pointer, apperrors.CodePathNotFound)
+			}
+			node = value
+		case []any:
+			i, err := index(token, len(n)-1)
+			if err != nil {
+				return nil, pathError(pointer, apperrors.CodePathNotFound)
+			}
+			node = n[i]
+		default:
+			return nil, pathError(pointer, apperrors.CodePathNotFound)
+		}
+	}
+	return node, nil
+}
+
+// add sets the member or inserts the array element at path, returning the updated node
+func add(node any, path []string, value any, pointer string) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	token, last := path[0], len(path) == 1
+	switch n := node.(type) {
+	case map[string]any:
+		if last {
+			n[token] = value
+			return n, nil
+		}
+		child, ok := n[token]
+		if !ok {
+			return nil, pathError(pointer, apperrors.CodePathNotFound)
+		}
+		child, err := add(child, path[1:], value, pointer)
+		if err != nil {
+			return nil, err
+		}
+		n[token] = child
+		return n, nil
+	case []any:
+		if last {
+			if token == "-" {
+				return append(n, value), nil
+			}
+			i, err := index(token, len(n))
+			if err != nil {
+				return nil, pathError(pointer, apperrors.CodePathNotFound)
+			}
+			return slices.Insert(n, i, value), nil
+		}
+		i, err := index(token, len(n)-1)
+		if err != nil {
+			return nil, pathError(pointer, apperrors.CodePathNotFound)
+		}
+		child, err := add(n[i], path[1:], value, pointer)
+		if err != nil {
+			return nil, err
+		}
+		n[i] = child
+		return n, nil
+	}
+
+	return nil, pathError(pointer, apperrors.CodePathNotFound)
+}
+
+// remove deletes the member or array element at path, returning the updated node and the removed value
+func remove(node any, path []string, pointer string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, node, nil
+	}
+
+	token, last := path[0], len(path) == 1
+	switch n := node.(type) {
+	case map[string]any:
+		child, ok := n[token]
+		if !ok {
+			return nil, nil, pathError(pointer, apperrors.CodePathNotFound)
+		}
+		if last {
+			delete(n, token)
+			return n, child, nil
+		}
+		child, removed, err := remove(child, path[1:], pointer)
+		if err != nil {
+			return nil, nil, err
+		}
+		n[token] = child
+		return n, removed, nil
+	case []any:
+		i, err := index(token, len(n)-1)
+		if err != nil {
+			return nil, nil, pathError(pointer, apperrors.CodePathNotFound)
+		}
+		if last {
+			removed := n[i]
+			return slices.Delete(n, i, i+1), removed, nil
+		}
+		child, removed, err := remove(n[i], path[1:], pointer)
+		if err != nil {
+			return nil, nil, err
+		}
+		n[i] = child
+		return n, removed, nil
+	}
+
+	return nil, nil, pathError(pointer, apperrors.CodePathNotFound)
+}
+
+// index parses an array index token, refusing leading zeros and indexes above limit
+func index(token string, limit int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i > limit {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	return i, nil
+}
+
+// clone deep copies a decoded JSON value, so a copied value is not shared by two paths
+func clone(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(v))
+		for key, item := range v {
+			c[key] = clone(item)
+		}
+		return c
+	case []any:
+		c := make([]any, len(v))
+		for i, item := range v {
+			c[i] = clone(item)
+		}
+		return c
+	}
+	return value
+}
+
+// pathError reports an operation that cannot be applied to the document, with the JSON pointer
+// written as the dotted field name used by validation errors
+func pathError(pointer, code string) error {
+	tokens, _ := parsePointer(pointer)
+	return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+		apperrors.NewValidationError(strings.Join(tokens, "."), code, nil),
+	}}
+}
+
+// decodeError converts a decoding error of the patched document into a client error
+func decodeError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return &apperrors.BadRequestError{
+			Message: fmt.Sprintf("invalid type for field %q: expected %s, got %s", typeErr.Field, typeErr.Type, typeErr.Value),
+			Field:   typeErr.Field,
+		}
+	}
+
+	if name, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		if field, unquoteErr := strconv.Unquote(name); unquoteErr == nil {
+			return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+				apperrors.NewValidationError(field, apperrors.CodeNotPatchable, nil),
+			}}
+		}
+	}
+
+	return err
+}
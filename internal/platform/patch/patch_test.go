@@ -0,0 +1,150 @@
+package patch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	apperrors "taskmanager/internal/platform/errors"
+)
+
+type document struct {
+	Title    string         `json:"title"`
+	Estimate *int           `json:"estimate"`
+	Limits   map[string]int `json:"limits"`
+	Tags     []string       `json:"tags"`
+}
+
+func TestApplyTo_MergePatch(t *testing.T) {
+	estimate := 3
+	doc := document{Title: "Título", Estimate: &estimate, Limits: map[string]int{"todo": 5, "done": 2}, Tags: []string{"a"}}
+
+	tests := []struct {
+		name    string
+		patch   string
+		want    document
+		wantErr error
+	}{
+		{"Merge patch without members keeps the document", `{}`, doc, nil},
+		{"Merge patch replaces a member", `{"title":"Novo"}`, document{Title: "Novo", Estimate: &estimate, Limits: doc.Limits, Tags: doc.Tags}, nil},
+		{"Merge patch with an empty string sets it", `{"title":""}`, document{Title: "", Estimate: &estimate, Limits: doc.Limits, Tags: doc.Tags}, nil},
+		{"Merge patch with null removes a member", `{"estimate":null}`, document{Title: "Título", Limits: doc.Limits, Tags: doc.Tags}, nil},
+		{"Merge patch merges nested objects", `{"limits":{"todo":null,"in_progress":1}}`, document{Title: "Título", Estimate: &estimate, Limits: map[string]int{"done": 2, "in_progress": 1}, Tags: doc.Tags}, nil},
+		{"Merge patch replaces arrays", `{"tags":["b","c"]}`, document{Title: "Título", Estimate: &estimate, Limits: doc.Limits, Tags: []string{"b", "c"}}, nil},
+		{"Merge patch with an unknown member", `{"status":"done"}`, document{}, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			{Field: "status", Type: apperrors.TypeInvalid, Code: apperrors.CodeNotPatchable, Message: "status cannot be patched"},
+		}}},
+		{"Merge patch with a value of the wrong type", `{"estimate":"three"}`, document{}, &apperrors.BadRequestError{
+			Message: `invalid type for field "estimate": expected int, got string`, Field: "estimate",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(MergePatchType, []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got, err := ApplyTo(p, doc)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Fatalf("ApplyTo() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyTo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyTo_JSONPatch(t *testing.T) {
+	estimate, two := 3, 2
+	doc := document{Title: "Título", Estimate: &estimate, Limits: map[string]int{"todo": 5}, Tags: []string{"a", "b"}}
+
+	tests := []struct {
+		name    string
+		patch   string
+		want    document
+		wantErr error
+	}{
+		{"JSON patch replaces a member", `[{"op":"replace","path":"/title","value":"Novo"}]`, document{Title: "Novo", Estimate: &estimate, Limits: doc.Limits, Tags: doc.Tags}, nil},
+		{"JSON patch adds a nested member", `[{"op":"add","path":"/limits/done","value":2}]`, document{Title: "Título", Estimate: &estimate, Limits: map[string]int{"todo": 5, "done": 2}, Tags: doc.Tags}, nil},
+		{"JSON patch removes a member", `[{"op":"remove","path":"/estimate"}]`, document{Title: "Título", Limits: doc.Limits, Tags: doc.Tags}, nil},
+		{"JSON patch appends and inserts array elements", `[{"op":"add","path":"/tags/-","value":"c"},{"op":"add","path":"/tags/0","value":"z"}]`, document{Title: "Título", Estimate: &estimate, Limits: doc.Limits, Tags: []string{"z", "a", "b", "c"}}, nil},
+		{"JSON patch removes an array element", `[{"op":"remove","path":"/tags/0"}]`, document{Title: "Título", Estimate: &estimate, Limits: doc.Limits, Tags: []string{"b"}}, nil},
+		{"JSON patch moves a member", `[{"op":"move","from":"/limits/todo","path":"/limits/done"}]`, document{Title: "Título", Estimate: &estimate, Limits: map[string]int{"done": 5}, Tags: doc.Tags}, nil},
+		{"JSON patch copies a member", `[{"op":"copy","from":"/tags/1","path":"/title"}]`, document{Title: "b", Estimate: &estimate, Limits: doc.Limits, Tags: doc.Tags}, nil},
+		{"JSON patch with a passing test", `[{"op":"test","path":"/estimate","value":3},{"op":"replace","path":"/estimate","value":2}]`, document{Title: "Título", Estimate: &two, Limits: doc.Limits, Tags: doc.Tags}, nil},
+		{"JSON patch with an escaped pointer", `[{"op":"add","path":"/limits/a~1b~0c","value":1}]`, document{Title: "Título", Estimate: &estimate, Limits: map[string]int{"todo": 5, "a/b~c": 1}, Tags: doc.Tags}, nil},
+		{"JSON patch with a failing test", `[{"op":"replace","path":"/title","value":"Novo"},{"op":"test","path":"/estimate","value":4}]`, document{}, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			{Field: "estimate", Type: apperrors.TypeInvalid, Code: apperrors.CodeTestFailed, Message: "test operation failed"},
+		}}},
+		{"JSON patch replacing a missing member", `[{"op":"replace","path":"/limits/done","value":1}]`, document{}, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			{Field: "limits.done", Type: apperrors.TypeNotFound, Code: apperrors.CodePathNotFound, Message: "path does not exist"},
+		}}},
+		{"JSON patch with an array index out of range", `[{"op":"remove","path":"/tags/2"}]`, document{}, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			{Field: "tags.2", Type: apperrors.TypeNotFound, Code: apperrors.CodePathNotFound, Message: "path does not exist"},
+		}}},
+		{"JSON patch adding an unknown member", `[{"op":"add","path":"/status","value":"done"}]`, document{}, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			{Field: "status", Type: apperrors.TypeInvalid, Code: apperrors.CodeNotPatchable, Message: "status cannot be patched"},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(JSONPatchType, []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got, err := ApplyTo(p, doc)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Fatalf("ApplyTo() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyTo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		mediaType string
+		body      string
+		wantErr   error
+	}{
+		{"Parse merge patch", MergePatchType, `{"title":"Novo"}`, nil},
+		{"Parse JSON patch", JSONPatchType, `[{"op":"remove","path":"/title"}]`, nil},
+		{"Parse merge patch with invalid JSON", MergePatchType, `{"title":`, &apperrors.BadRequestError{Message: "invalid JSON syntax"}},
+		{"Parse merge patch that is not an object", MergePatchType, `["title"]`, &apperrors.BadRequestError{Message: "merge patch must be a JSON object"}},
+		{"Parse JSON patch that is not an array", JSONPatchType, `{"op":"remove","path":"/title"}`, &apperrors.BadRequestError{Message: "JSON patch must be an array of operations"}},
+		{"Parse JSON patch with an unknown operation", JSONPatchType, `[{"op":"merge","path":"/title"}]`, &apperrors.BadRequestError{Message: `unknown operation "merge"`, Field: "[0].op"}},
+		{"Parse JSON patch without a value", JSONPatchType, `[{"op":"remove","path":"/title"},{"op":"add","path":"/title"}]`, &apperrors.BadRequestError{Message: "add operation requires a value", Field: "[1].value"}},
+		{"Parse JSON patch with an invalid pointer", JSONPatchType, `[{"op":"remove","path":"title"}]`, &apperrors.BadRequestError{Message: `invalid JSON pointer "title"`, Field: "[0].path"}},
+		{"Parse JSON patch moving a value into itself", JSONPatchType, `[{"op":"move","from":"/limits","path":"/limits/todo"}]`, &apperrors.BadRequestError{Message: "move operation cannot move a value into itself", Field: "[0].from"}},
+		{"Parse unsupported media type", "application/json", `{}`, &apperrors.BadRequestError{Message: `unsupported patch media type "application/json"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.mediaType, []byte(tt.body))
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSONPatch_Apply_NullValue(t *testing.T) {
+	p, err := Parse(JSONPatchType, []byte(`[{"op":"replace","path":"/estimate","value":null}]`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	got, err := p.Apply(map[string]any{"estimate": json.Number("3")})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if want := map[string]any{"estimate": nil}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Apply() = %v, want %v", got, want)
+	}
+}
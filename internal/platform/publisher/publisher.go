@@ -0,0 +1,17 @@
+package publisher
+
+import "context"
+
+// Message is an event handed to a Publisher
+// ID identifies the event across redeliveries, so consumers can deduplicate it
+type Message struct {
+	ID      string
+	Type    string
+	Payload []byte
+}
+
+// Publisher sends events to a broker or consumer
+// Publish may be called more than once for the same message when a previous attempt failed
+type Publisher interface {
+	Publish(ctx context.Context, m Message) error
+}
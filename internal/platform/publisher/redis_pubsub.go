@@ -0,0 +1,84 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// pubSubMessage is the JSON encoding of a Message on a pub/sub channel
+type pubSubMessage struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Payload string `json:"payload"`
+}
+
+// RedisPubSub publishes messages to a Redis pub/sub channel and subscribes to it
+// Pub/sub keeps no history: only the subscribers connected at publish time receive a message
+type RedisPubSub struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewRedisPubSub creates a RedisPubSub on the given channel
+func NewRedisPubSub(client *redis.Client, channel string) *RedisPubSub {
+	return &RedisPubSub{
+		client:  client,
+		channel: channel,
+	}
+}
+
+// Publish sends the message to the channel with PUBLISH
+func (p *RedisPubSub) Publish(ctx context.Context, m Message) error {
+	data, err := json.Marshal(pubSubMessage{ID: m.ID, Type: m.Type, Payload: string(m.Payload)})
+	if err != nil {
+		return fmt.Errorf("encode %q for channel %q: %w", m.ID, p.channel, err)
+	}
+
+	if err := p.client.Publish(ctx, p.channel, data).Err(); err != nil {
+		return fmt.Errorf("publish %q to channel %q: %w", m.ID, p.channel, err)
+	}
+
+	return nil
+}
+
+// Subscribe returns the messages published to the channel until ctx is canceled
+// The connection is reestablished by the client when lost; messages published meanwhile are missed
+func (p *RedisPubSub) Subscribe(ctx context.Context) <-chan Message {
+	sub := p.client.Subscribe(ctx, p.channel)
+	messages := make(chan Message)
+
+	go func() {
+		defer close(messages)
+		defer sub.Close()
+
+		received := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-received:
+				if !ok {
+					return
+				}
+
+				var m pubSubMessage
+				if err := json.Unmarshal([]byte(msg.Payload), &m); err != nil {
+					slog.Warn("Discarding invalid pub/sub message", "channel", p.channel, "error", err)
+					continue
+				}
+
+				select {
+				case messages <- Message{ID: m.ID, Type: m.Type, Payload: []byte(m.Payload)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messages
+}
@@ -0,0 +1,51 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Fields of each Redis Streams entry
+const (
+	FieldID      = "id"
+	FieldType    = "type"
+	FieldPayload = "payload"
+)
+
+// RedisStream publishes messages as entries of a Redis stream
+type RedisStream struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+// NewRedisStream creates a RedisStream appending to stream, trimmed to about maxLen entries
+func NewRedisStream(client *redis.Client, stream string, maxLen int64) *RedisStream {
+	return &RedisStream{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+// Publish appends the message to the stream with XADD
+// The entry ID is assigned by Redis; the message ID is stored in the id field for deduplication
+func (p *RedisStream) Publish(ctx context.Context, m Message) error {
+	err := p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: map[string]any{
+			FieldID:      m.ID,
+			FieldType:    m.Type,
+			FieldPayload: m.Payload,
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("publish %q to stream %q: %w", m.ID, p.stream, err)
+	}
+
+	return nil
+}
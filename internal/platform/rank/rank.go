@@ -0,0 +1,154 @@
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalid   = errors.New("invalid rank")
+	ErrOrder     = errors.New("previous rank must sort before next rank")
+	ErrExhausted = errors.New("no rank left between neighbors")
+)
+
+// alphabet holds the rank digits in byte order, so ranks compare as plain strings
+const alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const (
+	base = len(alphabet)
+
+	// Width is the number of leading digits After and Before step over
+	Width = 12
+	// Step is the gap left between ranks appended with After, 36^4
+	Step = 36 * 36 * 36 * 36
+	// MaxLength is the longest rank Between returns before asking for a rebalance
+	MaxLength = 32
+)
+
+// After returns a rank sorting after prev, leaving Step of room to the next one
+// An empty prev means an empty list; the result never needs a rebalance
+func After(prev string) string {
+	v := value(prev)
+	if v+Step < limit() {
+		return encode(v + Step)
+	}
+	return midpoint(prev, "", false)
+}
+
+// Before returns a rank sorting before next, leaving Step of room to the previous one
+func Before(next string) string {
+	if next == "" {
+		return After("")
+	}
+	v := value(next)
+	if v > Step {
+		return encode(v - Step)
+	}
+	return midpoint("", next, true)
+}
+
+// Between returns a rank sorting strictly between prev and next
+// An empty prev or next stands for the start or the end of the list
+// Returns ErrOrder when prev does not sort before next and ErrExhausted when
+// the result would be longer than MaxLength, in which case the list needs a rebalance
+func Between(prev, next string) (string, error) {
+	if !valid(prev) || !valid(next) {
+		return "", ErrInvalid
+	}
+	switch {
+	case next == "":
+		return After(prev), nil
+	case prev == "":
+		return Before(next), nil
+	case prev >= next:
+		return "", ErrOrder
+	}
+
+	r := midpoint(prev, next, true)
+	if len(r) > MaxLength {
+		return "", ErrExhausted
+	}
+	return r, nil
+}
+
+// midpoint returns the rank halfway between a and b, digit by digit
+// A missing digit of a counts as zero; b is ignored when bounded is false
+// Neither a nor b end with the zero digit, and neither does the result
+func midpoint(a, b string, bounded bool) string {
+	if bounded {
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(tail(a, n), b[n:], true)
+		}
+	}
+
+	lo, hi := strings.IndexByte(alphabet, digitAt(a, 0)), base
+	if bounded {
+		hi = strings.IndexByte(alphabet, b[0])
+	}
+	if hi-lo > 1 {
+		return string(alphabet[(lo+hi+1)/2])
+	}
+	if bounded && len(b) > 1 {
+		return b[:1]
+	}
+	return string(alphabet[lo]) + midpoint(tail(a, 1), "", false)
+}
+
+// value reads the first Width digits of r as a base 36 number, padding with zeros
+func value(r string) int64 {
+	var v int64
+	for i := range Width {
+		v = v*int64(base) + int64(strings.IndexByte(alphabet, digitAt(r, i)))
+	}
+	return v
+}
+
+// encode writes v as Width base 36 digits without the trailing zeros
+func encode(v int64) string {
+	digits := make([]byte, Width)
+	for i := Width - 1; i >= 0; i-- {
+		digits[i] = alphabet[v%int64(base)]
+		v /= int64(base)
+	}
+	return strings.TrimRight(string(digits), "0")
+}
+
+// limit is the first number that does not fit in Width digits
+func limit() int64 {
+	v := int64(1)
+	for range Width {
+		v *= int64(base)
+	}
+	return v
+}
+
+func digitAt(r string, i int) byte {
+	if i < len(r) {
+		return r[i]
+	}
+	return alphabet[0]
+}
+
+func tail(r string, n int) string {
+	if n < len(r) {
+		return r[n:]
+	}
+	return ""
+}
+
+// valid reports whether r only holds alphabet digits and does not end with zero
+func valid(r string) bool {
+	if strings.HasSuffix(r, "0") {
+		return false
+	}
+	for i := 0; i < len(r); i++ {
+		if strings.IndexByte(alphabet, r[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package rank
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		prev    string
+		next    string
+		want    string
+		wantErr error
+	}{
+		{"Between empty list", "", "", "00000001", nil},
+		{"Between last rank and end of list", "00000001", "", "00000002", nil},
+		{"Between start of list and first rank", "", "00000002", "00000001", nil},
+		{"Between start of list and a rank without room", "", "00000001", "00000000i", nil},
+		{"Between adjacent ranks", "00000001", "00000002", "00000001i", nil},
+		{"Between distant ranks", "1", "3", "2", nil},
+		{"Between ranks of different lengths", "1", "1i", "19", nil},
+		{"Between decimal ranks", "000000001", "000000002", "000000001i", nil},
+		{"Between equal ranks", "1", "1", "", ErrOrder},
+		{"Between reversed ranks", "2", "1", "", ErrOrder},
+		{"Between ranks ending with zero", "10", "2", "", ErrInvalid},
+		{"Between ranks with unknown digits", "A", "B", "", ErrInvalid},
+		{"Between ranks longer than MaxLength", strings.Repeat("1", MaxLength), strings.Repeat("1", MaxLength-1) + "2", "", ErrExhausted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.prev, tt.next)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Between() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Between() = %q, want %q", got, tt.want)
+			}
+			if err == nil && (tt.prev >= got || (tt.next != "" && got >= tt.next)) {
+				t.Errorf("Between() = %q does not sort between %q and %q", got, tt.prev, tt.next)
+			}
+		})
+	}
+}
+
+func TestBetween_Repeated(t *testing.T) {
+	prev, next := After(""), After(After(""))
+	for i := 0; ; i++ {
+		r, err := Between(prev, next)
+		if errors.Is(err, ErrExhausted) {
+			if i < 100 {
+				t.Fatalf("Between() exhausted after %d ranks", i)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("Between() error = %v", err)
+		}
+		if r <= prev || r >= next {
+			t.Fatalf("Between() = %q does not sort between %q and %q", r, prev, next)
+		}
+		if i%2 == 0 {
+			prev = r
+		} else {
+			next = r
+		}
+	}
+}
+
+func TestAfter(t *testing.T) {
+	tests := []struct {
+		name string
+		prev string
+		want string
+	}{
+		{"After empty list", "", "00000001"},
+		{"After rank", "00000001", "00000002"},
+		{"After decimal rank", "000000015", "000000025"},
+		{"After rank longer than Width", "000000011i5", "000000021i5"},
+		{"After last rank of Width", strings.Repeat("z", Width), strings.Repeat("z", Width) + "i"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := After(tt.prev)
+			if got != tt.want {
+				t.Errorf("After() = %q, want %q", got, tt.want)
+			}
+			if got <= tt.prev {
+				t.Errorf("After() = %q does not sort after %q", got, tt.prev)
+			}
+		})
+	}
+}
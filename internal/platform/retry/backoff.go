@@ -0,0 +1,19 @@
+package retry
+
+import "time"
+
+// Backoff returns the wait before retrying after the given number of failed attempts
+// It doubles from base on each attempt and is capped at max
+func Backoff(attempts int, base, max time.Duration) time.Duration {
+	wait := base
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= max {
+			return max
+		}
+	}
+	if wait > max {
+		return max
+	}
+	return wait
+}
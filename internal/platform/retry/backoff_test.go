@@ -0,0 +1,26 @@
+package retry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{"First attempt waits base", 1, 10 * time.Second},
+		{"Third attempt doubles twice", 3, 40 * time.Second},
+		{"Many attempts are capped at max", 20, time.Minute},
+		{"Zero attempts waits base", 0, 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Backoff(tt.attempts, 10*time.Second, time.Minute); got != tt.want {
+				t.Errorf("Backoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package sse
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Writer writes Server-Sent Events to a response, flushing each one to the client
+type Writer struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewWriter creates a Writer for the response
+// Returns false when the response can not be flushed, as events would be held in buffers
+func NewWriter(w http.ResponseWriter) (*Writer, bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, false
+	}
+
+	return &Writer{w: w, flusher: flusher}, true
+}
+
+// Start writes the event stream headers and the status code
+func (w *Writer) Start() {
+	w.w.Header().Set("Content-Type", "text/event-stream")
+	w.w.Header().Set("Cache-Control", "no-cache")
+	w.w.Header().Set("Connection", "keep-alive")
+	// Disable response buffering in nginx and similar proxies
+	w.w.Header().Set("X-Accel-Buffering", "no")
+	w.w.WriteHeader(http.StatusOK)
+	w.flusher.Flush()
+}
+
+// Event writes an event with the given id, name and data
+// Each line of data is sent as its own data field, as required by the format
+func (w *Writer) Event(id, event, data string) error {
+	var b strings.Builder
+	if id != "" {
+		fmt.Fprintf(&b, "id: %s\n", id)
+	}
+	if event != "" {
+		fmt.Fprintf(&b, "event: %s\n", event)
+	}
+	for _, line := range strings.Split(data, "\n") {
+		fmt.Fprintf(&b, "data: %s\n", line)
+	}
+	b.WriteString("\n")
+
+	return w.write(b.String())
+}
+
+// Comment writes a comment line, ignored by clients; used as heartbeat to keep idle connections open
+func (w *Writer) Comment(text string) error {
+	return w.write(": " + text + "\n\n")
+}
+
+// write sends a chunk of the stream and flushes it
+func (w *Writer) write(chunk string) error {
+	if _, err := w.w.Write([]byte(chunk)); err != nil {
+		return err
+	}
+
+	w.flusher.Flush()
+	return nil
+}
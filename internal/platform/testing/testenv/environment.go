@@ -104,6 +104,11 @@ func (e *Environment) DB() *gorm.DB {
 	return e.db
 }
 
+// BaseURL returns the URL of the HTTP test server, for clients of the API.
+func (e *Environment) BaseURL() string {
+	return e.baseURL
+}
+
 // dbConnector returns the database Provider created for this environment.
 func (e *Environment) DBConnector() database.Connector {
 	return e.dbConnector
@@ -0,0 +1,39 @@
+package token
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	now := time.Date(2025, 12, 1, 18, 30, 0, 0, time.UTC)
+	valid := Sign(secret, "Ana Souza", now.Add(time.Hour))
+
+	tests := []struct {
+		name    string
+		token   string
+		want    string
+		wantErr error
+	}{
+		{"Verify valid token", valid, "Ana Souza", nil},
+		{"Verify expired token", Sign(secret, "Ana Souza", now), "", ErrExpired},
+		{"Verify token signed with another secret", Sign([]byte("another secret"), "Ana Souza", now.Add(time.Hour)), "", ErrInvalid},
+		{"Verify tampered claims", Sign(secret, "Bruno Lima", now.Add(time.Hour))[:10] + valid[10:], "", ErrInvalid},
+		{"Verify token without signature", strings.Split(valid, ".")[0], "", ErrInvalid},
+		{"Verify empty token", "", "", ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(secret, tt.token, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Verify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// HeaderTimestamp carries the Unix time the message was signed at
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	// HeaderSignature carries the HMAC-SHA256 signature of the timestamp and body
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// maxResponseBody limits how much of a receiver response is read before the connection is reused
+const maxResponseBody = 64 << 10
+
+// Message is a signed HTTP POST to a webhook receiver
+type Message struct {
+	URL     string
+	Secret  string
+	Headers map[string]string
+	Body    []byte
+}
+
+// Client sends webhook messages over HTTP
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a Client whose requests give up after timeout
+func NewClient(timeout time.Duration) *Client {
+	return &Client{httpClient: &http.Client{Timeout: timeout}}
+}
+
+// Sign returns the signature of a body sent at timestamp: "sha256=" followed by the
+// hex-encoded HMAC-SHA256 of "<timestamp>.<body>" keyed by secret
+// Receivers recompute it to authenticate the sender and reject replayed timestamps
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts the message as JSON with its timestamp and signature headers
+// Returns the response status code; a non-2xx status is not an error
+func (c *Client) Send(ctx context.Context, m Message) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.URL, bytes.NewReader(m.Body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range m.Headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(m.Secret, timestamp, m.Body))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	return resp.StatusCode, nil
+}
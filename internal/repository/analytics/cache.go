@@ -0,0 +1,95 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"taskmanager/internal/entity/analytics"
+	"taskmanager/internal/platform/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const cacheKeyPrefix = "analytics:team:"
+
+// cachedDatasource wraps a Persistent implementation with Redis cache-aside logic.
+type cachedDatasource struct {
+	next   Persistent
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewCachedPersist creates a cache-aside decorator around the given Persistent implementation.
+func NewCachedPersist(next Persistent, client *redis.Client, ttl time.Duration) Persistent {
+	return &cachedDatasource{
+		next:   next,
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// TeamMetrics checks the cache first; on miss, queries the database and caches the result.
+func (c *cachedDatasource) TeamMetrics(ctx context.Context, teamID uint, period analytics.Period) (*analytics.TeamMetrics, error) {
+	key := teamMetricsCacheKey(teamID, period)
+
+	result, err := cache.Get[analytics.TeamMetrics](ctx, c.client, key)
+	if err != nil {
+		slog.Warn("Cache get error, falling back to database", "key", key, "error", err)
+	}
+	if result != nil {
+		return result, nil
+	}
+
+	result, err = c.next.TeamMetrics(ctx, teamID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cache.Set(ctx, c.client, key, result, c.ttl); err != nil {
+		slog.Warn("Cache set error", "key", key, "error", err)
+	}
+
+	return result, nil
+}
+
+// InvalidateTeam removes every cached metrics entry of the team.
+func (c *cachedDatasource) InvalidateTeam(ctx context.Context, teamID uint) error {
+	if err := c.next.InvalidateTeam(ctx, teamID); err != nil {
+		return err
+	}
+
+	prefix := teamCacheKeyPrefix(teamID)
+	if err := cache.DeleteByPrefix(ctx, c.client, prefix); err != nil {
+		slog.Warn("Cache invalidation error", "prefix", prefix, "error", err)
+		return err
+	}
+	return nil
+}
+
+// RecordSnapshots delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) RecordSnapshots(ctx context.Context, date time.Time) error {
+	return c.next.RecordSnapshots(ctx, date)
+}
+
+// ListStatusSnapshots delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) ListStatusSnapshots(ctx context.Context, teamID uint, from, to time.Time) ([]analytics.StatusSnapshot, error) {
+	return c.next.ListStatusSnapshots(ctx, teamID, from, to)
+}
+
+// ListSprintSnapshots delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) ListSprintSnapshots(ctx context.Context, sprintID uint) ([]analytics.SprintSnapshot, error) {
+	return c.next.ListSprintSnapshots(ctx, sprintID)
+}
+
+// teamCacheKeyPrefix builds the prefix shared by all cached entries of a team.
+func teamCacheKeyPrefix(teamID uint) string {
+	return fmt.Sprintf("%s%d:", cacheKeyPrefix, teamID)
+}
+
+// teamMetricsCacheKey builds a deterministic cache key for a team metrics query.
+func teamMetricsCacheKey(teamID uint, period analytics.Period) string {
+	return fmt.Sprintf("%smetrics:from=%s:to=%s:bucket=%s", teamCacheKeyPrefix(teamID),
+		period.From.Format(analytics.DateLayout), period.To.Format(analytics.DateLayout), period.Bucket)
+}
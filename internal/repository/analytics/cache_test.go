@@ -0,0 +1,126 @@
+//go:build test
+
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/analytics"
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/cache"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func Test_cachedDatasource_TeamMetrics(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+		testenv.WithRedis(redisTest),
+	)
+
+	period := analytics.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analytics.BucketWeek}
+
+	resetWithMinimalData := func() {
+		env.FlushRedis()
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name  string
+		setup func()
+		want  *analytics.TeamMetrics
+	}{
+		{
+			"Cache miss - aggregates the tasks of the team",
+			resetWithMinimalData,
+			&analytics.TeamMetrics{
+				Period:     period,
+				LeadTime:   devOpsLeadTime,
+				CycleTime:  devOpsCycleTime,
+				Throughput: []analytics.Throughput{{Start: date(2025, 11, 24), Done: 2}},
+				Done:       2,
+				Canceled:   1,
+			},
+		},
+		{
+			"Cache hit - returns cached data instead of querying database",
+			func() {
+				resetWithMinimalData()
+				_ = cache.Set(context.Background(), env.Redis(), teamMetricsCacheKey(2, period), &analytics.TeamMetrics{
+					Period: period,
+					Done:   999,
+				}, 5*time.Minute)
+			},
+			&analytics.TeamMetrics{Period: period, Done: 999},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithoutTransaction(t, context.Background(), env.DBConnector())
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			cached := NewCachedPersist(&datasource{}, env.Redis(), 5*time.Minute)
+			got, err := cached.TeamMetrics(ctx, 2, period)
+			if err != nil {
+				t.Fatalf("cachedDatasource.TeamMetrics() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+				t.Errorf("cachedDatasource.TeamMetrics() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_cachedDatasource_InvalidateTeam(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithRedis(redisTest),
+	)
+
+	period := analytics.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analytics.BucketWeek}
+	otherPeriod := analytics.Period{From: date(2025, 11, 1), To: date(2025, 11, 30), Bucket: analytics.BucketDay}
+
+	ctx := context.Background()
+	env.FlushRedis()
+	for _, key := range []string{
+		teamMetricsCacheKey(2, period),
+		teamMetricsCacheKey(2, otherPeriod),
+		teamMetricsCacheKey(1, period),
+	} {
+		_ = cache.Set(ctx, env.Redis(), key, &analytics.TeamMetrics{Period: period}, 5*time.Minute)
+	}
+
+	cached := NewCachedPersist(&datasource{}, env.Redis(), 5*time.Minute)
+	if err := cached.InvalidateTeam(ctx, 2); err != nil {
+		t.Fatalf("cachedDatasource.InvalidateTeam() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		key     string
+		wantHit bool
+	}{
+		{teamMetricsCacheKey(2, period), false},
+		{teamMetricsCacheKey(2, otherPeriod), false},
+		{teamMetricsCacheKey(1, period), true},
+	} {
+		got, _ := cache.Get[analytics.TeamMetrics](ctx, env.Redis(), tt.key)
+		if (got != nil) != tt.wantHit {
+			t.Errorf("cache entry %q present = %v, want %v", tt.key, got != nil, tt.wantHit)
+		}
+	}
+}
+
+func Test_teamMetricsCacheKey(t *testing.T) {
+	got := teamMetricsCacheKey(2, analytics.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analytics.BucketWeek})
+	want := "analytics:team:2:metrics:from=2025-11-24:to=2025-11-30:bucket=week"
+	if got != want {
+		t.Errorf("teamMetricsCacheKey() = %q, want %q", got, want)
+	}
+}
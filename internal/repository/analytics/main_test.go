@@ -0,0 +1,56 @@
+//go:build test
+
+package analytics
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/redistest"
+	"taskmanager/internal/testing/configtest"
+)
+
+var databaseTest *dbtest.Container
+var redisTest *redistest.Container
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Database database.Configuration `toml:"database"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Setup database container for all tests in this package
+		var err error
+		if databaseTest, err = dbtest.SetupDatabase(nil,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		); err != nil {
+			log.Fatalf("Failed to setup database: %v", err)
+		}
+		defer func() {
+			if err := databaseTest.TeardownDatabase(); err != nil {
+				log.Printf("Failed to teardown database: %v", err)
+			}
+		}()
+
+		// Setup Redis container for cache tests
+		if redisTest, err = redistest.SetupRedis(nil); err != nil {
+			log.Fatalf("Failed to setup redis: %v", err)
+		}
+		defer func() {
+			if err := redisTest.TeardownRedis(); err != nil {
+				log.Printf("Failed to teardown redis: %v", err)
+			}
+		}()
+
+		return m.Run()
+	}(m))
+}
@@ -0,0 +1,221 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"taskmanager/internal/entity/analytics"
+	"taskmanager/internal/entity/sprint"
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/database"
+)
+
+// Persistent defines the interface for analytics persistence
+type Persistent interface {
+	TeamMetrics(ctx context.Context, teamID uint, period analytics.Period) (*analytics.TeamMetrics, error)
+	InvalidateTeam(ctx context.Context, teamID uint) error
+	RecordSnapshots(ctx context.Context, date time.Time) error
+	ListStatusSnapshots(ctx context.Context, teamID uint, from, to time.Time) ([]analytics.StatusSnapshot, error)
+	ListSprintSnapshots(ctx context.Context, sprintID uint) ([]analytics.SprintSnapshot, error)
+}
+
+// datasource implements the persistent interface using PostgreSQL
+type datasource struct{}
+
+var persist Persistent = &datasource{}
+
+// SetPersist sets the persistent implementation
+func SetPersist(p Persistent) {
+	persist = p
+}
+
+// Persist returns the current persistent implementation
+func Persist() Persistent {
+	return persist
+}
+
+// TeamMetrics aggregates the tasks of a team finished within the period: lead and cycle time
+// percentiles of the done tasks, done tasks per bucket (empty buckets included) and the number of
+// done and canceled tasks
+func (p *datasource) TeamMetrics(ctx context.Context, teamID uint, period analytics.Period) (*analytics.TeamMetrics, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary struct {
+		LeadCount  int
+		LeadP50    *float64
+		LeadP85    *float64
+		LeadP95    *float64
+		CycleCount int
+		CycleP50   *float64
+		CycleP85   *float64
+		CycleP95   *float64
+		Done       int
+		Canceled   int
+	}
+
+	// percentile_cont ignores nulls, so tasks never started are left out of the cycle time
+	if err := db.Raw(`SELECT
+			COUNT(*) FILTER (WHERE status = @done) AS lead_count,
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - created_at) / 3600) FILTER (WHERE status = @done) AS lead_p50,
+			percentile_cont(0.85) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - created_at) / 3600) FILTER (WHERE status = @done) AS lead_p85,
+			percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - created_at) / 3600) FILTER (WHERE status = @done) AS lead_p95,
+			COUNT(started_at) FILTER (WHERE status = @done) AS cycle_count,
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - started_at) / 3600) FILTER (WHERE status = @done) AS cycle_p50,
+			percentile_cont(0.85) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - started_at) / 3600) FILTER (WHERE status = @done) AS cycle_p85,
+			percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - started_at) / 3600) FILTER (WHERE status = @done) AS cycle_p95,
+			COUNT(*) FILTER (WHERE status = @done) AS done,
+			COUNT(*) FILTER (WHERE status = @canceled) AS canceled
+		FROM tasks
+		WHERE team_id = @team AND deleted_at IS NULL AND finished_at >= @start AND finished_at < @end`,
+		map[string]any{
+			"team":     teamID,
+			"done":     task.StatusDone,
+			"canceled": task.StatusCanceled,
+			"start":    period.From,
+			"end":      period.End(),
+		}).
+		Scan(&summary).Error; err != nil {
+		return nil, err
+	}
+
+	var throughput []analytics.Throughput
+	if err := db.Raw(`SELECT buckets.start, COUNT(tasks.id) AS done
+		FROM generate_series(date_trunc(@bucket, @from::timestamp), date_trunc(@bucket, @to::timestamp), @interval::interval) AS buckets(start)
+		LEFT JOIN tasks ON date_trunc(@bucket, tasks.finished_at AT TIME ZONE 'UTC') = buckets.start
+			AND tasks.team_id = @team AND tasks.status = @done AND tasks.deleted_at IS NULL
+			AND tasks.finished_at >= @start AND tasks.finished_at < @end
+		GROUP BY buckets.start
+		ORDER BY buckets.start`,
+		map[string]any{
+			"bucket":   string(period.Bucket),
+			"interval": "1 " + string(period.Bucket),
+			"from":     period.From.Format(analytics.DateLayout),
+			"to":       period.To.Format(analytics.DateLayout),
+			"team":     teamID,
+			"done":     task.StatusDone,
+			"start":    period.From,
+			"end":      period.End(),
+		}).
+		Scan(&throughput).Error; err != nil {
+		return nil, err
+	}
+
+	return &analytics.TeamMetrics{
+		Period: period,
+		LeadTime: analytics.Percentiles{
+			Count: summary.LeadCount,
+			P50:   summary.LeadP50,
+			P85:   summary.LeadP85,
+			P95:   summary.LeadP95,
+		},
+		CycleTime: analytics.Percentiles{
+			Count: summary.CycleCount,
+			P50:   summary.CycleP50,
+			P85:   summary.CycleP85,
+			P95:   summary.CycleP95,
+		},
+		Throughput: throughput,
+		Done:       summary.Done,
+		Canceled:   summary.Canceled,
+	}, nil
+}
+
+// InvalidateTeam is a no-op: metrics are only cached by the cache decorator
+func (p *datasource) InvalidateTeam(ctx context.Context, teamID uint) error {
+	return nil
+}
+
+// RecordSnapshots records the status counts of every team and the scope and remaining work of every
+// active sprint on the given day, replacing the snapshots already recorded on it
+func (p *datasource) RecordSnapshots(ctx context.Context, date time.Time) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := map[string]any{
+		"date":        date.Format(analytics.DateLayout),
+		"to_do":       task.StatusTodo,
+		"in_progress": task.StatusInProgress,
+		"done":        task.StatusDone,
+		"canceled":    task.StatusCanceled,
+		"active":      sprint.StateActive,
+	}
+
+	if err := db.Exec(`INSERT INTO team_status_snapshots (team_id, snapshot_date, to_do, in_progress, done, canceled)
+		SELECT teams.id, @date::date,
+			COUNT(tasks.id) FILTER (WHERE tasks.status = @to_do),
+			COUNT(tasks.id) FILTER (WHERE tasks.status = @in_progress),
+			COUNT(tasks.id) FILTER (WHERE tasks.status = @done),
+			COUNT(tasks.id) FILTER (WHERE tasks.status = @canceled)
+		FROM teams
+		LEFT JOIN tasks ON tasks.team_id = teams.id AND tasks.deleted_at IS NULL
+		WHERE teams.deleted_at IS NULL
+		GROUP BY teams.id
+		ON CONFLICT (team_id, snapshot_date) DO UPDATE SET
+			to_do = EXCLUDED.to_do,
+			in_progress = EXCLUDED.in_progress,
+			done = EXCLUDED.done,
+			canceled = EXCLUDED.canceled,
+			updated_at = CURRENT_TIMESTAMP`, params).Error; err != nil {
+		return err
+	}
+
+	return db.Exec(`INSERT INTO sprint_snapshots (sprint_id, snapshot_date, total_tasks, remaining_tasks, total_points, remaining_points)
+		SELECT sprints.id, @date::date,
+			COUNT(tasks.id) FILTER (WHERE tasks.status <> @canceled),
+			COUNT(tasks.id) FILTER (WHERE tasks.status NOT IN (@done, @canceled)),
+			COALESCE(SUM(tasks.estimate) FILTER (WHERE tasks.status <> @canceled), 0),
+			COALESCE(SUM(tasks.estimate) FILTER (WHERE tasks.status NOT IN (@done, @canceled)), 0)
+		FROM sprints
+		LEFT JOIN tasks ON tasks.sprint_id = sprints.id AND tasks.deleted_at IS NULL
+		WHERE sprints.state = @active AND sprints.deleted_at IS NULL
+		GROUP BY sprints.id
+		ON CONFLICT (sprint_id, snapshot_date) DO UPDATE SET
+			total_tasks = EXCLUDED.total_tasks,
+			remaining_tasks = EXCLUDED.remaining_tasks,
+			total_points = EXCLUDED.total_points,
+			remaining_points = EXCLUDED.remaining_points,
+			updated_at = CURRENT_TIMESTAMP`, params).Error
+}
+
+// ListStatusSnapshots lists the status snapshots of a team from one day to another, both included, in date order
+func (p *datasource) ListStatusSnapshots(ctx context.Context, teamID uint, from, to time.Time) ([]analytics.StatusSnapshot, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []analytics.StatusSnapshot{}
+	if err := db.Table("team_status_snapshots").
+		Select("snapshot_date AS date, to_do, in_progress, done, canceled").
+		Where("team_id = ? AND snapshot_date BETWEEN ? AND ?", teamID, from.Format(analytics.DateLayout), to.Format(analytics.DateLayout)).
+		Order("snapshot_date").
+		Scan(&snapshots).Error; err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// ListSprintSnapshots lists the snapshots of a sprint in date order
+func (p *datasource) ListSprintSnapshots(ctx context.Context, sprintID uint) ([]analytics.SprintSnapshot, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []analytics.SprintSnapshot{}
+	if err := db.Table("sprint_snapshots").
+		Select("snapshot_date AS date, total_tasks, remaining_tasks, total_points, remaining_points").
+		Where("sprint_id = ?", sprintID).
+		Order("snapshot_date").
+		Scan(&snapshots).Error; err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
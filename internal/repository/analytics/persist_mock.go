@@ -0,0 +1,65 @@
+//go:build test
+
+package analytics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"taskmanager/internal/entity/analytics"
+)
+
+// MockPersistent é um mock da interface Persistent para testes
+type MockPersistent struct {
+	FnTeamMetrics         func(context.Context, uint, analytics.Period) (*analytics.TeamMetrics, error)
+	FnInvalidateTeam      func(context.Context, uint) error
+	FnRecordSnapshots     func(context.Context, time.Time) error
+	FnListStatusSnapshots func(context.Context, uint, time.Time, time.Time) ([]analytics.StatusSnapshot, error)
+	FnListSprintSnapshots func(context.Context, uint) ([]analytics.SprintSnapshot, error)
+}
+
+// TeamMetrics implementa o método TeamMetrics da interface Persistent
+func (m *MockPersistent) TeamMetrics(ctx context.Context, teamID uint, period analytics.Period) (*analytics.TeamMetrics, error) {
+	if m.FnTeamMetrics == nil {
+		slog.Error("fnTeamMetrics is nil")
+		return nil, nil
+	}
+	return m.FnTeamMetrics(ctx, teamID, period)
+}
+
+// InvalidateTeam implementa o método InvalidateTeam da interface Persistent
+func (m *MockPersistent) InvalidateTeam(ctx context.Context, teamID uint) error {
+	if m.FnInvalidateTeam == nil {
+		slog.Error("fnInvalidateTeam is nil")
+		return nil
+	}
+	return m.FnInvalidateTeam(ctx, teamID)
+}
+
+// RecordSnapshots implementa o método RecordSnapshots da interface Persistent
+func (m *MockPersistent) RecordSnapshots(ctx context.Context, date time.Time) error {
+	if m.FnRecordSnapshots == nil {
+		slog.Error("fnRecordSnapshots is nil")
+		return nil
+	}
+	return m.FnRecordSnapshots(ctx, date)
+}
+
+// ListStatusSnapshots implementa o método ListStatusSnapshots da interface Persistent
+func (m *MockPersistent) ListStatusSnapshots(ctx context.Context, teamID uint, from, to time.Time) ([]analytics.StatusSnapshot, error) {
+	if m.FnListStatusSnapshots == nil {
+		slog.Error("fnListStatusSnapshots is nil")
+		return nil, nil
+	}
+	return m.FnListStatusSnapshots(ctx, teamID, from, to)
+}
+
+// ListSprintSnapshots implementa o método ListSprintSnapshots da interface Persistent
+func (m *MockPersistent) ListSprintSnapshots(ctx context.Context, sprintID uint) ([]analytics.SprintSnapshot, error) {
+	if m.FnListSprintSnapshots == nil {
+		slog.Error("fnListSprintSnapshots is nil")
+		return nil, nil
+	}
+	return m.FnListSprintSnapshots(ctx, sprintID)
+}
@@ -0,0 +1,340 @@
+//go:build test
+
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/analytics"
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	"taskmanager/internal/platform/testing/assert"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// hours returns a pointer to the given number of hours
+func hours(h float64) *float64 {
+	return &h
+}
+
+// date returns the given day at midnight UTC
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// devOpsLeadTime and devOpsCycleTime summarize the two DevOps tasks done in the fixtures,
+// done in 6 and 10 days after creation and in 4 and 8 days after being started
+var (
+	devOpsLeadTime  = analytics.Percentiles{Count: 2, P50: hours(192), P85: hours(225.6), P95: hours(235.2)}
+	devOpsCycleTime = analytics.Percentiles{Count: 2, P50: hours(144), P85: hours(177.6), P95: hours(187.2)}
+)
+
+func Test_datasource_TeamMetrics(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		teamID  uint
+		period  analytics.Period
+		want    *analytics.TeamMetrics
+		wantErr error
+	}{
+		{
+			"Team metrics bucketed by week",
+			context.Background(),
+			2,
+			analytics.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analytics.BucketWeek},
+			&analytics.TeamMetrics{
+				Period:     analytics.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analytics.BucketWeek},
+				LeadTime:   devOpsLeadTime,
+				CycleTime:  devOpsCycleTime,
+				Throughput: []analytics.Throughput{{Start: date(2025, 11, 24), Done: 2}},
+				Done:       2,
+				Canceled:   1,
+			},
+			nil,
+		},
+		{
+			"Team metrics bucketed by day include empty buckets",
+			context.Background(),
+			2,
+			analytics.Period{From: date(2025, 11, 28), To: date(2025, 11, 30), Bucket: analytics.BucketDay},
+			&analytics.TeamMetrics{
+				Period:    analytics.Period{From: date(2025, 11, 28), To: date(2025, 11, 30), Bucket: analytics.BucketDay},
+				LeadTime:  devOpsLeadTime,
+				CycleTime: devOpsCycleTime,
+				Throughput: []analytics.Throughput{
+					{Start: date(2025, 11, 28), Done: 0},
+					{Start: date(2025, 11, 29), Done: 1},
+					{Start: date(2025, 11, 30), Done: 1},
+				},
+				Done:     2,
+				Canceled: 1,
+			},
+			nil,
+		},
+		{
+			"Team metrics bucketed by month leave out tasks finished before the period",
+			context.Background(),
+			2,
+			analytics.Period{From: date(2025, 11, 30), To: date(2025, 12, 31), Bucket: analytics.BucketMonth},
+			&analytics.TeamMetrics{
+				Period:    analytics.Period{From: date(2025, 11, 30), To: date(2025, 12, 31), Bucket: analytics.BucketMonth},
+				LeadTime:  analytics.Percentiles{Count: 1, P50: hours(144), P85: hours(144), P95: hours(144)},
+				CycleTime: analytics.Percentiles{Count: 1, P50: hours(96), P85: hours(96), P95: hours(96)},
+				Throughput: []analytics.Throughput{
+					{Start: date(2025, 11, 1), Done: 1},
+					{Start: date(2025, 12, 1), Done: 0},
+				},
+				Done: 1,
+			},
+			nil,
+		},
+		{
+			"Team metrics of a team without finished tasks",
+			context.Background(),
+			4,
+			analytics.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analytics.BucketWeek},
+			&analytics.TeamMetrics{
+				Period:     analytics.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analytics.BucketWeek},
+				Throughput: []analytics.Throughput{{Start: date(2025, 11, 24), Done: 0}},
+			},
+			nil,
+		},
+		{
+			"Team metrics with context nil",
+			nil,
+			2,
+			analytics.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analytics.BucketWeek},
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			p := &datasource{}
+			got, err := p.TeamMetrics(ctx, tt.teamID, tt.period)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.TeamMetrics() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+				t.Errorf("datasource.TeamMetrics() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_RecordSnapshots(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	day := date(2025, 12, 1)
+
+	tests := []struct {
+		name       string
+		setup      func()
+		ctx        context.Context
+		wantStatus []analytics.StatusSnapshot
+		wantSprint []analytics.SprintSnapshot
+		wantErr    error
+	}{
+		{
+			"Record snapshots of every team and of the active sprint",
+			func() {
+				dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+			},
+			context.Background(),
+			[]analytics.StatusSnapshot{
+				{Date: day, ToDo: 2, InProgress: 3},
+				{Date: day, ToDo: 1, InProgress: 1, Done: 2, Canceled: 1},
+				{Date: day, ToDo: 1, InProgress: 1, Done: 1},
+				{Date: day},
+			},
+			[]analytics.SprintSnapshot{
+				{Date: day, TotalTasks: 2, RemainingTasks: 2, TotalPoints: 5, RemainingPoints: 5},
+			},
+			nil,
+		},
+		{
+			"Record snapshots replaces the snapshots of the day",
+			func() {
+				dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+				env.DB().Exec("INSERT INTO team_status_snapshots (team_id, snapshot_date, to_do) VALUES (1, '2025-12-01', 99)")
+				env.DB().Exec("INSERT INTO sprint_snapshots (sprint_id, snapshot_date, total_tasks) VALUES (2, '2025-12-01', 99)")
+				env.DB().Exec("UPDATE tasks SET status = 'done', finished_at = '2025-12-01 10:00:00' WHERE uuid = '123e4567-e89b-12d3-a456-426614174001'")
+			},
+			context.Background(),
+			[]analytics.StatusSnapshot{
+				{Date: day, ToDo: 2, InProgress: 2, Done: 1},
+				{Date: day, ToDo: 1, InProgress: 1, Done: 2, Canceled: 1},
+				{Date: day, ToDo: 1, InProgress: 1, Done: 1},
+				{Date: day},
+			},
+			[]analytics.SprintSnapshot{
+				{Date: day, TotalTasks: 2, RemainingTasks: 1, TotalPoints: 5, RemainingPoints: 2},
+			},
+			nil,
+		},
+		{
+			"Record snapshots with context nil",
+			nil,
+			nil,
+			nil,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			p := &datasource{}
+			err := p.RecordSnapshots(ctx, day)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RecordSnapshots() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			var status []analytics.StatusSnapshot
+			for teamID := uint(1); teamID <= 4; teamID++ {
+				snapshots, err := p.ListStatusSnapshots(ctx, teamID, day, day)
+				if err != nil {
+					t.Fatalf("datasource.ListStatusSnapshots() error = %v", err)
+				}
+				status = append(status, snapshots...)
+			}
+			if diff := cmp.Diff(tt.wantStatus, status); diff != "" {
+				t.Errorf("datasource.RecordSnapshots() status snapshots mismatch (-want +got):\n%s", diff)
+			}
+
+			sprintSnapshots, err := p.ListSprintSnapshots(ctx, 2)
+			if err != nil {
+				t.Fatalf("datasource.ListSprintSnapshots() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantSprint, sprintSnapshots); diff != "" {
+				t.Errorf("datasource.RecordSnapshots() sprint snapshots mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListStatusSnapshots(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql", "snapshots.sql")
+
+	tests := []struct {
+		name   string
+		teamID uint
+		from   time.Time
+		to     time.Time
+		want   []analytics.StatusSnapshot
+	}{
+		{
+			"List status snapshots within the period, skipping days without snapshot",
+			2,
+			date(2025, 11, 26),
+			date(2025, 11, 29),
+			[]analytics.StatusSnapshot{
+				{Date: date(2025, 11, 26), ToDo: 2, InProgress: 3},
+				{Date: date(2025, 11, 28), ToDo: 1, InProgress: 2, Canceled: 1},
+				{Date: date(2025, 11, 29), ToDo: 1, InProgress: 1, Done: 1, Canceled: 1},
+			},
+		},
+		{
+			"List status snapshots of a team without snapshots",
+			3,
+			date(2025, 11, 1),
+			date(2025, 11, 30),
+			[]analytics.StatusSnapshot{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.ListStatusSnapshots(ctx, tt.teamID, tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("datasource.ListStatusSnapshots() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("datasource.ListStatusSnapshots() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListSprintSnapshots(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql", "snapshots.sql")
+
+	tests := []struct {
+		name     string
+		sprintID uint
+		want     []analytics.SprintSnapshot
+	}{
+		{
+			"List sprint snapshots in date order",
+			4,
+			[]analytics.SprintSnapshot{
+				{Date: date(2025, 11, 24), TotalTasks: 3, RemainingTasks: 3, TotalPoints: 10, RemainingPoints: 10},
+				{Date: date(2025, 11, 28), TotalTasks: 2, RemainingTasks: 2, TotalPoints: 8, RemainingPoints: 8},
+				{Date: date(2025, 11, 29), TotalTasks: 2, RemainingTasks: 1, TotalPoints: 8, RemainingPoints: 5},
+				{Date: date(2025, 11, 30), TotalTasks: 2, RemainingTasks: 0, TotalPoints: 8, RemainingPoints: 0},
+			},
+		},
+		{
+			"List snapshots of a sprint never active",
+			3,
+			[]analytics.SprintSnapshot{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.ListSprintSnapshots(ctx, tt.sprintID)
+			if err != nil {
+				t.Fatalf("datasource.ListSprintSnapshots() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("datasource.ListSprintSnapshots() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+//go:build test
+
+package board
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/platform/cache"
+	"taskmanager/internal/platform/testing/redistest"
+)
+
+var redisTest *redistest.Container
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		// Setup Redis container for presence tests
+		var err error
+		if redisTest, err = redistest.SetupRedis(nil); err != nil {
+			log.Fatalf("Failed to setup redis: %v", err)
+		}
+		defer func() {
+			if err := redisTest.TeardownRedis(); err != nil {
+				log.Printf("Failed to teardown redis: %v", err)
+			}
+		}()
+
+		cache.SetClient(redisTest.Client())
+
+		return m.Run()
+	}(m))
+}
@@ -0,0 +1,127 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"taskmanager/internal/entity/board"
+	"taskmanager/internal/platform/cache"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Persistent defines the interface for board presence persistence
+type Persistent interface {
+	AddViewer(ctx context.Context, teamUUID uuid.UUID, v board.Viewer, expiresAt time.Time) error
+	RemoveViewer(ctx context.Context, teamUUID uuid.UUID, v board.Viewer) error
+	ListViewers(ctx context.Context, teamUUID uuid.UUID, now time.Time) ([]board.Viewer, error)
+}
+
+// datasource implements the persistent interface using Redis, shared by every replica
+// The viewers of a team are a sorted set scored by the unix expiry of each connection
+type datasource struct{}
+
+var persist Persistent = &datasource{}
+
+// SetPersist sets the persistent implementation
+func SetPersist(p Persistent) {
+	persist = p
+}
+
+// Persist returns the current persistent implementation
+func Persist() Persistent {
+	return persist
+}
+
+// presenceKey returns the key of the viewers of a team board
+func presenceKey(teamUUID uuid.UUID) string {
+	return "board:presence:" + teamUUID.String()
+}
+
+// member encodes a viewer as a sorted set member; the connection ID comes first as names may contain ":"
+func member(v board.Viewer) string {
+	return v.ConnectionID.String() + ":" + v.Name
+}
+
+// AddViewer adds or refreshes a viewer of a team board until expiresAt
+// The key expires with its latest viewer, so boards left by crashed replicas are cleaned up
+func (p *datasource) AddViewer(ctx context.Context, teamUUID uuid.UUID, v board.Viewer, expiresAt time.Time) error {
+	client, err := cache.Client()
+	if err != nil {
+		return err
+	}
+
+	key := presenceKey(teamUUID)
+	_, err = client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(expiresAt.Unix()), Member: member(v)})
+		pipe.ExpireAt(ctx, key, expiresAt)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("presence add %q: %w", key, err)
+	}
+
+	return nil
+}
+
+// RemoveViewer removes a viewer from a team board
+func (p *datasource) RemoveViewer(ctx context.Context, teamUUID uuid.UUID, v board.Viewer) error {
+	client, err := cache.Client()
+	if err != nil {
+		return err
+	}
+
+	key := presenceKey(teamUUID)
+	if err := client.ZRem(ctx, key, member(v)).Err(); err != nil {
+		return fmt.Errorf("presence remove %q: %w", key, err)
+	}
+
+	return nil
+}
+
+// ListViewers lists the viewers of a team board not expired at now, ordered by name
+// Expired viewers are removed
+func (p *datasource) ListViewers(ctx context.Context, teamUUID uuid.UUID, now time.Time) ([]board.Viewer, error) {
+	client, err := cache.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	key := presenceKey(teamUUID)
+	var members *redis.StringSliceCmd
+	_, err = client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Unix(), 10))
+		members = pipe.ZRange(ctx, key, 0, -1)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("presence list %q: %w", key, err)
+	}
+
+	viewers := make([]board.Viewer, 0, len(members.Val()))
+	for _, m := range members.Val() {
+		connectionID, name, ok := strings.Cut(m, ":")
+		if !ok {
+			continue
+		}
+		id, err := uuid.Parse(connectionID)
+		if err != nil {
+			continue
+		}
+		viewers = append(viewers, board.Viewer{ConnectionID: id, Name: name})
+	}
+
+	sort.Slice(viewers, func(i, j int) bool {
+		if viewers[i].Name != viewers[j].Name {
+			return viewers[i].Name < viewers[j].Name
+		}
+		return viewers[i].ConnectionID.String() < viewers[j].ConnectionID.String()
+	})
+
+	return viewers, nil
+}
@@ -0,0 +1,47 @@
+//go:build test
+
+package board
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/board"
+)
+
+// MockPersistent é um mock da interface Persistent para testes
+type MockPersistent struct {
+	FnAddViewer    func(context.Context, uuid.UUID, board.Viewer, time.Time) error
+	FnRemoveViewer func(context.Context, uuid.UUID, board.Viewer) error
+	FnListViewers  func(context.Context, uuid.UUID, time.Time) ([]board.Viewer, error)
+}
+
+// AddViewer implementa o método AddViewer da interface Persistent
+func (m *MockPersistent) AddViewer(ctx context.Context, teamUUID uuid.UUID, v board.Viewer, expiresAt time.Time) error {
+	if m.FnAddViewer == nil {
+		slog.Error("fnAddViewer is nil")
+		return nil
+	}
+	return m.FnAddViewer(ctx, teamUUID, v, expiresAt)
+}
+
+// RemoveViewer implementa o método RemoveViewer da interface Persistent
+func (m *MockPersistent) RemoveViewer(ctx context.Context, teamUUID uuid.UUID, v board.Viewer) error {
+	if m.FnRemoveViewer == nil {
+		slog.Error("fnRemoveViewer is nil")
+		return nil
+	}
+	return m.FnRemoveViewer(ctx, teamUUID, v)
+}
+
+// ListViewers implementa o método ListViewers da interface Persistent
+func (m *MockPersistent) ListViewers(ctx context.Context, teamUUID uuid.UUID, now time.Time) ([]board.Viewer, error) {
+	if m.FnListViewers == nil {
+		slog.Error("fnListViewers is nil")
+		return nil, nil
+	}
+	return m.FnListViewers(ctx, teamUUID, now)
+}
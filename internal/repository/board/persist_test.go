@@ -0,0 +1,92 @@
+//go:build test
+
+package board
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/board"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+var (
+	teamUUID = uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+	ana      = board.Viewer{ConnectionID: uuid.MustParse("aaaa4567-e89b-12d3-a456-426614174000"), Name: "Ana: Souza"}
+	bruno    = board.Viewer{ConnectionID: uuid.MustParse("bbbb4567-e89b-12d3-a456-426614174000"), Name: "Bruno"}
+	brunoTab = board.Viewer{ConnectionID: uuid.MustParse("bbbb4567-e89b-12d3-a456-426614174001"), Name: "Bruno"}
+)
+
+func Test_datasource_ListViewers(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithRedis(redisTest),
+	)
+
+	now := time.Date(2025, 12, 1, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		setup   func(p *datasource)
+		want    []board.Viewer
+		wantErr bool
+	}{
+		{
+			"ListViewers ordered by name",
+			func(p *datasource) {
+				p.AddViewer(context.Background(), teamUUID, bruno, now.Add(time.Minute))
+				p.AddViewer(context.Background(), teamUUID, ana, now.Add(time.Minute))
+				p.AddViewer(context.Background(), teamUUID, brunoTab, now.Add(time.Minute))
+			},
+			[]board.Viewer{ana, bruno, brunoTab},
+			false,
+		},
+		{
+			"ListViewers skips expired viewers",
+			func(p *datasource) {
+				p.AddViewer(context.Background(), teamUUID, ana, now.Add(-time.Second))
+				p.AddViewer(context.Background(), teamUUID, bruno, now.Add(time.Minute))
+			},
+			[]board.Viewer{bruno},
+			false,
+		},
+		{
+			"ListViewers after refresh and removal",
+			func(p *datasource) {
+				p.AddViewer(context.Background(), teamUUID, ana, now.Add(-time.Second))
+				p.AddViewer(context.Background(), teamUUID, ana, now.Add(time.Minute))
+				p.AddViewer(context.Background(), teamUUID, bruno, now.Add(time.Minute))
+				p.RemoveViewer(context.Background(), teamUUID, bruno)
+			},
+			[]board.Viewer{ana},
+			false,
+		},
+		{
+			"ListViewers of board without viewers",
+			nil,
+			[]board.Viewer{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env.FlushRedis()
+
+			p := &datasource{}
+			if tt.setup != nil {
+				tt.setup(p)
+			}
+
+			got, err := p.ListViewers(context.Background(), teamUUID, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("datasource.ListViewers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.ListViewers() diff: %s", diff)
+			}
+		})
+	}
+}
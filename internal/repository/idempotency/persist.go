@@ -0,0 +1,124 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"taskmanager/internal/entity/idempotency"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+
+	"gorm.io/gorm"
+)
+
+// Persistent defines the interface for idempotency key persistence
+type Persistent interface {
+	Reserve(ctx context.Context, k *idempotency.Key) (bool, error)
+	RetrieveByKey(ctx context.Context, key string) (*idempotency.Key, error)
+	SaveResponse(ctx context.Context, key string, statusCode int, headers map[string]string, body []byte) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// datasource implements the persistent interface using PostgreSQL
+type datasource struct{}
+
+var persist Persistent = &datasource{}
+
+// SetPersist sets the persistent implementation
+func SetPersist(p Persistent) {
+	persist = p
+}
+
+// Persist returns the current persistent implementation
+func Persist() Persistent {
+	return persist
+}
+
+// Reserve inserts the key, replacing it when expired at k.CreatedAt, in the transaction held by the context
+// Returns false when the key is held by another request; the insert waits for a concurrent transaction
+// holding the key to finish, so the stored key is committed when false is returned
+func (p *datasource) Reserve(ctx context.Context, k *idempotency.Key) (bool, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	result := db.Exec(`INSERT INTO idempotency_keys (key, request_hash, status_code, response_headers, response_body, created_at, expires_at)
+		VALUES (?, ?, 0, '{}', '', ?, ?)
+		ON CONFLICT (key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = EXCLUDED.status_code,
+			response_headers = EXCLUDED.response_headers,
+			response_body = EXCLUDED.response_body,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at`,
+		k.Key, k.RequestHash, k.CreatedAt, k.ExpiresAt)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// RetrieveByKey retrieves a stored idempotency key
+func (p *datasource) RetrieveByKey(ctx context.Context, key string) (*idempotency.Key, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var k idempotency.Key
+	if err := db.Where("key = ?", key).First(&k).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &k, nil
+}
+
+// SaveResponse stores the response replayed for a reserved key
+func (p *datasource) SaveResponse(ctx context.Context, key string, statusCode int, headers map[string]string, body []byte) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&idempotency.Key{}).
+		Where("key = ?", key).
+		Updates(&idempotency.Key{
+			StatusCode:      statusCode,
+			ResponseHeaders: headers,
+			ResponseBody:    body,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteExpired removes the keys expired at the given time
+// Returns the number of keys removed
+func (p *datasource) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	result := db.Where("expires_at <= ?", now).Delete(&idempotency.Key{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
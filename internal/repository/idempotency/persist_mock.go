@@ -0,0 +1,55 @@
+//go:build test
+
+package idempotency
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"taskmanager/internal/entity/idempotency"
+)
+
+// MockPersistent é um mock da interface Persistent para testes
+type MockPersistent struct {
+	FnReserve       func(context.Context, *idempotency.Key) (bool, error)
+	FnRetrieveByKey func(context.Context, string) (*idempotency.Key, error)
+	FnSaveResponse  func(context.Context, string, int, map[string]string, []byte) error
+	FnDeleteExpired func(context.Context, time.Time) (int64, error)
+}
+
+// Reserve implementa o método Reserve da interface Persistent
+func (m *MockPersistent) Reserve(ctx context.Context, k *idempotency.Key) (bool, error) {
+	if m.FnReserve == nil {
+		slog.Error("fnReserve is nil")
+		return false, nil
+	}
+	return m.FnReserve(ctx, k)
+}
+
+// RetrieveByKey implementa o método RetrieveByKey da interface Persistent
+func (m *MockPersistent) RetrieveByKey(ctx context.Context, key string) (*idempotency.Key, error) {
+	if m.FnRetrieveByKey == nil {
+		slog.Error("fnRetrieveByKey is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveByKey(ctx, key)
+}
+
+// SaveResponse implementa o método SaveResponse da interface Persistent
+func (m *MockPersistent) SaveResponse(ctx context.Context, key string, statusCode int, headers map[string]string, body []byte) error {
+	if m.FnSaveResponse == nil {
+		slog.Error("fnSaveResponse is nil")
+		return nil
+	}
+	return m.FnSaveResponse(ctx, key, statusCode, headers, body)
+}
+
+// DeleteExpired implementa o método DeleteExpired da interface Persistent
+func (m *MockPersistent) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	if m.FnDeleteExpired == nil {
+		slog.Error("fnDeleteExpired is nil")
+		return 0, nil
+	}
+	return m.FnDeleteExpired(ctx, now)
+}
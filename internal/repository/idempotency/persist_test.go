@@ -0,0 +1,243 @@
+//go:build test
+
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/idempotency"
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_datasource_Reserve(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithKeys := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "idempotency_keys.sql")
+	}
+
+	now := time.Date(2025, 12, 1, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		setup      func()
+		ctx        context.Context
+		key        string
+		want       bool
+		wantHash   string
+		wantStatus int
+		wantErr    error
+	}{
+		{"Reserve a new key", resetWithKeys, context.Background(), "key-new", true, "new-hash", 0, nil},
+		{"Reserve a live key", resetWithKeys, context.Background(), "key-live", false, "f0e1d2c3b4a5968778695a4b3c2d1e0ff0e1d2c3b4a5968778695a4b3c2d1e0f", 200, nil},
+		{"Reserve an expired key", resetWithKeys, context.Background(), "key-expired", true, "new-hash", 0, nil},
+		{"Reserve with context nil", resetWithKeys, nil, "key-new", false, "", 0, database.ErrContextDatabase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.Reserve(ctx, &idempotency.Key{
+				Key:         tt.key,
+				RequestHash: "new-hash",
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(24 * time.Hour),
+			})
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.Reserve() error diff: %s", diff)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("datasource.Reserve() = %t, want %t", got, tt.want)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			stored, err := p.RetrieveByKey(ctx, tt.key)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveByKey() error: %v", err)
+			}
+			if stored.RequestHash != tt.wantHash || stored.StatusCode != tt.wantStatus {
+				t.Errorf("datasource.Reserve() stored hash=%q status=%d, want hash=%q status=%d", stored.RequestHash, stored.StatusCode, tt.wantHash, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveByKey(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithKeys := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "idempotency_keys.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		key     string
+		want    *idempotency.Key
+		wantErr error
+	}{
+		{
+			"Retrieve key with success",
+			resetWithKeys,
+			context.Background(),
+			"key-live",
+			&idempotency.Key{
+				Key:             "key-live",
+				RequestHash:     "f0e1d2c3b4a5968778695a4b3c2d1e0ff0e1d2c3b4a5968778695a4b3c2d1e0f",
+				StatusCode:      200,
+				ResponseHeaders: map[string]string{"ETag": `"1"`},
+				ResponseBody:    []byte(`{"uuid":"123e4567-e89b-12d3-a456-426614174000"}`),
+				CreatedAt:       time.Date(2025, 12, 1, 18, 0, 0, 0, time.UTC),
+				ExpiresAt:       time.Date(2025, 12, 2, 18, 0, 0, 0, time.UTC),
+			},
+			nil,
+		},
+		{"Retrieve key not found", resetWithKeys, context.Background(), "key-missing", nil, errs.ErrNotFound},
+		{"Retrieve key with context nil", resetWithKeys, nil, "key-live", nil, database.ErrContextDatabase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithoutTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.RetrieveByKey(ctx, tt.key)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveByKey() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.RetrieveByKey() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_SaveResponse(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithKeys := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "idempotency_keys.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		key     string
+		wantErr error
+	}{
+		{"Save response with success", resetWithKeys, context.Background(), "key-live", nil},
+		{"Save response of a key not found", resetWithKeys, context.Background(), "key-missing", errs.ErrNotFound},
+		{"Save response with context nil", resetWithKeys, nil, "key-live", database.ErrContextDatabase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.SaveResponse(ctx, tt.key, 202, map[string]string{"Location": "/api/imports/1"}, []byte(`{"status":"pending"}`))
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.SaveResponse() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveByKey(ctx, tt.key)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveByKey() error: %v", err)
+			}
+			if got.StatusCode != 202 || got.ResponseHeaders["Location"] != "/api/imports/1" || string(got.ResponseBody) != `{"status":"pending"}` {
+				t.Errorf("datasource.SaveResponse() stored status=%d headers=%v body=%s", got.StatusCode, got.ResponseHeaders, got.ResponseBody)
+			}
+		})
+	}
+}
+
+func Test_datasource_DeleteExpired(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithKeys := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "idempotency_keys.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		now     time.Time
+		want    int64
+		wantErr error
+	}{
+		{"Delete the expired key", resetWithKeys, context.Background(), time.Date(2025, 12, 1, 20, 0, 0, 0, time.UTC), 1, nil},
+		{"Delete every key", resetWithKeys, context.Background(), time.Date(2025, 12, 3, 0, 0, 0, 0, time.UTC), 2, nil},
+		{"Delete nothing before expiration", resetWithKeys, context.Background(), time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), 0, nil},
+		{"Delete with context nil", resetWithKeys, nil, time.Date(2025, 12, 1, 20, 0, 0, 0, time.UTC), 0, database.ErrContextDatabase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.DeleteExpired(ctx, tt.now)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.DeleteExpired() error diff: %s", diff)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("datasource.DeleteExpired() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
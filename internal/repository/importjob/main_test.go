@@ -0,0 +1,42 @@
+//go:build test
+
+package importjob
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/testing/configtest"
+)
+
+var databaseTest *dbtest.Container
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Database database.Configuration `toml:"database"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Setup database container for all tests in this package
+		var err error
+		if databaseTest, err = dbtest.SetupDatabase(nil, dbtest.WithMigrations(paths.MigrationDir())); err != nil {
+			log.Fatalf("Failed to setup database: %v", err)
+		}
+		defer func() {
+			if err := databaseTest.TeardownDatabase(); err != nil {
+				log.Printf("Failed to teardown database: %v", err)
+			}
+		}()
+
+		return m.Run()
+	}(m))
+}
@@ -0,0 +1,116 @@
+package importjob
+
+import (
+	"context"
+	"errors"
+
+	"taskmanager/internal/entity/importjob"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Persistent defines the interface for import job persistence
+type Persistent interface {
+	Create(ctx context.Context, j *importjob.ImportJob) error
+	RetrieveByUUID(ctx context.Context, jobUUID uuid.UUID) (*importjob.ImportJob, error)
+	ClaimNextPending(ctx context.Context) (*importjob.ImportJob, error)
+	Update(ctx context.Context, j *importjob.ImportJob) error
+}
+
+// datasource implements the persistent interface using PostgreSQL
+type datasource struct{}
+
+var persist Persistent = &datasource{}
+
+// SetPersist sets the persistent implementation
+func SetPersist(p Persistent) {
+	persist = p
+}
+
+// Persist returns the current persistent implementation
+func Persist() Persistent {
+	return persist
+}
+
+// Create saves a new import job to the database
+func (p *datasource) Create(ctx context.Context, j *importjob.ImportJob) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db.Create(j).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RetrieveByUUID retrieves an import job by UUID from the database
+func (p *datasource) RetrieveByUUID(ctx context.Context, jobUUID uuid.UUID) (*importjob.ImportJob, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var j importjob.ImportJob
+	if err := db.Where("uuid = ?", jobUUID).First(&j).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+// ClaimNextPending locks the oldest pending import job for processing
+// Jobs locked by another transaction are skipped; must run inside a transaction
+// Returns ErrNotFound when there is no pending job
+func (p *datasource) ClaimNextPending(ctx context.Context) (*importjob.ImportJob, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var j importjob.ImportJob
+	err = db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ?", importjob.StatusPending).
+		Order("id ASC").
+		First(&j).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+// Update saves the state, counters and report of an import job
+func (p *datasource) Update(ctx context.Context, j *importjob.ImportJob) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&importjob.ImportJob{}).
+		Where("uuid = ?", j.UUID).
+		Select("status", "total_rows", "imported_rows", "failed_rows", "report", "error_message", "started_at", "finished_at").
+		Updates(j)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
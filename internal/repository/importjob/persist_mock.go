@@ -0,0 +1,55 @@
+//go:build test
+
+package importjob
+
+import (
+	"context"
+	"log/slog"
+	"taskmanager/internal/entity/importjob"
+
+	"github.com/google/uuid"
+)
+
+// MockPersistent é um mock da interface Persistent para testes
+type MockPersistent struct {
+	FnCreate           func(context.Context, *importjob.ImportJob) error
+	FnRetrieveByUUID   func(context.Context, uuid.UUID) (*importjob.ImportJob, error)
+	FnClaimNextPending func(context.Context) (*importjob.ImportJob, error)
+	FnUpdate           func(context.Context, *importjob.ImportJob) error
+}
+
+// Create implementa o método Create da interface Persistent
+func (m *MockPersistent) Create(ctx context.Context, j *importjob.ImportJob) error {
+	if m.FnCreate == nil {
+		slog.Error("fnCreate is nil")
+		return nil
+	}
+	return m.FnCreate(ctx, j)
+}
+
+// RetrieveByUUID implementa o método RetrieveByUUID da interface Persistent
+func (m *MockPersistent) RetrieveByUUID(ctx context.Context, jobUUID uuid.UUID) (*importjob.ImportJob, error) {
+	if m.FnRetrieveByUUID == nil {
+		slog.Error("fnRetrieveByUUID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveByUUID(ctx, jobUUID)
+}
+
+// ClaimNextPending implementa o método ClaimNextPending da interface Persistent
+func (m *MockPersistent) ClaimNextPending(ctx context.Context) (*importjob.ImportJob, error) {
+	if m.FnClaimNextPending == nil {
+		slog.Error("fnClaimNextPending is nil")
+		return nil, nil
+	}
+	return m.FnClaimNextPending(ctx)
+}
+
+// Update implementa o método Update da interface Persistent
+func (m *MockPersistent) Update(ctx context.Context, j *importjob.ImportJob) error {
+	if m.FnUpdate == nil {
+		slog.Error("fnUpdate is nil")
+		return nil
+	}
+	return m.FnUpdate(ctx, j)
+}
@@ -0,0 +1,338 @@
+//go:build test
+
+package importjob
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/importjob"
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func Test_datasource_Create(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithImportJobs := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "import_jobs.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		job     *importjob.ImportJob
+		wantErr error
+	}{
+		{
+			"Create import job with success",
+			resetWithImportJobs,
+			context.Background(),
+			&importjob.ImportJob{
+				Format:  importjob.FormatCSV,
+				Status:  importjob.StatusPending,
+				Mapping: importjob.Mapping{importjob.FieldTitle: "Nome"},
+				Content: "Nome,description\nNova tarefa,Descrição",
+				Report:  []importjob.RowError{},
+			},
+			nil,
+		},
+		{
+			"Create import job with context nil",
+			resetWithImportJobs,
+			nil,
+			&importjob.ImportJob{
+				Format:  importjob.FormatCSV,
+				Status:  importjob.StatusPending,
+				Mapping: importjob.Mapping{},
+				Content: "title,description\nNova tarefa,Descrição",
+				Report:  []importjob.RowError{},
+			},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.Create(ctx, tt.job)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.Create() error diff: %s", diff)
+				return
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveByUUID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithImportJobs := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "import_jobs.sql")
+	}
+
+	startedAt := time.Date(2025, 12, 1, 18, 22, 0, 0, time.UTC)
+	finishedAt := time.Date(2025, 12, 1, 18, 22, 1, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		jobUUID uuid.UUID
+		want    *importjob.ImportJob
+		wantErr error
+	}{
+		{
+			"Retrieve import job by UUID with success",
+			resetWithImportJobs,
+			context.Background(),
+			uuid.MustParse("aaae4567-e89b-12d3-a456-426614174000"),
+			&importjob.ImportJob{
+				Model: gorm.Model{
+					ID:        1,
+					CreatedAt: time.Date(2025, 12, 1, 18, 21, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2025, 12, 1, 18, 22, 1, 0, time.UTC),
+				},
+				UUID:         uuid.MustParse("aaae4567-e89b-12d3-a456-426614174000"),
+				Format:       importjob.FormatCSV,
+				Status:       importjob.StatusCompleted,
+				Mapping:      importjob.Mapping{},
+				Content:      "title,description\nImplementar autenticação,Criar sistema JWT",
+				TotalRows:    1,
+				ImportedRows: 1,
+				Report:       []importjob.RowError{},
+				StartedAt:    &startedAt,
+				FinishedAt:   &finishedAt,
+			},
+			nil,
+		},
+		{
+			"Retrieve import job by UUID not found",
+			resetWithImportJobs,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"Retrieve import job by UUID with context nil",
+			nil,
+			nil,
+			uuid.MustParse("aaae4567-e89b-12d3-a456-426614174000"),
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.RetrieveByUUID(ctx, tt.jobUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveByUUID() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.RetrieveByUUID() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ClaimNextPending(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithImportJobs := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "import_jobs.sql")
+	}
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		wantUUID uuid.UUID
+		wantErr  error
+	}{
+		{
+			"Claim oldest pending import job with success",
+			resetWithImportJobs,
+			context.Background(),
+			uuid.MustParse("aaae4567-e89b-12d3-a456-426614174001"),
+			nil,
+		},
+		{
+			"Claim pending import job without pending jobs",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.UUID{},
+			errs.ErrNotFound,
+		},
+		{
+			"Claim pending import job with context nil",
+			nil,
+			nil,
+			uuid.UUID{},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ClaimNextPending(ctx)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ClaimNextPending() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.UUID != tt.wantUUID {
+				t.Errorf("datasource.ClaimNextPending() uuid = %v, want %v", got.UUID, tt.wantUUID)
+			}
+			if diff := cmp.Diff(got.Mapping, importjob.Mapping{importjob.FieldTitle: "name"}); diff != "" {
+				t.Errorf("datasource.ClaimNextPending() mapping diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_Update(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithImportJobs := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "import_jobs.sql")
+	}
+
+	startedAt := time.Date(2025, 12, 2, 10, 0, 0, 0, time.UTC)
+	finishedAt := time.Date(2025, 12, 2, 10, 0, 5, 0, time.UTC)
+	report := []importjob.RowError{
+		{Row: 2, Errors: []errs.ValidationError{{Field: "title", Message: "title is required"}}},
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		job     *importjob.ImportJob
+		wantErr error
+	}{
+		{
+			"Update import job with success",
+			resetWithImportJobs,
+			context.Background(),
+			&importjob.ImportJob{
+				UUID:         uuid.MustParse("aaae4567-e89b-12d3-a456-426614174002"),
+				Status:       importjob.StatusCompleted,
+				TotalRows:    2,
+				ImportedRows: 1,
+				FailedRows:   1,
+				Report:       report,
+				StartedAt:    &startedAt,
+				FinishedAt:   &finishedAt,
+			},
+			nil,
+		},
+		{
+			"Update import job not found",
+			resetWithImportJobs,
+			context.Background(),
+			&importjob.ImportJob{
+				UUID:   uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+				Status: importjob.StatusFailed,
+				Report: []importjob.RowError{},
+			},
+			errs.ErrNotFound,
+		},
+		{
+			"Update import job with context nil",
+			nil,
+			nil,
+			&importjob.ImportJob{
+				UUID:   uuid.MustParse("aaae4567-e89b-12d3-a456-426614174002"),
+				Status: importjob.StatusFailed,
+				Report: []importjob.RowError{},
+			},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.Update(ctx, tt.job)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.Update() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveByUUID(ctx, tt.job.UUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveByUUID() error: %v", err)
+			}
+			if got.Status != tt.job.Status || got.ImportedRows != tt.job.ImportedRows || got.FailedRows != tt.job.FailedRows {
+				t.Errorf("datasource.Update() got status=%v imported=%d failed=%d", got.Status, got.ImportedRows, got.FailedRows)
+			}
+			if diff := cmp.Diff(got.Report, tt.job.Report); diff != "" {
+				t.Errorf("datasource.Update() report diff: %s", diff)
+			}
+		})
+	}
+}
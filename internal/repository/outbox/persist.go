@@ -0,0 +1,185 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"taskmanager/internal/entity/outbox"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Persistent defines the interface for outbox persistence
+type Persistent interface {
+	Create(ctx context.Context, m *outbox.Message) error
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error)
+	MarkPublished(ctx context.Context, id uint, publishedAt time.Time) error
+	UpdateAttempt(ctx context.Context, m *outbox.Message) error
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+	ListPublishedAfter(ctx context.Context, messageUUID uuid.UUID, limit int) ([]outbox.Message, error)
+}
+
+// relayLockKey is the advisory lock held by the relay while it claims and sends messages,
+// so a single relay sends them at a time and in order
+const relayLockKey = 7_305_120
+
+// datasource implements the persistent interface using PostgreSQL
+type datasource struct{}
+
+var persist Persistent = &datasource{}
+
+// SetPersist sets the persistent implementation
+func SetPersist(p Persistent) {
+	persist = p
+}
+
+// Persist returns the current persistent implementation
+func Persist() Persistent {
+	return persist
+}
+
+// Create saves a new message to the outbox in the transaction held by the context
+func (p *datasource) Create(ctx context.Context, m *outbox.Message) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db.Create(m).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimDue takes the relay lock and returns up to limit pending messages due at now, in id order
+// A pending message backed off past now holds back the messages recorded after it, so they are
+// relayed in order; failed messages no longer hold them. Must run inside a transaction
+func (p *datasource) ClaimDue(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Exec("SELECT pg_advisory_xact_lock(?)", relayLockKey).Error; err != nil {
+		return nil, err
+	}
+
+	var messages []outbox.Message
+	err = db.Where("status = ? AND next_attempt_at <= ?", outbox.MessageStatusPending, now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM outbox held
+			WHERE held.status = ? AND held.next_attempt_at > ? AND held.id < outbox.id
+		)`, outbox.MessageStatusPending, now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// MarkPublished records that a message was accepted by every publisher
+func (p *datasource) MarkPublished(ctx context.Context, id uint, publishedAt time.Time) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&outbox.Message{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":       outbox.MessageStatusPublished,
+			"published_at": publishedAt,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// UpdateAttempt saves the status, attempts, last error and next attempt of a message
+func (p *datasource) UpdateAttempt(ctx context.Context, m *outbox.Message) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&outbox.Message{}).
+		Where("id = ?", m.ID).
+		Updates(map[string]any{
+			"status":          m.Status,
+			"attempts":        m.Attempts,
+			"last_error":      m.LastError,
+			"next_attempt_at": m.NextAttemptAt,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeletePublishedBefore removes the messages published before the given time
+// Returns the number of messages removed
+func (p *datasource) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	result := db.Where("published_at IS NOT NULL AND published_at < ?", before).
+		Delete(&outbox.Message{})
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ListPublishedAfter lists up to limit published messages recorded after the message with the given UUID, in id order
+// The relay publishes in id order, so the messages after its id are exactly the ones published after it
+// Returns ErrNotFound when that message does not exist, is not published yet or was purged
+func (p *datasource) ListPublishedAfter(ctx context.Context, messageUUID uuid.UUID, limit int) ([]outbox.Message, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var last outbox.Message
+	if err := db.Where("uuid = ? AND status = ?", messageUUID, outbox.MessageStatusPublished).First(&last).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var messages []outbox.Message
+	err = db.Where("status = ? AND id > ?", outbox.MessageStatusPublished, last.ID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,77 @@
+//go:build test
+
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/outbox"
+)
+
+// MockPersistent é um mock da interface Persistent para testes
+type MockPersistent struct {
+	FnCreate                func(context.Context, *outbox.Message) error
+	FnClaimDue              func(context.Context, time.Time, int) ([]outbox.Message, error)
+	FnMarkPublished         func(context.Context, uint, time.Time) error
+	FnUpdateAttempt         func(context.Context, *outbox.Message) error
+	FnDeletePublishedBefore func(context.Context, time.Time) (int64, error)
+	FnListPublishedAfter    func(context.Context, uuid.UUID, int) ([]outbox.Message, error)
+}
+
+// Create implementa o método Create da interface Persistent
+func (m *MockPersistent) Create(ctx context.Context, msg *outbox.Message) error {
+	if m.FnCreate == nil {
+		slog.Error("fnCreate is nil")
+		return nil
+	}
+	return m.FnCreate(ctx, msg)
+}
+
+// ClaimDue implementa o método ClaimDue da interface Persistent
+func (m *MockPersistent) ClaimDue(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error) {
+	if m.FnClaimDue == nil {
+		slog.Error("fnClaimDue is nil")
+		return nil, nil
+	}
+	return m.FnClaimDue(ctx, now, limit)
+}
+
+// MarkPublished implementa o método MarkPublished da interface Persistent
+func (m *MockPersistent) MarkPublished(ctx context.Context, id uint, publishedAt time.Time) error {
+	if m.FnMarkPublished == nil {
+		slog.Error("fnMarkPublished is nil")
+		return nil
+	}
+	return m.FnMarkPublished(ctx, id, publishedAt)
+}
+
+// UpdateAttempt implementa o método UpdateAttempt da interface Persistent
+func (m *MockPersistent) UpdateAttempt(ctx context.Context, msg *outbox.Message) error {
+	if m.FnUpdateAttempt == nil {
+		slog.Error("fnUpdateAttempt is nil")
+		return nil
+	}
+	return m.FnUpdateAttempt(ctx, msg)
+}
+
+// DeletePublishedBefore implementa o método DeletePublishedBefore da interface Persistent
+func (m *MockPersistent) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	if m.FnDeletePublishedBefore == nil {
+		slog.Error("fnDeletePublishedBefore is nil")
+		return 0, nil
+	}
+	return m.FnDeletePublishedBefore(ctx, before)
+}
+
+// ListPublishedAfter implementa o método ListPublishedAfter da interface Persistent
+func (m *MockPersistent) ListPublishedAfter(ctx context.Context, messageUUID uuid.UUID, limit int) ([]outbox.Message, error) {
+	if m.FnListPublishedAfter == nil {
+		slog.Error("fnListPublishedAfter is nil")
+		return nil, nil
+	}
+	return m.FnListPublishedAfter(ctx, messageUUID, limit)
+}
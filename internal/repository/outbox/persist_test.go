@@ -0,0 +1,529 @@
+//go:build test
+
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/outbox"
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+// messageUUIDs returns the UUIDs of the messages in order
+func messageUUIDs(messages []outbox.Message) []uuid.UUID {
+	uuids := make([]uuid.UUID, len(messages))
+	for i, m := range messages {
+		uuids[i] = m.UUID
+	}
+	return uuids
+}
+
+// retrieveByUUID reads a message directly, as the relay never retrieves a single message
+func retrieveByUUID(t *testing.T, ctx context.Context, messageUUID uuid.UUID) outbox.Message {
+	t.Helper()
+
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		t.Fatalf("database.DBFromContext() error: %v", err)
+	}
+
+	var m outbox.Message
+	if err := db.Where("uuid = ?", messageUUID).First(&m).Error; err != nil {
+		t.Fatalf("retrieve outbox message %v error: %v", messageUUID, err)
+	}
+	return m
+}
+
+func Test_datasource_Create(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithOutbox := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "outbox.sql")
+	}
+
+	eventUUID := uuid.MustParse("eeee4567-e89b-12d3-a456-426614174009")
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		message *outbox.Message
+		wantErr error
+	}{
+		{
+			"Create message with success",
+			resetWithOutbox,
+			context.Background(),
+			&outbox.Message{
+				UUID:          eventUUID,
+				EventType:     "task.created",
+				Payload:       `{"id":"eeee4567-e89b-12d3-a456-426614174009","type":"task.created"}`,
+				NextAttemptAt: time.Date(2025, 12, 2, 10, 0, 0, 0, time.UTC),
+			},
+			nil,
+		},
+		{
+			"Create message with context nil",
+			resetWithOutbox,
+			nil,
+			&outbox.Message{
+				UUID:          eventUUID,
+				EventType:     "task.created",
+				Payload:       `{}`,
+				NextAttemptAt: time.Date(2025, 12, 2, 10, 0, 0, 0, time.UTC),
+			},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.Create(ctx, tt.message)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.Create() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got := retrieveByUUID(t, ctx, eventUUID)
+			if got.Payload != tt.message.Payload || got.PublishedAt != nil || got.Attempts != 0 {
+				t.Errorf("datasource.Create() got payload=%q published_at=%v attempts=%d", got.Payload, got.PublishedAt, got.Attempts)
+			}
+		})
+	}
+}
+
+func Test_datasource_ClaimDue(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithOutbox := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "outbox.sql")
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		ctx       context.Context
+		now       time.Time
+		limit     int
+		wantUUIDs []uuid.UUID
+		wantErr   error
+	}{
+		{
+			"Claim due messages holding back the ones after a backed off message",
+			resetWithOutbox,
+			context.Background(),
+			time.Date(2025, 12, 1, 18, 35, 0, 0, time.UTC),
+			10,
+			[]uuid.UUID{uuid.MustParse("eeee4567-e89b-12d3-a456-426614174001")},
+			nil,
+		},
+		{
+			"Claim due messages after the backoff skipping failed ones",
+			resetWithOutbox,
+			context.Background(),
+			time.Date(2025, 12, 1, 18, 45, 0, 0, time.UTC),
+			10,
+			[]uuid.UUID{
+				uuid.MustParse("eeee4567-e89b-12d3-a456-426614174001"),
+				uuid.MustParse("eeee4567-e89b-12d3-a456-426614174002"),
+				uuid.MustParse("eeee4567-e89b-12d3-a456-426614174005"),
+			},
+			nil,
+		},
+		{
+			"Claim due messages with limit",
+			resetWithOutbox,
+			context.Background(),
+			time.Date(2025, 12, 1, 18, 45, 0, 0, time.UTC),
+			1,
+			[]uuid.UUID{uuid.MustParse("eeee4567-e89b-12d3-a456-426614174001")},
+			nil,
+		},
+		{
+			"Claim due messages before any is due",
+			resetWithOutbox,
+			context.Background(),
+			time.Date(2025, 12, 1, 18, 0, 0, 0, time.UTC),
+			10,
+			[]uuid.UUID{},
+			nil,
+		},
+		{
+			"Claim due messages with context nil",
+			nil,
+			nil,
+			time.Date(2025, 12, 1, 18, 45, 0, 0, time.UTC),
+			10,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ClaimDue(ctx, tt.now, tt.limit)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ClaimDue() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if diff := cmp.Diff(messageUUIDs(got), tt.wantUUIDs); diff != "" {
+				t.Errorf("datasource.ClaimDue() uuids diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_MarkPublished(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithOutbox := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "outbox.sql")
+	}
+
+	publishedAt := time.Date(2025, 12, 1, 18, 36, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		id      uint
+		wantErr error
+	}{
+		{
+			"Mark message published with success",
+			resetWithOutbox,
+			context.Background(),
+			2,
+			nil,
+		},
+		{
+			"Mark message published not found",
+			resetWithOutbox,
+			context.Background(),
+			999,
+			errs.ErrNotFound,
+		},
+		{
+			"Mark message published with context nil",
+			nil,
+			nil,
+			2,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.MarkPublished(ctx, tt.id, publishedAt)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.MarkPublished() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got := retrieveByUUID(t, ctx, uuid.MustParse("eeee4567-e89b-12d3-a456-426614174001"))
+			if got.Status != outbox.MessageStatusPublished || got.PublishedAt == nil || !got.PublishedAt.Equal(publishedAt) {
+				t.Errorf("datasource.MarkPublished() status = %q published_at = %v, want published at %v", got.Status, got.PublishedAt, publishedAt)
+			}
+		})
+	}
+}
+
+func Test_datasource_UpdateAttempt(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithOutbox := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "outbox.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		message *outbox.Message
+		wantErr error
+	}{
+		{
+			"Update attempt with success",
+			resetWithOutbox,
+			context.Background(),
+			&outbox.Message{
+				ID:            3,
+				UUID:          uuid.MustParse("eeee4567-e89b-12d3-a456-426614174002"),
+				Status:        outbox.MessageStatusPending,
+				Attempts:      3,
+				LastError:     "timeout",
+				NextAttemptAt: time.Date(2025, 12, 1, 18, 50, 0, 0, time.UTC),
+			},
+			nil,
+		},
+		{
+			"Update attempt marking the message failed",
+			resetWithOutbox,
+			context.Background(),
+			&outbox.Message{
+				ID:            3,
+				UUID:          uuid.MustParse("eeee4567-e89b-12d3-a456-426614174002"),
+				Status:        outbox.MessageStatusFailed,
+				Attempts:      3,
+				LastError:     "timeout",
+				NextAttemptAt: time.Date(2025, 12, 1, 18, 40, 0, 0, time.UTC),
+			},
+			nil,
+		},
+		{
+			"Update attempt not found",
+			resetWithOutbox,
+			context.Background(),
+			&outbox.Message{ID: 999, Attempts: 1},
+			errs.ErrNotFound,
+		},
+		{
+			"Update attempt with context nil",
+			nil,
+			nil,
+			&outbox.Message{ID: 3, Attempts: 1},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.UpdateAttempt(ctx, tt.message)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.UpdateAttempt() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got := retrieveByUUID(t, ctx, tt.message.UUID)
+			if got.Status != tt.message.Status || got.Attempts != tt.message.Attempts || got.LastError != tt.message.LastError || !got.NextAttemptAt.Equal(tt.message.NextAttemptAt) {
+				t.Errorf("datasource.UpdateAttempt() got status=%q attempts=%d last_error=%q next_attempt_at=%v", got.Status, got.Attempts, got.LastError, got.NextAttemptAt)
+			}
+			if got.PublishedAt != nil {
+				t.Errorf("datasource.UpdateAttempt() published_at = %v, want nil", got.PublishedAt)
+			}
+		})
+	}
+}
+
+func Test_datasource_DeletePublishedBefore(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithOutbox := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "outbox.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		before  time.Time
+		want    int64
+		wantErr error
+	}{
+		{
+			"Delete messages published before retention",
+			resetWithOutbox,
+			context.Background(),
+			time.Date(2025, 11, 15, 0, 0, 0, 0, time.UTC),
+			1,
+			nil,
+		},
+		{
+			"Delete all published messages keeping pending ones",
+			resetWithOutbox,
+			context.Background(),
+			time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+			2,
+			nil,
+		},
+		{
+			"Delete published messages with context nil",
+			nil,
+			nil,
+			time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+			0,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.DeletePublishedBefore(ctx, tt.before)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.DeletePublishedBefore() error diff: %s", diff)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("datasource.DeletePublishedBefore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListPublishedAfter(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithOutbox := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "outbox.sql")
+	}
+
+	tests := []struct {
+		name        string
+		setup       func()
+		ctx         context.Context
+		messageUUID uuid.UUID
+		limit       int
+		wantUUIDs   []uuid.UUID
+		wantErr     error
+	}{
+		{
+			"List messages published after an older message in id order, whatever their published_at",
+			resetWithOutbox,
+			context.Background(),
+			uuid.MustParse("eeee4567-e89b-12d3-a456-426614174000"),
+			10,
+			[]uuid.UUID{uuid.MustParse("eeee4567-e89b-12d3-a456-426614174003")},
+			nil,
+		},
+		{
+			"List messages published after the latest message",
+			resetWithOutbox,
+			context.Background(),
+			uuid.MustParse("eeee4567-e89b-12d3-a456-426614174003"),
+			10,
+			[]uuid.UUID{},
+			nil,
+		},
+		{
+			"List messages published after a pending message",
+			resetWithOutbox,
+			context.Background(),
+			uuid.MustParse("eeee4567-e89b-12d3-a456-426614174001"),
+			10,
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"List messages published after an unknown message",
+			resetWithOutbox,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			10,
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"List messages published after with context nil",
+			nil,
+			nil,
+			uuid.MustParse("eeee4567-e89b-12d3-a456-426614174003"),
+			10,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ListPublishedAfter(ctx, tt.messageUUID, tt.limit)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ListPublishedAfter() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if diff := cmp.Diff(messageUUIDs(got), tt.wantUUIDs); diff != "" {
+				t.Errorf("datasource.ListPublishedAfter() diff: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package project
+
+import (
+	"context"
+	"errors"
+
+	"taskmanager/internal/entity/project"
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/entity/team"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Persistent defines the interface for project persistence
+type Persistent interface {
+	Create(ctx context.Context, p *project.Project) error
+	RetrieveByUUID(ctx context.Context, projectUUID uuid.UUID) (*project.Project, error)
+	ListPaginated(ctx context.Context, page, limit int) (*project.ListProjects, error)
+	CreateMilestone(ctx context.Context, milestone *project.Milestone) error
+	RetrieveMilestoneByUUID(ctx context.Context, milestoneUUID uuid.UUID) (*project.Milestone, error)
+	RetrieveTaskProjectID(ctx context.Context, taskUUID uuid.UUID) (*uint, error)
+	UpdateTaskProject(ctx context.Context, taskUUID uuid.UUID, projectID, milestoneID *uint) error
+	ListTasks(ctx context.Context, projectID uint, filter project.TaskFilter, sort task.ListSort, page, limit int) (*task.ListTasks, error)
+	CountTasks(ctx context.Context, projectID uint) ([]project.TaskCount, error)
+	ListTeams(ctx context.Context, projectID uint) ([]team.Team, error)
+}
+
+// datasource implements the persistent interface using PostgreSQL
+type datasource struct{}
+
+var persist Persistent = &datasource{}
+
+// SetPersist sets the persistent implementation
+func SetPersist(p Persistent) {
+	persist = p
+}
+
+// Persist returns the current persistent implementation
+func Persist() Persistent {
+	return persist
+}
+
+// Create saves a new project to the database
+func (p *datasource) Create(ctx context.Context, pr *project.Project) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Omit("Milestones").Create(pr).Error
+}
+
+// RetrieveByUUID retrieves a project by UUID with its milestones, in target date order
+func (p *datasource) RetrieveByUUID(ctx context.Context, projectUUID uuid.UUID) (*project.Project, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr project.Project
+	if err := db.Preload("Milestones", func(db *gorm.DB) *gorm.DB {
+		return db.Order("target_date ASC").Order("id ASC")
+	}).Where("uuid = ?", projectUUID).First(&pr).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &pr, nil
+}
+
+// ListPaginated lists projects with pagination, most recent first
+func (p *datasource) ListPaginated(ctx context.Context, page, limit int) (*project.ListProjects, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []project.Project
+	var totalItems int64
+
+	query := db.Model(&project.Project{})
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC").Order("id DESC").Offset(offset).Limit(limit).Find(&projects).Error; err != nil {
+		return nil, err
+	}
+
+	return &project.ListProjects{
+		Projects:   projects,
+		TotalItems: int(totalItems),
+		Limit:      limit,
+		Page:       page,
+	}, nil
+}
+
+// CreateMilestone saves a new milestone to the database
+func (p *datasource) CreateMilestone(ctx context.Context, m *project.Milestone) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Create(m).Error
+}
+
+// RetrieveMilestoneByUUID retrieves a milestone by UUID from the database
+func (p *datasource) RetrieveMilestoneByUUID(ctx context.Context, milestoneUUID uuid.UUID) (*project.Milestone, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var m project.Milestone
+	if err := db.Where("uuid = ?", milestoneUUID).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// RetrieveTaskProjectID retrieves the project_id of a task by UUID
+func (p *datasource) RetrieveTaskProjectID(ctx context.Context, taskUUID uuid.UUID) (*uint, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		ProjectID *uint `gorm:"column:project_id"`
+	}
+	if err := db.Table("tasks").
+		Select("project_id").
+		Where("uuid = ? AND deleted_at IS NULL", taskUUID).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return result.ProjectID, nil
+}
+
+// UpdateTaskProject updates the project_id and milestone_id of a task; nil removes the task from them
+func (p *datasource) UpdateTaskProject(ctx context.Context, taskUUID uuid.UUID, projectID, milestoneID *uint) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Table("tasks").
+		Where("uuid = ? AND deleted_at IS NULL", taskUUID).
+		Updates(map[string]any{"project_id": projectID, "milestone_id": milestoneID})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListTasks lists the tasks of a project with pagination and optional filters,
+// most recent first or in rank order
+// Team and milestone filters match by UUID, so unknown ones list no tasks
+func (p *datasource) ListTasks(ctx context.Context, projectID uint, filter project.TaskFilter, sort task.ListSort, page, limit int) (*task.ListTasks, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []task.Task
+	var totalItems int64
+
+	query := db.Model(&task.Task{}).Where("project_id = ?", projectID)
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	if filter.TeamUUID != nil {
+		query = query.Where("team_id = (SELECT id FROM teams WHERE uuid = ? AND deleted_at IS NULL)", *filter.TeamUUID)
+	}
+
+	if filter.MilestoneUUID != nil {
+		query = query.Where("milestone_id = (SELECT id FROM milestones WHERE uuid = ? AND deleted_at IS NULL)", *filter.MilestoneUUID)
+	}
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, err
+	}
+
+	if sort == task.SortRank {
+		query = query.Order("rank ASC").Order("id ASC")
+	} else {
+		query = query.Order("created_at DESC").Order("id DESC")
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Offset(offset).Limit(limit).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+
+	return &task.ListTasks{
+		Limit:      limit,
+		Page:       page,
+		Tasks:      tasks,
+		TotalItems: int(totalItems),
+	}, nil
+}
+
+// CountTasks counts the tasks of a project per milestone, team and status
+// Combinations without tasks are absent from the result
+func (p *datasource) CountTasks(ctx context.Context, projectID uint) ([]project.TaskCount, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []project.TaskCount
+	if err := db.Model(&task.Task{}).
+		Select("milestone_id, team_id, status, COUNT(*) AS count").
+		Where("project_id = ?", projectID).
+		Group("milestone_id, team_id, status").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// ListTeams lists the teams with tasks in a project, in name order
+func (p *datasource) ListTeams(ctx context.Context, projectID uint) ([]team.Team, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []team.Team
+	if err := db.Where("id IN (?)", db.Model(&task.Task{}).Select("team_id").Where("project_id = ?", projectID)).
+		Order("name ASC").Order("id ASC").
+		Find(&teams).Error; err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,118 @@
+//go:build test
+
+package project
+
+import (
+	"context"
+	"log/slog"
+
+	"taskmanager/internal/entity/project"
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/entity/team"
+
+	"github.com/google/uuid"
+)
+
+// MockPersistent é um mock da interface Persistent para testes
+type MockPersistent struct {
+	FnCreate                  func(context.Context, *project.Project) error
+	FnRetrieveByUUID          func(context.Context, uuid.UUID) (*project.Project, error)
+	FnListPaginated           func(context.Context, int, int) (*project.ListProjects, error)
+	FnCreateMilestone         func(context.Context, *project.Milestone) error
+	FnRetrieveMilestoneByUUID func(context.Context, uuid.UUID) (*project.Milestone, error)
+	FnRetrieveTaskProjectID   func(context.Context, uuid.UUID) (*uint, error)
+	FnUpdateTaskProject       func(context.Context, uuid.UUID, *uint, *uint) error
+	FnListTasks               func(context.Context, uint, project.TaskFilter, task.ListSort, int, int) (*task.ListTasks, error)
+	FnCountTasks              func(context.Context, uint) ([]project.TaskCount, error)
+	FnListTeams               func(context.Context, uint) ([]team.Team, error)
+}
+
+// Create implementa o método Create da interface Persistent
+func (m *MockPersistent) Create(ctx context.Context, p *project.Project) error {
+	if m.FnCreate == nil {
+		slog.Error("fnCreate is nil")
+		return nil
+	}
+	return m.FnCreate(ctx, p)
+}
+
+// RetrieveByUUID implementa o método RetrieveByUUID da interface Persistent
+func (m *MockPersistent) RetrieveByUUID(ctx context.Context, projectUUID uuid.UUID) (*project.Project, error) {
+	if m.FnRetrieveByUUID == nil {
+		slog.Error("fnRetrieveByUUID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveByUUID(ctx, projectUUID)
+}
+
+// ListPaginated implementa o método ListPaginated da interface Persistent
+func (m *MockPersistent) ListPaginated(ctx context.Context, page, limit int) (*project.ListProjects, error) {
+	if m.FnListPaginated == nil {
+		slog.Error("fnListPaginated is nil")
+		return nil, nil
+	}
+	return m.FnListPaginated(ctx, page, limit)
+}
+
+// CreateMilestone implementa o método CreateMilestone da interface Persistent
+func (m *MockPersistent) CreateMilestone(ctx context.Context, milestone *project.Milestone) error {
+	if m.FnCreateMilestone == nil {
+		slog.Error("fnCreateMilestone is nil")
+		return nil
+	}
+	return m.FnCreateMilestone(ctx, milestone)
+}
+
+// RetrieveMilestoneByUUID implementa o método RetrieveMilestoneByUUID da interface Persistent
+func (m *MockPersistent) RetrieveMilestoneByUUID(ctx context.Context, milestoneUUID uuid.UUID) (*project.Milestone, error) {
+	if m.FnRetrieveMilestoneByUUID == nil {
+		slog.Error("fnRetrieveMilestoneByUUID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveMilestoneByUUID(ctx, milestoneUUID)
+}
+
+// RetrieveTaskProjectID implementa o método RetrieveTaskProjectID da interface Persistent
+func (m *MockPersistent) RetrieveTaskProjectID(ctx context.Context, taskUUID uuid.UUID) (*uint, error) {
+	if m.FnRetrieveTaskProjectID == nil {
+		slog.Error("fnRetrieveTaskProjectID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveTaskProjectID(ctx, taskUUID)
+}
+
+// UpdateTaskProject implementa o método UpdateTaskProject da interface Persistent
+func (m *MockPersistent) UpdateTaskProject(ctx context.Context, taskUUID uuid.UUID, projectID, milestoneID *uint) error {
+	if m.FnUpdateTaskProject == nil {
+		slog.Error("fnUpdateTaskProject is nil")
+		return nil
+	}
+	return m.FnUpdateTaskProject(ctx, taskUUID, projectID, milestoneID)
+}
+
+// ListTasks implementa o método ListTasks da interface Persistent
+func (m *MockPersistent) ListTasks(ctx context.Context, projectID uint, filter project.TaskFilter, sort task.ListSort, page, limit int) (*task.ListTasks, error) {
+	if m.FnListTasks == nil {
+		slog.Error("fnListTasks is nil")
+		return nil, nil
+	}
+	return m.FnListTasks(ctx, projectID, filter, sort, page, limit)
+}
+
+// CountTasks implementa o método CountTasks da interface Persistent
+func (m *MockPersistent) CountTasks(ctx context.Context, projectID uint) ([]project.TaskCount, error) {
+	if m.FnCountTasks == nil {
+		slog.Error("fnCountTasks is nil")
+		return nil, nil
+	}
+	return m.FnCountTasks(ctx, projectID)
+}
+
+// ListTeams implementa o método ListTeams da interface Persistent
+func (m *MockPersistent) ListTeams(ctx context.Context, projectID uint) ([]team.Team, error) {
+	if m.FnListTeams == nil {
+		slog.Error("fnListTeams is nil")
+		return nil, nil
+	}
+	return m.FnListTeams(ctx, projectID)
+}
@@ -0,0 +1,439 @@
+//go:build test
+
+package project
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/project"
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+var (
+	launchProjectUUID    = uuid.MustParse("666e4567-e89b-12d3-a456-426614174000")
+	infraProjectUUID     = uuid.MustParse("666e4567-e89b-12d3-a456-426614174001")
+	betaMilestoneUUID    = uuid.MustParse("777e4567-e89b-12d3-a456-426614174000")
+	launchMilestoneUUID  = uuid.MustParse("777e4567-e89b-12d3-a456-426614174001")
+	devOpsTeamUUID       = uuid.MustParse("222e4567-e89b-12d3-a456-426614174000")
+	noTeamTaskUUID       = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	documentationUUID    = uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	ciCdTaskUUID         = uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	redisTaskUUID        = uuid.MustParse("123e4567-e89b-12d3-a456-426614174003")
+	dashboardTaskUUID    = uuid.MustParse("123e4567-e89b-12d3-a456-426614174006")
+	testCoverageTaskUUID = uuid.MustParse("423e4567-e89b-12d3-a456-426614174002")
+	outOfProjectTaskUUID = uuid.MustParse("123e4567-e89b-12d3-a456-426614174004")
+	missingUUID          = uuid.MustParse("00000000-0000-0000-0000-000000000000")
+)
+
+// taskUUIDs returns the UUIDs of the tasks, in order
+func taskUUIDs(tasks []task.Task) []uuid.UUID {
+	uuids := make([]uuid.UUID, len(tasks))
+	for i, t := range tasks {
+		uuids[i] = t.UUID
+	}
+	return uuids
+}
+
+func Test_datasource_Create(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithProjects := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		project *project.Project
+		wantErr error
+	}{
+		{
+			"Create project with success",
+			resetWithProjects,
+			context.Background(),
+			&project.Project{Name: "Portal do cliente", Description: "Autoatendimento para clientes"},
+			nil,
+		},
+		{
+			"Create project with context nil",
+			resetWithProjects,
+			nil,
+			&project.Project{Name: "Portal do cliente", Description: "Autoatendimento para clientes"},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.Create(ctx, tt.project)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.Create() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr == nil && (tt.project.ID == 0 || tt.project.UUID == (uuid.UUID{})) {
+				t.Errorf("datasource.Create() did not set the ID and UUID: %+v", tt.project)
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveByUUID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+
+	tests := []struct {
+		name           string
+		projectUUID    uuid.UUID
+		wantName       string
+		wantMilestones []uuid.UUID
+		wantErr        error
+	}{
+		{
+			"Retrieve project with milestones in target date order",
+			launchProjectUUID,
+			"Lançamento v2",
+			[]uuid.UUID{betaMilestoneUUID, launchMilestoneUUID},
+			nil,
+		},
+		{"Retrieve project without milestones", infraProjectUUID, "Migração de infraestrutura", []uuid.UUID{}, nil},
+		{"Retrieve missing project", missingUUID, "", nil, errs.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.RetrieveByUUID(ctx, tt.projectUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveByUUID() error diff: %s", diff)
+				return
+			}
+			if got == nil {
+				return
+			}
+			if got.Name != tt.wantName {
+				t.Errorf("datasource.RetrieveByUUID() name = %q, want %q", got.Name, tt.wantName)
+			}
+			milestones := make([]uuid.UUID, len(got.Milestones))
+			for i, m := range got.Milestones {
+				milestones[i] = m.UUID
+			}
+			if diff := cmp.Diff(tt.wantMilestones, milestones); diff != "" {
+				t.Errorf("datasource.RetrieveByUUID() milestones mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListPaginated(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+
+	tests := []struct {
+		name      string
+		page      int
+		limit     int
+		want      []uuid.UUID
+		wantTotal int
+	}{
+		{"List projects most recent first", 1, 10, []uuid.UUID{infraProjectUUID, launchProjectUUID}, 2},
+		{"List second page of projects", 2, 1, []uuid.UUID{launchProjectUUID}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.ListPaginated(ctx, tt.page, tt.limit)
+			if err != nil {
+				t.Fatalf("datasource.ListPaginated() error = %v", err)
+			}
+			uuids := make([]uuid.UUID, len(got.Projects))
+			for i, pr := range got.Projects {
+				uuids[i] = pr.UUID
+			}
+			if diff := cmp.Diff(tt.want, uuids); diff != "" {
+				t.Errorf("datasource.ListPaginated() mismatch (-want +got):\n%s", diff)
+			}
+			if got.TotalItems != tt.wantTotal {
+				t.Errorf("datasource.ListPaginated() total = %d, want %d", got.TotalItems, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func Test_datasource_CreateMilestone(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+
+	ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+	m := &project.Milestone{ProjectID: 2, Name: "Logs centralizados", TargetDate: time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC)}
+
+	p := &datasource{}
+	if err := p.CreateMilestone(ctx, m); err != nil {
+		t.Fatalf("datasource.CreateMilestone() error = %v", err)
+	}
+
+	got, err := p.RetrieveMilestoneByUUID(ctx, m.UUID)
+	if err != nil {
+		t.Fatalf("datasource.RetrieveMilestoneByUUID() error = %v", err)
+	}
+	if got.ProjectID != 2 || !got.TargetDate.Equal(m.TargetDate) {
+		t.Errorf("datasource.RetrieveMilestoneByUUID() = %+v, want %+v", got, m)
+	}
+
+	if _, err := p.RetrieveMilestoneByUUID(ctx, missingUUID); err != errs.ErrNotFound {
+		t.Errorf("datasource.RetrieveMilestoneByUUID() error = %v, want %v", err, errs.ErrNotFound)
+	}
+}
+
+func Test_datasource_TaskProject(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithProjects := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+	}
+
+	projectID := uint(2)
+
+	tests := []struct {
+		name          string
+		taskUUID      uuid.UUID
+		projectID     *uint
+		wantProjectID *uint
+		wantErr       error
+	}{
+		{"Add task to a project", outOfProjectTaskUUID, &projectID, &projectID, nil},
+		{"Remove task from its project", documentationUUID, nil, nil, nil},
+		{"Update missing task", missingUUID, &projectID, nil, errs.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetWithProjects()
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			err := p.UpdateTaskProject(ctx, tt.taskUUID, tt.projectID, nil)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.UpdateTaskProject() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveTaskProjectID(ctx, tt.taskUUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveTaskProjectID() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantProjectID, got); diff != "" {
+				t.Errorf("datasource.RetrieveTaskProjectID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+
+	done := task.StatusDone
+
+	tests := []struct {
+		name      string
+		filter    project.TaskFilter
+		sort      task.ListSort
+		want      []uuid.UUID
+		wantTotal int
+	}{
+		{
+			"List tasks of a project across teams",
+			project.TaskFilter{},
+			task.SortCreatedAt,
+			[]uuid.UUID{noTeamTaskUUID, documentationUUID, testCoverageTaskUUID, redisTaskUUID, ciCdTaskUUID, dashboardTaskUUID},
+			6,
+		},
+		{
+			"List tasks of a project in rank order",
+			project.TaskFilter{},
+			task.SortRank,
+			[]uuid.UUID{dashboardTaskUUID, ciCdTaskUUID, redisTaskUUID, testCoverageTaskUUID, documentationUUID, noTeamTaskUUID},
+			6,
+		},
+		{
+			"List tasks of a project by status",
+			project.TaskFilter{Status: &done},
+			task.SortCreatedAt,
+			[]uuid.UUID{testCoverageTaskUUID, ciCdTaskUUID, dashboardTaskUUID},
+			3,
+		},
+		{
+			"List tasks of a project by team",
+			project.TaskFilter{TeamUUID: &devOpsTeamUUID},
+			task.SortCreatedAt,
+			[]uuid.UUID{redisTaskUUID, ciCdTaskUUID, dashboardTaskUUID},
+			3,
+		},
+		{
+			"List tasks of a project by milestone",
+			project.TaskFilter{MilestoneUUID: &betaMilestoneUUID},
+			task.SortCreatedAt,
+			[]uuid.UUID{documentationUUID, redisTaskUUID, ciCdTaskUUID},
+			3,
+		},
+		{
+			"List tasks of a project by unknown team",
+			project.TaskFilter{TeamUUID: &missingUUID},
+			task.SortCreatedAt,
+			[]uuid.UUID{},
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.ListTasks(ctx, 1, tt.filter, tt.sort, 1, 10)
+			if err != nil {
+				t.Fatalf("datasource.ListTasks() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, taskUUIDs(got.Tasks)); diff != "" {
+				t.Errorf("datasource.ListTasks() mismatch (-want +got):\n%s", diff)
+			}
+			if got.TotalItems != tt.wantTotal {
+				t.Errorf("datasource.ListTasks() total = %d, want %d", got.TotalItems, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func Test_datasource_CountTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+
+	ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+	p := &datasource{}
+	got, err := p.CountTasks(ctx, 1)
+	if err != nil {
+		t.Fatalf("datasource.CountTasks() error = %v", err)
+	}
+
+	// Milestone and team IDs of the fixtures, 0 standing for none
+	id := func(v *uint) uint {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	type count struct {
+		MilestoneID uint
+		TeamID      uint
+		Status      task.TaskStatus
+		Count       int
+	}
+	counts := make([]count, len(got))
+	for i, c := range got {
+		counts[i] = count{id(c.MilestoneID), id(c.TeamID), c.Status, c.Count}
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].MilestoneID != counts[j].MilestoneID {
+			return counts[i].MilestoneID < counts[j].MilestoneID
+		}
+		if counts[i].TeamID != counts[j].TeamID {
+			return counts[i].TeamID < counts[j].TeamID
+		}
+		return counts[i].Status < counts[j].Status
+	})
+
+	want := []count{
+		{0, 3, task.StatusDone, 1},
+		{1, 1, task.StatusInProgress, 1},
+		{1, 2, task.StatusCanceled, 1},
+		{1, 2, task.StatusDone, 1},
+		{2, 0, task.StatusTodo, 1},
+		{2, 2, task.StatusDone, 1},
+	}
+	if diff := cmp.Diff(want, counts); diff != "" {
+		t.Errorf("datasource.CountTasks() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_datasource_ListTeams(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+
+	tests := []struct {
+		name      string
+		projectID uint
+		want      []string
+	}{
+		{"List teams of a project in name order", 1, []string{"Time de Desenvolvimento", "Time de DevOps", "Time de QA"}},
+		{"List teams of a project with a single team", 2, []string{"Time de DevOps"}},
+		{"List teams of a project without tasks", 3, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.ListTeams(ctx, tt.projectID)
+			if err != nil {
+				t.Fatalf("datasource.ListTeams() error = %v", err)
+			}
+			names := make([]string, len(got))
+			for i, team := range got {
+				names[i] = team.Name
+			}
+			if diff := cmp.Diff(tt.want, names); diff != "" {
+				t.Errorf("datasource.ListTeams() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
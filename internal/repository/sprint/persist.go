@@ -0,0 +1,311 @@
+package sprint
+
+import (
+	"context"
+	"errors"
+
+	"taskmanager/internal/entity/sprint"
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Persistent defines the interface for sprint persistence
+type Persistent interface {
+	Create(ctx context.Context, s *sprint.Sprint) error
+	RetrieveByUUID(ctx context.Context, sprintUUID uuid.UUID) (*sprint.Sprint, error)
+	ListByTeamID(ctx context.Context, teamID uint, page, limit int) (*sprint.ListSprints, error)
+	RetrieveActiveByTeamID(ctx context.Context, teamID uint) (*sprint.Sprint, error)
+	RetrieveNextPlanned(ctx context.Context, teamID, excludeID uint) (*sprint.Sprint, error)
+	UpdateState(ctx context.Context, s *sprint.Sprint) error
+	LockTeam(ctx context.Context, teamID uint) error
+	ListTasks(ctx context.Context, sprintID uint) ([]task.Task, error)
+	RetrieveTaskSprintID(ctx context.Context, taskUUID uuid.UUID) (*uint, error)
+	UpdateTaskSprintID(ctx context.Context, taskUUID uuid.UUID, sprintID *uint) (*task.Task, error)
+	MoveUnfinishedTasks(ctx context.Context, fromID uint, toID *uint) ([]task.Task, error)
+	ListVelocity(ctx context.Context, teamID uint, limit int) ([]sprint.SprintVelocity, error)
+}
+
+// sprintLockClass is the first key of the advisory locks held while the sprints of a team change state;
+// the second key is the team ID
+const sprintLockClass = 7_305_119
+
+// velocityRow maps a sprint row with the estimates and tasks it completed
+type velocityRow struct {
+	sprint.Sprint   `gorm:"embedded"`
+	CompletedPoints int `gorm:"column:completed_points"`
+	CompletedTasks  int `gorm:"column:completed_tasks"`
+}
+
+// datasource implements the persistent interface using PostgreSQL
+type datasource struct{}
+
+var persist Persistent = &datasource{}
+
+// SetPersist sets the persistent implementation
+func SetPersist(p Persistent) {
+	persist = p
+}
+
+// Persist returns the current persistent implementation
+func Persist() Persistent {
+	return persist
+}
+
+// Create saves a new sprint to the database
+func (p *datasource) Create(ctx context.Context, s *sprint.Sprint) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Omit("Team", "Tasks").Create(s).Error
+}
+
+// RetrieveByUUID retrieves a sprint by UUID with its team from the database
+func (p *datasource) RetrieveByUUID(ctx context.Context, sprintUUID uuid.UUID) (*sprint.Sprint, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var s sprint.Sprint
+	if err := db.Preload("Team").Where("uuid = ?", sprintUUID).First(&s).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// ListByTeamID lists the sprints of a team with pagination, in start date order
+func (p *datasource) ListByTeamID(ctx context.Context, teamID uint, page, limit int) (*sprint.ListSprints, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sprints []sprint.Sprint
+	var totalItems int64
+
+	query := db.Model(&sprint.Sprint{}).Where("team_id = ?", teamID)
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Preload("Team").Order("start_date ASC").Order("id ASC").Offset(offset).Limit(limit).Find(&sprints).Error; err != nil {
+		return nil, err
+	}
+
+	return &sprint.ListSprints{
+		Sprints:    sprints,
+		TotalItems: int(totalItems),
+		Limit:      limit,
+		Page:       page,
+	}, nil
+}
+
+// RetrieveActiveByTeamID retrieves the active sprint of a team
+// Returns ErrNotFound when the team has no active sprint
+func (p *datasource) RetrieveActiveByTeamID(ctx context.Context, teamID uint) (*sprint.Sprint, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var s sprint.Sprint
+	if err := db.Where("team_id = ? AND state = ?", teamID, sprint.StateActive).First(&s).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// RetrieveNextPlanned retrieves the planned sprint of a team that starts first, skipping excludeID
+// Returns ErrNotFound when the team has no other planned sprint
+func (p *datasource) RetrieveNextPlanned(ctx context.Context, teamID, excludeID uint) (*sprint.Sprint, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var s sprint.Sprint
+	if err := db.Preload("Team").
+		Where("team_id = ? AND state = ? AND id <> ?", teamID, sprint.StatePlanned, excludeID).
+		Order("start_date ASC").Order("id ASC").
+		First(&s).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// UpdateState updates the state and the start and completion times of a sprint
+func (p *datasource) UpdateState(ctx context.Context, s *sprint.Sprint) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&sprint.Sprint{}).
+		Where("id = ?", s.ID).
+		Select("state", "started_at", "completed_at", "updated_at").
+		Updates(s)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// LockTeam takes the transaction-scoped advisory lock that serializes the sprint changes of a team,
+// so two sprints of the team cannot be started together
+// Must run inside a transaction; the lock is released on commit or rollback
+func (p *datasource) LockTeam(ctx context.Context, teamID uint) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Exec("SELECT pg_advisory_xact_lock(?, ?)", sprintLockClass, teamID).Error
+}
+
+// ListTasks lists the tasks of a sprint in rank order
+func (p *datasource) ListTasks(ctx context.Context, sprintID uint) ([]task.Task, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []task.Task
+	if err := db.Where("sprint_id = ?", sprintID).Order("rank ASC").Order("id ASC").Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// RetrieveTaskSprintID retrieves the sprint_id of a task by UUID
+func (p *datasource) RetrieveTaskSprintID(ctx context.Context, taskUUID uuid.UUID) (*uint, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		SprintID *uint `gorm:"column:sprint_id"`
+	}
+	if err := db.Table("tasks").
+		Select("sprint_id").
+		Where("uuid = ? AND deleted_at IS NULL", taskUUID).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return result.SprintID, nil
+}
+
+// UpdateTaskSprintID updates the sprint_id of a task, incrementing its version; nil moves it back to the backlog
+// Returns the updated task
+func (p *datasource) UpdateTaskSprintID(ctx context.Context, taskUUID uuid.UUID, sprintID *uint) (*task.Task, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []task.Task
+	result := db.Model(&tasks).
+		Clauses(clause.Returning{}).
+		Where("uuid = ?", taskUUID).
+		Updates(map[string]any{"sprint_id": sprintID, "version": gorm.Expr("version + 1")})
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, errs.ErrNotFound
+	}
+
+	return &tasks[0], nil
+}
+
+// MoveUnfinishedTasks moves the tasks of a sprint still to do or in progress to another sprint,
+// or back to the backlog when toID is nil, incrementing their versions
+// Returns the tasks moved
+func (p *datasource) MoveUnfinishedTasks(ctx context.Context, fromID uint, toID *uint) ([]task.Task, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []task.Task
+	if err := db.Model(&tasks).
+		Clauses(clause.Returning{}).
+		Where("sprint_id = ? AND status IN ?", fromID, []task.TaskStatus{task.StatusTodo, task.StatusInProgress}).
+		Updates(map[string]any{"sprint_id": toID, "version": gorm.Expr("version + 1")}).Error; err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// ListVelocity lists the last completed sprints of a team, most recent first, with the estimates and
+// number of the tasks they completed: tasks done between the start and the completion of the sprint
+// Tasks without an estimate count as zero points
+func (p *datasource) ListVelocity(ctx context.Context, teamID uint, limit int) ([]sprint.SprintVelocity, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []velocityRow
+	if err := db.Model(&sprint.Sprint{}).
+		Select(`sprints.*,
+			COALESCE(SUM(tasks.estimate), 0) AS completed_points,
+			COUNT(tasks.id) AS completed_tasks`).
+		Joins(`LEFT JOIN tasks ON tasks.sprint_id = sprints.id
+			AND tasks.deleted_at IS NULL
+			AND tasks.status = ?
+			AND tasks.finished_at BETWEEN sprints.started_at AND sprints.completed_at`, task.StatusDone).
+		Where("sprints.team_id = ? AND sprints.state = ?", teamID, sprint.StateCompleted).
+		Group("sprints.id").
+		Order("sprints.completed_at DESC").Order("sprints.id DESC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	velocities := make([]sprint.SprintVelocity, len(rows))
+	for i, row := range rows {
+		velocities[i] = sprint.SprintVelocity{
+			Sprint:          row.Sprint,
+			CompletedPoints: row.CompletedPoints,
+			CompletedTasks:  row.CompletedTasks,
+		}
+	}
+
+	return velocities, nil
+}
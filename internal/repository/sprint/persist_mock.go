@@ -0,0 +1,137 @@
+//go:build test
+
+package sprint
+
+import (
+	"context"
+	"log/slog"
+
+	"taskmanager/internal/entity/sprint"
+	"taskmanager/internal/entity/task"
+
+	"github.com/google/uuid"
+)
+
+// MockPersistent é um mock da interface Persistent para testes
+type MockPersistent struct {
+	FnCreate                 func(context.Context, *sprint.Sprint) error
+	FnRetrieveByUUID         func(context.Context, uuid.UUID) (*sprint.Sprint, error)
+	FnListByTeamID           func(context.Context, uint, int, int) (*sprint.ListSprints, error)
+	FnRetrieveActiveByTeamID func(context.Context, uint) (*sprint.Sprint, error)
+	FnRetrieveNextPlanned    func(context.Context, uint, uint) (*sprint.Sprint, error)
+	FnUpdateState            func(context.Context, *sprint.Sprint) error
+	FnLockTeam               func(context.Context, uint) error
+	FnListTasks              func(context.Context, uint) ([]task.Task, error)
+	FnRetrieveTaskSprintID   func(context.Context, uuid.UUID) (*uint, error)
+	FnUpdateTaskSprintID     func(context.Context, uuid.UUID, *uint) (*task.Task, error)
+	FnMoveUnfinishedTasks    func(context.Context, uint, *uint) ([]task.Task, error)
+	FnListVelocity           func(context.Context, uint, int) ([]sprint.SprintVelocity, error)
+}
+
+// Create implementa o método Create da interface Persistent
+func (m *MockPersistent) Create(ctx context.Context, s *sprint.Sprint) error {
+	if m.FnCreate == nil {
+		slog.Error("fnCreate is nil")
+		return nil
+	}
+	return m.FnCreate(ctx, s)
+}
+
+// RetrieveByUUID implementa o método RetrieveByUUID da interface Persistent
+func (m *MockPersistent) RetrieveByUUID(ctx context.Context, sprintUUID uuid.UUID) (*sprint.Sprint, error) {
+	if m.FnRetrieveByUUID == nil {
+		slog.Error("fnRetrieveByUUID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveByUUID(ctx, sprintUUID)
+}
+
+// ListByTeamID implementa o método ListByTeamID da interface Persistent
+func (m *MockPersistent) ListByTeamID(ctx context.Context, teamID uint, page, limit int) (*sprint.ListSprints, error) {
+	if m.FnListByTeamID == nil {
+		slog.Error("fnListByTeamID is nil")
+		return nil, nil
+	}
+	return m.FnListByTeamID(ctx, teamID, page, limit)
+}
+
+// RetrieveActiveByTeamID implementa o método RetrieveActiveByTeamID da interface Persistent
+func (m *MockPersistent) RetrieveActiveByTeamID(ctx context.Context, teamID uint) (*sprint.Sprint, error) {
+	if m.FnRetrieveActiveByTeamID == nil {
+		slog.Error("fnRetrieveActiveByTeamID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveActiveByTeamID(ctx, teamID)
+}
+
+// RetrieveNextPlanned implementa o método RetrieveNextPlanned da interface Persistent
+func (m *MockPersistent) RetrieveNextPlanned(ctx context.Context, teamID, excludeID uint) (*sprint.Sprint, error) {
+	if m.FnRetrieveNextPlanned == nil {
+		slog.Error("fnRetrieveNextPlanned is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveNextPlanned(ctx, teamID, excludeID)
+}
+
+// UpdateState implementa o método UpdateState da interface Persistent
+func (m *MockPersistent) UpdateState(ctx context.Context, s *sprint.Sprint) error {
+	if m.FnUpdateState == nil {
+		slog.Error("fnUpdateState is nil")
+		return nil
+	}
+	return m.FnUpdateState(ctx, s)
+}
+
+// LockTeam implementa o método LockTeam da interface Persistent
+func (m *MockPersistent) LockTeam(ctx context.Context, teamID uint) error {
+	if m.FnLockTeam == nil {
+		slog.Error("fnLockTeam is nil")
+		return nil
+	}
+	return m.FnLockTeam(ctx, teamID)
+}
+
+// ListTasks implementa o método ListTasks da interface Persistent
+func (m *MockPersistent) ListTasks(ctx context.Context, sprintID uint) ([]task.Task, error) {
+	if m.FnListTasks == nil {
+		slog.Error("fnListTasks is nil")
+		return nil, nil
+	}
+	return m.FnListTasks(ctx, sprintID)
+}
+
+// RetrieveTaskSprintID implementa o método RetrieveTaskSprintID da interface Persistent
+func (m *MockPersistent) RetrieveTaskSprintID(ctx context.Context, taskUUID uuid.UUID) (*uint, error) {
+	if m.FnRetrieveTaskSprintID == nil {
+		slog.Error("fnRetrieveTaskSprintID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveTaskSprintID(ctx, taskUUID)
+}
+
+// UpdateTaskSprintID implementa o método UpdateTaskSprintID da interface Persistent
+func (m *MockPersistent) UpdateTaskSprintID(ctx context.Context, taskUUID uuid.UUID, sprintID *uint) (*task.Task, error) {
+	if m.FnUpdateTaskSprintID == nil {
+		slog.Error("fnUpdateTaskSprintID is nil")
+		return nil, nil
+	}
+	return m.FnUpdateTaskSprintID(ctx, taskUUID, sprintID)
+}
+
+// MoveUnfinishedTasks implementa o método MoveUnfinishedTasks da interface Persistent
+func (m *MockPersistent) MoveUnfinishedTasks(ctx context.Context, fromID uint, toID *uint) ([]task.Task, error) {
+	if m.FnMoveUnfinishedTasks == nil {
+		slog.Error("fnMoveUnfinishedTasks is nil")
+		return nil, nil
+	}
+	return m.FnMoveUnfinishedTasks(ctx, fromID, toID)
+}
+
+// ListVelocity implementa o método ListVelocity da interface Persistent
+func (m *MockPersistent) ListVelocity(ctx context.Context, teamID uint, limit int) ([]sprint.SprintVelocity, error) {
+	if m.FnListVelocity == nil {
+		slog.Error("fnListVelocity is nil")
+		return nil, nil
+	}
+	return m.FnListVelocity(ctx, teamID, limit)
+}
@@ -0,0 +1,534 @@
+//go:build test
+
+package sprint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/sprint"
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+var (
+	completedSprintUUID = uuid.MustParse("555e4567-e89b-12d3-a456-426614174000")
+	activeSprintUUID    = uuid.MustParse("555e4567-e89b-12d3-a456-426614174001")
+	plannedSprintUUID   = uuid.MustParse("555e4567-e89b-12d3-a456-426614174002")
+	devOpsSprintUUID    = uuid.MustParse("555e4567-e89b-12d3-a456-426614174003")
+	missingUUID         = uuid.MustParse("00000000-0000-0000-0000-000000000000")
+)
+
+// sprintUUIDs returns the UUIDs of the sprints, in order
+func sprintUUIDs(sprints []sprint.Sprint) []uuid.UUID {
+	uuids := make([]uuid.UUID, len(sprints))
+	for i, s := range sprints {
+		uuids[i] = s.UUID
+	}
+	return uuids
+}
+
+func Test_datasource_Create(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithSprints := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		sprint  *sprint.Sprint
+		wantErr error
+	}{
+		{
+			"Create sprint with success",
+			resetWithSprints,
+			context.Background(),
+			&sprint.Sprint{
+				TeamID:    1,
+				Name:      "Sprint 4",
+				StartDate: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+				State:     sprint.StatePlanned,
+			},
+			nil,
+		},
+		{
+			"Create sprint with context nil",
+			resetWithSprints,
+			nil,
+			&sprint.Sprint{TeamID: 1, Name: "Sprint 4"},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.Create(ctx, tt.sprint)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.Create() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr == nil && (tt.sprint.ID == 0 || tt.sprint.UUID == (uuid.UUID{})) {
+				t.Errorf("datasource.Create() did not set the ID and UUID: %+v", tt.sprint)
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveByUUID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+
+	startedAt := time.Date(2025, 11, 17, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		sprintUUID   uuid.UUID
+		want         *sprint.Sprint
+		wantTeamUUID uuid.UUID
+		wantErr      error
+	}{
+		{
+			"Retrieve sprint by UUID with success",
+			activeSprintUUID,
+			&sprint.Sprint{
+				UUID:      activeSprintUUID,
+				TeamID:    1,
+				Name:      "Sprint 2",
+				Goal:      "Melhorar performance",
+				StartDate: time.Date(2025, 11, 17, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC),
+				State:     sprint.StateActive,
+				StartedAt: &startedAt,
+			},
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			nil,
+		},
+		{
+			"Retrieve missing sprint",
+			missingUUID,
+			nil,
+			uuid.UUID{},
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.RetrieveByUUID(ctx, tt.sprintUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveByUUID() error diff: %s", diff)
+				return
+			}
+			if tt.want == nil {
+				return
+			}
+			if got.Team == nil || got.Team.UUID != tt.wantTeamUUID {
+				t.Errorf("datasource.RetrieveByUUID() team = %+v, want %s", got.Team, tt.wantTeamUUID)
+			}
+			got.Model, got.Team = tt.want.Model, nil
+			if diff := cmp.Diff(tt.want, got, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+				t.Errorf("datasource.RetrieveByUUID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListByTeamID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+
+	tests := []struct {
+		name      string
+		teamID    uint
+		page      int
+		limit     int
+		want      []uuid.UUID
+		wantTotal int
+	}{
+		{"List sprints of a team in start date order", 1, 1, 10, []uuid.UUID{completedSprintUUID, activeSprintUUID, plannedSprintUUID}, 3},
+		{"List second page of sprints of a team", 1, 2, 2, []uuid.UUID{plannedSprintUUID}, 3},
+		{"List sprints of a team without sprints", 3, 1, 10, []uuid.UUID{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.ListByTeamID(ctx, tt.teamID, tt.page, tt.limit)
+			if err != nil {
+				t.Fatalf("datasource.ListByTeamID() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, sprintUUIDs(got.Sprints)); diff != "" {
+				t.Errorf("datasource.ListByTeamID() mismatch (-want +got):\n%s", diff)
+			}
+			if got.TotalItems != tt.wantTotal {
+				t.Errorf("datasource.ListByTeamID() total = %d, want %d", got.TotalItems, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveActiveByTeamID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+
+	tests := []struct {
+		name    string
+		teamID  uint
+		want    uuid.UUID
+		wantErr error
+	}{
+		{"Retrieve active sprint of a team", 1, activeSprintUUID, nil},
+		{"Retrieve active sprint of a team without one", 2, uuid.UUID{}, errs.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.RetrieveActiveByTeamID(ctx, tt.teamID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveActiveByTeamID() error diff: %s", diff)
+				return
+			}
+			if got != nil && got.UUID != tt.want {
+				t.Errorf("datasource.RetrieveActiveByTeamID() = %s, want %s", got.UUID, tt.want)
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveNextPlanned(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+
+	tests := []struct {
+		name      string
+		teamID    uint
+		excludeID uint
+		want      uuid.UUID
+		wantErr   error
+	}{
+		{"Retrieve next planned sprint of a team", 1, 2, plannedSprintUUID, nil},
+		{"Retrieve next planned sprint skipping the excluded one", 1, 3, uuid.UUID{}, errs.ErrNotFound},
+		{"Retrieve next planned sprint of a team without one", 2, 4, uuid.UUID{}, errs.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.RetrieveNextPlanned(ctx, tt.teamID, tt.excludeID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveNextPlanned() error diff: %s", diff)
+				return
+			}
+			if got != nil && got.UUID != tt.want {
+				t.Errorf("datasource.RetrieveNextPlanned() = %s, want %s", got.UUID, tt.want)
+			}
+		})
+	}
+}
+
+func Test_datasource_UpdateState(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+
+	completedAt := time.Date(2025, 11, 28, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		sprint  *sprint.Sprint
+		wantErr error
+	}{
+		{
+			"Update state of a sprint",
+			&sprint.Sprint{UUID: activeSprintUUID, State: sprint.StateCompleted, CompletedAt: &completedAt},
+			nil,
+		},
+		{
+			"Update state of a missing sprint",
+			&sprint.Sprint{UUID: missingUUID, State: sprint.StateCompleted, CompletedAt: &completedAt},
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			if tt.wantErr == nil {
+				current, err := p.RetrieveByUUID(ctx, tt.sprint.UUID)
+				if err != nil {
+					t.Fatalf("datasource.RetrieveByUUID() error = %v", err)
+				}
+				tt.sprint.ID, tt.sprint.StartedAt = current.ID, current.StartedAt
+			}
+
+			err := p.UpdateState(ctx, tt.sprint)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.UpdateState() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveByUUID(ctx, tt.sprint.UUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveByUUID() error = %v", err)
+			}
+			if got.State != sprint.StateCompleted || got.CompletedAt == nil || !got.CompletedAt.Equal(completedAt) || got.StartedAt == nil {
+				t.Errorf("datasource.UpdateState() = %+v, want a completed sprint keeping its start time", got)
+			}
+		})
+	}
+}
+
+func Test_datasource_TaskSprintID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithSprints := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+	}
+
+	activeSprintID := uint(2)
+	plannedSprintID := uint(3)
+
+	tests := []struct {
+		name     string
+		taskUUID uuid.UUID
+		sprintID *uint
+		wantErr  error
+	}{
+		{"Move task to another sprint", uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"), &plannedSprintID, nil},
+		{"Plan task of the backlog in a sprint", uuid.MustParse("123e4567-e89b-12d3-a456-426614174005"), &activeSprintID, nil},
+		{"Move task back to the backlog", uuid.MustParse("123e4567-e89b-12d3-a456-426614174004"), nil, nil},
+		{"Move missing task", missingUUID, &activeSprintID, errs.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetWithSprints()
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			updated, err := p.UpdateTaskSprintID(ctx, tt.taskUUID, tt.sprintID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.UpdateTaskSprintID() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr == nil && (updated.UUID != tt.taskUUID || updated.Version != 2) {
+				t.Errorf("datasource.UpdateTaskSprintID() = task %s at version %d, want %s at version 2", updated.UUID, updated.Version, tt.taskUUID)
+			}
+
+			got, err := p.RetrieveTaskSprintID(ctx, tt.taskUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveTaskSprintID() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(tt.sprintID, got); diff != "" {
+				t.Errorf("datasource.RetrieveTaskSprintID() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+
+	tests := []struct {
+		name     string
+		sprintID uint
+		want     []uuid.UUID
+	}{
+		{
+			"List tasks of a sprint in rank order",
+			2,
+			[]uuid.UUID{
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174004"),
+			},
+		},
+		{"List tasks of a sprint without tasks", 3, []uuid.UUID{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.ListTasks(ctx, tt.sprintID)
+			if err != nil {
+				t.Fatalf("datasource.ListTasks() error = %v", err)
+			}
+			uuids := make([]uuid.UUID, len(got))
+			for i, task := range got {
+				uuids[i] = task.UUID
+			}
+			if diff := cmp.Diff(tt.want, uuids); diff != "" {
+				t.Errorf("datasource.ListTasks() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_MoveUnfinishedTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithSprints := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+	}
+
+	plannedSprintID := uint(3)
+
+	tests := []struct {
+		name     string
+		fromID   uint
+		toID     *uint
+		want     int
+		wantLeft int
+	}{
+		{"Move unfinished tasks into the next sprint", 2, &plannedSprintID, 2, 0},
+		{"Move unfinished tasks back to the backlog", 2, nil, 2, 0},
+		{"Move unfinished tasks keeps finished ones", 4, nil, 0, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetWithSprints()
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.MoveUnfinishedTasks(ctx, tt.fromID, tt.toID)
+			if err != nil {
+				t.Fatalf("datasource.MoveUnfinishedTasks() error = %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("datasource.MoveUnfinishedTasks() moved %d tasks, want %d", len(got), tt.want)
+			}
+			for _, m := range got {
+				if m.Version != 2 || cmp.Diff(tt.toID, m.SprintID) != "" {
+					t.Errorf("datasource.MoveUnfinishedTasks() moved task %s to sprint %v at version %d, want %v at version 2", m.UUID, m.SprintID, m.Version, tt.toID)
+				}
+			}
+
+			left, err := p.ListTasks(ctx, tt.fromID)
+			if err != nil {
+				t.Fatalf("datasource.ListTasks() error = %v", err)
+			}
+			if len(left) != tt.wantLeft {
+				t.Errorf("datasource.MoveUnfinishedTasks() left %d tasks, want %d", len(left), tt.wantLeft)
+			}
+			for _, l := range left {
+				if l.Status == task.StatusTodo || l.Status == task.StatusInProgress {
+					t.Errorf("datasource.MoveUnfinishedTasks() left unfinished task %s", l.UUID)
+				}
+			}
+		})
+	}
+}
+
+func Test_datasource_ListVelocity(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+
+	type velocity struct {
+		UUID            uuid.UUID
+		CompletedPoints int
+		CompletedTasks  int
+	}
+
+	tests := []struct {
+		name   string
+		teamID uint
+		limit  int
+		want   []velocity
+	}{
+		{
+			"List velocity counts the estimates of the tasks done during the sprint",
+			2,
+			3,
+			[]velocity{{devOpsSprintUUID, 8, 2}},
+		},
+		{
+			"List velocity of a sprint without done tasks",
+			1,
+			3,
+			[]velocity{{completedSprintUUID, 0, 0}},
+		},
+		{
+			"List velocity of a team without completed sprints",
+			3,
+			3,
+			[]velocity{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+
+			p := &datasource{}
+			got, err := p.ListVelocity(ctx, tt.teamID, tt.limit)
+			if err != nil {
+				t.Fatalf("datasource.ListVelocity() error = %v", err)
+			}
+			velocities := make([]velocity, len(got))
+			for i, v := range got {
+				velocities[i] = velocity{v.Sprint.UUID, v.CompletedPoints, v.CompletedTasks}
+			}
+			if diff := cmp.Diff(tt.want, velocities); diff != "" {
+				t.Errorf("datasource.ListVelocity() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
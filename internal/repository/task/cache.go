@@ -2,12 +2,14 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	"taskmanager/internal/entity/task"
 	"taskmanager/internal/platform/cache"
+	"taskmanager/internal/platform/pagination"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -15,6 +17,31 @@ import (
 
 const cacheKeyPrefix = "tasks:list:"
 
+// modifiedAtKey holds the time of the last list cache invalidation; outside cacheKeyPrefix
+// so that invalidations keep it
+const modifiedAtKey = "tasks:modified_at"
+
+// deferredInvalidationKey is the context key for a pending list cache invalidation.
+type deferredInvalidationKey struct{}
+
+// deferredInvalidation records the list cache invalidation requested while deferral is active.
+type deferredInvalidation struct {
+	invalidate func(ctx context.Context)
+}
+
+// DeferListCacheInvalidation returns a context in which list cache invalidations
+// triggered by mutations are postponed, and a flush function that performs them once.
+// Flush is a no-op when no mutation happened or the persistence has no cache.
+func DeferListCacheInvalidation(ctx context.Context) (context.Context, func()) {
+	deferred := &deferredInvalidation{}
+	flush := func() {
+		if deferred.invalidate != nil {
+			deferred.invalidate(ctx)
+		}
+	}
+	return context.WithValue(ctx, deferredInvalidationKey{}, deferred), flush
+}
+
 // cachedDatasource wraps a Persistent implementation with Redis cache-aside logic.
 type cachedDatasource struct {
 	next   Persistent
@@ -31,9 +58,10 @@ func NewCachedPersist(next Persistent, client *redis.Client, ttl time.Duration)
 	}
 }
 
-// ListPaginated checks the cache first; on miss, queries the database and caches the result.
-func (c *cachedDatasource) ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, page, limit int) (*task.ListTasks, error) {
-	key := listCacheKey(statusFilter, page, limit)
+// ListPaginated checks the cache first; on miss, queries the database and caches the result
+// along with the time of the last invalidation.
+func (c *cachedDatasource) ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, page, limit int) (*task.ListTasks, error) {
+	key := listCacheKey(statusFilter, sort, page, limit)
 
 	result, err := cache.Get[task.ListTasks](ctx, c.client, key)
 	if err != nil {
@@ -43,10 +71,37 @@ func (c *cachedDatasource) ListPaginated(ctx context.Context, statusFilter *task
 		return result, nil
 	}
 
-	result, err = c.next.ListPaginated(ctx, statusFilter, page, limit)
+	result, err = c.next.ListPaginated(ctx, statusFilter, sort, page, limit)
 	if err != nil {
 		return nil, err
 	}
+	result.ModifiedAt = c.modifiedAt(ctx)
+
+	if err := cache.Set(ctx, c.client, key, result, c.ttl); err != nil {
+		slog.Warn("Cache set error", "key", key, "error", err)
+	}
+
+	return result, nil
+}
+
+// ListByCursor checks the cache first; on miss, queries the database and caches the result
+// along with the time of the last invalidation.
+func (c *cachedDatasource) ListByCursor(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int, withTotal bool) (*task.ListTasks, error) {
+	key := listCursorCacheKey(statusFilter, sort, cursor, limit, withTotal)
+
+	result, err := cache.Get[task.ListTasks](ctx, c.client, key)
+	if err != nil {
+		slog.Warn("Cache get error, falling back to database", "key", key, "error", err)
+	}
+	if result != nil {
+		return result, nil
+	}
+
+	result, err = c.next.ListByCursor(ctx, statusFilter, sort, cursor, limit, withTotal)
+	if err != nil {
+		return nil, err
+	}
+	result.ModifiedAt = c.modifiedAt(ctx)
 
 	if err := cache.Set(ctx, c.client, key, result, c.ttl); err != nil {
 		slog.Warn("Cache set error", "key", key, "error", err)
@@ -74,8 +129,8 @@ func (c *cachedDatasource) Update(ctx context.Context, taskUUID uuid.UUID, t *ta
 }
 
 // Delete delegates to the next implementation and invalidates the list cache.
-func (c *cachedDatasource) Delete(ctx context.Context, taskUUID uuid.UUID) error {
-	if err := c.next.Delete(ctx, taskUUID); err != nil {
+func (c *cachedDatasource) Delete(ctx context.Context, taskUUID uuid.UUID, version int) error {
+	if err := c.next.Delete(ctx, taskUUID, version); err != nil {
 		return err
 	}
 	c.invalidateListCache(ctx)
@@ -83,8 +138,8 @@ func (c *cachedDatasource) Delete(ctx context.Context, taskUUID uuid.UUID) error
 }
 
 // UpdateStatus delegates to the next implementation and invalidates the list cache.
-func (c *cachedDatasource) UpdateStatus(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
-	if err := c.next.UpdateStatus(ctx, taskUUID, updates); err != nil {
+func (c *cachedDatasource) UpdateStatus(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
+	if err := c.next.UpdateStatus(ctx, taskUUID, version, updates); err != nil {
 		return err
 	}
 	c.invalidateListCache(ctx)
@@ -101,18 +156,111 @@ func (c *cachedDatasource) ListByTeamID(ctx context.Context, teamID uint) ([]tas
 	return c.next.ListByTeamID(ctx, teamID)
 }
 
-// invalidateListCache removes all cached list entries.
+// ListByTeamAndStatus delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) ListByTeamAndStatus(ctx context.Context, teamID uint, status task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int) (*task.ListTasks, error) {
+	return c.next.ListByTeamAndStatus(ctx, teamID, status, sort, cursor, limit)
+}
+
+// CountByTeamGroupedByStatus delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) CountByTeamGroupedByStatus(ctx context.Context, teamID uint) (map[task.TaskStatus]int, error) {
+	return c.next.CountByTeamGroupedByStatus(ctx, teamID)
+}
+
+// Search delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) Search(ctx context.Context, term, language string, page, limit int) (*task.ListSearchResults, error) {
+	return c.next.Search(ctx, term, language, page, limit)
+}
+
+// SearchLanguage delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) SearchLanguage(ctx context.Context) (string, error) {
+	return c.next.SearchLanguage(ctx)
+}
+
+// ExportInBatches delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) ExportInBatches(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, batchSize int, fn func([]task.ExportItem) error) error {
+	return c.next.ExportInBatches(ctx, statusFilter, sort, batchSize, fn)
+}
+
+// LastRank delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) LastRank(ctx context.Context) (string, error) {
+	return c.next.LastRank(ctx)
+}
+
+// RetrieveNeighborByRank delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) RetrieveNeighborByRank(ctx context.Context, t *task.Task, direction pagination.Direction, excludeID uint) (*task.Task, error) {
+	return c.next.RetrieveNeighborByRank(ctx, t, direction, excludeID)
+}
+
+// UpdateRank delegates to the next implementation and invalidates the list cache.
+func (c *cachedDatasource) UpdateRank(ctx context.Context, taskUUID uuid.UUID, rank string) error {
+	if err := c.next.UpdateRank(ctx, taskUUID, rank); err != nil {
+		return err
+	}
+	c.invalidateListCache(ctx)
+	return nil
+}
+
+// LockRanks delegates directly to the next implementation (no cache).
+func (c *cachedDatasource) LockRanks(ctx context.Context) error {
+	return c.next.LockRanks(ctx)
+}
+
+// Rebalance delegates to the next implementation and invalidates the list cache.
+func (c *cachedDatasource) Rebalance(ctx context.Context) error {
+	if err := c.next.Rebalance(ctx); err != nil {
+		return err
+	}
+	c.invalidateListCache(ctx)
+	return nil
+}
+
+// invalidateListCache removes all cached list entries, or records the invalidation
+// when the context defers it (see DeferListCacheInvalidation).
 func (c *cachedDatasource) invalidateListCache(ctx context.Context) {
+	if deferred, ok := ctx.Value(deferredInvalidationKey{}).(*deferredInvalidation); ok {
+		deferred.invalidate = c.deleteListCache
+		return
+	}
+	c.deleteListCache(ctx)
+}
+
+// deleteListCache records the time of the invalidation and removes all cached list entries.
+func (c *cachedDatasource) deleteListCache(ctx context.Context) {
+	if err := c.client.Set(ctx, modifiedAtKey, time.Now().UTC(), 0).Err(); err != nil {
+		slog.Warn("Cache set error", "key", modifiedAtKey, "error", err)
+	}
 	if err := cache.DeleteByPrefix(ctx, c.client, cacheKeyPrefix); err != nil {
 		slog.Warn("Cache invalidation error", "prefix", cacheKeyPrefix, "error", err)
 	}
 }
 
+// modifiedAt returns the time of the last list cache invalidation; zero when unknown.
+func (c *cachedDatasource) modifiedAt(ctx context.Context) time.Time {
+	modifiedAt, err := c.client.Get(ctx, modifiedAtKey).Time()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		slog.Warn("Cache get error", "key", modifiedAtKey, "error", err)
+	}
+	return modifiedAt
+}
+
 // listCacheKey builds a deterministic cache key for a paginated list query.
-func listCacheKey(statusFilter *task.TaskStatus, page, limit int) string {
+func listCacheKey(statusFilter *task.TaskStatus, sort task.ListSort, page, limit int) string {
+	status := "all"
+	if statusFilter != nil {
+		status = string(*statusFilter)
+	}
+	return fmt.Sprintf("%sstatus=%s:sort=%s:page=%d:limit=%d", cacheKeyPrefix, status, sort, page, limit)
+}
+
+// listCursorCacheKey builds a deterministic cache key for a cursor-paginated list query.
+func listCursorCacheKey(statusFilter *task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int, withTotal bool) string {
 	status := "all"
 	if statusFilter != nil {
 		status = string(*statusFilter)
 	}
-	return fmt.Sprintf("%sstatus=%s:page=%d:limit=%d", cacheKeyPrefix, status, page, limit)
+	position := "first"
+	if cursor != nil {
+		position = cursor.Encode()
+	}
+	return fmt.Sprintf("%sstatus=%s:sort=%s:cursor=%s:limit=%d:total=%t", cacheKeyPrefix, status, sort, position, limit, withTotal)
 }
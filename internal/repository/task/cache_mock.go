@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/pagination"
 
 	"github.com/google/uuid"
 )
@@ -26,13 +27,29 @@ func NewMockCachedPersist(next Persistent) *MockCachedPersistent {
 }
 
 // ListPaginated checks the in-memory cache first; on miss, queries the next and caches the result.
-func (m *MockCachedPersistent) ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, page, limit int) (*task.ListTasks, error) {
-	key := listCacheKey(statusFilter, page, limit)
+func (m *MockCachedPersistent) ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, page, limit int) (*task.ListTasks, error) {
+	key := listCacheKey(statusFilter, sort, page, limit)
 	if cached, ok := m.store[key]; ok {
 		return cached, nil
 	}
 
-	result, err := m.Next.ListPaginated(ctx, statusFilter, page, limit)
+	result, err := m.Next.ListPaginated(ctx, statusFilter, sort, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	m.store[key] = result
+	return result, nil
+}
+
+// ListByCursor checks the in-memory cache first; on miss, queries the next and caches the result.
+func (m *MockCachedPersistent) ListByCursor(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int, withTotal bool) (*task.ListTasks, error) {
+	key := listCursorCacheKey(statusFilter, sort, cursor, limit, withTotal)
+	if cached, ok := m.store[key]; ok {
+		return cached, nil
+	}
+
+	result, err := m.Next.ListByCursor(ctx, statusFilter, sort, cursor, limit, withTotal)
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +77,8 @@ func (m *MockCachedPersistent) Update(ctx context.Context, taskUUID uuid.UUID, t
 }
 
 // Delete delegates to the next implementation and invalidates the list cache.
-func (m *MockCachedPersistent) Delete(ctx context.Context, taskUUID uuid.UUID) error {
-	if err := m.Next.Delete(ctx, taskUUID); err != nil {
+func (m *MockCachedPersistent) Delete(ctx context.Context, taskUUID uuid.UUID, version int) error {
+	if err := m.Next.Delete(ctx, taskUUID, version); err != nil {
 		return err
 	}
 	m.invalidate()
@@ -69,8 +86,8 @@ func (m *MockCachedPersistent) Delete(ctx context.Context, taskUUID uuid.UUID) e
 }
 
 // UpdateStatus delegates to the next implementation and invalidates the list cache.
-func (m *MockCachedPersistent) UpdateStatus(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
-	if err := m.Next.UpdateStatus(ctx, taskUUID, updates); err != nil {
+func (m *MockCachedPersistent) UpdateStatus(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
+	if err := m.Next.UpdateStatus(ctx, taskUUID, version, updates); err != nil {
 		return err
 	}
 	m.invalidate()
@@ -87,6 +104,64 @@ func (m *MockCachedPersistent) ListByTeamID(ctx context.Context, teamID uint) ([
 	return m.Next.ListByTeamID(ctx, teamID)
 }
 
+// ListByTeamAndStatus delegates directly to the next implementation (no cache).
+func (m *MockCachedPersistent) ListByTeamAndStatus(ctx context.Context, teamID uint, status task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int) (*task.ListTasks, error) {
+	return m.Next.ListByTeamAndStatus(ctx, teamID, status, sort, cursor, limit)
+}
+
+// CountByTeamGroupedByStatus delegates directly to the next implementation (no cache).
+func (m *MockCachedPersistent) CountByTeamGroupedByStatus(ctx context.Context, teamID uint) (map[task.TaskStatus]int, error) {
+	return m.Next.CountByTeamGroupedByStatus(ctx, teamID)
+}
+
+// Search delegates directly to the next implementation (no cache).
+func (m *MockCachedPersistent) Search(ctx context.Context, term, language string, page, limit int) (*task.ListSearchResults, error) {
+	return m.Next.Search(ctx, term, language, page, limit)
+}
+
+// SearchLanguage delegates directly to the next implementation (no cache).
+func (m *MockCachedPersistent) SearchLanguage(ctx context.Context) (string, error) {
+	return m.Next.SearchLanguage(ctx)
+}
+
+// ExportInBatches delegates directly to the next implementation (no cache).
+func (m *MockCachedPersistent) ExportInBatches(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, batchSize int, fn func([]task.ExportItem) error) error {
+	return m.Next.ExportInBatches(ctx, statusFilter, sort, batchSize, fn)
+}
+
+// LastRank delegates directly to the next implementation (no cache).
+func (m *MockCachedPersistent) LastRank(ctx context.Context) (string, error) {
+	return m.Next.LastRank(ctx)
+}
+
+// RetrieveNeighborByRank delegates directly to the next implementation (no cache).
+func (m *MockCachedPersistent) RetrieveNeighborByRank(ctx context.Context, t *task.Task, direction pagination.Direction, excludeID uint) (*task.Task, error) {
+	return m.Next.RetrieveNeighborByRank(ctx, t, direction, excludeID)
+}
+
+// UpdateRank delegates to the next implementation and invalidates the list cache.
+func (m *MockCachedPersistent) UpdateRank(ctx context.Context, taskUUID uuid.UUID, rank string) error {
+	if err := m.Next.UpdateRank(ctx, taskUUID, rank); err != nil {
+		return err
+	}
+	m.invalidate()
+	return nil
+}
+
+// LockRanks delegates directly to the next implementation (no cache).
+func (m *MockCachedPersistent) LockRanks(ctx context.Context) error {
+	return m.Next.LockRanks(ctx)
+}
+
+// Rebalance delegates to the next implementation and invalidates the list cache.
+func (m *MockCachedPersistent) Rebalance(ctx context.Context) error {
+	if err := m.Next.Rebalance(ctx); err != nil {
+		return err
+	}
+	m.invalidate()
+	return nil
+}
+
 // invalidate removes all cached list entries.
 func (m *MockCachedPersistent) invalidate() {
 	m.store = make(map[string]*task.ListTasks)
@@ -11,6 +11,7 @@ import (
 	"taskmanager/internal/paths"
 	"taskmanager/internal/platform/cache"
 	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 	"taskmanager/internal/platform/testing/assert"
 	"taskmanager/internal/platform/testing/dbtest"
 	"taskmanager/internal/platform/testing/testenv"
@@ -184,7 +185,7 @@ func Test_cachedDatasource_ListPaginated(t *testing.T) {
 			func() {
 				env.FlushRedis()
 				dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
-				_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, 1, 10), &task.ListTasks{
+				_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, task.SortCreatedAt, 1, 10), &task.ListTasks{
 					Page:       1,
 					Limit:      10,
 					TotalItems: 999,
@@ -212,7 +213,7 @@ func Test_cachedDatasource_ListPaginated(t *testing.T) {
 			}
 
 			cached := NewCachedPersist(&datasource{}, env.Redis(), 5*time.Minute)
-			got, err := cached.ListPaginated(ctx, tt.statusFilter, tt.page, tt.limit)
+			got, err := cached.ListPaginated(ctx, tt.statusFilter, task.SortCreatedAt, tt.page, tt.limit)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("cachedDatasource.ListPaginated() error diff: %s", diff)
 				return
@@ -235,8 +236,8 @@ func Test_cachedDatasource_Create(t *testing.T) {
 	populateCacheAndReset := func() {
 		env.FlushRedis()
 		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
-		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
-		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(&statusTodo, 1, 10), &task.ListTasks{TotalItems: 5}, 5*time.Minute)
+		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, task.SortCreatedAt, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
+		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(&statusTodo, task.SortCreatedAt, 1, 10), &task.ListTasks{TotalItems: 5}, 5*time.Minute)
 	}
 
 	tests := []struct {
@@ -277,8 +278,8 @@ func Test_cachedDatasource_Create(t *testing.T) {
 			}
 
 			if tt.wantErr == nil {
-				keyAll := listCacheKey(nil, 1, 10)
-				keyTodo := listCacheKey(&statusTodo, 1, 10)
+				keyAll := listCacheKey(nil, task.SortCreatedAt, 1, 10)
+				keyTodo := listCacheKey(&statusTodo, task.SortCreatedAt, 1, 10)
 				afterAll, _ := cache.Get[task.ListTasks](ctx, env.Redis(), keyAll)
 				afterTodo, _ := cache.Get[task.ListTasks](ctx, env.Redis(), keyTodo)
 				if afterAll != nil {
@@ -301,7 +302,7 @@ func Test_cachedDatasource_Update(t *testing.T) {
 	populateCacheAndReset := func() {
 		env.FlushRedis()
 		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
-		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
+		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, task.SortCreatedAt, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
 	}
 
 	existingTaskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
@@ -323,6 +324,7 @@ func Test_cachedDatasource_Update(t *testing.T) {
 				Title:       "Título atualizado",
 				Description: "Descrição atualizada",
 				Status:      task.StatusInProgress,
+				Version:     1,
 			},
 			nil,
 		},
@@ -334,6 +336,7 @@ func Test_cachedDatasource_Update(t *testing.T) {
 			&task.Task{
 				Title:       "Título atualizado",
 				Description: "Descrição atualizada",
+				Version:     1,
 			},
 			errs.ErrNotFound,
 		},
@@ -356,7 +359,7 @@ func Test_cachedDatasource_Update(t *testing.T) {
 				return
 			}
 
-			key := listCacheKey(nil, 1, 10)
+			key := listCacheKey(nil, task.SortCreatedAt, 1, 10)
 			after, _ := cache.Get[task.ListTasks](ctx, env.Redis(), key)
 			if tt.wantErr == nil && after != nil {
 				t.Error("expected list cache to be invalidated after successful Update")
@@ -377,7 +380,7 @@ func Test_cachedDatasource_Delete(t *testing.T) {
 	populateCacheAndReset := func() {
 		env.FlushRedis()
 		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
-		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
+		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, task.SortCreatedAt, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
 	}
 
 	existingTaskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
@@ -416,13 +419,13 @@ func Test_cachedDatasource_Delete(t *testing.T) {
 			}
 
 			cached := NewCachedPersist(&datasource{}, env.Redis(), 5*time.Minute)
-			err := cached.Delete(ctx, tt.taskUUID)
+			err := cached.Delete(ctx, tt.taskUUID, 1)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("cachedDatasource.Delete() error diff: %s", diff)
 				return
 			}
 
-			key := listCacheKey(nil, 1, 10)
+			key := listCacheKey(nil, task.SortCreatedAt, 1, 10)
 			after, _ := cache.Get[task.ListTasks](ctx, env.Redis(), key)
 			if tt.wantErr == nil && after != nil {
 				t.Error("expected list cache to be invalidated after successful Delete")
@@ -443,7 +446,7 @@ func Test_cachedDatasource_UpdateStatus(t *testing.T) {
 	populateCacheAndReset := func() {
 		env.FlushRedis()
 		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
-		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
+		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, task.SortCreatedAt, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
 	}
 
 	existingTaskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
@@ -489,13 +492,13 @@ func Test_cachedDatasource_UpdateStatus(t *testing.T) {
 			}
 
 			cached := NewCachedPersist(&datasource{}, env.Redis(), 5*time.Minute)
-			err := cached.UpdateStatus(ctx, tt.taskUUID, tt.updates)
+			err := cached.UpdateStatus(ctx, tt.taskUUID, 1, tt.updates)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("cachedDatasource.UpdateStatus() error diff: %s", diff)
 				return
 			}
 
-			key := listCacheKey(nil, 1, 10)
+			key := listCacheKey(nil, task.SortCreatedAt, 1, 10)
 			after, _ := cache.Get[task.ListTasks](ctx, env.Redis(), key)
 			if tt.wantErr == nil && after != nil {
 				t.Error("expected list cache to be invalidated after successful UpdateStatus")
@@ -679,27 +682,171 @@ func Test_cachedDatasource_ListByTeamID(t *testing.T) {
 	}
 }
 
+func Test_cachedDatasource_ModifiedAt(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+		testenv.WithRedis(redisTest),
+	)
+
+	tests := []struct {
+		name       string
+		invalidate bool
+	}{
+		{"List without invalidation has no modification time", false},
+		{"List after invalidation carries its time", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithoutTransaction(t, context.Background(), env.DBConnector())
+			env.FlushRedis()
+			dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+
+			cached := NewCachedPersist(&datasource{}, env.Redis(), 5*time.Minute)
+			before := time.Now().Add(-time.Second)
+			if tt.invalidate {
+				if err := cached.Delete(ctx, uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), 1); err != nil {
+					t.Fatalf("cachedDatasource.Delete() unexpected error: %v", err)
+				}
+			}
+
+			got, err := cached.ListPaginated(ctx, nil, task.SortCreatedAt, 1, 10)
+			if err != nil {
+				t.Fatalf("cachedDatasource.ListPaginated() unexpected error: %v", err)
+			}
+			if tt.invalidate && got.ModifiedAt.Before(before) {
+				t.Errorf("cachedDatasource.ListPaginated() ModifiedAt = %v, want after %v", got.ModifiedAt, before)
+			}
+			if !tt.invalidate && !got.ModifiedAt.IsZero() {
+				t.Errorf("cachedDatasource.ListPaginated() ModifiedAt = %v, want zero", got.ModifiedAt)
+			}
+
+			hit, _ := cache.Get[task.ListTasks](ctx, env.Redis(), listCacheKey(nil, task.SortCreatedAt, 1, 10))
+			if hit == nil || !hit.ModifiedAt.Equal(got.ModifiedAt) {
+				t.Error("expected the cached list to keep the modification time")
+			}
+		})
+	}
+}
+
+func Test_DeferListCacheInvalidation(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+		testenv.WithRedis(redisTest),
+	)
+
+	populateCacheAndReset := func() {
+		env.FlushRedis()
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+		_ = cache.Set(context.Background(), env.Redis(), listCacheKey(nil, task.SortCreatedAt, 1, 10), &task.ListTasks{TotalItems: 14}, 5*time.Minute)
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		taskUUIDs []uuid.UUID
+	}{
+		{
+			"Defer invalidation of several deletes until flush",
+			populateCacheAndReset,
+			[]uuid.UUID{
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+			},
+		},
+		{
+			"Defer invalidation without mutations keeps cache",
+			populateCacheAndReset,
+			[]uuid.UUID{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dbtest.SetupDBWithoutTransaction(t, context.Background(), env.DBConnector())
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			cached := NewCachedPersist(&datasource{}, env.Redis(), 5*time.Minute)
+			deferredCtx, flush := DeferListCacheInvalidation(ctx)
+			for _, taskUUID := range tt.taskUUIDs {
+				if err := cached.Delete(deferredCtx, taskUUID, 1); err != nil {
+					t.Fatalf("cachedDatasource.Delete() unexpected error: %v", err)
+				}
+			}
+
+			key := listCacheKey(nil, task.SortCreatedAt, 1, 10)
+			before, _ := cache.Get[task.ListTasks](ctx, env.Redis(), key)
+			if before == nil {
+				t.Error("expected list cache to remain before flush")
+			}
+
+			flush()
+
+			after, _ := cache.Get[task.ListTasks](ctx, env.Redis(), key)
+			if len(tt.taskUUIDs) > 0 && after != nil {
+				t.Error("expected list cache to be invalidated after flush")
+			}
+			if len(tt.taskUUIDs) == 0 && after == nil {
+				t.Error("expected list cache to remain after flush without mutations")
+			}
+		})
+	}
+}
+
 func Test_listCacheKey(t *testing.T) {
 	statusTodo := task.StatusTodo
 
 	tests := []struct {
 		name         string
 		statusFilter *task.TaskStatus
+		sort         task.ListSort
 		page         int
 		limit        int
 		want         string
 	}{
-		{"without filter", nil, 1, 10, "tasks:list:status=all:page=1:limit=10"},
-		{"with status filter", &statusTodo, 2, 20, "tasks:list:status=to_do:page=2:limit=20"},
-		{"different page", nil, 3, 5, "tasks:list:status=all:page=3:limit=5"},
+		{"without filter", nil, task.SortCreatedAt, 1, 10, "tasks:list:status=all:sort=created_at:page=1:limit=10"},
+		{"with status filter", &statusTodo, task.SortCreatedAt, 2, 20, "tasks:list:status=to_do:sort=created_at:page=2:limit=20"},
+		{"different page", nil, task.SortCreatedAt, 3, 5, "tasks:list:status=all:sort=created_at:page=3:limit=5"},
+		{"sorted by rank", nil, task.SortRank, 1, 10, "tasks:list:status=all:sort=rank:page=1:limit=10"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := listCacheKey(tt.statusFilter, tt.page, tt.limit)
+			got := listCacheKey(tt.statusFilter, tt.sort, tt.page, tt.limit)
 			if got != tt.want {
 				t.Errorf("listCacheKey() = %q, want %q", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_listCursorCacheKey(t *testing.T) {
+	statusTodo := task.StatusTodo
+	cursor := pagination.NewCursor(time.Date(2025, 12, 1, 18, 21, 6, 0, time.UTC), 5, pagination.DirectionNext)
+	rankCursor := pagination.NewRankCursor("000000005", 5, pagination.DirectionNext)
+
+	tests := []struct {
+		name         string
+		statusFilter *task.TaskStatus
+		sort         task.ListSort
+		cursor       *pagination.Cursor
+		limit        int
+		withTotal    bool
+		want         string
+	}{
+		{"first page without filter", nil, task.SortCreatedAt, nil, 10, true, "tasks:list:status=all:sort=created_at:cursor=first:limit=10:total=true"},
+		{"first page with status filter", &statusTodo, task.SortCreatedAt, nil, 20, false, "tasks:list:status=to_do:sort=created_at:cursor=first:limit=20:total=false"},
+		{"with cursor", nil, task.SortCreatedAt, cursor, 5, true, "tasks:list:status=all:sort=created_at:cursor=" + cursor.Encode() + ":limit=5:total=true"},
+		{"with rank cursor", nil, task.SortRank, rankCursor, 5, true, "tasks:list:status=all:sort=rank:cursor=" + rankCursor.Encode() + ":limit=5:total=true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := listCursorCacheKey(tt.statusFilter, tt.sort, tt.cursor, tt.limit, tt.withTotal)
+			if got != tt.want {
+				t.Errorf("listCursorCacheKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
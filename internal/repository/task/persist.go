@@ -3,10 +3,15 @@ package task
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"time"
 
 	"taskmanager/internal/entity/task"
 	"taskmanager/internal/platform/database"
 	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -17,10 +22,44 @@ type Persistent interface {
 	Create(ctx context.Context, t *task.Task) error
 	RetrieveByUUID(ctx context.Context, taskUUID uuid.UUID) (*task.Task, error)
 	Update(ctx context.Context, taskUUID uuid.UUID, t *task.Task) error
-	Delete(ctx context.Context, taskUUID uuid.UUID) error
-	ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, page, limit int) (*task.ListTasks, error)
-	UpdateStatus(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error
+	Delete(ctx context.Context, taskUUID uuid.UUID, version int) error
+	ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, page, limit int) (*task.ListTasks, error)
+	ListByCursor(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int, withTotal bool) (*task.ListTasks, error)
+	UpdateStatus(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error
 	ListByTeamID(ctx context.Context, teamID uint) ([]task.Task, error)
+	ListByTeamAndStatus(ctx context.Context, teamID uint, status task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int) (*task.ListTasks, error)
+	CountByTeamGroupedByStatus(ctx context.Context, teamID uint) (map[task.TaskStatus]int, error)
+	Search(ctx context.Context, term, language string, page, limit int) (*task.ListSearchResults, error)
+	SearchLanguage(ctx context.Context) (string, error)
+	ExportInBatches(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, batchSize int, fn func([]task.ExportItem) error) error
+	LastRank(ctx context.Context) (string, error)
+	RetrieveNeighborByRank(ctx context.Context, t *task.Task, direction pagination.Direction, excludeID uint) (*task.Task, error)
+	UpdateRank(ctx context.Context, taskUUID uuid.UUID, rank string) error
+	LockRanks(ctx context.Context) error
+	Rebalance(ctx context.Context) error
+}
+
+// searchHeadlineOptions configures the snippets produced by ts_headline
+const searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2"
+
+// searchLanguagePattern matches the text search configuration in the expression of search_vector
+var searchLanguagePattern = regexp.MustCompile(`to_tsvector\('([^']+)'::regconfig`)
+
+// rankLockKey is the advisory lock held while ranks are computed and rebalanced
+const rankLockKey = 7_305_118
+
+// searchRow maps a task row with the rank and snippets computed by the search query
+type searchRow struct {
+	task.Task            `gorm:"embedded"`
+	SearchRank           float64 `gorm:"column:search_rank"`
+	TitleHighlight       string  `gorm:"column:title_highlight"`
+	DescriptionHighlight string  `gorm:"column:description_highlight"`
+}
+
+// exportRow maps a task row with the name of its team
+type exportRow struct {
+	task.Task `gorm:"embedded"`
+	TeamName  string `gorm:"column:team_name"`
 }
 
 // datasource implements the persistent interface using PostgreSQL
@@ -71,49 +110,73 @@ func (p *datasource) RetrieveByUUID(ctx context.Context, taskUUID uuid.UUID) (*t
 	return &t, nil
 }
 
-// Update updates an existing task in the datasource
+// Update updates an existing task in the datasource if it is still at t.Version, incrementing it
 func (p *datasource) Update(ctx context.Context, taskUUID uuid.UUID, t *task.Task) error {
 	db, err := database.DBFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
+	// Only the editable fields are written, so a nil estimate is cleared and the rank, status, team
+	// and sprint, changed by their own operations, are not overwritten by a concurrent request
+	version := t.Version
+	t.Version++
 	result := db.Model(&task.Task{}).
-		Where("uuid = ?", taskUUID).
+		Where("uuid = ? AND version = ?", taskUUID, version).
+		Select("title", "description", "estimate", "version", "updated_at").
 		Updates(t)
 
 	if result.Error != nil {
+		t.Version = version
 		return result.Error
 	}
 
 	if result.RowsAffected == 0 {
-		return errs.ErrNotFound
+		t.Version = version
+		return notFoundOrModified(db, taskUUID, version)
 	}
 
 	return nil
 }
 
-// Delete performs a soft delete of a task in the datasource
-func (p *datasource) Delete(ctx context.Context, taskUUID uuid.UUID) error {
+// Delete performs a soft delete of a task in the datasource if it is still at the given version
+func (p *datasource) Delete(ctx context.Context, taskUUID uuid.UUID, version int) error {
 	db, err := database.DBFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
-	result := db.Where("uuid = ?", taskUUID).Delete(&task.Task{})
+	result := db.Where("uuid = ? AND version = ?", taskUUID, version).Delete(&task.Task{})
 	if result.Error != nil {
 		return result.Error
 	}
 
 	if result.RowsAffected == 0 {
-		return errs.ErrNotFound
+		return notFoundOrModified(db, taskUUID, version)
 	}
 
 	return nil
 }
 
+// notFoundOrModified tells apart a write that matched no task because the task does not exist
+// from one that lost the race against a change to the version the write expected
+func notFoundOrModified(db *gorm.DB, taskUUID uuid.UUID, version int) error {
+	var count int64
+	if err := db.Model(&task.Task{}).Where("uuid = ?", taskUUID).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return errs.ErrNotFound
+	}
+
+	return &errs.PreconditionFailedError{
+		Message: fmt.Sprintf("task has been modified since version %d", version),
+	}
+}
+
 // ListPaginated lists tasks with pagination and optional filters from the datasource
-func (p *datasource) ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, page, limit int) (*task.ListTasks, error) {
+func (p *datasource) ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, page, limit int) (*task.ListTasks, error) {
 	db, err := database.DBFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -132,8 +195,14 @@ func (p *datasource) ListPaginated(ctx context.Context, statusFilter *task.TaskS
 		return nil, err
 	}
 
+	if sort == task.SortRank {
+		query = query.Order("rank ASC").Order("id ASC")
+	} else {
+		query = query.Order("created_at DESC").Order("id DESC")
+	}
+
 	offset := (page - 1) * limit
-	if err := query.Order("created_at DESC").Order("id DESC").Offset(offset).Limit(limit).Find(&tasks).Error; err != nil {
+	if err := query.Offset(offset).Limit(limit).Find(&tasks).Error; err != nil {
 		return nil, err
 	}
 
@@ -145,23 +214,63 @@ func (p *datasource) ListPaginated(ctx context.Context, statusFilter *task.TaskS
 	}, nil
 }
 
-// UpdateStatus updates only the status and timestamps in the datasource
-func (p *datasource) UpdateStatus(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+// ListByCursor lists tasks with keyset pagination on (created_at, id), or (rank, id) when sorted by rank,
+// and optional filters from the datasource
+// The total count is only computed when withTotal is true
+func (p *datasource) ListByCursor(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int, withTotal bool) (*task.ListTasks, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []task.Task
+
+	query := db.Model(&task.Task{})
+
+	if statusFilter != nil {
+		query = query.Where("status = ?", *statusFilter)
+	}
+
+	result := &task.ListTasks{Limit: limit}
+
+	if withTotal {
+		var totalItems int64
+		if err := query.Count(&totalItems).Error; err != nil {
+			return nil, err
+		}
+		result.TotalItems = int(totalItems)
+	}
+
+	if err := query.Scopes(keysetScope(sort, cursor, limit)).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+
+	result.Tasks, result.NextCursor, result.PrevCursor = keysetWindow(sort, tasks, cursor, limit)
+
+	return result, nil
+}
+
+// UpdateStatus updates only the status and timestamps in the datasource if the task is still
+// at the given version, incrementing it
+func (p *datasource) UpdateStatus(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 	db, err := database.DBFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
+	values := maps.Clone(updates)
+	values["version"] = gorm.Expr("version + 1")
+
 	result := db.Model(&task.Task{}).
-		Where("uuid = ?", taskUUID).
-		Updates(updates)
+		Where("uuid = ? AND version = ?", taskUUID, version).
+		Updates(values)
 
 	if result.Error != nil {
 		return result.Error
 	}
 
 	if result.RowsAffected == 0 {
-		return errs.ErrNotFound
+		return notFoundOrModified(db, taskUUID, version)
 	}
 
 	return nil
@@ -181,3 +290,306 @@ func (p *datasource) ListByTeamID(ctx context.Context, teamID uint) ([]task.Task
 
 	return tasks, nil
 }
+
+// ListByTeamAndStatus lists the tasks of a team in one status with keyset pagination on (created_at, id),
+// or (rank, id) when sorted by rank
+func (p *datasource) ListByTeamAndStatus(ctx context.Context, teamID uint, status task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int) (*task.ListTasks, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []task.Task
+	query := db.Model(&task.Task{}).Where("team_id = ? AND status = ?", teamID, status)
+	if err := query.Scopes(keysetScope(sort, cursor, limit)).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+
+	result := &task.ListTasks{Limit: limit}
+	result.Tasks, result.NextCursor, result.PrevCursor = keysetWindow(sort, tasks, cursor, limit)
+
+	return result, nil
+}
+
+// CountByTeamGroupedByStatus counts the tasks of a team per status
+// Statuses without tasks are absent from the result
+func (p *datasource) CountByTeamGroupedByStatus(ctx context.Context, teamID uint) (map[task.TaskStatus]int, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Status task.TaskStatus
+		Count  int
+	}
+	if err := db.Model(&task.Task{}).
+		Select("status, COUNT(*) AS count").
+		Where("team_id = ?", teamID).
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[task.TaskStatus]int, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts, nil
+}
+
+// Search performs a full-text search over task titles and descriptions, ordered by rank
+// The highlights are HTML: the text of the task is escaped and only the <mark> markers are markup
+func (p *datasource) Search(ctx context.Context, term, language string, page, limit int) (*task.ListSearchResults, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	args := map[string]any{
+		"language": language,
+		"term":     term,
+		"options":  searchHeadlineOptions,
+	}
+
+	var rows []searchRow
+	var totalItems int64
+
+	query := db.Model(&task.Task{}).
+		Where("search_vector @@ websearch_to_tsquery(@language::regconfig, @term)", args)
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.
+		Select(`tasks.*,
+			ts_rank_cd(search_vector, websearch_to_tsquery(@language::regconfig, @term)) AS search_rank,
+			ts_headline(@language::regconfig, `+htmlEscaped("title")+`, websearch_to_tsquery(@language::regconfig, @term), @options) AS title_highlight,
+			ts_headline(@language::regconfig, `+htmlEscaped("description")+`, websearch_to_tsquery(@language::regconfig, @term), @options) AS description_highlight`, args).
+		Order("search_rank DESC").Order("created_at DESC").Order("id DESC").
+		Offset(offset).Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]task.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = task.SearchResult{
+			Task:                 row.Task,
+			Rank:                 row.SearchRank,
+			TitleHighlight:       row.TitleHighlight,
+			DescriptionHighlight: row.DescriptionHighlight,
+		}
+	}
+
+	return &task.ListSearchResults{
+		Limit:      limit,
+		Page:       page,
+		Results:    results,
+		TotalItems: int(totalItems),
+	}, nil
+}
+
+// SearchLanguage returns the text search configuration the search_vector column is generated with
+// It is read from the generation expression of the column, so it reflects the migrated schema
+func (p *datasource) SearchLanguage(ctx context.Context) (string, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var expression string
+	if err := db.Raw(`SELECT pg_get_expr(d.adbin, d.adrelid)
+		FROM pg_attrdef d
+		JOIN pg_attribute a ON a.attrelid = d.adrelid AND a.attnum = d.adnum
+		WHERE d.adrelid = 'tasks'::regclass AND a.attname = 'search_vector'`).
+		Scan(&expression).Error; err != nil {
+		return "", err
+	}
+
+	match := searchLanguagePattern.FindStringSubmatch(expression)
+	if match == nil {
+		return "", fmt.Errorf("text search configuration not found in search_vector: %q", expression)
+	}
+
+	return match[1], nil
+}
+
+// ExportInBatches reads tasks with their team name in batches in the list order of sort and passes each batch to fn
+// Batches are fetched by keyset on (created_at, id), or (rank, id) when sorted by rank, so memory use is
+// bounded by batchSize. Stops at the first error returned by fn
+func (p *datasource) ExportInBatches(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, batchSize int, fn func([]task.ExportItem) error) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var last *exportRow
+	for {
+		query := db.Model(&task.Task{}).
+			Select("tasks.*, COALESCE(teams.name, '') AS team_name").
+			Joins("LEFT JOIN teams ON teams.id = tasks.team_id AND teams.deleted_at IS NULL")
+
+		if statusFilter != nil {
+			query = query.Where("tasks.status = ?", *statusFilter)
+		}
+
+		if sort == task.SortRank {
+			if last != nil {
+				query = query.Where("(tasks.rank, tasks.id) > (?, ?)", last.Rank, last.ID)
+			}
+			query = query.Order("tasks.rank ASC").Order("tasks.id ASC")
+		} else {
+			if last != nil {
+				query = query.Where("(tasks.created_at, tasks.id) < (?, ?)", last.CreatedAt, last.ID)
+			}
+			query = query.Order("tasks.created_at DESC").Order("tasks.id DESC")
+		}
+
+		var rows []exportRow
+		if err := query.Limit(batchSize).Find(&rows).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		items := make([]task.ExportItem, len(rows))
+		for i, row := range rows {
+			items[i] = task.ExportItem{Task: row.Task, TeamName: row.TeamName}
+		}
+		if err := fn(items); err != nil {
+			return err
+		}
+
+		if len(rows) < batchSize {
+			return nil
+		}
+		last = &rows[len(rows)-1]
+	}
+}
+
+// LastRank returns the highest rank among the tasks, empty when there are none
+func (p *datasource) LastRank(ctx context.Context) (string, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var rank string
+	if err := db.Model(&task.Task{}).Select("COALESCE(MAX(rank), '')").Scan(&rank).Error; err != nil {
+		return "", err
+	}
+
+	return rank, nil
+}
+
+// RetrieveNeighborByRank retrieves the task right after (DirectionNext) or before (DirectionPrev) t
+// in its status column, ordered by (rank, id), skipping excludeID
+// The column holds the tasks of the team and status of t; tasks without a team share one column per status
+// Returns nil when t is at the end of the column in that direction
+func (p *datasource) RetrieveNeighborByRank(ctx context.Context, t *task.Task, direction pagination.Direction, excludeID uint) (*task.Task, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := db.Where("status = ? AND id <> ?", t.Status, excludeID)
+	if t.TeamID != nil {
+		query = query.Where("team_id = ?", *t.TeamID)
+	} else {
+		query = query.Where("team_id IS NULL")
+	}
+
+	var tasks []task.Task
+	cursor := pagination.NewRankCursor(t.Rank, t.ID, direction)
+	if err := query.Scopes(pagination.RankScope(cursor, 1)).Limit(1).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+
+	return &tasks[0], nil
+}
+
+// UpdateRank sets the rank of a task, incrementing its version and updated_at
+// A move changes the position of the task on the board, so both validators of its GET change
+func (p *datasource) UpdateRank(ctx context.Context, taskUUID uuid.UUID, rank string) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&task.Task{}).
+		Where("uuid = ?", taskUUID).
+		Updates(map[string]any{"rank": rank, "version": gorm.Expr("version + 1")})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// LockRanks takes the transaction-scoped advisory lock that serializes rank changes
+// Must run inside a transaction; the lock is released on commit or rollback
+func (p *datasource) LockRanks(ctx context.Context) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Exec("SELECT pg_advisory_xact_lock(?)", rankLockKey).Error
+}
+
+// Rebalance rewrites the ranks of all tasks evenly spaced, keeping their order (rank, id)
+// Uses the same decimal ranks as migration 000010, valid rank digits with room between neighbors.
+// Versions are kept: the renumbering leaves the order unchanged, so the If-Match of clients stays valid
+func (p *datasource) Rebalance(ctx context.Context) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Exec(`UPDATE tasks SET rank = ranked.rank
+		FROM (
+			SELECT id, rtrim(lpad((row_number() OVER (ORDER BY rank, id) * 1000)::text, 12, '0'), '0') AS rank
+			FROM tasks
+			WHERE deleted_at IS NULL
+		) AS ranked
+		WHERE tasks.id = ranked.id AND tasks.rank <> ranked.rank`).Error
+}
+
+// htmlEscaped returns the SQL expression of column with the HTML special characters escaped
+func htmlEscaped(column string) string {
+	return fmt.Sprintf(`replace(replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;')`, column)
+}
+
+// keysetScope applies the keyset condition and ordering of the requested sort
+func keysetScope(sort task.ListSort, cursor *pagination.Cursor, limit int) func(*gorm.DB) *gorm.DB {
+	if sort == task.SortRank {
+		return pagination.RankScope(cursor, limit)
+	}
+	return pagination.Scope(cursor, limit)
+}
+
+// keysetWindow trims a page read with keysetScope and returns its cursors
+func keysetWindow(sort task.ListSort, tasks []task.Task, cursor *pagination.Cursor, limit int) ([]task.Task, *pagination.Cursor, *pagination.Cursor) {
+	if sort == task.SortRank {
+		return pagination.RankWindow(tasks, cursor, limit, func(t task.Task) (string, uint) {
+			return t.Rank, t.ID
+		})
+	}
+	return pagination.Window(tasks, cursor, limit, func(t task.Task) (time.Time, uint) {
+		return t.CreatedAt, t.ID
+	})
+}
@@ -6,19 +6,31 @@ import (
 	"context"
 	"log/slog"
 	"taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/pagination"
 
 	"github.com/google/uuid"
 )
 
 // MockPersistent é um mock da interface Persistent para testes
 type MockPersistent struct {
-	FnCreate         func(context.Context, *task.Task) error
-	FnRetrieveByUUID func(context.Context, uuid.UUID) (*task.Task, error)
-	FnUpdate         func(context.Context, uuid.UUID, *task.Task) error
-	FnDelete         func(context.Context, uuid.UUID) error
-	FnListPaginated  func(context.Context, *task.TaskStatus, int, int) (*task.ListTasks, error)
-	FnUpdateStatus   func(context.Context, uuid.UUID, map[string]any) error
-	FnListByTeamID   func(context.Context, uint) ([]task.Task, error)
+	FnCreate                     func(context.Context, *task.Task) error
+	FnRetrieveByUUID             func(context.Context, uuid.UUID) (*task.Task, error)
+	FnUpdate                     func(context.Context, uuid.UUID, *task.Task) error
+	FnDelete                     func(context.Context, uuid.UUID, int) error
+	FnListPaginated              func(context.Context, *task.TaskStatus, task.ListSort, int, int) (*task.ListTasks, error)
+	FnListByCursor               func(context.Context, *task.TaskStatus, task.ListSort, *pagination.Cursor, int, bool) (*task.ListTasks, error)
+	FnUpdateStatus               func(context.Context, uuid.UUID, int, map[string]any) error
+	FnListByTeamID               func(context.Context, uint) ([]task.Task, error)
+	FnListByTeamAndStatus        func(context.Context, uint, task.TaskStatus, task.ListSort, *pagination.Cursor, int) (*task.ListTasks, error)
+	FnCountByTeamGroupedByStatus func(context.Context, uint) (map[task.TaskStatus]int, error)
+	FnSearch                     func(context.Context, string, string, int, int) (*task.ListSearchResults, error)
+	FnSearchLanguage             func(context.Context) (string, error)
+	FnExportInBatches            func(context.Context, *task.TaskStatus, task.ListSort, int, func([]task.ExportItem) error) error
+	FnLastRank                   func(context.Context) (string, error)
+	FnRetrieveNeighborByRank     func(context.Context, *task.Task, pagination.Direction, uint) (*task.Task, error)
+	FnUpdateRank                 func(context.Context, uuid.UUID, string) error
+	FnLockRanks                  func(context.Context) error
+	FnRebalance                  func(context.Context) error
 }
 
 // Create implementa o método Create da interface Persistent
@@ -49,30 +61,39 @@ func (m *MockPersistent) Update(ctx context.Context, taskUUID uuid.UUID, t *task
 }
 
 // Delete implementa o método Delete da interface Persistent
-func (m *MockPersistent) Delete(ctx context.Context, taskUUID uuid.UUID) error {
+func (m *MockPersistent) Delete(ctx context.Context, taskUUID uuid.UUID, version int) error {
 	if m.FnDelete == nil {
 		slog.Error("fnDelete is nil")
 		return nil
 	}
-	return m.FnDelete(ctx, taskUUID)
+	return m.FnDelete(ctx, taskUUID, version)
 }
 
 // ListPaginated implementa o método ListPaginated da interface Persistent
-func (m *MockPersistent) ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, page, limit int) (*task.ListTasks, error) {
+func (m *MockPersistent) ListPaginated(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, page, limit int) (*task.ListTasks, error) {
 	if m.FnListPaginated == nil {
 		slog.Error("fnListPaginated is nil")
 		return nil, nil
 	}
-	return m.FnListPaginated(ctx, statusFilter, page, limit)
+	return m.FnListPaginated(ctx, statusFilter, sort, page, limit)
+}
+
+// ListByCursor implementa o método ListByCursor da interface Persistent
+func (m *MockPersistent) ListByCursor(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int, withTotal bool) (*task.ListTasks, error) {
+	if m.FnListByCursor == nil {
+		slog.Error("fnListByCursor is nil")
+		return nil, nil
+	}
+	return m.FnListByCursor(ctx, statusFilter, sort, cursor, limit, withTotal)
 }
 
 // UpdateStatus implementa o método UpdateStatus da interface Persistent
-func (m *MockPersistent) UpdateStatus(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+func (m *MockPersistent) UpdateStatus(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 	if m.FnUpdateStatus == nil {
 		slog.Error("fnUpdateStatus is nil")
 		return nil
 	}
-	return m.FnUpdateStatus(ctx, taskUUID, updates)
+	return m.FnUpdateStatus(ctx, taskUUID, version, updates)
 }
 
 // ListByTeamID implementa o método ListByTeamID da interface Persistent
@@ -83,3 +104,93 @@ func (m *MockPersistent) ListByTeamID(ctx context.Context, teamID uint) ([]task.
 	}
 	return m.FnListByTeamID(ctx, teamID)
 }
+
+// ListByTeamAndStatus implementa o método ListByTeamAndStatus da interface Persistent
+func (m *MockPersistent) ListByTeamAndStatus(ctx context.Context, teamID uint, status task.TaskStatus, sort task.ListSort, cursor *pagination.Cursor, limit int) (*task.ListTasks, error) {
+	if m.FnListByTeamAndStatus == nil {
+		slog.Error("fnListByTeamAndStatus is nil")
+		return nil, nil
+	}
+	return m.FnListByTeamAndStatus(ctx, teamID, status, sort, cursor, limit)
+}
+
+// CountByTeamGroupedByStatus implementa o método CountByTeamGroupedByStatus da interface Persistent
+func (m *MockPersistent) CountByTeamGroupedByStatus(ctx context.Context, teamID uint) (map[task.TaskStatus]int, error) {
+	if m.FnCountByTeamGroupedByStatus == nil {
+		slog.Error("fnCountByTeamGroupedByStatus is nil")
+		return nil, nil
+	}
+	return m.FnCountByTeamGroupedByStatus(ctx, teamID)
+}
+
+// Search implementa o método Search da interface Persistent
+func (m *MockPersistent) Search(ctx context.Context, term, language string, page, limit int) (*task.ListSearchResults, error) {
+	if m.FnSearch == nil {
+		slog.Error("fnSearch is nil")
+		return nil, nil
+	}
+	return m.FnSearch(ctx, term, language, page, limit)
+}
+
+// SearchLanguage implementa o método SearchLanguage da interface Persistent
+func (m *MockPersistent) SearchLanguage(ctx context.Context) (string, error) {
+	if m.FnSearchLanguage == nil {
+		slog.Error("fnSearchLanguage is nil")
+		return "", nil
+	}
+	return m.FnSearchLanguage(ctx)
+}
+
+// ExportInBatches implementa o método ExportInBatches da interface Persistent
+func (m *MockPersistent) ExportInBatches(ctx context.Context, statusFilter *task.TaskStatus, sort task.ListSort, batchSize int, fn func([]task.ExportItem) error) error {
+	if m.FnExportInBatches == nil {
+		slog.Error("fnExportInBatches is nil")
+		return nil
+	}
+	return m.FnExportInBatches(ctx, statusFilter, sort, batchSize, fn)
+}
+
+// LastRank implementa o método LastRank da interface Persistent
+func (m *MockPersistent) LastRank(ctx context.Context) (string, error) {
+	if m.FnLastRank == nil {
+		slog.Error("fnLastRank is nil")
+		return "", nil
+	}
+	return m.FnLastRank(ctx)
+}
+
+// RetrieveNeighborByRank implementa o método RetrieveNeighborByRank da interface Persistent
+func (m *MockPersistent) RetrieveNeighborByRank(ctx context.Context, t *task.Task, direction pagination.Direction, excludeID uint) (*task.Task, error) {
+	if m.FnRetrieveNeighborByRank == nil {
+		slog.Error("fnRetrieveNeighborByRank is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveNeighborByRank(ctx, t, direction, excludeID)
+}
+
+// UpdateRank implementa o método UpdateRank da interface Persistent
+func (m *MockPersistent) UpdateRank(ctx context.Context, taskUUID uuid.UUID, rank string) error {
+	if m.FnUpdateRank == nil {
+		slog.Error("fnUpdateRank is nil")
+		return nil
+	}
+	return m.FnUpdateRank(ctx, taskUUID, rank)
+}
+
+// LockRanks implementa o método LockRanks da interface Persistent
+func (m *MockPersistent) LockRanks(ctx context.Context) error {
+	if m.FnLockRanks == nil {
+		slog.Error("fnLockRanks is nil")
+		return nil
+	}
+	return m.FnLockRanks(ctx)
+}
+
+// Rebalance implementa o método Rebalance da interface Persistent
+func (m *MockPersistent) Rebalance(ctx context.Context) error {
+	if m.FnRebalance == nil {
+		slog.Error("fnRebalance is nil")
+		return nil
+	}
+	return m.FnRebalance(ctx)
+}
@@ -4,6 +4,8 @@ package task
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"taskmanager/internal/paths"
 	"taskmanager/internal/platform/database"
 	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 	"taskmanager/internal/platform/testing/assert"
 	"taskmanager/internal/platform/testing/dbtest"
 	"taskmanager/internal/platform/testing/testenv"
@@ -192,6 +195,7 @@ func Test_datasource_Update(t *testing.T) {
 				Title:       "Título atualizado",
 				Description: "Descrição atualizada",
 				Status:      task.StatusInProgress,
+				Version:     1,
 			},
 			nil,
 		},
@@ -206,9 +210,25 @@ func Test_datasource_Update(t *testing.T) {
 				Title:       "Título atualizado",
 				Description: "Descrição atualizada",
 				Status:      task.StatusInProgress,
+				Version:     1,
 			},
 			errs.ErrNotFound,
 		},
+		{
+			"Update task with a stale version",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			&task.Task{
+				Title:       "Título atualizado",
+				Description: "Descrição atualizada",
+				Status:      task.StatusInProgress,
+				Version:     2,
+			},
+			&errs.PreconditionFailedError{Message: "task has been modified since version 2"},
+		},
 		{
 			"Update task with context nil",
 			nil,
@@ -218,6 +238,7 @@ func Test_datasource_Update(t *testing.T) {
 				Title:       "Título atualizado",
 				Description: "Descrição atualizada",
 				Status:      task.StatusInProgress,
+				Version:     1,
 			},
 			database.ErrContextDatabase,
 		},
@@ -239,6 +260,10 @@ func Test_datasource_Update(t *testing.T) {
 				t.Errorf("datasource.Update() error diff: %s", diff)
 				return
 			}
+
+			if tt.wantErr == nil && tt.task.Version != 2 {
+				t.Errorf("datasource.Update() version = %d, want 2", tt.task.Version)
+			}
 		})
 	}
 }
@@ -257,6 +282,7 @@ func Test_datasource_Delete(t *testing.T) {
 		setup    func()
 		ctx      context.Context
 		taskUUID uuid.UUID
+		version  int
 		wantErr  error
 	}{
 		{
@@ -266,6 +292,7 @@ func Test_datasource_Delete(t *testing.T) {
 			},
 			context.Background(),
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			1,
 			nil,
 		},
 		{
@@ -275,13 +302,25 @@ func Test_datasource_Delete(t *testing.T) {
 			},
 			context.Background(),
 			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			1,
 			errs.ErrNotFound,
 		},
+		{
+			"Delete task with a stale version",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			2,
+			&errs.PreconditionFailedError{Message: "task has been modified since version 2"},
+		},
 		{
 			"Delete task with context nil",
 			nil,
 			nil,
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			1,
 			database.ErrContextDatabase,
 		},
 	}
@@ -297,7 +336,7 @@ func Test_datasource_Delete(t *testing.T) {
 			}
 
 			p := &datasource{}
-			err := p.Delete(ctx, tt.taskUUID)
+			err := p.Delete(ctx, tt.taskUUID, tt.version)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("datasource.Delete() error diff: %s", diff)
 				return
@@ -1104,7 +1143,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 			}
 
 			p := &datasource{}
-			got, err := p.ListPaginated(ctx, tt.statusFilter, tt.page, tt.limit)
+			got, err := p.ListPaginated(ctx, tt.statusFilter, task.SortCreatedAt, tt.page, tt.limit)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("datasource.ListPaginated() error diff: %s", diff)
 				return
@@ -1130,6 +1169,7 @@ func Test_datasource_UpdateStatus(t *testing.T) {
 		setup    func()
 		ctx      context.Context
 		taskUUID uuid.UUID
+		version  int
 		updates  map[string]any
 		wantErr  error
 	}{
@@ -1140,6 +1180,7 @@ func Test_datasource_UpdateStatus(t *testing.T) {
 			},
 			context.Background(),
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			1,
 			map[string]any{
 				"status": task.StatusInProgress,
 			},
@@ -1152,6 +1193,7 @@ func Test_datasource_UpdateStatus(t *testing.T) {
 			},
 			context.Background(),
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+			1,
 			map[string]any{
 				"status":      task.StatusDone,
 				"started_at":  time.Date(2025, 12, 1, 18, 21, 6, 0, time.UTC),
@@ -1166,16 +1208,31 @@ func Test_datasource_UpdateStatus(t *testing.T) {
 			},
 			context.Background(),
 			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			1,
 			map[string]any{
 				"status": task.StatusInProgress,
 			},
 			errs.ErrNotFound,
 		},
+		{
+			"UpdateStatus with a stale version",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			2,
+			map[string]any{
+				"status": task.StatusInProgress,
+			},
+			&errs.PreconditionFailedError{Message: "task has been modified since version 2"},
+		},
 		{
 			"UpdateStatus with context nil",
 			nil,
 			nil,
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			1,
 			map[string]any{
 				"status": task.StatusInProgress,
 			},
@@ -1194,7 +1251,7 @@ func Test_datasource_UpdateStatus(t *testing.T) {
 			}
 
 			p := &datasource{}
-			err := p.UpdateStatus(ctx, tt.taskUUID, tt.updates)
+			err := p.UpdateStatus(ctx, tt.taskUUID, tt.version, tt.updates)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("datasource.UpdateStatus() error diff: %s", diff)
 				return
@@ -1427,3 +1484,1042 @@ func Test_datasource_ListByTeamID(t *testing.T) {
 		})
 	}
 }
+
+func Test_datasource_Search(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		ctx            context.Context
+		term           string
+		page           int
+		limit          int
+		wantUUIDs      []uuid.UUID
+		wantTotalItems int
+		wantErr        error
+	}{
+		{
+			"Search with matches in title and description",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			"autenticação",
+			1,
+			10,
+			[]uuid.UUID{
+				uuid.MustParse("223e4567-e89b-12d3-a456-426614174000"),
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			2,
+			nil,
+		},
+		{
+			"Search with pagination",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			"autenticação",
+			2,
+			1,
+			[]uuid.UUID{
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			2,
+			nil,
+		},
+		{
+			"Search with websearch syntax excluding terms",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			"autenticação -refatorar",
+			1,
+			10,
+			[]uuid.UUID{
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			1,
+			nil,
+		},
+		{
+			"Search with markup in the title is highlighted as escaped text",
+			func() {
+				resetWithMinimalData()
+				env.DB().Exec("UPDATE tasks SET title = ? WHERE uuid = ?",
+					`<script>alert("xss")</script> autenticação`, "123e4567-e89b-12d3-a456-426614174000")
+			},
+			context.Background(),
+			"xss",
+			1,
+			10,
+			[]uuid.UUID{
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			},
+			1,
+			nil,
+		},
+		{
+			"Search without matches",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			"inexistente",
+			1,
+			10,
+			[]uuid.UUID{},
+			0,
+			nil,
+		},
+		{
+			"Search with context nil",
+			nil,
+			nil,
+			"autenticação",
+			1,
+			10,
+			nil,
+			0,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.Search(ctx, tt.term, "portuguese", tt.page, tt.limit)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.Search() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotUUIDs := make([]uuid.UUID, len(got.Results))
+			for i, r := range got.Results {
+				gotUUIDs[i] = r.Task.UUID
+				if r.Rank <= 0 {
+					t.Errorf("datasource.Search() rank = %v, want > 0", r.Rank)
+				}
+				if !strings.Contains(r.TitleHighlight+r.DescriptionHighlight, "<mark>") {
+					t.Errorf("datasource.Search() highlights without match markers: %q / %q", r.TitleHighlight, r.DescriptionHighlight)
+				}
+				markup := strings.NewReplacer("<mark>", "", "</mark>", "").Replace(r.TitleHighlight + r.DescriptionHighlight)
+				if strings.ContainsAny(markup, `<>"`) {
+					t.Errorf("datasource.Search() highlights with unescaped markup: %q / %q", r.TitleHighlight, r.DescriptionHighlight)
+				}
+			}
+			if diff := cmp.Diff(gotUUIDs, tt.wantUUIDs); diff != "" {
+				t.Errorf("datasource.Search() uuids diff: %s", diff)
+			}
+			if got.TotalItems != tt.wantTotalItems {
+				t.Errorf("datasource.Search() total items = %d, want %d", got.TotalItems, tt.wantTotalItems)
+			}
+		})
+	}
+}
+
+func Test_datasource_SearchLanguage(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    string
+		wantErr error
+	}{
+		{"Search language of the migrated column", context.Background(), "portuguese", nil},
+		{"Search language with context nil", nil, "", database.ErrContextDatabase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithoutTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			p := &datasource{}
+			got, err := p.SearchLanguage(ctx)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.SearchLanguage() error diff: %s", diff)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("datasource.SearchLanguage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListByCursor(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+	statusDone := task.StatusDone
+	seedTime := time.Date(2025, 12, 1, 18, 21, 6, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setup          func()
+		ctx            context.Context
+		statusFilter   *task.TaskStatus
+		cursor         *pagination.Cursor
+		limit          int
+		withTotal      bool
+		wantUUIDs      []uuid.UUID
+		wantTotalItems int
+		wantNext       bool
+		wantPrev       bool
+		wantErr        error
+	}{
+		{
+			"List first page",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			nil,
+			nil,
+			3,
+			true,
+			[]uuid.UUID{
+				uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+				uuid.MustParse("423e4567-e89b-12d3-a456-426614174000"),
+				uuid.MustParse("323e4567-e89b-12d3-a456-426614174000"),
+			},
+			14,
+			true,
+			false,
+			nil,
+		},
+		{
+			"List next page after cursor",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			nil,
+			pagination.NewCursor(seedTime, 10, pagination.DirectionNext),
+			3,
+			false,
+			[]uuid.UUID{
+				uuid.MustParse("223e4567-e89b-12d3-a456-426614174000"),
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174004"),
+			},
+			0,
+			true,
+			true,
+			nil,
+		},
+		{
+			"List previous page before cursor",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			nil,
+			pagination.NewCursor(seedTime, 5, pagination.DirectionPrev),
+			3,
+			false,
+			[]uuid.UUID{
+				uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+				uuid.MustParse("423e4567-e89b-12d3-a456-426614174000"),
+				uuid.MustParse("323e4567-e89b-12d3-a456-426614174000"),
+			},
+			0,
+			true,
+			false,
+			nil,
+		},
+		{
+			"List with status filter",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			&statusDone,
+			nil,
+			2,
+			true,
+			[]uuid.UUID{
+				uuid.MustParse("423e4567-e89b-12d3-a456-426614174002"),
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+			},
+			3,
+			true,
+			false,
+			nil,
+		},
+		{
+			"List last page",
+			func() {
+				resetWithMinimalData()
+			},
+			context.Background(),
+			&statusDone,
+			pagination.NewCursor(seedTime.AddDate(0, 0, -7), 7, pagination.DirectionNext),
+			2,
+			true,
+			[]uuid.UUID{
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174006"),
+			},
+			3,
+			false,
+			true,
+			nil,
+		},
+		{
+			"List with context nil",
+			nil,
+			nil,
+			nil,
+			nil,
+			10,
+			true,
+			nil,
+			0,
+			false,
+			false,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ListByCursor(ctx, tt.statusFilter, task.SortCreatedAt, tt.cursor, tt.limit, tt.withTotal)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ListByCursor() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotUUIDs := make([]uuid.UUID, len(got.Tasks))
+			for i, tk := range got.Tasks {
+				gotUUIDs[i] = tk.UUID
+			}
+			if diff := cmp.Diff(gotUUIDs, tt.wantUUIDs); diff != "" {
+				t.Errorf("datasource.ListByCursor() uuids diff: %s", diff)
+			}
+			if got.TotalItems != tt.wantTotalItems {
+				t.Errorf("datasource.ListByCursor() total items = %d, want %d", got.TotalItems, tt.wantTotalItems)
+			}
+			if (got.NextCursor != nil) != tt.wantNext {
+				t.Errorf("datasource.ListByCursor() next cursor = %v, want present %t", got.NextCursor, tt.wantNext)
+			}
+			if (got.PrevCursor != nil) != tt.wantPrev {
+				t.Errorf("datasource.ListByCursor() prev cursor = %v, want present %t", got.PrevCursor, tt.wantPrev)
+			}
+		})
+	}
+}
+
+func Test_datasource_ExportInBatches(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	statusDone := task.StatusDone
+
+	tests := []struct {
+		name           string
+		setup          func()
+		ctx            context.Context
+		statusFilter   *task.TaskStatus
+		sort           task.ListSort
+		batchSize      int
+		fnErr          error
+		wantBatchSizes []int
+		wantFirst      []string
+		wantErr        error
+	}{
+		{
+			"Export all tasks in batches with team names, newest first",
+			resetWithMinimalData,
+			context.Background(),
+			nil,
+			task.SortCreatedAt,
+			5,
+			nil,
+			[]int{5, 5, 4},
+			[]string{"223e4567-e89b-12d3-a456-426614174001", "Time de Desenvolvimento", "423e4567-e89b-12d3-a456-426614174000", "Time de QA"},
+			nil,
+		},
+		{
+			"Export all tasks in batches ordered by rank",
+			resetWithMinimalData,
+			context.Background(),
+			nil,
+			task.SortRank,
+			5,
+			nil,
+			[]int{5, 5, 4},
+			[]string{"123e4567-e89b-12d3-a456-426614174006", "Time de DevOps", "123e4567-e89b-12d3-a456-426614174002", "Time de DevOps"},
+			nil,
+		},
+		{
+			"Export tasks filtered by status",
+			resetWithMinimalData,
+			context.Background(),
+			&statusDone,
+			task.SortCreatedAt,
+			5,
+			nil,
+			[]int{3},
+			nil,
+			nil,
+		},
+		{
+			"Export tasks with exact batch multiple",
+			resetWithMinimalData,
+			context.Background(),
+			nil,
+			task.SortRank,
+			7,
+			nil,
+			[]int{7, 7},
+			nil,
+			nil,
+		},
+		{
+			"Export tasks aborted by callback error",
+			resetWithMinimalData,
+			context.Background(),
+			nil,
+			task.SortCreatedAt,
+			5,
+			errors.New("client disconnected"),
+			[]int{5},
+			nil,
+			errors.New("client disconnected"),
+		},
+		{
+			"Export tasks with context nil",
+			nil,
+			nil,
+			nil,
+			task.SortCreatedAt,
+			5,
+			nil,
+			nil,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithoutTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			var (
+				batchSizes []int
+				first      []string
+			)
+			p := &datasource{}
+			err := p.ExportInBatches(ctx, tt.statusFilter, tt.sort, tt.batchSize, func(items []task.ExportItem) error {
+				batchSizes = append(batchSizes, len(items))
+				if first == nil && len(items) > 1 {
+					first = []string{items[0].Task.UUID.String(), items[0].TeamName, items[1].Task.UUID.String(), items[1].TeamName}
+				}
+				if tt.statusFilter != nil {
+					for _, item := range items {
+						if item.Task.Status != *tt.statusFilter {
+							t.Errorf("datasource.ExportInBatches() status = %v, want %v", item.Task.Status, *tt.statusFilter)
+						}
+					}
+				}
+				return tt.fnErr
+			})
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ExportInBatches() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(batchSizes, tt.wantBatchSizes); diff != "" {
+				t.Errorf("datasource.ExportInBatches() batch sizes diff: %s", diff)
+			}
+			if tt.wantFirst != nil {
+				if diff := cmp.Diff(first, tt.wantFirst); diff != "" {
+					t.Errorf("datasource.ExportInBatches() first items diff: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func Test_datasource_ListByTeamAndStatus(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		ctx       context.Context
+		teamID    uint
+		status    task.TaskStatus
+		sort      task.ListSort
+		cursor    *pagination.Cursor
+		limit     int
+		wantUUIDs []uuid.UUID
+		wantNext  bool
+		wantPrev  bool
+		wantErr   error
+	}{
+		{
+			"List first page of a column",
+			resetWithMinimalData,
+			context.Background(),
+			1,
+			task.StatusInProgress,
+			task.SortCreatedAt,
+			nil,
+			2,
+			[]uuid.UUID{
+				uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174005"),
+			},
+			true,
+			false,
+			nil,
+		},
+		{
+			"List next page of a column",
+			resetWithMinimalData,
+			context.Background(),
+			1,
+			task.StatusInProgress,
+			task.SortCreatedAt,
+			pagination.NewCursor(time.Date(2025, 11, 29, 18, 21, 6, 0, time.UTC), 4, pagination.DirectionNext),
+			2,
+			[]uuid.UUID{
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+			},
+			false,
+			true,
+			nil,
+		},
+		{
+			"List first page of a column sorted by rank",
+			resetWithMinimalData,
+			context.Background(),
+			1,
+			task.StatusInProgress,
+			task.SortRank,
+			nil,
+			2,
+			[]uuid.UUID{
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+				uuid.MustParse("123e4567-e89b-12d3-a456-426614174005"),
+			},
+			true,
+			false,
+			nil,
+		},
+		{
+			"List next page of a column sorted by rank",
+			resetWithMinimalData,
+			context.Background(),
+			1,
+			task.StatusInProgress,
+			task.SortRank,
+			pagination.NewRankCursor("000000006", 4, pagination.DirectionNext),
+			2,
+			[]uuid.UUID{
+				uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+			},
+			false,
+			true,
+			nil,
+		},
+		{
+			"List empty column",
+			resetWithMinimalData,
+			context.Background(),
+			1,
+			task.StatusDone,
+			task.SortCreatedAt,
+			nil,
+			2,
+			[]uuid.UUID{},
+			false,
+			false,
+			nil,
+		},
+		{
+			"List with context nil",
+			nil,
+			nil,
+			1,
+			task.StatusTodo,
+			task.SortCreatedAt,
+			nil,
+			2,
+			nil,
+			false,
+			false,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ListByTeamAndStatus(ctx, tt.teamID, tt.status, tt.sort, tt.cursor, tt.limit)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ListByTeamAndStatus() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotUUIDs := make([]uuid.UUID, len(got.Tasks))
+			for i, tk := range got.Tasks {
+				gotUUIDs[i] = tk.UUID
+			}
+			if diff := cmp.Diff(gotUUIDs, tt.wantUUIDs); diff != "" {
+				t.Errorf("datasource.ListByTeamAndStatus() uuids diff: %s", diff)
+			}
+			if (got.NextCursor != nil) != tt.wantNext {
+				t.Errorf("datasource.ListByTeamAndStatus() next cursor = %v, want present %t", got.NextCursor, tt.wantNext)
+			}
+			if (got.PrevCursor != nil) != tt.wantPrev {
+				t.Errorf("datasource.ListByTeamAndStatus() prev cursor = %v, want present %t", got.PrevCursor, tt.wantPrev)
+			}
+		})
+	}
+}
+
+func Test_datasource_CountByTeamGroupedByStatus(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		teamID  uint
+		want    map[task.TaskStatus]int
+		wantErr error
+	}{
+		{
+			"Count tasks of a team per status",
+			resetWithMinimalData,
+			context.Background(),
+			2,
+			map[task.TaskStatus]int{
+				task.StatusTodo:       1,
+				task.StatusInProgress: 1,
+				task.StatusDone:       2,
+				task.StatusCanceled:   1,
+			},
+			nil,
+		},
+		{
+			"Count tasks of a team without tasks",
+			resetWithMinimalData,
+			context.Background(),
+			4,
+			map[task.TaskStatus]int{},
+			nil,
+		},
+		{
+			"Count with context nil",
+			nil,
+			nil,
+			1,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.CountByTeamGroupedByStatus(ctx, tt.teamID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.CountByTeamGroupedByStatus() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.CountByTeamGroupedByStatus() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_LastRank(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		want    string
+		wantErr error
+	}{
+		{
+			"LastRank of the newest task",
+			resetWithMinimalData,
+			context.Background(),
+			"000000014",
+			nil,
+		},
+		{
+			"LastRank without tasks",
+			func() { _ = dbtest.CleanDatabase(env.DB()) },
+			context.Background(),
+			"",
+			nil,
+		},
+		{
+			"LastRank with context nil",
+			nil,
+			nil,
+			"",
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.LastRank(ctx)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.LastRank() error diff: %s", diff)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("datasource.LastRank() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveNeighborByRank(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	teamID := uint(1)
+	inProgress := &task.Task{Model: gorm.Model{ID: 4}, Status: task.StatusInProgress, TeamID: &teamID, Rank: "000000006"}
+	withoutTeam := &task.Task{Model: gorm.Model{ID: 1}, Status: task.StatusTodo, Rank: "00000001"}
+	want := func(value string) *uuid.UUID {
+		u := uuid.MustParse(value)
+		return &u
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		ctx       context.Context
+		task      *task.Task
+		direction pagination.Direction
+		excludeID uint
+		want      *uuid.UUID
+		wantErr   error
+	}{
+		{
+			"RetrieveNeighborByRank next in the column",
+			resetWithMinimalData,
+			context.Background(),
+			inProgress,
+			pagination.DirectionNext,
+			0,
+			want("223e4567-e89b-12d3-a456-426614174001"),
+			nil,
+		},
+		{
+			"RetrieveNeighborByRank previous in the column",
+			resetWithMinimalData,
+			context.Background(),
+			inProgress,
+			pagination.DirectionPrev,
+			0,
+			want("123e4567-e89b-12d3-a456-426614174001"),
+			nil,
+		},
+		{
+			"RetrieveNeighborByRank skipping the excluded task",
+			resetWithMinimalData,
+			context.Background(),
+			inProgress,
+			pagination.DirectionPrev,
+			2,
+			nil,
+			nil,
+		},
+		{
+			"RetrieveNeighborByRank in the column of tasks without team",
+			resetWithMinimalData,
+			context.Background(),
+			withoutTeam,
+			pagination.DirectionNext,
+			0,
+			nil,
+			nil,
+		},
+		{
+			"RetrieveNeighborByRank with context nil",
+			nil,
+			nil,
+			inProgress,
+			pagination.DirectionNext,
+			0,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.RetrieveNeighborByRank(ctx, tt.task, tt.direction, tt.excludeID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveNeighborByRank() error diff: %s", diff)
+				return
+			}
+
+			var gotUUID *uuid.UUID
+			if got != nil {
+				gotUUID = &got.UUID
+			}
+			if diff := cmp.Diff(gotUUID, tt.want); diff != "" {
+				t.Errorf("datasource.RetrieveNeighborByRank() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_UpdateRank(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		taskUUID uuid.UUID
+		rank     string
+		wantErr  error
+	}{
+		{
+			"UpdateRank with success",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+			"000000005i",
+			nil,
+		},
+		{
+			"UpdateRank of missing task",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			"000000005i",
+			errs.ErrNotFound,
+		},
+		{
+			"UpdateRank with context nil",
+			nil,
+			nil,
+			uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+			"000000005i",
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			var before *task.Task
+			if tt.wantErr == nil {
+				var err error
+				if before, err = p.RetrieveByUUID(ctx, tt.taskUUID); err != nil {
+					t.Fatalf("datasource.RetrieveByUUID() error: %v", err)
+				}
+			}
+
+			err := p.UpdateRank(ctx, tt.taskUUID, tt.rank)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.UpdateRank() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveByUUID(ctx, tt.taskUUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveByUUID() error: %v", err)
+			}
+			if got.Rank != tt.rank {
+				t.Errorf("datasource.UpdateRank() rank = %q, want %q", got.Rank, tt.rank)
+			}
+			if got.Version != 2 {
+				t.Errorf("datasource.UpdateRank() version = %d, want 2", got.Version)
+			}
+			if !got.UpdatedAt.After(before.UpdatedAt) {
+				t.Errorf("datasource.UpdateRank() updated_at = %v, want after %v", got.UpdatedAt, before.UpdatedAt)
+			}
+		})
+	}
+}
+
+func Test_datasource_Rebalance(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	t.Run("Rebalance keeps the order and spaces the ranks", func(t *testing.T) {
+		ctx := dbtest.SetupDBWithTransaction(t, context.Background(), env.DBConnector())
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+
+		p := &datasource{}
+		if err := p.UpdateRank(ctx, uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"), "000000005i"); err != nil {
+			t.Fatalf("datasource.UpdateRank() error: %v", err)
+		}
+
+		before, err := p.ListByTeamAndStatus(ctx, 1, task.StatusInProgress, task.SortRank, nil, 10)
+		if err != nil {
+			t.Fatalf("datasource.ListByTeamAndStatus() error: %v", err)
+		}
+
+		if err := p.Rebalance(ctx); err != nil {
+			t.Fatalf("datasource.Rebalance() error: %v", err)
+		}
+
+		got, err := p.ListByTeamAndStatus(ctx, 1, task.StatusInProgress, task.SortRank, nil, 10)
+		if err != nil {
+			t.Fatalf("datasource.ListByTeamAndStatus() error: %v", err)
+		}
+
+		gotRanks := map[uuid.UUID]string{}
+		gotVersions, wantVersions := map[uuid.UUID]int{}, map[uuid.UUID]int{}
+		for i, tk := range got.Tasks {
+			gotRanks[tk.UUID] = tk.Rank
+			gotVersions[tk.UUID] = tk.Version
+			wantVersions[before.Tasks[i].UUID] = before.Tasks[i].Version
+		}
+		wantRanks := map[uuid.UUID]string{
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"): "000000005",
+			uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"): "000000006",
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174005"): "000000007",
+		}
+		if diff := cmp.Diff(gotRanks, wantRanks); diff != "" {
+			t.Errorf("datasource.Rebalance() ranks diff: %s", diff)
+		}
+		if diff := cmp.Diff(gotVersions, wantVersions); diff != "" {
+			t.Errorf("datasource.Rebalance() versions diff: %s", diff)
+		}
+	})
+
+	t.Run("Rebalance with context nil", func(t *testing.T) {
+		p := &datasource{}
+		if diff := assert.CompareErrors(p.Rebalance(nil), database.ErrContextDatabase); diff != "" {
+			t.Errorf("datasource.Rebalance() error diff: %s", diff)
+		}
+	})
+}
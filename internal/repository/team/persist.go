@@ -3,22 +3,34 @@ package team
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"taskmanager/internal/entity/team"
 	"taskmanager/internal/platform/database"
 	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Persistent defines the interface for team persistence
 type Persistent interface {
 	Create(ctx context.Context, t *team.Team) error
 	RetrieveByUUID(ctx context.Context, teamUUID uuid.UUID) (*team.Team, error)
+	RetrieveByName(ctx context.Context, name string) (*team.Team, error)
 	ListPaginated(ctx context.Context, page, limit int) (*team.ListTeams, error)
+	ListByCursor(ctx context.Context, cursor *pagination.Cursor, limit int, withTotal bool) (*team.ListTeams, error)
 	RetrieveTaskTeamID(ctx context.Context, taskUUID uuid.UUID) (*uint, error)
+	RetrieveTaskTeamUUID(ctx context.Context, taskUUID uuid.UUID) (*uuid.UUID, error)
 	UpdateTaskTeamID(ctx context.Context, taskUUID uuid.UUID, teamID *uint) error
+	UpdateCalendarTokenHash(ctx context.Context, teamUUID uuid.UUID, hash string) error
+	Update(ctx context.Context, teamUUID uuid.UUID, t *team.Team) error
+	UpdateWIPLimits(ctx context.Context, teamUUID uuid.UUID, version int, limits team.WIPLimits) error
+	LockWIPLimits(ctx context.Context, teamID uint) (team.WIPLimits, error)
+	CreateWIPLimitOverride(ctx context.Context, o *team.WIPLimitOverride) error
 }
 
 // datasource implements the persistent interface using PostgreSQL
@@ -68,6 +80,25 @@ func (p *datasource) RetrieveByUUID(ctx context.Context, teamUUID uuid.UUID) (*t
 	return &t, nil
 }
 
+// RetrieveByName retrieves a team by name from the database
+// The comparison is case-insensitive; the oldest team wins when names repeat
+func (p *datasource) RetrieveByName(ctx context.Context, name string) (*team.Team, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var t team.Team
+	if err := db.Where("LOWER(name) = LOWER(?)", name).Order("id ASC").First(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &t, nil
+}
+
 // ListPaginated lists teams with pagination from the database
 func (p *datasource) ListPaginated(ctx context.Context, page, limit int) (*team.ListTeams, error) {
 	db, err := database.DBFromContext(ctx)
@@ -97,6 +128,39 @@ func (p *datasource) ListPaginated(ctx context.Context, page, limit int) (*team.
 	}, nil
 }
 
+// ListByCursor lists teams with keyset pagination on (created_at, id) from the database
+// The total count is only computed when withTotal is true
+func (p *datasource) ListByCursor(ctx context.Context, cursor *pagination.Cursor, limit int, withTotal bool) (*team.ListTeams, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []team.Team
+
+	query := db.Model(&team.Team{})
+
+	result := &team.ListTeams{Limit: limit}
+
+	if withTotal {
+		var totalItems int64
+		if err := query.Count(&totalItems).Error; err != nil {
+			return nil, err
+		}
+		result.TotalItems = int(totalItems)
+	}
+
+	if err := query.Scopes(pagination.Scope(cursor, limit)).Find(&teams).Error; err != nil {
+		return nil, err
+	}
+
+	result.Teams, result.NextCursor, result.PrevCursor = pagination.Window(teams, cursor, limit, func(t team.Team) (time.Time, uint) {
+		return t.CreatedAt, t.ID
+	})
+
+	return result, nil
+}
+
 // RetrieveTaskTeamID retrieves the team_id of a task by UUID
 func (p *datasource) RetrieveTaskTeamID(ctx context.Context, taskUUID uuid.UUID) (*uint, error) {
 	db, err := database.DBFromContext(ctx)
@@ -120,16 +184,69 @@ func (p *datasource) RetrieveTaskTeamID(ctx context.Context, taskUUID uuid.UUID)
 	return result.TeamID, nil
 }
 
+// RetrieveTaskTeamUUID retrieves the UUID of the team of a task by UUID
+// Returns nil when the task belongs to no team
+func (p *datasource) RetrieveTaskTeamUUID(ctx context.Context, taskUUID uuid.UUID) (*uuid.UUID, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		TeamUUID *uuid.UUID `gorm:"column:team_uuid"`
+	}
+	if err := db.Table("tasks").
+		Select("teams.uuid AS team_uuid").
+		Joins("LEFT JOIN teams ON teams.id = tasks.team_id AND teams.deleted_at IS NULL").
+		Where("tasks.uuid = ? AND tasks.deleted_at IS NULL", taskUUID).
+		First(&result).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return result.TeamUUID, nil
+}
+
 // UpdateTaskTeamID updates the team_id of a task
+// A task leaving its team also leaves the sprint it was planned in
 func (p *datasource) UpdateTaskTeamID(ctx context.Context, taskUUID uuid.UUID, teamID *uint) error {
 	db, err := database.DBFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
+	updates := map[string]any{"team_id": teamID}
+	if teamID == nil {
+		updates["sprint_id"] = nil
+	}
+
 	result := db.Table("tasks").
 		Where("uuid = ?", taskUUID).
-		Update("team_id", teamID)
+		Updates(updates)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// UpdateCalendarTokenHash replaces the calendar feed token hash of a team
+func (p *datasource) UpdateCalendarTokenHash(ctx context.Context, teamUUID uuid.UUID, hash string) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&team.Team{}).
+		Where("uuid = ?", teamUUID).
+		Update("calendar_token_hash", hash)
 
 	if result.Error != nil {
 		return result.Error
@@ -141,3 +258,104 @@ func (p *datasource) UpdateTaskTeamID(ctx context.Context, taskUUID uuid.UUID, t
 
 	return nil
 }
+
+// UpdateWIPLimits replaces the WIP limits of a team if it is still at the given version, incrementing it
+func (p *datasource) UpdateWIPLimits(ctx context.Context, teamUUID uuid.UUID, version int, limits team.WIPLimits) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Updates with a struct applies the JSON serializer of the column
+	result := db.Model(&team.Team{}).
+		Where("uuid = ? AND version = ?", teamUUID, version).
+		Select("wip_limits", "version").
+		Updates(&team.Team{WIPLimits: limits, Version: version + 1})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return notFoundOrModified(db, teamUUID, version)
+	}
+
+	return nil
+}
+
+// Update updates the name, description and WIP limits of a team if it is still at t.Version, incrementing it
+func (p *datasource) Update(ctx context.Context, teamUUID uuid.UUID, t *team.Team) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The calendar token hash, rotated by its own operation, is not overwritten
+	version := t.Version
+	t.Version++
+	result := db.Model(&team.Team{}).
+		Where("uuid = ? AND version = ?", teamUUID, version).
+		Select("name", "description", "wip_limits", "version", "updated_at").
+		Updates(t)
+
+	if result.Error != nil {
+		t.Version = version
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		t.Version = version
+		return notFoundOrModified(db, teamUUID, version)
+	}
+
+	return nil
+}
+
+// notFoundOrModified tells apart a write that matched no team because the team does not exist
+// from one that lost the race against a change to the version the write expected
+func notFoundOrModified(db *gorm.DB, teamUUID uuid.UUID, version int) error {
+	var count int64
+	if err := db.Model(&team.Team{}).Where("uuid = ?", teamUUID).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return errs.ErrNotFound
+	}
+
+	return &errs.PreconditionFailedError{
+		Message: fmt.Sprintf("team has been modified since version %d", version),
+	}
+}
+
+// LockWIPLimits retrieves the WIP limits of a team by ID, locking the team row until the transaction ends
+// Status changes into the team's columns are serialized, so two of them cannot both take the last slot
+func (p *datasource) LockWIPLimits(ctx context.Context, teamID uint) (team.WIPLimits, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var t team.Team
+	if err := db.Select("id", "wip_limits").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", teamID).
+		First(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return t.WIPLimits, nil
+}
+
+// CreateWIPLimitOverride records a status change that exceeded the WIP limit of a team
+func (p *datasource) CreateWIPLimitOverride(ctx context.Context, o *team.WIPLimitOverride) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db.Create(o).Error
+}
@@ -6,17 +6,26 @@ import (
 	"context"
 	"log/slog"
 	"taskmanager/internal/entity/team"
+	"taskmanager/internal/platform/pagination"
 
 	"github.com/google/uuid"
 )
 
 // MockPersistent é um mock da interface Persistent para testes
 type MockPersistent struct {
-	FnCreate             func(context.Context, *team.Team) error
-	FnRetrieveByUUID     func(context.Context, uuid.UUID) (*team.Team, error)
-	FnListPaginated      func(context.Context, int, int) (*team.ListTeams, error)
-	FnRetrieveTaskTeamID func(context.Context, uuid.UUID) (*uint, error)
-	FnUpdateTaskTeamID   func(context.Context, uuid.UUID, *uint) error
+	FnCreate                  func(context.Context, *team.Team) error
+	FnRetrieveByUUID          func(context.Context, uuid.UUID) (*team.Team, error)
+	FnRetrieveByName          func(context.Context, string) (*team.Team, error)
+	FnListPaginated           func(context.Context, int, int) (*team.ListTeams, error)
+	FnListByCursor            func(context.Context, *pagination.Cursor, int, bool) (*team.ListTeams, error)
+	FnRetrieveTaskTeamID      func(context.Context, uuid.UUID) (*uint, error)
+	FnRetrieveTaskTeamUUID    func(context.Context, uuid.UUID) (*uuid.UUID, error)
+	FnUpdateTaskTeamID        func(context.Context, uuid.UUID, *uint) error
+	FnUpdateCalendarTokenHash func(context.Context, uuid.UUID, string) error
+	FnUpdate                  func(context.Context, uuid.UUID, *team.Team) error
+	FnUpdateWIPLimits         func(context.Context, uuid.UUID, int, team.WIPLimits) error
+	FnLockWIPLimits           func(context.Context, uint) (team.WIPLimits, error)
+	FnCreateWIPLimitOverride  func(context.Context, *team.WIPLimitOverride) error
 }
 
 // Create implementa o método Create da interface Persistent
@@ -46,6 +55,24 @@ func (m *MockPersistent) ListPaginated(ctx context.Context, page, limit int) (*t
 	return m.FnListPaginated(ctx, page, limit)
 }
 
+// ListByCursor implementa o método ListByCursor da interface Persistent
+func (m *MockPersistent) ListByCursor(ctx context.Context, cursor *pagination.Cursor, limit int, withTotal bool) (*team.ListTeams, error) {
+	if m.FnListByCursor == nil {
+		slog.Error("fnListByCursor is nil")
+		return nil, nil
+	}
+	return m.FnListByCursor(ctx, cursor, limit, withTotal)
+}
+
+// RetrieveByName implementa o método RetrieveByName da interface Persistent
+func (m *MockPersistent) RetrieveByName(ctx context.Context, name string) (*team.Team, error) {
+	if m.FnRetrieveByName == nil {
+		slog.Error("fnRetrieveByName is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveByName(ctx, name)
+}
+
 // RetrieveTaskTeamID implementa o método RetrieveTaskTeamID da interface Persistent
 func (m *MockPersistent) RetrieveTaskTeamID(ctx context.Context, taskUUID uuid.UUID) (*uint, error) {
 	if m.FnRetrieveTaskTeamID == nil {
@@ -55,6 +82,15 @@ func (m *MockPersistent) RetrieveTaskTeamID(ctx context.Context, taskUUID uuid.U
 	return m.FnRetrieveTaskTeamID(ctx, taskUUID)
 }
 
+// RetrieveTaskTeamUUID implementa o método RetrieveTaskTeamUUID da interface Persistent
+func (m *MockPersistent) RetrieveTaskTeamUUID(ctx context.Context, taskUUID uuid.UUID) (*uuid.UUID, error) {
+	if m.FnRetrieveTaskTeamUUID == nil {
+		slog.Error("fnRetrieveTaskTeamUUID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveTaskTeamUUID(ctx, taskUUID)
+}
+
 // UpdateTaskTeamID implementa o método UpdateTaskTeamID da interface Persistent
 func (m *MockPersistent) UpdateTaskTeamID(ctx context.Context, taskUUID uuid.UUID, teamID *uint) error {
 	if m.FnUpdateTaskTeamID == nil {
@@ -63,3 +99,48 @@ func (m *MockPersistent) UpdateTaskTeamID(ctx context.Context, taskUUID uuid.UUI
 	}
 	return m.FnUpdateTaskTeamID(ctx, taskUUID, teamID)
 }
+
+// UpdateCalendarTokenHash implementa o método UpdateCalendarTokenHash da interface Persistent
+func (m *MockPersistent) UpdateCalendarTokenHash(ctx context.Context, teamUUID uuid.UUID, hash string) error {
+	if m.FnUpdateCalendarTokenHash == nil {
+		slog.Error("fnUpdateCalendarTokenHash is nil")
+		return nil
+	}
+	return m.FnUpdateCalendarTokenHash(ctx, teamUUID, hash)
+}
+
+// Update implementa o método Update da interface Persistent
+func (m *MockPersistent) Update(ctx context.Context, teamUUID uuid.UUID, t *team.Team) error {
+	if m.FnUpdate == nil {
+		slog.Error("fnUpdate is nil")
+		return nil
+	}
+	return m.FnUpdate(ctx, teamUUID, t)
+}
+
+// UpdateWIPLimits implementa o método UpdateWIPLimits da interface Persistent
+func (m *MockPersistent) UpdateWIPLimits(ctx context.Context, teamUUID uuid.UUID, version int, limits team.WIPLimits) error {
+	if m.FnUpdateWIPLimits == nil {
+		slog.Error("fnUpdateWIPLimits is nil")
+		return nil
+	}
+	return m.FnUpdateWIPLimits(ctx, teamUUID, version, limits)
+}
+
+// LockWIPLimits implementa o método LockWIPLimits da interface Persistent
+func (m *MockPersistent) LockWIPLimits(ctx context.Context, teamID uint) (team.WIPLimits, error) {
+	if m.FnLockWIPLimits == nil {
+		slog.Error("fnLockWIPLimits is nil")
+		return nil, nil
+	}
+	return m.FnLockWIPLimits(ctx, teamID)
+}
+
+// CreateWIPLimitOverride implementa o método CreateWIPLimitOverride da interface Persistent
+func (m *MockPersistent) CreateWIPLimitOverride(ctx context.Context, o *team.WIPLimitOverride) error {
+	if m.FnCreateWIPLimitOverride == nil {
+		slog.Error("fnCreateWIPLimitOverride is nil")
+		return nil
+	}
+	return m.FnCreateWIPLimitOverride(ctx, o)
+}
@@ -7,10 +7,12 @@ import (
 	"testing"
 	"time"
 
+	taskEntity "taskmanager/internal/entity/task"
 	"taskmanager/internal/entity/team"
 	"taskmanager/internal/paths"
 	"taskmanager/internal/platform/database"
 	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 	"taskmanager/internal/platform/testing/assert"
 	"taskmanager/internal/platform/testing/dbtest"
 	"taskmanager/internal/platform/testing/testenv"
@@ -109,6 +111,7 @@ func Test_datasource_RetrieveByUUID(t *testing.T) {
 				UUID:        uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
 				Name:        "Time de Desenvolvimento",
 				Description: "Equipe responsável pelo desenvolvimento de features e manutenção do código",
+				WIPLimits:   team.WIPLimits{},
 			},
 			nil,
 		},
@@ -153,6 +156,82 @@ func Test_datasource_RetrieveByUUID(t *testing.T) {
 	}
 }
 
+func Test_datasource_RetrieveByName(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		teamName string
+		want     *team.Team
+		wantErr  error
+	}{
+		{
+			"Retrieve team by name ignoring case with success",
+			resetWithMinimalData,
+			context.Background(),
+			"time de desenvolvimento",
+			&team.Team{
+				Model: gorm.Model{
+					ID:        1,
+					CreatedAt: time.Date(2025, 12, 1, 18, 20, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2025, 12, 1, 18, 20, 0, 0, time.UTC),
+				},
+				UUID:        uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+				Name:        "Time de Desenvolvimento",
+				Description: "Equipe responsável pelo desenvolvimento de features e manutenção do código",
+				WIPLimits:   team.WIPLimits{},
+			},
+			nil,
+		},
+		{
+			"Retrieve team by name not found",
+			resetWithMinimalData,
+			context.Background(),
+			"Time inexistente",
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"Retrieve team by name with context nil",
+			nil,
+			nil,
+			"Time de Desenvolvimento",
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.RetrieveByName(ctx, tt.teamName)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveByName() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.RetrieveByName() diff: %s", diff)
+			}
+		})
+	}
+}
+
 func Test_datasource_ListPaginated(t *testing.T) {
 	env := testenv.Setup(t,
 		testenv.WithDatabase(databaseTest),
@@ -190,6 +269,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 						UUID:        uuid.MustParse("444e4567-e89b-12d3-a456-426614174000"),
 						Name:        "Time de UX/UI",
 						Description: "Equipe responsável por design e experiência do usuário",
+						WIPLimits:   team.WIPLimits{},
 					},
 					{
 						Model: gorm.Model{
@@ -200,6 +280,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 						UUID:        uuid.MustParse("333e4567-e89b-12d3-a456-426614174000"),
 						Name:        "Time de QA",
 						Description: "Equipe responsável por testes e garantia de qualidade",
+						WIPLimits:   team.WIPLimits{},
 					},
 					{
 						Model: gorm.Model{
@@ -210,6 +291,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 						UUID:        uuid.MustParse("222e4567-e89b-12d3-a456-426614174000"),
 						Name:        "Time de DevOps",
 						Description: "Equipe responsável por infraestrutura, CI/CD e deploy",
+						WIPLimits:   team.WIPLimits{},
 					},
 				},
 				TotalItems: 4,
@@ -235,6 +317,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 						UUID:        uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
 						Name:        "Time de Desenvolvimento",
 						Description: "Equipe responsável pelo desenvolvimento de features e manutenção do código",
+						WIPLimits:   team.WIPLimits{},
 					},
 				},
 				TotalItems: 4,
@@ -260,6 +343,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 						UUID:        uuid.MustParse("444e4567-e89b-12d3-a456-426614174000"),
 						Name:        "Time de UX/UI",
 						Description: "Equipe responsável por design e experiência do usuário",
+						WIPLimits:   team.WIPLimits{},
 					},
 					{
 						Model: gorm.Model{
@@ -270,6 +354,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 						UUID:        uuid.MustParse("333e4567-e89b-12d3-a456-426614174000"),
 						Name:        "Time de QA",
 						Description: "Equipe responsável por testes e garantia de qualidade",
+						WIPLimits:   team.WIPLimits{},
 					},
 					{
 						Model: gorm.Model{
@@ -280,6 +365,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 						UUID:        uuid.MustParse("222e4567-e89b-12d3-a456-426614174000"),
 						Name:        "Time de DevOps",
 						Description: "Equipe responsável por infraestrutura, CI/CD e deploy",
+						WIPLimits:   team.WIPLimits{},
 					},
 					{
 						Model: gorm.Model{
@@ -290,6 +376,7 @@ func Test_datasource_ListPaginated(t *testing.T) {
 						UUID:        uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
 						Name:        "Time de Desenvolvimento",
 						Description: "Equipe responsável pelo desenvolvimento de features e manutenção do código",
+						WIPLimits:   team.WIPLimits{},
 					},
 				},
 				TotalItems: 4,
@@ -430,6 +517,91 @@ func Test_datasource_RetrieveTaskTeamID(t *testing.T) {
 	}
 }
 
+func Test_datasource_RetrieveTaskTeamUUID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	teamUUID1 := uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+	teamUUID2 := uuid.MustParse("222e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		taskUUID uuid.UUID
+		want     *uuid.UUID
+		wantErr  error
+	}{
+		{
+			"RetrieveTaskTeamUUID with task associated to team",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+			&teamUUID1,
+			nil,
+		},
+		{
+			"RetrieveTaskTeamUUID with task not associated to team",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			nil,
+			nil,
+		},
+		{
+			"RetrieveTaskTeamUUID with task associated to different team",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+			&teamUUID2,
+			nil,
+		},
+		{
+			"RetrieveTaskTeamUUID task not found",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"RetrieveTaskTeamUUID with context nil",
+			nil,
+			nil,
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.RetrieveTaskTeamUUID(ctx, tt.taskUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveTaskTeamUUID() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.RetrieveTaskTeamUUID() diff: %s", diff)
+			}
+		})
+	}
+}
+
 func Test_datasource_UpdateTaskTeamID(t *testing.T) {
 	env := testenv.Setup(t,
 		testenv.WithDatabase(databaseTest),
@@ -511,3 +683,564 @@ func Test_datasource_UpdateTaskTeamID(t *testing.T) {
 		})
 	}
 }
+
+func Test_datasource_UpdateCalendarTokenHash(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	hash := team.HashCalendarToken("secret")
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		teamUUID uuid.UUID
+		hash     string
+		wantErr  error
+	}{
+		{
+			"UpdateCalendarTokenHash with success",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			hash,
+			nil,
+		},
+		{
+			"UpdateCalendarTokenHash team not found",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			hash,
+			errs.ErrNotFound,
+		},
+		{
+			"UpdateCalendarTokenHash with context nil",
+			nil,
+			nil,
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			hash,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.UpdateCalendarTokenHash(ctx, tt.teamUUID, tt.hash)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.UpdateCalendarTokenHash() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveByUUID(ctx, tt.teamUUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveByUUID() error: %v", err)
+			}
+			if got.CalendarTokenHash != tt.hash {
+				t.Errorf("datasource.UpdateCalendarTokenHash() hash = %q, want %q", got.CalendarTokenHash, tt.hash)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListByCursor(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+	seedTime := time.Date(2025, 12, 1, 18, 20, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		ctx       context.Context
+		cursor    *pagination.Cursor
+		limit     int
+		withTotal bool
+		want      *team.ListTeams
+		wantErr   error
+	}{
+		{
+			"ListByCursor first page with total",
+			resetWithMinimalData,
+			context.Background(),
+			nil,
+			2,
+			true,
+			&team.ListTeams{
+				Limit:      2,
+				TotalItems: 4,
+				Teams: []team.Team{
+					{
+						Model: gorm.Model{
+							ID:        4,
+							CreatedAt: seedTime,
+							UpdatedAt: seedTime,
+						},
+						UUID:        uuid.MustParse("444e4567-e89b-12d3-a456-426614174000"),
+						Name:        "Time de UX/UI",
+						Description: "Equipe responsável por design e experiência do usuário",
+						WIPLimits:   team.WIPLimits{},
+					},
+					{
+						Model: gorm.Model{
+							ID:        3,
+							CreatedAt: seedTime,
+							UpdatedAt: seedTime,
+						},
+						UUID:        uuid.MustParse("333e4567-e89b-12d3-a456-426614174000"),
+						Name:        "Time de QA",
+						Description: "Equipe responsável por testes e garantia de qualidade",
+						WIPLimits:   team.WIPLimits{},
+					},
+				},
+				NextCursor: pagination.NewCursor(seedTime, 3, pagination.DirectionNext),
+			},
+			nil,
+		},
+		{
+			"ListByCursor next page without total",
+			resetWithMinimalData,
+			context.Background(),
+			pagination.NewCursor(seedTime, 3, pagination.DirectionNext),
+			2,
+			false,
+			&team.ListTeams{
+				Limit: 2,
+				Teams: []team.Team{
+					{
+						Model: gorm.Model{
+							ID:        2,
+							CreatedAt: seedTime,
+							UpdatedAt: seedTime,
+						},
+						UUID:        uuid.MustParse("222e4567-e89b-12d3-a456-426614174000"),
+						Name:        "Time de DevOps",
+						Description: "Equipe responsável por infraestrutura, CI/CD e deploy",
+						WIPLimits:   team.WIPLimits{},
+					},
+					{
+						Model: gorm.Model{
+							ID:        1,
+							CreatedAt: seedTime,
+							UpdatedAt: seedTime,
+						},
+						UUID:        uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+						Name:        "Time de Desenvolvimento",
+						Description: "Equipe responsável pelo desenvolvimento de features e manutenção do código",
+						WIPLimits:   team.WIPLimits{},
+					},
+				},
+				PrevCursor: pagination.NewCursor(seedTime, 2, pagination.DirectionPrev),
+			},
+			nil,
+		},
+		{
+			"ListByCursor previous page",
+			resetWithMinimalData,
+			context.Background(),
+			pagination.NewCursor(seedTime, 2, pagination.DirectionPrev),
+			2,
+			false,
+			&team.ListTeams{
+				Limit: 2,
+				Teams: []team.Team{
+					{
+						Model: gorm.Model{
+							ID:        4,
+							CreatedAt: seedTime,
+							UpdatedAt: seedTime,
+						},
+						UUID:        uuid.MustParse("444e4567-e89b-12d3-a456-426614174000"),
+						Name:        "Time de UX/UI",
+						Description: "Equipe responsável por design e experiência do usuário",
+						WIPLimits:   team.WIPLimits{},
+					},
+					{
+						Model: gorm.Model{
+							ID:        3,
+							CreatedAt: seedTime,
+							UpdatedAt: seedTime,
+						},
+						UUID:        uuid.MustParse("333e4567-e89b-12d3-a456-426614174000"),
+						Name:        "Time de QA",
+						Description: "Equipe responsável por testes e garantia de qualidade",
+						WIPLimits:   team.WIPLimits{},
+					},
+				},
+				NextCursor: pagination.NewCursor(seedTime, 3, pagination.DirectionNext),
+			},
+			nil,
+		},
+		{
+			"ListByCursor with context nil",
+			nil,
+			nil,
+			nil,
+			10,
+			true,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ListByCursor(ctx, tt.cursor, tt.limit, tt.withTotal)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ListByCursor() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.ListByCursor() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_UpdateWIPLimits(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	limits := team.WIPLimits{taskEntity.StatusInProgress: 3}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		teamUUID uuid.UUID
+		version  int
+		limits   team.WIPLimits
+		wantErr  error
+	}{
+		{
+			"UpdateWIPLimits with success",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			1,
+			limits,
+			nil,
+		},
+		{
+			"UpdateWIPLimits clearing the limits",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			1,
+			team.WIPLimits{},
+			nil,
+		},
+		{
+			"UpdateWIPLimits team not found",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			1,
+			limits,
+			errs.ErrNotFound,
+		},
+		{
+			"UpdateWIPLimits with a stale version",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			2,
+			limits,
+			&errs.PreconditionFailedError{Message: "team has been modified since version 2"},
+		},
+		{
+			"UpdateWIPLimits with context nil",
+			nil,
+			nil,
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			1,
+			limits,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.UpdateWIPLimits(ctx, tt.teamUUID, tt.version, tt.limits)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.UpdateWIPLimits() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveByUUID(ctx, tt.teamUUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveByUUID() error: %v", err)
+			}
+			if diff := cmp.Diff(got.WIPLimits, tt.limits); diff != "" {
+				t.Errorf("datasource.UpdateWIPLimits() diff: %s", diff)
+			}
+			if got.Version != tt.version+1 {
+				t.Errorf("datasource.UpdateWIPLimits() version = %d, want %d", got.Version, tt.version+1)
+			}
+		})
+	}
+}
+
+func Test_datasource_Update(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		teamUUID uuid.UUID
+		team     *team.Team
+		wantErr  error
+	}{
+		{
+			"Update with success",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			&team.Team{Name: "Time renomeado", Description: "Nova descrição", WIPLimits: team.WIPLimits{taskEntity.StatusInProgress: 3}, Version: 1},
+			nil,
+		},
+		{
+			"Update team not found",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			&team.Team{Name: "Time renomeado", Description: "Nova descrição", WIPLimits: team.WIPLimits{}, Version: 1},
+			errs.ErrNotFound,
+		},
+		{
+			"Update with a stale version",
+			resetWithMinimalData,
+			context.Background(),
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			&team.Team{Name: "Time renomeado", Description: "Nova descrição", WIPLimits: team.WIPLimits{}, Version: 2},
+			&errs.PreconditionFailedError{Message: "team has been modified since version 2"},
+		},
+		{
+			"Update with context nil",
+			nil,
+			nil,
+			uuid.MustParse("111e4567-e89b-12d3-a456-426614174000"),
+			&team.Team{Name: "Time renomeado", Description: "Nova descrição", WIPLimits: team.WIPLimits{}, Version: 1},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			version := tt.team.Version
+			err := p.Update(ctx, tt.teamUUID, tt.team)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.Update() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				if tt.team.Version != version {
+					t.Errorf("datasource.Update() version = %d, want %d", tt.team.Version, version)
+				}
+				return
+			}
+
+			got, err := p.RetrieveByUUID(ctx, tt.teamUUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveByUUID() error: %v", err)
+			}
+			if got.Name != tt.team.Name || got.Description != tt.team.Description {
+				t.Errorf("datasource.Update() = %q, %q, want %q, %q", got.Name, got.Description, tt.team.Name, tt.team.Description)
+			}
+			if diff := cmp.Diff(got.WIPLimits, tt.team.WIPLimits); diff != "" {
+				t.Errorf("datasource.Update() wip limits diff: %s", diff)
+			}
+			if got.Version != version+1 {
+				t.Errorf("datasource.Update() version = %d, want %d", got.Version, version+1)
+			}
+		})
+	}
+}
+
+func Test_datasource_LockWIPLimits(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		teamID  uint
+		want    team.WIPLimits
+		wantErr error
+	}{
+		{
+			"LockWIPLimits of a team without limits",
+			resetWithMinimalData,
+			context.Background(),
+			1,
+			team.WIPLimits{},
+			nil,
+		},
+		{
+			"LockWIPLimits team not found",
+			resetWithMinimalData,
+			context.Background(),
+			999,
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"LockWIPLimits with context nil",
+			nil,
+			nil,
+			1,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.LockWIPLimits(ctx, tt.teamID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.LockWIPLimits() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.LockWIPLimits() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_CreateWIPLimitOverride(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithMinimalData := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		override *team.WIPLimitOverride
+		wantErr  error
+	}{
+		{
+			"CreateWIPLimitOverride with success",
+			resetWithMinimalData,
+			context.Background(),
+			&team.WIPLimitOverride{TeamID: 1, TaskID: 1, Status: taskEntity.StatusInProgress, WIPLimit: 2, TaskCount: 3},
+			nil,
+		},
+		{
+			"CreateWIPLimitOverride with context nil",
+			nil,
+			nil,
+			&team.WIPLimitOverride{TeamID: 1, TaskID: 1, Status: taskEntity.StatusInProgress, WIPLimit: 2, TaskCount: 3},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.CreateWIPLimitOverride(ctx, tt.override)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.CreateWIPLimitOverride() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if tt.override.ID == 0 || tt.override.UUID == uuid.Nil {
+				t.Errorf("datasource.CreateWIPLimitOverride() id = %d, uuid = %s, want both set", tt.override.ID, tt.override.UUID)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"taskmanager/internal/entity/webhook"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Persistent defines the interface for webhook subscription and delivery persistence
+type Persistent interface {
+	CreateSubscription(ctx context.Context, s *webhook.Subscription) error
+	RetrieveSubscriptionByUUID(ctx context.Context, subscriptionUUID uuid.UUID) (*webhook.Subscription, error)
+	ListSubscriptions(ctx context.Context, page, limit int) (*webhook.ListSubscriptions, error)
+	ListSubscriptionsByEvent(ctx context.Context, eventType webhook.EventType) ([]webhook.Subscription, error)
+	DeleteSubscription(ctx context.Context, subscriptionUUID uuid.UUID) error
+	CreateDelivery(ctx context.Context, d *webhook.Delivery) error
+	RetrieveDeliveryByUUID(ctx context.Context, subscriptionID uint, deliveryUUID uuid.UUID) (*webhook.Delivery, error)
+	ListDeliveries(ctx context.Context, subscriptionID uint, page, limit int) (*webhook.ListDeliveries, error)
+	ClaimNextDueDelivery(ctx context.Context, now time.Time) (*webhook.Delivery, error)
+	UpdateDelivery(ctx context.Context, d *webhook.Delivery) error
+}
+
+// datasource implements the persistent interface using PostgreSQL
+type datasource struct{}
+
+var persist Persistent = &datasource{}
+
+// SetPersist sets the persistent implementation
+func SetPersist(p Persistent) {
+	persist = p
+}
+
+// Persist returns the current persistent implementation
+func Persist() Persistent {
+	return persist
+}
+
+// CreateSubscription saves a new webhook subscription to the database
+func (p *datasource) CreateSubscription(ctx context.Context, s *webhook.Subscription) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db.Create(s).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RetrieveSubscriptionByUUID retrieves a webhook subscription by UUID from the database
+func (p *datasource) RetrieveSubscriptionByUUID(ctx context.Context, subscriptionUUID uuid.UUID) (*webhook.Subscription, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var s webhook.Subscription
+	if err := db.Where("uuid = ?", subscriptionUUID).First(&s).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// ListSubscriptions lists webhook subscriptions with pagination from the database
+func (p *datasource) ListSubscriptions(ctx context.Context, page, limit int) (*webhook.ListSubscriptions, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []webhook.Subscription
+	var totalItems int64
+
+	query := db.Model(&webhook.Subscription{})
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("created_at DESC").Order("id DESC").Offset(offset).Limit(limit).Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return &webhook.ListSubscriptions{
+		Limit:         limit,
+		Page:          page,
+		Subscriptions: subscriptions,
+		TotalItems:    int(totalItems),
+	}, nil
+}
+
+// ListSubscriptionsByEvent lists the webhook subscriptions receiving an event type
+func (p *datasource) ListSubscriptionsByEvent(ctx context.Context, eventType webhook.EventType) ([]webhook.Subscription, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contains, err := json.Marshal([]webhook.EventType{eventType})
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriptions []webhook.Subscription
+	if err := db.Where("events @> ?::jsonb", string(contains)).Order("id ASC").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteSubscription performs a soft delete of a webhook subscription
+func (p *datasource) DeleteSubscription(ctx context.Context, subscriptionUUID uuid.UUID) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Where("uuid = ?", subscriptionUUID).Delete(&webhook.Subscription{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// CreateDelivery saves a new webhook delivery to the database
+func (p *datasource) CreateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db.Omit("Subscription").Create(d).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RetrieveDeliveryByUUID retrieves a delivery of a subscription by UUID from the database
+func (p *datasource) RetrieveDeliveryByUUID(ctx context.Context, subscriptionID uint, deliveryUUID uuid.UUID) (*webhook.Delivery, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var d webhook.Delivery
+	if err := db.Where("subscription_id = ? AND uuid = ?", subscriptionID, deliveryUUID).First(&d).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// ListDeliveries lists the deliveries of a subscription with pagination, newest first
+func (p *datasource) ListDeliveries(ctx context.Context, subscriptionID uint, page, limit int) (*webhook.ListDeliveries, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []webhook.Delivery
+	var totalItems int64
+
+	query := db.Model(&webhook.Delivery{}).Where("subscription_id = ?", subscriptionID)
+
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+
+	return &webhook.ListDeliveries{
+		Limit:      limit,
+		Page:       page,
+		Deliveries: deliveries,
+		TotalItems: int(totalItems),
+	}, nil
+}
+
+// ClaimNextDueDelivery locks the pending delivery whose next attempt is the oldest one due at now
+// The subscription is loaded with it and is nil when it was deleted. Deliveries locked by
+// another transaction are skipped; must run inside a transaction
+// Returns ErrNotFound when no delivery is due
+func (p *datasource) ClaimNextDueDelivery(ctx context.Context, now time.Time) (*webhook.Delivery, error) {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var d webhook.Delivery
+	err = db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Preload("Subscription").
+		Where("status = ? AND next_attempt_at <= ?", webhook.DeliveryStatusPending, now).
+		Order("next_attempt_at ASC").
+		Order("id ASC").
+		First(&d).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// UpdateDelivery saves the state and last attempt outcome of a delivery
+func (p *datasource) UpdateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	db, err := database.DBFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&webhook.Delivery{}).
+		Where("uuid = ?", d.UUID).
+		Updates(map[string]any{
+			"status":          d.Status,
+			"attempts":        d.Attempts,
+			"next_attempt_at": d.NextAttemptAt,
+			"last_attempt_at": d.LastAttemptAt,
+			"response_status": d.ResponseStatus,
+			"last_error":      d.LastError,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+//go:build test
+
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"taskmanager/internal/entity/webhook"
+
+	"github.com/google/uuid"
+)
+
+// MockPersistent é um mock da interface Persistent para testes
+type MockPersistent struct {
+	FnCreateSubscription         func(context.Context, *webhook.Subscription) error
+	FnRetrieveSubscriptionByUUID func(context.Context, uuid.UUID) (*webhook.Subscription, error)
+	FnListSubscriptions          func(context.Context, int, int) (*webhook.ListSubscriptions, error)
+	FnListSubscriptionsByEvent   func(context.Context, webhook.EventType) ([]webhook.Subscription, error)
+	FnDeleteSubscription         func(context.Context, uuid.UUID) error
+	FnCreateDelivery             func(context.Context, *webhook.Delivery) error
+	FnRetrieveDeliveryByUUID     func(context.Context, uint, uuid.UUID) (*webhook.Delivery, error)
+	FnListDeliveries             func(context.Context, uint, int, int) (*webhook.ListDeliveries, error)
+	FnClaimNextDueDelivery       func(context.Context, time.Time) (*webhook.Delivery, error)
+	FnUpdateDelivery             func(context.Context, *webhook.Delivery) error
+}
+
+// CreateSubscription implementa o método CreateSubscription da interface Persistent
+func (m *MockPersistent) CreateSubscription(ctx context.Context, s *webhook.Subscription) error {
+	if m.FnCreateSubscription == nil {
+		slog.Error("fnCreateSubscription is nil")
+		return nil
+	}
+	return m.FnCreateSubscription(ctx, s)
+}
+
+// RetrieveSubscriptionByUUID implementa o método RetrieveSubscriptionByUUID da interface Persistent
+func (m *MockPersistent) RetrieveSubscriptionByUUID(ctx context.Context, subscriptionUUID uuid.UUID) (*webhook.Subscription, error) {
+	if m.FnRetrieveSubscriptionByUUID == nil {
+		slog.Error("fnRetrieveSubscriptionByUUID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveSubscriptionByUUID(ctx, subscriptionUUID)
+}
+
+// ListSubscriptions implementa o método ListSubscriptions da interface Persistent
+func (m *MockPersistent) ListSubscriptions(ctx context.Context, page, limit int) (*webhook.ListSubscriptions, error) {
+	if m.FnListSubscriptions == nil {
+		slog.Error("fnListSubscriptions is nil")
+		return nil, nil
+	}
+	return m.FnListSubscriptions(ctx, page, limit)
+}
+
+// ListSubscriptionsByEvent implementa o método ListSubscriptionsByEvent da interface Persistent
+func (m *MockPersistent) ListSubscriptionsByEvent(ctx context.Context, eventType webhook.EventType) ([]webhook.Subscription, error) {
+	if m.FnListSubscriptionsByEvent == nil {
+		slog.Error("fnListSubscriptionsByEvent is nil")
+		return nil, nil
+	}
+	return m.FnListSubscriptionsByEvent(ctx, eventType)
+}
+
+// DeleteSubscription implementa o método DeleteSubscription da interface Persistent
+func (m *MockPersistent) DeleteSubscription(ctx context.Context, subscriptionUUID uuid.UUID) error {
+	if m.FnDeleteSubscription == nil {
+		slog.Error("fnDeleteSubscription is nil")
+		return nil
+	}
+	return m.FnDeleteSubscription(ctx, subscriptionUUID)
+}
+
+// CreateDelivery implementa o método CreateDelivery da interface Persistent
+func (m *MockPersistent) CreateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	if m.FnCreateDelivery == nil {
+		slog.Error("fnCreateDelivery is nil")
+		return nil
+	}
+	return m.FnCreateDelivery(ctx, d)
+}
+
+// RetrieveDeliveryByUUID implementa o método RetrieveDeliveryByUUID da interface Persistent
+func (m *MockPersistent) RetrieveDeliveryByUUID(ctx context.Context, subscriptionID uint, deliveryUUID uuid.UUID) (*webhook.Delivery, error) {
+	if m.FnRetrieveDeliveryByUUID == nil {
+		slog.Error("fnRetrieveDeliveryByUUID is nil")
+		return nil, nil
+	}
+	return m.FnRetrieveDeliveryByUUID(ctx, subscriptionID, deliveryUUID)
+}
+
+// ListDeliveries implementa o método ListDeliveries da interface Persistent
+func (m *MockPersistent) ListDeliveries(ctx context.Context, subscriptionID uint, page, limit int) (*webhook.ListDeliveries, error) {
+	if m.FnListDeliveries == nil {
+		slog.Error("fnListDeliveries is nil")
+		return nil, nil
+	}
+	return m.FnListDeliveries(ctx, subscriptionID, page, limit)
+}
+
+// ClaimNextDueDelivery implementa o método ClaimNextDueDelivery da interface Persistent
+func (m *MockPersistent) ClaimNextDueDelivery(ctx context.Context, now time.Time) (*webhook.Delivery, error) {
+	if m.FnClaimNextDueDelivery == nil {
+		slog.Error("fnClaimNextDueDelivery is nil")
+		return nil, nil
+	}
+	return m.FnClaimNextDueDelivery(ctx, now)
+}
+
+// UpdateDelivery implementa o método UpdateDelivery da interface Persistent
+func (m *MockPersistent) UpdateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	if m.FnUpdateDelivery == nil {
+		slog.Error("fnUpdateDelivery is nil")
+		return nil
+	}
+	return m.FnUpdateDelivery(ctx, d)
+}
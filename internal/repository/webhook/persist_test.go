@@ -0,0 +1,824 @@
+//go:build test
+
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"taskmanager/internal/entity/webhook"
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/database"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	tasksSubscriptionUUID = uuid.MustParse("bbbe4567-e89b-12d3-a456-426614174000")
+	teamsSubscriptionUUID = uuid.MustParse("bbbe4567-e89b-12d3-a456-426614174001")
+)
+
+func Test_datasource_CreateSubscription(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		ctx          context.Context
+		subscription *webhook.Subscription
+		wantErr      error
+	}{
+		{
+			"Create subscription with success",
+			resetWithWebhooks,
+			context.Background(),
+			&webhook.Subscription{
+				URL:    "https://example.com/hooks/new",
+				Secret: "whsec_new_0123456789",
+				Events: []webhook.EventType{webhook.EventTaskCreated},
+			},
+			nil,
+		},
+		{
+			"Create subscription with context nil",
+			resetWithWebhooks,
+			nil,
+			&webhook.Subscription{
+				URL:    "https://example.com/hooks/new",
+				Secret: "whsec_new_0123456789",
+				Events: []webhook.EventType{webhook.EventTaskCreated},
+			},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.CreateSubscription(ctx, tt.subscription)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.CreateSubscription() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if tt.subscription.UUID == (uuid.UUID{}) {
+				t.Errorf("datasource.CreateSubscription() did not generate UUID")
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveSubscriptionByUUID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	tests := []struct {
+		name             string
+		setup            func()
+		ctx              context.Context
+		subscriptionUUID uuid.UUID
+		want             *webhook.Subscription
+		wantErr          error
+	}{
+		{
+			"Retrieve subscription by UUID with success",
+			resetWithWebhooks,
+			context.Background(),
+			teamsSubscriptionUUID,
+			&webhook.Subscription{
+				Model: gorm.Model{
+					ID:        2,
+					CreatedAt: time.Date(2025, 12, 1, 18, 26, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2025, 12, 1, 18, 26, 0, 0, time.UTC),
+				},
+				UUID:   teamsSubscriptionUUID,
+				URL:    "https://example.com/hooks/teams",
+				Secret: "whsec_fedcba9876543210",
+				Events: []webhook.EventType{webhook.EventTeamTaskAssociated},
+			},
+			nil,
+		},
+		{
+			"Retrieve subscription by UUID not found",
+			resetWithWebhooks,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"Retrieve subscription by UUID with context nil",
+			nil,
+			nil,
+			teamsSubscriptionUUID,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.RetrieveSubscriptionByUUID(ctx, tt.subscriptionUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveSubscriptionByUUID() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.RetrieveSubscriptionByUUID() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListSubscriptions(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		ctx            context.Context
+		page           int
+		limit          int
+		wantUUIDs      []uuid.UUID
+		wantTotalItems int
+		wantErr        error
+	}{
+		{
+			"List subscriptions newest first",
+			resetWithWebhooks,
+			context.Background(),
+			1,
+			10,
+			[]uuid.UUID{teamsSubscriptionUUID, tasksSubscriptionUUID},
+			2,
+			nil,
+		},
+		{
+			"List subscriptions second page",
+			resetWithWebhooks,
+			context.Background(),
+			2,
+			1,
+			[]uuid.UUID{tasksSubscriptionUUID},
+			2,
+			nil,
+		},
+		{
+			"List subscriptions with context nil",
+			nil,
+			nil,
+			1,
+			10,
+			nil,
+			0,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ListSubscriptions(ctx, tt.page, tt.limit)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ListSubscriptions() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotUUIDs := make([]uuid.UUID, len(got.Subscriptions))
+			for i, s := range got.Subscriptions {
+				gotUUIDs[i] = s.UUID
+			}
+			if diff := cmp.Diff(gotUUIDs, tt.wantUUIDs); diff != "" {
+				t.Errorf("datasource.ListSubscriptions() uuids diff: %s", diff)
+			}
+			if got.TotalItems != tt.wantTotalItems {
+				t.Errorf("datasource.ListSubscriptions() total items = %d, want %d", got.TotalItems, tt.wantTotalItems)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListSubscriptionsByEvent(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		ctx       context.Context
+		eventType webhook.EventType
+		wantUUIDs []uuid.UUID
+		wantErr   error
+	}{
+		{
+			"List subscriptions of task.status_changed",
+			resetWithWebhooks,
+			context.Background(),
+			webhook.EventTaskStatusChanged,
+			[]uuid.UUID{tasksSubscriptionUUID},
+			nil,
+		},
+		{
+			"List subscriptions of team.task_associated",
+			resetWithWebhooks,
+			context.Background(),
+			webhook.EventTeamTaskAssociated,
+			[]uuid.UUID{teamsSubscriptionUUID},
+			nil,
+		},
+		{
+			"List subscriptions of event without subscribers",
+			resetWithWebhooks,
+			context.Background(),
+			webhook.EventTeamTaskDisassociated,
+			[]uuid.UUID{},
+			nil,
+		},
+		{
+			"List subscriptions by event with context nil",
+			nil,
+			nil,
+			webhook.EventTaskCreated,
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ListSubscriptionsByEvent(ctx, tt.eventType)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ListSubscriptionsByEvent() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotUUIDs := make([]uuid.UUID, len(got))
+			for i, s := range got {
+				gotUUIDs[i] = s.UUID
+			}
+			if diff := cmp.Diff(gotUUIDs, tt.wantUUIDs); diff != "" {
+				t.Errorf("datasource.ListSubscriptionsByEvent() uuids diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_DeleteSubscription(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	tests := []struct {
+		name             string
+		setup            func()
+		ctx              context.Context
+		subscriptionUUID uuid.UUID
+		wantErr          error
+	}{
+		{
+			"Delete subscription with success",
+			resetWithWebhooks,
+			context.Background(),
+			tasksSubscriptionUUID,
+			nil,
+		},
+		{
+			"Delete subscription not found",
+			resetWithWebhooks,
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			errs.ErrNotFound,
+		},
+		{
+			"Delete subscription with context nil",
+			nil,
+			nil,
+			tasksSubscriptionUUID,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.DeleteSubscription(ctx, tt.subscriptionUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.DeleteSubscription() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			_, err = p.RetrieveSubscriptionByUUID(ctx, tt.subscriptionUUID)
+			if diff := assert.CompareErrors(err, errs.ErrNotFound); diff != "" {
+				t.Errorf("datasource.RetrieveSubscriptionByUUID() after delete error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_CreateDelivery(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	nextAttemptAt := time.Date(2025, 12, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		delivery *webhook.Delivery
+		wantErr  error
+	}{
+		{
+			"Create delivery with success",
+			resetWithWebhooks,
+			context.Background(),
+			&webhook.Delivery{
+				SubscriptionID: 1,
+				EventID:        uuid.MustParse("ddde4567-e89b-12d3-a456-426614174009"),
+				EventType:      webhook.EventTaskDeleted,
+				Payload:        `{"id":"ddde4567-e89b-12d3-a456-426614174009","type":"task.deleted"}`,
+				Status:         webhook.DeliveryStatusPending,
+				NextAttemptAt:  &nextAttemptAt,
+			},
+			nil,
+		},
+		{
+			"Create delivery with context nil",
+			resetWithWebhooks,
+			nil,
+			&webhook.Delivery{
+				SubscriptionID: 1,
+				EventID:        uuid.MustParse("ddde4567-e89b-12d3-a456-426614174009"),
+				EventType:      webhook.EventTaskDeleted,
+				Payload:        `{}`,
+				Status:         webhook.DeliveryStatusPending,
+			},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.CreateDelivery(ctx, tt.delivery)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.CreateDelivery() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveDeliveryByUUID(ctx, tt.delivery.SubscriptionID, tt.delivery.UUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveDeliveryByUUID() error: %v", err)
+			}
+			if got.Payload != tt.delivery.Payload || got.EventID != tt.delivery.EventID {
+				t.Errorf("datasource.CreateDelivery() got payload=%q event_id=%v", got.Payload, got.EventID)
+			}
+		})
+	}
+}
+
+func Test_datasource_RetrieveDeliveryByUUID(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	lastAttemptAt := time.Date(2025, 12, 1, 18, 30, 1, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setup          func()
+		ctx            context.Context
+		subscriptionID uint
+		deliveryUUID   uuid.UUID
+		want           *webhook.Delivery
+		wantErr        error
+	}{
+		{
+			"Retrieve delivery by UUID with success",
+			resetWithWebhooks,
+			context.Background(),
+			1,
+			uuid.MustParse("ccce4567-e89b-12d3-a456-426614174000"),
+			&webhook.Delivery{
+				Model: gorm.Model{
+					ID:        1,
+					CreatedAt: time.Date(2025, 12, 1, 18, 30, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2025, 12, 1, 18, 30, 1, 0, time.UTC),
+				},
+				UUID:           uuid.MustParse("ccce4567-e89b-12d3-a456-426614174000"),
+				SubscriptionID: 1,
+				EventID:        uuid.MustParse("ddde4567-e89b-12d3-a456-426614174000"),
+				EventType:      webhook.EventTaskCreated,
+				Payload:        `{"id":"ddde4567-e89b-12d3-a456-426614174000","type":"task.created","occurred_at":"2025-12-01T18:30:00Z","data":{"uuid":"123e4567-e89b-12d3-a456-426614174000","title":"Implementar autenticação"}}`,
+				Status:         webhook.DeliveryStatusSucceeded,
+				Attempts:       1,
+				LastAttemptAt:  &lastAttemptAt,
+				ResponseStatus: 200,
+			},
+			nil,
+		},
+		{
+			"Retrieve delivery of another subscription",
+			resetWithWebhooks,
+			context.Background(),
+			2,
+			uuid.MustParse("ccce4567-e89b-12d3-a456-426614174000"),
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"Retrieve delivery by UUID with context nil",
+			nil,
+			nil,
+			1,
+			uuid.MustParse("ccce4567-e89b-12d3-a456-426614174000"),
+			nil,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.RetrieveDeliveryByUUID(ctx, tt.subscriptionID, tt.deliveryUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.RetrieveDeliveryByUUID() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("datasource.RetrieveDeliveryByUUID() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_datasource_ListDeliveries(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	tests := []struct {
+		name           string
+		setup          func()
+		ctx            context.Context
+		subscriptionID uint
+		page           int
+		limit          int
+		wantUUIDs      []uuid.UUID
+		wantTotalItems int
+		wantErr        error
+	}{
+		{
+			"List deliveries newest first",
+			resetWithWebhooks,
+			context.Background(),
+			1,
+			1,
+			10,
+			[]uuid.UUID{
+				uuid.MustParse("ccce4567-e89b-12d3-a456-426614174002"),
+				uuid.MustParse("ccce4567-e89b-12d3-a456-426614174001"),
+				uuid.MustParse("ccce4567-e89b-12d3-a456-426614174000"),
+			},
+			3,
+			nil,
+		},
+		{
+			"List deliveries second page",
+			resetWithWebhooks,
+			context.Background(),
+			1,
+			2,
+			2,
+			[]uuid.UUID{uuid.MustParse("ccce4567-e89b-12d3-a456-426614174000")},
+			3,
+			nil,
+		},
+		{
+			"List deliveries with context nil",
+			nil,
+			nil,
+			1,
+			1,
+			10,
+			nil,
+			0,
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ListDeliveries(ctx, tt.subscriptionID, tt.page, tt.limit)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ListDeliveries() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			gotUUIDs := make([]uuid.UUID, len(got.Deliveries))
+			for i, d := range got.Deliveries {
+				gotUUIDs[i] = d.UUID
+			}
+			if diff := cmp.Diff(gotUUIDs, tt.wantUUIDs); diff != "" {
+				t.Errorf("datasource.ListDeliveries() uuids diff: %s", diff)
+			}
+			if got.TotalItems != tt.wantTotalItems {
+				t.Errorf("datasource.ListDeliveries() total items = %d, want %d", got.TotalItems, tt.wantTotalItems)
+			}
+		})
+	}
+}
+
+func Test_datasource_ClaimNextDueDelivery(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	tests := []struct {
+		name                 string
+		setup                func()
+		ctx                  context.Context
+		now                  time.Time
+		wantUUID             uuid.UUID
+		wantSubscriptionUUID uuid.UUID
+		wantErr              error
+	}{
+		{
+			"Claim oldest due delivery with its subscription",
+			resetWithWebhooks,
+			context.Background(),
+			time.Date(2025, 12, 1, 18, 50, 0, 0, time.UTC),
+			uuid.MustParse("ccce4567-e89b-12d3-a456-426614174002"),
+			tasksSubscriptionUUID,
+			nil,
+		},
+		{
+			"Claim due delivery before its next attempt",
+			resetWithWebhooks,
+			context.Background(),
+			time.Date(2025, 12, 1, 18, 32, 0, 0, time.UTC),
+			uuid.UUID{},
+			uuid.UUID{},
+			errs.ErrNotFound,
+		},
+		{
+			"Claim due delivery with context nil",
+			nil,
+			nil,
+			time.Date(2025, 12, 1, 18, 50, 0, 0, time.UTC),
+			uuid.UUID{},
+			uuid.UUID{},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			got, err := p.ClaimNextDueDelivery(ctx, tt.now)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.ClaimNextDueDelivery() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.UUID != tt.wantUUID {
+				t.Errorf("datasource.ClaimNextDueDelivery() uuid = %v, want %v", got.UUID, tt.wantUUID)
+			}
+			if got.Subscription == nil || got.Subscription.UUID != tt.wantSubscriptionUUID {
+				t.Errorf("datasource.ClaimNextDueDelivery() subscription = %v, want %v", got.Subscription, tt.wantSubscriptionUUID)
+			}
+		})
+	}
+}
+
+func Test_datasource_UpdateDelivery(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(databaseTest),
+	)
+
+	resetWithWebhooks := func() {
+		dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	}
+
+	lastAttemptAt := time.Date(2025, 12, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		setup    func()
+		ctx      context.Context
+		delivery *webhook.Delivery
+		wantErr  error
+	}{
+		{
+			"Update delivery with success",
+			resetWithWebhooks,
+			context.Background(),
+			&webhook.Delivery{
+				UUID:           uuid.MustParse("ccce4567-e89b-12d3-a456-426614174002"),
+				SubscriptionID: 1,
+				Status:         webhook.DeliveryStatusSucceeded,
+				Attempts:       2,
+				LastAttemptAt:  &lastAttemptAt,
+				ResponseStatus: 204,
+			},
+			nil,
+		},
+		{
+			"Update delivery not found",
+			resetWithWebhooks,
+			context.Background(),
+			&webhook.Delivery{
+				UUID:   uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+				Status: webhook.DeliveryStatusFailed,
+			},
+			errs.ErrNotFound,
+		},
+		{
+			"Update delivery with context nil",
+			nil,
+			nil,
+			&webhook.Delivery{
+				UUID:   uuid.MustParse("ccce4567-e89b-12d3-a456-426614174002"),
+				Status: webhook.DeliveryStatusFailed,
+			},
+			database.ErrContextDatabase,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if tt.ctx != nil {
+				ctx = dbtest.SetupDBWithTransaction(t, tt.ctx, env.DBConnector())
+			}
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			p := &datasource{}
+			err := p.UpdateDelivery(ctx, tt.delivery)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("datasource.UpdateDelivery() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := p.RetrieveDeliveryByUUID(ctx, tt.delivery.SubscriptionID, tt.delivery.UUID)
+			if err != nil {
+				t.Fatalf("datasource.RetrieveDeliveryByUUID() error: %v", err)
+			}
+			if got.Status != tt.delivery.Status || got.Attempts != tt.delivery.Attempts || got.ResponseStatus != tt.delivery.ResponseStatus {
+				t.Errorf("datasource.UpdateDelivery() got status=%v attempts=%d response_status=%d", got.Status, got.Attempts, got.ResponseStatus)
+			}
+			if got.NextAttemptAt != nil || got.LastError != "" {
+				t.Errorf("datasource.UpdateDelivery() got next_attempt_at=%v last_error=%q", got.NextAttemptAt, got.LastError)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package transport
+
+import (
+	"log/slog"
+	"net/http"
+
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/transport/dto"
+	"taskmanager/internal/usecase/analytics"
+)
+
+// RetrieveTeamMetrics retrieves the lead time, cycle time, throughput and canceled ratio of a team
+func RetrieveTeamMetrics(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	period, err := dto.ToMetricsPeriod(
+		httputil.QueryParam(r, "from"),
+		httputil.QueryParam(r, "to"),
+		httputil.QueryParam(r, "bucket"),
+	)
+	if err != nil {
+		slog.Error("error parsing period for retrieve team metrics", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	m, err := analytics.TeamMetrics(r.Context(), teamUUID, period)
+	if err != nil {
+		slog.Error("error retrieving team metrics", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToTeamMetricsResponse(teamUUID, *m))
+}
+
+// RetrieveTeamCFD retrieves the cumulative flow of a team: its daily task counts per status
+func RetrieveTeamCFD(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	period, err := dto.ToCFDPeriod(httputil.QueryParam(r, "from"), httputil.QueryParam(r, "to"))
+	if err != nil {
+		slog.Error("error parsing period for retrieve team cfd", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	c, err := analytics.CFD(r.Context(), teamUUID, period)
+	if err != nil {
+		slog.Error("error retrieving team cfd", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToCFDResponse(teamUUID, *c))
+}
+
+// RetrieveSprintBurndown retrieves the remaining work of a sprint per day against the ideal line
+func RetrieveSprintBurndown(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	sprintUUID := httputil.URLParamUUID(r, "uuid")
+
+	s, b, err := analytics.Burndown(r.Context(), sprintUUID)
+	if err != nil {
+		slog.Error("error retrieving sprint burndown", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToBurndownResponse(*s, *b))
+}
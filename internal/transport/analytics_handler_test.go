@@ -0,0 +1,122 @@
+//go:build test
+
+package transport
+
+import (
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/internal/platform/testing/venomtest"
+)
+
+func TestRetrieveTeamMetrics(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/teams/metrics/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/teams/metrics/bad_request.yml"},
+		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/teams/metrics/validation_errors.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/teams/metrics/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve team metrics "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveTeamCFD(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSnapshots(env) }, "success/teams/cfd/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithSnapshots(env) }, "failure/teams/cfd/bad_request.yml"},
+		{"with validation errors", func() { resetWithSnapshots(env) }, "failure/teams/cfd/validation_errors.yml"},
+		{"with not found", func() { resetWithSnapshots(env) }, "failure/teams/cfd/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve team cfd "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveSprintBurndown(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSnapshots(env) }, "success/sprints/burndown/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithSnapshots(env) }, "failure/sprints/burndown/bad_request.yml"},
+		{"with not found", func() { resetWithSnapshots(env) }, "failure/sprints/burndown/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve sprint burndown "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"taskmanager/internal/platform/database"
+	apperrors "taskmanager/internal/platform/errors"
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/platform/i18n"
+	"taskmanager/internal/transport/dto"
+	"taskmanager/internal/usecase/board"
+)
+
+// boardWriteTimeout bounds every frame written to a board channel
+const boardWriteTimeout = 10 * time.Second
+
+// boardUpgrader accepts board channels from the origins allowed by board.CheckOrigin
+var boardUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return board.CheckOrigin(r.Header.Get("Origin"), r.Host) == nil },
+}
+
+// BoardChannel opens the WebSocket collaboration channel of the team boards
+// The viewer token comes from the token query parameter or the Authorization bearer header, and
+// browsers must be on an allowed origin. Each command runs in its own database transaction and is
+// answered with an ack or error frame
+func BoardChannel(dbConnector database.Connector) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := board.CheckOrigin(r.Header.Get("Origin"), r.Host); err != nil {
+			slog.Warn("Rejecting board channel", "error", err)
+			statusCode, body := httputil.HandleErrorResponse(err, nil)
+			httputil.WriteResponse(w, r, statusCode, body)
+			return
+		}
+
+		session, err := board.Authenticate(boardToken(r), time.Now())
+		if err != nil {
+			slog.Warn("Rejecting board channel", "error", err)
+			statusCode, body := httputil.HandleErrorResponse(err, nil)
+			httputil.WriteResponse(w, r, statusCode, body)
+			return
+		}
+
+		conn, err := boardUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// The upgrader already replied with an HTTP error
+			slog.Error("error upgrading board channel", "error", err)
+			return
+		}
+
+		c := &boardConnection{
+			dbConnector: dbConnector,
+			conn:        conn,
+			session:     session,
+			lang:        i18n.Negotiate(r.Header.Get("Accept-Language")),
+			replies:     make(chan dto.BoardMessageResponse, board.Config.SendBufferSize),
+		}
+		c.serve(r.Context())
+	}
+}
+
+// boardToken reads the viewer token from the request
+func boardToken(r *http.Request) string {
+	if token := httputil.QueryParam(r, "token"); token != "" {
+		return token
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return ""
+	}
+	return token
+}
+
+// boardConnection is a board channel; the writer goroutine is the only one writing frames
+type boardConnection struct {
+	dbConnector database.Connector
+	conn        *websocket.Conn
+	session     *board.Session
+	lang        string // Language of the error messages, from the Accept-Language of the upgrade request
+	replies     chan dto.BoardMessageResponse
+}
+
+// serve reads commands until the connection is closed, then leaves the joined boards
+func (c *boardConnection) serve(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.write(ctx)
+	}()
+
+	c.read(ctx)
+	cancel()
+	<-done
+
+	c.session.Close(context.WithoutCancel(ctx))
+	c.conn.Close()
+}
+
+// read runs the received commands until the connection fails or the writer stops
+// Viewers missing pongs for two ping intervals are disconnected
+func (c *boardConnection) read(ctx context.Context) {
+	readTimeout := 2 * board.Config.PingInterval()
+	c.conn.SetReadLimit(board.Config.MaxMessageBytes)
+	c.conn.SetReadDeadline(time.Now().Add(readTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(readTimeout))
+	})
+
+	for {
+		_, reader, err := c.conn.NextReader()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				slog.Warn("Board channel closed", "connection_id", c.session.Viewer.ConnectionID, "error", err)
+			}
+			return
+		}
+
+		var req dto.BoardCommandRequest
+		if err := json.NewDecoder(reader).Decode(&req); err != nil {
+			slog.Error("error decoding board command", "error", err)
+			if !c.reply(ctx, dto.ToBoardErrorResponse("", &apperrors.BadRequestError{Message: "invalid JSON syntax"}, c.lang)) {
+				return
+			}
+			continue
+		}
+
+		if !c.reply(ctx, c.handle(ctx, req)) {
+			return
+		}
+	}
+}
+
+// handle runs a command in a database transaction, committed when it succeeds
+func (c *boardConnection) handle(ctx context.Context, req dto.BoardCommandRequest) dto.BoardMessageResponse {
+	cmd, err := req.ToBoardCommand()
+	if err != nil {
+		return dto.ToBoardErrorResponse(req.ID, err, c.lang)
+	}
+
+	txCtx, err := c.dbConnector.InjectDBsIntoContext(ctx, database.WithDBTransaction())
+	if err != nil {
+		slog.Error("Failed to inject database transaction", "error", err)
+		return dto.ToBoardErrorResponse(req.ID, err, c.lang)
+	}
+
+	if err := c.session.Handle(txCtx, cmd); err != nil {
+		slog.Error("error handling board command", "type", cmd.Type, "error", err)
+		if err := c.dbConnector.Rollback(txCtx); err != nil {
+			slog.Error("Error on rollback transaction", "error", err)
+		}
+		return dto.ToBoardErrorResponse(req.ID, err, c.lang)
+	}
+
+	if err := c.dbConnector.Commit(txCtx); err != nil {
+		slog.Error("Error on commit transaction", "error", err)
+		return dto.ToBoardErrorResponse(req.ID, err, c.lang)
+	}
+
+	return dto.ToBoardAckResponse(req.ID)
+}
+
+// reply hands a reply to the writer; returns false once the writer stopped
+func (c *boardConnection) reply(ctx context.Context, resp dto.BoardMessageResponse) bool {
+	select {
+	case c.replies <- resp:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// write sends the replies, the session messages and the pings, which also refresh the presence
+// The connection is closed when the session is lost, which unblocks read
+func (c *boardConnection) write(ctx context.Context) {
+	defer c.conn.Close()
+
+	ping := time.NewTicker(board.Config.PingInterval())
+	defer ping.Stop()
+
+	for {
+		var frame any
+		select {
+		case <-ctx.Done():
+			c.writeControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return
+		case <-c.session.Lost():
+			c.writeControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "lagging behind"))
+			return
+		case <-ping.C:
+			if err := c.session.Refresh(ctx); err != nil {
+				slog.Error("error refreshing board presence", "error", err)
+			}
+			if err := c.writeControl(websocket.PingMessage, nil); err != nil {
+				return
+			}
+			continue
+		case resp := <-c.replies:
+			frame = resp
+		case m := <-c.session.Messages():
+			frame = dto.ToBoardMessageResponse(m)
+		}
+
+		c.conn.SetWriteDeadline(time.Now().Add(boardWriteTimeout))
+		if err := c.conn.WriteJSON(frame); err != nil {
+			slog.Error("error writing board channel", "error", err)
+			return
+		}
+	}
+}
+
+// writeControl writes a control frame within the write timeout
+func (c *boardConnection) writeControl(messageType int, data []byte) error {
+	return c.conn.WriteControl(messageType, data, time.Now().Add(boardWriteTimeout))
+}
@@ -0,0 +1,266 @@
+//go:build test
+
+package transport
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/websocket"
+
+	"taskmanager/internal/paths"
+	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/internal/platform/testing/venomtest"
+	"taskmanager/internal/transport/dto"
+	"taskmanager/internal/usecase/board"
+)
+
+func TestBoardChannelUnauthorized(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	resetWithMinimalData(env)
+	env.RunAPISuite(t, "failure/board/channel/unauthorized.yml")
+}
+
+func TestBoardChannelForbiddenOrigin(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	resetWithMinimalData(env)
+	env.RunAPISuite(t, "failure/board/channel/forbidden_origin.yml")
+}
+
+// dialBoard opens a board channel on server for a viewer named name
+func dialBoard(t *testing.T, server *httptest.Server, name string) *websocket.Conn {
+	t.Helper()
+
+	token, err := board.IssueToken(name, time.Now())
+	if err != nil {
+		t.Fatalf("IssueToken() error: %v", err)
+	}
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/board/ws?token=" + token
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("Dial() error: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+// readBoardReply reads frames until the ack or error of the command with the given id
+// The presence and event frames read meanwhile are returned too
+func readBoardReply(t *testing.T, conn *websocket.Conn, id string) (dto.BoardMessageResponse, []dto.BoardMessageResponse) {
+	t.Helper()
+
+	var pushed []dto.BoardMessageResponse
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var frame dto.BoardMessageResponse
+		if err := conn.ReadJSON(&frame); err != nil {
+			t.Fatalf("ReadJSON() error: %v", err)
+		}
+		if (frame.Type == dto.BoardMessageAck || frame.Type == dto.BoardMessageError) && frame.ID == id {
+			return frame, pushed
+		}
+		pushed = append(pushed, frame)
+	}
+}
+
+func TestBoardChannel(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+	)
+
+	const teamUUID = "111e4567-e89b-12d3-a456-426614174000"
+
+	tests := []struct {
+		name      string
+		join      bool
+		command   string
+		wantReply dto.BoardMessageResponse
+	}{
+		{
+			"Move task of the joined board",
+			true,
+			`{"id":"c1","type":"move_task","team_uuid":"` + teamUUID + `","task_uuid":"123e4567-e89b-12d3-a456-426614174004","status":"in_progress"}`,
+			dto.BoardMessageResponse{Type: "ack", ID: "c1"},
+		},
+		{
+			"Associate task to the joined board",
+			true,
+			`{"id":"c1","type":"associate_task","team_uuid":"` + teamUUID + `","task_uuid":"123e4567-e89b-12d3-a456-426614174000"}`,
+			dto.BoardMessageResponse{Type: "ack", ID: "c1"},
+		},
+		{
+			"Move task of another team",
+			true,
+			`{"id":"c1","type":"move_task","team_uuid":"` + teamUUID + `","task_uuid":"123e4567-e89b-12d3-a456-426614174002","status":"in_progress"}`,
+			dto.BoardMessageResponse{Type: "error", ID: "c1", Errors: []apperrors.ValidationError{
+				{Field: "task_uuid", Type: apperrors.TypeState, Code: apperrors.CodeTaskNotOnBoard, Message: "task does not belong to the team"},
+			}},
+		},
+		{
+			"Move task of a board not joined",
+			false,
+			`{"id":"c1","type":"move_task","team_uuid":"` + teamUUID + `","task_uuid":"123e4567-e89b-12d3-a456-426614174004","status":"in_progress"}`,
+			dto.BoardMessageResponse{Type: "error", ID: "c1", Errors: []apperrors.ValidationError{
+				{Field: "team_uuid", Type: apperrors.TypeState, Code: apperrors.CodeBoardNotJoined, Message: "team board not joined"},
+			}},
+		},
+		{
+			"Join unknown team",
+			false,
+			`{"id":"c1","type":"join","team_uuid":"999e4567-e89b-12d3-a456-426614174000"}`,
+			dto.BoardMessageResponse{Type: "error", ID: "c1", Errors: []apperrors.ValidationError{
+				{Field: "team_uuid", Type: apperrors.TypeNotFound, Code: apperrors.CodeTeamNotFound, Message: "team not found"},
+			}},
+		},
+		{
+			"Invalid team UUID",
+			false,
+			`{"id":"c1","type":"join","team_uuid":"invalid-uuid-format"}`,
+			dto.BoardMessageResponse{Type: "error", ID: "c1", Errors: []apperrors.ValidationError{
+				{Field: "team_uuid", Type: apperrors.TypeInvalid, Code: apperrors.CodeBadRequest, Message: "invalid uuid format", Params: map[string]any{"reason": "invalid uuid format"}},
+			}},
+		},
+		{
+			"Unknown command type",
+			false,
+			`{"id":"c1","type":"delete_board","team_uuid":"` + teamUUID + `"}`,
+			dto.BoardMessageResponse{Type: "error", ID: "c1", Errors: []apperrors.ValidationError{
+				{Field: "type", Type: apperrors.TypeInvalid, Code: apperrors.CodeInvalidCommand, Message: "invalid command type"},
+			}},
+		},
+		{
+			"Invalid JSON",
+			false,
+			`{"id":`,
+			dto.BoardMessageResponse{Type: "error", Errors: []apperrors.ValidationError{
+				{Type: apperrors.TypeInvalid, Code: apperrors.CodeBadRequest, Message: "invalid JSON syntax", Params: map[string]any{"reason": "invalid JSON syntax"}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetWithMinimalData(env)
+			server := httptest.NewServer(Routes(dbConnector))
+			defer server.Close()
+
+			conn := dialBoard(t, server, "Ada")
+			if tt.join {
+				if err := conn.WriteMessage(websocket.TextMessage, []byte(`{"id":"join","type":"join","team_uuid":"`+teamUUID+`"}`)); err != nil {
+					t.Fatalf("WriteMessage() error: %v", err)
+				}
+				if reply, _ := readBoardReply(t, conn, "join"); reply.Type != dto.BoardMessageAck {
+					t.Fatalf("join reply = %+v, want ack", reply)
+				}
+			}
+
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(tt.command)); err != nil {
+				t.Fatalf("WriteMessage() error: %v", err)
+			}
+
+			reply, _ := readBoardReply(t, conn, tt.wantReply.ID)
+			if diff := cmp.Diff(tt.wantReply, reply); diff != "" {
+				t.Errorf("BoardChannel() reply mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBoardChannelPresence(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+	)
+
+	resetWithMinimalData(env)
+	server := httptest.NewServer(Routes(dbConnector))
+	defer server.Close()
+
+	const (
+		join  = `{"id":"join","type":"join","team_uuid":"111e4567-e89b-12d3-a456-426614174000"}`
+		leave = `{"id":"leave","type":"leave","team_uuid":"111e4567-e89b-12d3-a456-426614174000"}`
+	)
+
+	ada := dialBoard(t, server, "Ada")
+	grace := dialBoard(t, server, "Grace")
+
+	// Ada is told about every presence change, including the ones pushed while its own join was answered
+	var got [][]string
+	collect := func(frames []dto.BoardMessageResponse) {
+		for _, frame := range frames {
+			if frame.Type == "presence" {
+				got = append(got, frame.Viewers)
+			}
+		}
+	}
+
+	for _, step := range []struct {
+		conn    *websocket.Conn
+		command string
+		id      string
+	}{
+		{ada, join, "join"},
+		{grace, join, "join"},
+		{grace, leave, "leave"},
+	} {
+		if err := step.conn.WriteMessage(websocket.TextMessage, []byte(step.command)); err != nil {
+			t.Fatalf("WriteMessage() error: %v", err)
+		}
+		_, pushed := readBoardReply(t, step.conn, step.id)
+		if step.conn == ada {
+			collect(pushed)
+		}
+	}
+
+	ada.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for len(got) < 3 {
+		var frame dto.BoardMessageResponse
+		if err := ada.ReadJSON(&frame); err != nil {
+			t.Fatalf("ReadJSON() error: %v, presence so far %v", err, got)
+		}
+		collect([]dto.BoardMessageResponse{frame})
+	}
+
+	want := [][]string{{"Ada"}, {"Ada", "Grace"}, {"Ada"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("BoardChannel() presence mismatch (-want +got):\n%s", diff)
+	}
+}
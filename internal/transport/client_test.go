@@ -0,0 +1,196 @@
+//go:build test
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/pkg/client"
+)
+
+func TestClientTasks(t *testing.T) {
+	env := setupClientEnv(t)
+	resetWithMinimalData(env)
+	c := client.New(env.BaseURL())
+	ctx := context.Background()
+
+	created, err := c.CreateTask(ctx, client.CreateTaskRequest{Title: "Client task", Description: "Created by the client"}, client.WithIdempotencyKey("client-task"))
+	if err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+	replayed, err := c.CreateTask(ctx, client.CreateTaskRequest{Title: "Client task", Description: "Created by the client"}, client.WithIdempotencyKey("client-task"))
+	if err != nil || replayed.UUID != created.UUID {
+		t.Fatalf("CreateTask() replay = %v, %v, want task %s", replayed, err, created.UUID)
+	}
+
+	var etag string
+	retrieved, err := c.RetrieveTask(ctx, created.UUID, client.CaptureETag(&etag))
+	if err != nil || retrieved.Title != "Client task" || etag == "" {
+		t.Fatalf("RetrieveTask() = %v, ETag %q, error %v", retrieved, etag, err)
+	}
+
+	updated, err := c.UpdateTask(ctx, created.UUID, client.UpdateTaskRequest{Title: "Updated", Description: "Updated by the client"}, client.WithIfMatch(etag))
+	if err != nil || updated.Title != "Updated" {
+		t.Fatalf("UpdateTask() = %v, error %v", updated, err)
+	}
+	if _, err := c.UpdateTask(ctx, created.UUID, client.UpdateTaskRequest{Title: "Stale", Description: "Stale"}, client.WithIfMatch(etag)); !errors.Is(err, client.ErrPreconditionFailed) {
+		t.Errorf("UpdateTask() with a stale ETag error = %v, want ErrPreconditionFailed", err)
+	}
+
+	patched, err := c.MergePatchTask(ctx, created.UUID, map[string]any{"description": "Patched"})
+	if err != nil || patched.Description != "Patched" {
+		t.Fatalf("MergePatchTask() = %v, error %v", patched, err)
+	}
+
+	if err := c.UpdateTaskStatus(ctx, created.UUID, client.StatusUpdateRequest{Status: "in_progress"}); err != nil {
+		t.Fatalf("UpdateTaskStatus() error = %v", err)
+	}
+	if retrieved, err := c.RetrieveTask(ctx, created.UUID); err != nil || retrieved.Status != "in_progress" {
+		t.Errorf("RetrieveTask() after UpdateTaskStatus = %v, error %v, want in_progress", retrieved, err)
+	}
+
+	page, err := c.ListTasks(ctx, client.TaskListParams{Status: "in_progress", Limit: 1}, 1)
+	if err != nil || page.ItemsPerPage != 1 || len(page.Items) != 1 || page.Items[0].Status != "in_progress" {
+		t.Errorf("ListTasks() = %v, error %v, want one in_progress task", page, err)
+	}
+	first, err := c.ListTasksByCursor(ctx, client.TaskListParams{Limit: 2}, "")
+	if err != nil || len(first.Items) != 2 || first.NextCursor == nil {
+		t.Fatalf("ListTasksByCursor() = %v, error %v, want 2 tasks and a next cursor", first, err)
+	}
+	second, err := c.ListTasksByCursor(ctx, client.TaskListParams{Limit: 2}, *first.NextCursor)
+	if err != nil || len(second.Items) == 0 || second.Items[0].UUID == first.Items[0].UUID {
+		t.Errorf("ListTasksByCursor() next page = %v, error %v", second, err)
+	}
+
+	export, err := c.ExportTasks(ctx, client.ExportFormatNDJSON, "")
+	if err != nil {
+		t.Fatalf("ExportTasks() error = %v", err)
+	}
+	body, _ := io.ReadAll(export)
+	export.Close()
+	if !strings.Contains(string(body), created.UUID.String()) {
+		t.Errorf("ExportTasks() = %s, want task %s", body, created.UUID)
+	}
+
+	if err := c.DeleteTask(ctx, created.UUID); err != nil {
+		t.Fatalf("DeleteTask() error = %v", err)
+	}
+	if _, err := c.RetrieveTask(ctx, created.UUID); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("RetrieveTask() after DeleteTask error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestClientTeams(t *testing.T) {
+	env := setupClientEnv(t)
+	resetWithMinimalData(env)
+	c := client.New(env.BaseURL())
+	ctx := context.Background()
+	taskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	team, err := c.CreateTeam(ctx, client.CreateTeamRequest{Name: "Client team", Description: "Created by the client"})
+	if err != nil {
+		t.Fatalf("CreateTeam() error = %v", err)
+	}
+
+	if err := c.AssociateTaskToTeam(ctx, team.UUID, taskUUID); err != nil {
+		t.Fatalf("AssociateTaskToTeam() error = %v", err)
+	}
+	retrieved, err := c.RetrieveTeam(ctx, team.UUID)
+	if err != nil || len(retrieved.Tasks) != 1 || retrieved.Tasks[0].UUID != taskUUID {
+		t.Fatalf("RetrieveTeam() = %v, error %v, want task %s", retrieved, err, taskUUID)
+	}
+
+	board, err := c.RetrieveTeamBoard(ctx, team.UUID, client.TaskListParams{}, "")
+	if err != nil || len(board.Columns) == 0 {
+		t.Errorf("RetrieveTeamBoard() = %v, error %v", board, err)
+	}
+
+	limits, err := c.UpdateTeamWIPLimits(ctx, team.UUID, client.WIPLimitsRequest{WIPLimits: map[string]int{"in_progress": 3}})
+	if err != nil || limits.WIPLimits["in_progress"] != 3 {
+		t.Errorf("UpdateTeamWIPLimits() = %v, error %v", limits, err)
+	}
+
+	patched, err := c.MergePatchTeam(ctx, team.UUID, map[string]any{"name": "Patched team"})
+	if err != nil || patched.Name != "Patched team" {
+		t.Errorf("MergePatchTeam() = %v, error %v", patched, err)
+	}
+
+	token, err := c.RotateTeamCalendarToken(ctx, team.UUID)
+	if err != nil {
+		t.Fatalf("RotateTeamCalendarToken() error = %v", err)
+	}
+	feed, err := c.RetrieveTeamCalendar(ctx, team.UUID, token.Token)
+	if err != nil {
+		t.Fatalf("RetrieveTeamCalendar() error = %v", err)
+	}
+	body, _ := io.ReadAll(feed)
+	feed.Close()
+	if !strings.HasPrefix(string(body), "BEGIN:VCALENDAR") {
+		t.Errorf("RetrieveTeamCalendar() = %s, want an iCalendar feed", body)
+	}
+
+	if err := c.DisassociateTaskFromTeam(ctx, team.UUID, taskUUID); err != nil {
+		t.Fatalf("DisassociateTaskFromTeam() error = %v", err)
+	}
+	if retrieved, err := c.RetrieveTeam(ctx, team.UUID); err != nil || len(retrieved.Tasks) != 0 {
+		t.Errorf("RetrieveTeam() after DisassociateTaskFromTeam = %v, error %v, want no tasks", retrieved, err)
+	}
+
+	page, err := c.ListTeams(ctx, 1, 2)
+	if err != nil || len(page.Items) != 2 {
+		t.Errorf("ListTeams() = %v, error %v, want 2 teams", page, err)
+	}
+	first, err := c.ListTeamsByCursor(ctx, "", 2)
+	if err != nil || len(first.Items) != 2 || first.NextCursor == nil {
+		t.Errorf("ListTeamsByCursor() = %v, error %v, want 2 teams and a next cursor", first, err)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	env := setupClientEnv(t)
+	resetWithMinimalData(env)
+	c := client.New(env.BaseURL(), client.WithLanguage("pt-BR"))
+	ctx := context.Background()
+
+	_, err := c.CreateTask(ctx, client.CreateTaskRequest{Description: "Without title"})
+	var validErr *client.ValidationErrors
+	if !errors.As(err, &validErr) || len(validErr.Errors) == 0 || validErr.Errors[0].Field != "title" || validErr.Errors[0].Code != "REQUIRED" {
+		t.Errorf("CreateTask() without title error = %#v, want ValidationErrors with REQUIRED on title", err)
+	}
+
+	_, err = c.ListTasksByCursor(ctx, client.TaskListParams{}, "not-a-valid-cursor")
+	var badReqErr *client.BadRequestError
+	if !errors.As(err, &badReqErr) || badReqErr.Message != "invalid cursor" || badReqErr.Field != "cursor" {
+		t.Errorf("ListTasksByCursor() with an invalid cursor error = %#v, want BadRequestError on cursor", err)
+	}
+
+	_, err = c.ListTasks(ctx, client.TaskListParams{Status: "unknown"}, 1)
+	if !errors.As(err, &badReqErr) || badReqErr.Field != "status" {
+		t.Errorf("ListTasks() with an invalid status error = %#v, want BadRequestError on status", err)
+	}
+
+	if _, err := c.RetrieveTeam(ctx, uuid.New()); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("RetrieveTeam() of an unknown team error = %v, want ErrNotFound", err)
+	}
+}
+
+// setupClientEnv returns an environment serving Routes for the API client
+func setupClientEnv(t *testing.T) *testenv.Environment {
+	return testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+	)
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"taskmanager/internal/entity/analytics"
+	"taskmanager/internal/platform/errors"
+)
+
+// ToMetricsPeriod converts the from, to and bucket query parameters to the period of team metrics
+// from and to are calendar dates (YYYY-MM-DD); empty values are left zero to use the defaults
+func ToMetricsPeriod(from, to, bucket string) (analytics.Period, error) {
+	period, err := ToCFDPeriod(from, to)
+	if err != nil {
+		return analytics.Period{}, err
+	}
+
+	b := analytics.Bucket(bucket)
+	if bucket != "" && !b.IsValid() {
+		return analytics.Period{}, &errors.BadRequestError{
+			Message: "invalid bucket value",
+			Field:   "bucket",
+		}
+	}
+
+	period.Bucket = b
+	return period, nil
+}
+
+// ToCFDPeriod converts the from and to query parameters to the period of a cumulative flow
+// from and to are calendar dates (YYYY-MM-DD); empty values are left zero to use the defaults
+func ToCFDPeriod(from, to string) (analytics.Period, error) {
+	fromDate, err := parseDate(from, "from")
+	if err != nil {
+		return analytics.Period{}, err
+	}
+
+	toDate, err := parseDate(to, "to")
+	if err != nil {
+		return analytics.Period{}, err
+	}
+
+	return analytics.Period{From: fromDate, To: toDate}, nil
+}
@@ -0,0 +1,164 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/analytics"
+	"taskmanager/internal/entity/sprint"
+)
+
+// PercentilesResponse represents the percentiles of durations in hours, null without tasks
+type PercentilesResponse struct {
+	Count int      `json:"count"`
+	P50   *float64 `json:"p50"`
+	P85   *float64 `json:"p85"`
+	P95   *float64 `json:"p95"`
+}
+
+// ThroughputResponse represents the tasks done within a bucket
+type ThroughputResponse struct {
+	Start string `json:"start"`
+	Done  int    `json:"done"`
+}
+
+// TeamMetricsResponse represents the metrics of a team over a period
+type TeamMetricsResponse struct {
+	TeamUUID       uuid.UUID            `json:"team_uuid"`
+	From           string               `json:"from"`
+	To             string               `json:"to"`
+	Bucket         analytics.Bucket     `json:"bucket"`
+	LeadTimeHours  PercentilesResponse  `json:"lead_time_hours"`
+	CycleTimeHours PercentilesResponse  `json:"cycle_time_hours"`
+	Throughput     []ThroughputResponse `json:"throughput"`
+	Done           int                  `json:"done"`
+	Canceled       int                  `json:"canceled"`
+	CanceledRatio  *float64             `json:"canceled_ratio"`
+}
+
+// ToTeamMetricsResponse converts the metrics of a team to TeamMetricsResponse
+func ToTeamMetricsResponse(teamUUID uuid.UUID, m analytics.TeamMetrics) TeamMetricsResponse {
+	throughput := make([]ThroughputResponse, len(m.Throughput))
+	for i, t := range m.Throughput {
+		throughput[i] = ThroughputResponse{
+			Start: t.Start.Format(analytics.DateLayout),
+			Done:  t.Done,
+		}
+	}
+
+	return TeamMetricsResponse{
+		TeamUUID:       teamUUID,
+		From:           m.Period.From.Format(analytics.DateLayout),
+		To:             m.Period.To.Format(analytics.DateLayout),
+		Bucket:         m.Period.Bucket,
+		LeadTimeHours:  toPercentilesResponse(m.LeadTime),
+		CycleTimeHours: toPercentilesResponse(m.CycleTime),
+		Throughput:     throughput,
+		Done:           m.Done,
+		Canceled:       m.Canceled,
+		CanceledRatio:  m.CanceledRatio(),
+	}
+}
+
+// toPercentilesResponse converts analytics.Percentiles to PercentilesResponse
+func toPercentilesResponse(p analytics.Percentiles) PercentilesResponse {
+	return PercentilesResponse{
+		Count: p.Count,
+		P50:   p.P50,
+		P85:   p.P85,
+		P95:   p.P95,
+	}
+}
+
+// StatusSnapshotResponse represents the task counts per status of a team at the end of a day
+type StatusSnapshotResponse struct {
+	Date       string `json:"date"`
+	ToDo       int    `json:"to_do"`
+	InProgress int    `json:"in_progress"`
+	Done       int    `json:"done"`
+	Canceled   int    `json:"canceled"`
+}
+
+// CFDResponse represents the cumulative flow of a team over a period
+type CFDResponse struct {
+	TeamUUID uuid.UUID                `json:"team_uuid"`
+	From     string                   `json:"from"`
+	To       string                   `json:"to"`
+	Days     []StatusSnapshotResponse `json:"days"`
+}
+
+// ToCFDResponse converts the cumulative flow of a team to CFDResponse
+func ToCFDResponse(teamUUID uuid.UUID, c analytics.CFD) CFDResponse {
+	days := make([]StatusSnapshotResponse, len(c.Days))
+	for i, d := range c.Days {
+		days[i] = StatusSnapshotResponse{
+			Date:       d.Date.Format(analytics.DateLayout),
+			ToDo:       d.ToDo,
+			InProgress: d.InProgress,
+			Done:       d.Done,
+			Canceled:   d.Canceled,
+		}
+	}
+
+	return CFDResponse{
+		TeamUUID: teamUUID,
+		From:     c.Period.From.Format(analytics.DateLayout),
+		To:       c.Period.To.Format(analytics.DateLayout),
+		Days:     days,
+	}
+}
+
+// IdealPointResponse represents the remaining points of the ideal burndown at the end of a day
+type IdealPointResponse struct {
+	Date            string  `json:"date"`
+	RemainingPoints float64 `json:"remaining_points"`
+}
+
+// SprintSnapshotResponse represents the scope and remaining work of a sprint at the end of a day
+type SprintSnapshotResponse struct {
+	Date            string `json:"date"`
+	TotalTasks      int    `json:"total_tasks"`
+	RemainingTasks  int    `json:"remaining_tasks"`
+	TotalPoints     int    `json:"total_points"`
+	RemainingPoints int    `json:"remaining_points"`
+}
+
+// BurndownResponse represents the burndown of a sprint
+type BurndownResponse struct {
+	SprintUUID      uuid.UUID                `json:"sprint_uuid"`
+	StartDate       string                   `json:"start_date"`
+	EndDate         string                   `json:"end_date"`
+	CommittedPoints int                      `json:"committed_points"`
+	Ideal           []IdealPointResponse     `json:"ideal"`
+	Actual          []SprintSnapshotResponse `json:"actual"`
+}
+
+// ToBurndownResponse converts the burndown of a sprint to BurndownResponse
+func ToBurndownResponse(s sprint.Sprint, b analytics.Burndown) BurndownResponse {
+	ideal := make([]IdealPointResponse, len(b.Ideal))
+	for i, p := range b.Ideal {
+		ideal[i] = IdealPointResponse{
+			Date:            p.Date.Format(analytics.DateLayout),
+			RemainingPoints: p.RemainingPoints,
+		}
+	}
+
+	actual := make([]SprintSnapshotResponse, len(b.Actual))
+	for i, a := range b.Actual {
+		actual[i] = SprintSnapshotResponse{
+			Date:            a.Date.Format(analytics.DateLayout),
+			TotalTasks:      a.TotalTasks,
+			RemainingTasks:  a.RemainingTasks,
+			TotalPoints:     a.TotalPoints,
+			RemainingPoints: a.RemainingPoints,
+		}
+	}
+
+	return BurndownResponse{
+		SprintUUID:      s.UUID,
+		StartDate:       s.StartDate.Format(sprint.DateLayout),
+		EndDate:         s.EndDate.Format(sprint.DateLayout),
+		CommittedPoints: b.CommittedPoints,
+		Ideal:           ideal,
+		Actual:          actual,
+	}
+}
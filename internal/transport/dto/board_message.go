@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+
+	boardEntity "taskmanager/internal/entity/board"
+	taskEntity "taskmanager/internal/entity/task"
+	apperrors "taskmanager/internal/platform/errors"
+)
+
+// Board channel replies to commands
+const (
+	BoardMessageAck   = "ack"
+	BoardMessageError = "error"
+)
+
+// BoardCommandRequest represents a command frame sent over the board channel
+// id is echoed in the ack or error reply; empty UUIDs are reported by the command validation
+type BoardCommandRequest struct {
+	ID               string `json:"id"`
+	Type             string `json:"type"`
+	TeamUUID         string `json:"team_uuid"`
+	TaskUUID         string `json:"task_uuid"`
+	Status           string `json:"status"`
+	OverrideWIPLimit bool   `json:"override_wip_limit"`
+}
+
+// BoardMessageResponse represents a frame pushed over the board channel
+// Presence frames carry viewers, event frames the event, and error frames the errors of a command
+type BoardMessageResponse struct {
+	Type     string                      `json:"type"`
+	ID       string                      `json:"id,omitempty"`
+	TeamUUID *uuid.UUID                  `json:"team_uuid,omitempty"`
+	Viewers  []string                    `json:"viewers,omitempty"`
+	Event    json.RawMessage             `json:"event,omitempty"`
+	Errors   []apperrors.ValidationError `json:"errors,omitempty"`
+}
+
+// ToBoardCommand converts BoardCommandRequest to board.Command
+// Returns BadRequestError for malformed UUIDs
+func (r *BoardCommandRequest) ToBoardCommand() (boardEntity.Command, error) {
+	cmd := boardEntity.Command{
+		ID:               r.ID,
+		Type:             boardEntity.CommandType(r.Type),
+		Status:           taskEntity.TaskStatus(r.Status),
+		OverrideWIPLimit: r.OverrideWIPLimit,
+	}
+
+	var err error
+	if r.TeamUUID != "" {
+		if cmd.TeamUUID, err = uuid.Parse(r.TeamUUID); err != nil {
+			return cmd, &apperrors.BadRequestError{Message: "invalid uuid format", Field: "team_uuid"}
+		}
+	}
+
+	if r.TaskUUID != "" {
+		if cmd.TaskUUID, err = uuid.Parse(r.TaskUUID); err != nil {
+			return cmd, &apperrors.BadRequestError{Message: "invalid uuid format", Field: "task_uuid"}
+		}
+	}
+
+	return cmd, nil
+}
+
+// ToBoardMessageResponse converts board.Message to BoardMessageResponse
+func ToBoardMessageResponse(m boardEntity.Message) BoardMessageResponse {
+	teamUUID := m.TeamUUID
+	resp := BoardMessageResponse{
+		Type:     string(m.Type),
+		TeamUUID: &teamUUID,
+		Viewers:  m.Viewers,
+	}
+
+	if m.Type == boardEntity.MessagePresence && resp.Viewers == nil {
+		resp.Viewers = []string{}
+	}
+
+	if m.Event != nil {
+		resp.Event = json.RawMessage(m.Event.Payload)
+	}
+
+	return resp
+}
+
+// ToBoardAckResponse returns the reply to a command run successfully
+func ToBoardAckResponse(id string) BoardMessageResponse {
+	return BoardMessageResponse{Type: BoardMessageAck, ID: id}
+}
+
+// ToBoardErrorResponse returns the reply to a failed command, with errors in the validation errors shape
+// and their messages in lang
+func ToBoardErrorResponse(id string, err error, lang string) BoardMessageResponse {
+	resp := BoardMessageResponse{Type: BoardMessageError, ID: id}
+
+	var validErr *apperrors.ValidationErrors
+	var badReqErr *apperrors.BadRequestError
+	switch {
+	case errors.As(err, &validErr):
+	case errors.As(err, &badReqErr):
+		validErr = &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{apperrors.NewBadRequestValidationError(badReqErr)}}
+	case errors.Is(err, apperrors.ErrNotFound):
+		validErr = &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{apperrors.NewValidationError("", apperrors.CodeNotFound, nil)}}
+	default:
+		validErr = &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{apperrors.NewValidationError("", apperrors.CodeInternalError, nil)}}
+	}
+
+	resp.Errors = validErr.Localize(lang).Errors
+	return resp
+}
@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	taskEntity "taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/errors"
+)
+
+// BulkTaskRequest represents the payload for bulk task operations
+// Mode defaults to all_or_nothing when empty
+type BulkTaskRequest struct {
+	Mode       string                 `json:"mode"`
+	Operations []BulkOperationRequest `json:"operations"`
+}
+
+// BulkOperationRequest represents a single operation of a bulk request
+// status and override_wip_limit are used by update_status, team_uuid by associate_team and disassociate_team,
+// and title/description/estimate by update (absent or null fields are kept)
+type BulkOperationRequest struct {
+	Op               string  `json:"op"`
+	TaskUUID         string  `json:"task_uuid"`
+	Status           string  `json:"status"`
+	OverrideWIPLimit bool    `json:"override_wip_limit"`
+	TeamUUID         string  `json:"team_uuid"`
+	Title            *string `json:"title"`
+	Description      *string `json:"description"`
+	Estimate         *int    `json:"estimate"`
+}
+
+// ToBulkMode converts the request mode to task.BulkMode
+func (r *BulkTaskRequest) ToBulkMode() taskEntity.BulkMode {
+	if r.Mode == "" {
+		return taskEntity.BulkModeAllOrNothing
+	}
+	return taskEntity.BulkMode(r.Mode)
+}
+
+// ToBulkOperations converts the request operations to task.BulkOperation
+// Returns BadRequestError for unknown operation types or malformed UUIDs
+func (r *BulkTaskRequest) ToBulkOperations() ([]taskEntity.BulkOperation, error) {
+	operations := make([]taskEntity.BulkOperation, len(r.Operations))
+	for i, req := range r.Operations {
+		op, err := req.toBulkOperation()
+		if err != nil {
+			err.Field = fmt.Sprintf("operations[%d].%s", i, err.Field)
+			return nil, err
+		}
+		operations[i] = op
+	}
+	return operations, nil
+}
+
+// toBulkOperation converts a single operation request to task.BulkOperation
+func (r *BulkOperationRequest) toBulkOperation() (taskEntity.BulkOperation, *errors.BadRequestError) {
+	op := taskEntity.BulkOperation{Type: taskEntity.BulkOperationType(r.Op)}
+
+	taskUUID, err := uuid.Parse(r.TaskUUID)
+	if err != nil {
+		return op, &errors.BadRequestError{Message: "invalid task_uuid format", Field: "task_uuid"}
+	}
+	op.TaskUUID = taskUUID
+
+	switch op.Type {
+	case taskEntity.BulkOpUpdateStatus:
+		op.Status = taskEntity.TaskStatus(r.Status)
+		op.OverrideWIPLimit = r.OverrideWIPLimit
+	case taskEntity.BulkOpAssociateTeam, taskEntity.BulkOpDisassociateTeam:
+		teamUUID, err := uuid.Parse(r.TeamUUID)
+		if err != nil {
+			return op, &errors.BadRequestError{Message: "invalid team_uuid format", Field: "team_uuid"}
+		}
+		op.TeamUUID = teamUUID
+	case taskEntity.BulkOpUpdate:
+		op.Updates = map[string]any{}
+		if r.Title != nil {
+			op.Updates["title"] = *r.Title
+		}
+		if r.Description != nil {
+			op.Updates["description"] = *r.Description
+		}
+		if r.Estimate != nil {
+			op.Updates["estimate"] = r.Estimate
+		}
+	case taskEntity.BulkOpDelete:
+	default:
+		return op, &errors.BadRequestError{Message: "invalid op value", Field: "op"}
+	}
+
+	return op, nil
+}
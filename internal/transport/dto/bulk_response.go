@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	taskEntity "taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/errors"
+)
+
+// BulkTaskResponse represents the API response for bulk task operations
+type BulkTaskResponse struct {
+	Mode      string                    `json:"mode"`
+	Succeeded int                       `json:"succeeded"`
+	Failed    int                       `json:"failed"`
+	Results   []BulkOperationResultItem `json:"results"`
+}
+
+// BulkOperationResultItem represents the outcome of a single bulk operation
+type BulkOperationResultItem struct {
+	Index    int                      `json:"index"`
+	Op       string                   `json:"op"`
+	TaskUUID uuid.UUID                `json:"task_uuid"`
+	Success  bool                     `json:"success"`
+	Errors   []errors.ValidationError `json:"errors,omitempty"`
+}
+
+// ToBulkTaskResponse converts bulk results to BulkTaskResponse
+func ToBulkTaskResponse(mode taskEntity.BulkMode, results []taskEntity.BulkResult) BulkTaskResponse {
+	resp := BulkTaskResponse{
+		Mode:    string(mode),
+		Results: make([]BulkOperationResultItem, len(results)),
+	}
+	for i, result := range results {
+		item := BulkOperationResultItem{
+			Index:    result.Index,
+			Op:       string(result.Operation.Type),
+			TaskUUID: result.Operation.TaskUUID,
+			Success:  result.Errors == nil,
+		}
+		if result.Errors != nil {
+			item.Errors = result.Errors.Errors
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+		resp.Results[i] = item
+	}
+	return resp
+}
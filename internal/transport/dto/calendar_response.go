@@ -0,0 +1,111 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/entity/team"
+	"taskmanager/internal/platform/ical"
+)
+
+// calendarProdID identifies the product that generated the calendar feed
+const calendarProdID = "-//taskmanager//Team Calendar//EN"
+
+// CalendarTokenResponse represents a newly generated calendar feed token
+// URL is the path calendar clients subscribe to; the token is not shown again
+type CalendarTokenResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// ToCalendarTokenResponse builds the calendar feed token response of a team
+func ToCalendarTokenResponse(teamUUID uuid.UUID, token string) CalendarTokenResponse {
+	return CalendarTokenResponse{
+		Token: token,
+		URL:   fmt.Sprintf("/api/teams/%s/calendar.ics?token=%s", teamUUID, token),
+	}
+}
+
+// ToTeamCalendar converts a team with its tasks to an iCalendar feed
+// Tasks with start and finish timestamps become VEVENTs spanning them; the others become VTODOs
+func ToTeamCalendar(t team.Team) ical.Calendar {
+	components := make([]ical.Component, len(t.Tasks))
+	for i, tk := range t.Tasks {
+		components[i] = toCalendarComponent(tk)
+	}
+
+	return ical.Calendar{
+		ProdID: calendarProdID,
+		Properties: []ical.Property{
+			ical.Raw("CALSCALE", "GREGORIAN"),
+			ical.Raw("METHOD", "PUBLISH"),
+			ical.Text("X-WR-CALNAME", t.Name),
+			ical.Text("X-WR-CALDESC", t.Description),
+		},
+		Components: components,
+	}
+}
+
+// toCalendarComponent converts a task to a VEVENT or VTODO whose UID derives from the task UUID
+// DTSTAMP uses the last update so the feed is stable between requests
+func toCalendarComponent(t task.Task) ical.Component {
+	properties := []ical.Property{
+		ical.Raw("UID", calendarUID(t.UUID)),
+		ical.DateTime("DTSTAMP", t.UpdatedAt),
+		ical.DateTime("CREATED", t.CreatedAt),
+		ical.DateTime("LAST-MODIFIED", t.UpdatedAt),
+		ical.Text("SUMMARY", t.Title),
+		ical.Text("DESCRIPTION", t.Description),
+	}
+
+	if t.StartedAt != nil && t.FinishedAt != nil {
+		properties = append(properties,
+			ical.DateTime("DTSTART", *t.StartedAt),
+			ical.DateTime("DTEND", *t.FinishedAt),
+			ical.Raw("STATUS", eventStatus(t.Status)),
+		)
+		return ical.Component{Name: "VEVENT", Properties: properties}
+	}
+
+	if t.StartedAt != nil {
+		properties = append(properties, ical.DateTime("DTSTART", *t.StartedAt))
+	}
+	properties = append(properties, ical.Raw("STATUS", todoStatus(t.Status)))
+	if t.Status == task.StatusDone && t.FinishedAt != nil {
+		properties = append(properties,
+			ical.DateTime("COMPLETED", *t.FinishedAt),
+			ical.Raw("PERCENT-COMPLETE", "100"),
+		)
+	}
+
+	return ical.Component{Name: "VTODO", Properties: properties}
+}
+
+// calendarUID returns the globally unique identifier of a task's calendar entry
+func calendarUID(taskUUID uuid.UUID) string {
+	return taskUUID.String() + "@taskmanager"
+}
+
+// eventStatus maps a finished task status to a VEVENT STATUS value
+func eventStatus(status task.TaskStatus) string {
+	if status == task.StatusCanceled {
+		return "CANCELLED"
+	}
+	return "CONFIRMED"
+}
+
+// todoStatus maps a task status to a VTODO STATUS value
+func todoStatus(status task.TaskStatus) string {
+	switch status {
+	case task.StatusInProgress:
+		return "IN-PROCESS"
+	case task.StatusDone:
+		return "COMPLETED"
+	case task.StatusCanceled:
+		return "CANCELLED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
@@ -0,0 +1,236 @@
+package dto
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/errors"
+)
+
+// ExportFormat identifies the output format of a task export
+type ExportFormat string
+
+const (
+	ExportFormatCSV      ExportFormat = "csv"
+	ExportFormatNDJSON   ExportFormat = "ndjson"
+	ExportFormatMarkdown ExportFormat = "markdown"
+)
+
+// taskExportColumns are the CSV and Markdown columns, named after the TaskExportResponse JSON fields
+var taskExportColumns = []string{
+	"uuid", "title", "description", "status", "rank", "estimate", "finished_at", "started_at", "created_at", "updated_at", "team_name",
+}
+
+// TaskExportResponse represents an exported task: the TaskResponse fields plus the team name
+type TaskExportResponse struct {
+	TaskResponse
+	TeamName string `json:"team_name"`
+}
+
+// ToTaskExportResponse converts a task.ExportItem to TaskExportResponse
+func ToTaskExportResponse(item task.ExportItem) TaskExportResponse {
+	return TaskExportResponse{
+		TaskResponse: ToTaskResponse(item.Task),
+		TeamName:     item.TeamName,
+	}
+}
+
+// TaskExportWriter writes exported tasks to an underlying writer in a given format
+// The header, when the format has one, is written with the first batch or on Close
+type TaskExportWriter interface {
+	ContentType() string
+	FileExtension() string
+	Write(items []task.ExportItem) error
+	Close() error
+}
+
+// ToExportFormat converts the format query parameter to ExportFormat, defaulting to CSV
+func ToExportFormat(format string) (ExportFormat, error) {
+	switch ExportFormat(format) {
+	case "":
+		return ExportFormatCSV, nil
+	case ExportFormatCSV, ExportFormatNDJSON, ExportFormatMarkdown:
+		return ExportFormat(format), nil
+	default:
+		return "", &errors.BadRequestError{
+			Message: "invalid format value",
+			Field:   "format",
+		}
+	}
+}
+
+// NewTaskExportWriter creates a TaskExportWriter for the format writing to w
+func NewTaskExportWriter(format ExportFormat, w io.Writer) TaskExportWriter {
+	switch format {
+	case ExportFormatNDJSON:
+		return &ndjsonExportWriter{encoder: json.NewEncoder(w)}
+	case ExportFormatMarkdown:
+		return &markdownExportWriter{w: w}
+	default:
+		return &csvExportWriter{w: csv.NewWriter(w)}
+	}
+}
+
+// csvExportWriter writes tasks as CSV with a header row
+// Cells starting like a formula are prefixed with a quote (see csvSafeRecord)
+type csvExportWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (c *csvExportWriter) ContentType() string   { return "text/csv; charset=utf-8" }
+func (c *csvExportWriter) FileExtension() string { return "csv" }
+
+func (c *csvExportWriter) Write(items []task.ExportItem) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := c.w.Write(csvSafeRecord(exportRecord(item))); err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvExportWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvExportWriter) writeHeader() error {
+	if c.headerWritten {
+		return nil
+	}
+	c.headerWritten = true
+	return c.w.Write(taskExportColumns)
+}
+
+// csvFormulaPrefixes are the first characters that make spreadsheets read a cell as a formula
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// csvSafeRecord prefixes the cells a spreadsheet would read as a formula with a quote, so they open as text
+func csvSafeRecord(record []string) []string {
+	for i, cell := range record {
+		if cell != "" && strings.ContainsRune(csvFormulaPrefixes, rune(cell[0])) {
+			record[i] = "'" + cell
+		}
+	}
+	return record
+}
+
+// ndjsonExportWriter writes one TaskExportResponse JSON object per line
+type ndjsonExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (n *ndjsonExportWriter) ContentType() string   { return "application/x-ndjson" }
+func (n *ndjsonExportWriter) FileExtension() string { return "ndjson" }
+
+func (n *ndjsonExportWriter) Write(items []task.ExportItem) error {
+	for _, item := range items {
+		if err := n.encoder.Encode(ToTaskExportResponse(item)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *ndjsonExportWriter) Close() error { return nil }
+
+// markdownExportWriter writes tasks as a Markdown table
+type markdownExportWriter struct {
+	w             io.Writer
+	headerWritten bool
+}
+
+func (m *markdownExportWriter) ContentType() string   { return "text/markdown; charset=utf-8" }
+func (m *markdownExportWriter) FileExtension() string { return "md" }
+
+func (m *markdownExportWriter) Write(items []task.ExportItem) error {
+	if err := m.writeHeader(); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := m.writeRow(exportRecord(item)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *markdownExportWriter) Close() error {
+	return m.writeHeader()
+}
+
+func (m *markdownExportWriter) writeHeader() error {
+	if m.headerWritten {
+		return nil
+	}
+	m.headerWritten = true
+
+	if err := m.writeRow(taskExportColumns); err != nil {
+		return err
+	}
+	separator := make([]string, len(taskExportColumns))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	return m.writeRow(separator)
+}
+
+func (m *markdownExportWriter) writeRow(cells []string) error {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = markdownCellReplacer.Replace(cell)
+	}
+	_, err := fmt.Fprintf(m.w, "| %s |\n", strings.Join(escaped, " | "))
+	return err
+}
+
+// markdownCellReplacer escapes the characters that would break a Markdown table cell
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>", "\r", "<br>")
+
+// exportRecord returns the values of an exported task in taskExportColumns order
+func exportRecord(item task.ExportItem) []string {
+	resp := ToTaskExportResponse(item)
+	return []string{
+		resp.UUID.String(),
+		resp.Title,
+		resp.Description,
+		resp.Status,
+		resp.Rank,
+		exportInt(resp.Estimate),
+		exportTime(resp.FinishedAt),
+		exportTime(resp.StartedAt),
+		exportTime(&resp.CreatedAt),
+		exportTime(&resp.UpdatedAt),
+		resp.TeamName,
+	}
+}
+
+// exportInt formats an optional number; nil becomes empty
+func exportInt(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(*n)
+}
+
+// exportTime formats a timestamp like its JSON encoding; nil becomes empty
+func exportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
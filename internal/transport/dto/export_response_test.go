@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/task"
+)
+
+func TestCSVExportWriter(t *testing.T) {
+	estimate := 5
+
+	tests := []struct {
+		name            string
+		title           string
+		description     string
+		wantTitle       string
+		wantDescription string
+	}{
+		{"Plain text is written as is", "Revisar PR", "Revisar o PR do SDK", "Revisar PR", "Revisar o PR do SDK"},
+		{"Formula in the title is written as text", `=HYPERLINK("http://evil.example","Clique")`, "Descrição", `'=HYPERLINK("http://evil.example","Clique")`, "Descrição"},
+		{"Plus sign is written as text", "+1+1", "", "'+1+1", ""},
+		{"Minus sign is written as text", "-2+3", "", "'-2+3", ""},
+		{"At sign is written as text", "@SUM(A1)", "", "'@SUM(A1)", ""},
+		{"Tab and carriage return are written as text", "\t=1", "\r=1", "'\t=1", "'\r=1"},
+		{"Formula characters after the first are kept", "Tarefa = prioridade", "a-b", "Tarefa = prioridade", "a-b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer := NewTaskExportWriter(ExportFormatCSV, &buf)
+			err := writer.Write([]task.ExportItem{{
+				Task: task.Task{
+					UUID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+					Title:       tt.title,
+					Description: tt.description,
+					Status:      task.StatusTodo,
+					Rank:        "0i",
+					Estimate:    &estimate,
+				},
+			}})
+			if err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+
+			records, err := csv.NewReader(&buf).ReadAll()
+			if err != nil {
+				t.Fatalf("csv.ReadAll() error = %v", err)
+			}
+			if diff := cmp.Diff(records[0], taskExportColumns); diff != "" {
+				t.Errorf("Write() header diff: %s", diff)
+			}
+			if got := records[1][1]; got != tt.wantTitle {
+				t.Errorf("Write() title = %q, want %q", got, tt.wantTitle)
+			}
+			if got := records[1][2]; got != tt.wantDescription {
+				t.Errorf("Write() description = %q, want %q", got, tt.wantDescription)
+			}
+			if got := records[1][4]; got != "0i" {
+				t.Errorf("Write() rank = %q, want %q", got, "0i")
+			}
+			if got := records[1][5]; got != "5" {
+				t.Errorf("Write() estimate = %q, want %q", got, "5")
+			}
+		})
+	}
+}
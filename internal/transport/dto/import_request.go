@@ -0,0 +1,30 @@
+package dto
+
+import "taskmanager/internal/entity/importjob"
+
+// CreateImportRequest represents the payload for submitting a task import
+// Mapping maps task fields (title, description, team) to CSV columns or NDJSON keys;
+// Team is an optional team name or UUID for rows without their own team
+type CreateImportRequest struct {
+	Format  string            `json:"format"`
+	Content string            `json:"content"`
+	Mapping map[string]string `json:"mapping"`
+	Team    string            `json:"team"`
+	DryRun  bool              `json:"dry_run"`
+}
+
+// ToImportJob converts CreateImportRequest to importjob.ImportJob
+func (r *CreateImportRequest) ToImportJob() *importjob.ImportJob {
+	mapping := importjob.Mapping{}
+	for field, column := range r.Mapping {
+		mapping[field] = column
+	}
+
+	return &importjob.ImportJob{
+		Format:  importjob.Format(r.Format),
+		Content: r.Content,
+		Mapping: mapping,
+		Team:    r.Team,
+		DryRun:  r.DryRun,
+	}
+}
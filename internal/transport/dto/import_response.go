@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/importjob"
+	"taskmanager/internal/platform/errors"
+)
+
+// ImportResponse represents the API response for an import job
+// In dry-run mode imported_rows counts the rows that would be imported
+type ImportResponse struct {
+	UUID         uuid.UUID                `json:"uuid"`
+	Format       string                   `json:"format"`
+	Status       string                   `json:"status"`
+	DryRun       bool                     `json:"dry_run"`
+	Mapping      map[string]string        `json:"mapping"`
+	Team         string                   `json:"team,omitempty"`
+	TotalRows    int                      `json:"total_rows"`
+	ImportedRows int                      `json:"imported_rows"`
+	FailedRows   int                      `json:"failed_rows"`
+	Report       []ImportRowErrorResponse `json:"report"`
+	ErrorMessage string                   `json:"error_message,omitempty"`
+	StartedAt    *time.Time               `json:"started_at,omitempty"`
+	FinishedAt   *time.Time               `json:"finished_at,omitempty"`
+	CreatedAt    time.Time                `json:"created_at"`
+	UpdatedAt    time.Time                `json:"updated_at"`
+}
+
+// ImportRowErrorResponse represents the validation errors of an imported row
+type ImportRowErrorResponse struct {
+	Row    int                      `json:"row"`
+	Errors []errors.ValidationError `json:"errors"`
+}
+
+// ToImportResponse converts an importjob.ImportJob to ImportResponse
+func ToImportResponse(j importjob.ImportJob) ImportResponse {
+	report := make([]ImportRowErrorResponse, len(j.Report))
+	for i, rowErr := range j.Report {
+		report[i] = ImportRowErrorResponse{
+			Row:    rowErr.Row,
+			Errors: rowErr.Errors,
+		}
+	}
+
+	mapping := map[string]string{}
+	for field, column := range j.Mapping {
+		mapping[field] = column
+	}
+
+	return ImportResponse{
+		UUID:         j.UUID,
+		Format:       string(j.Format),
+		Status:       string(j.Status),
+		DryRun:       j.DryRun,
+		Mapping:      mapping,
+		Team:         j.Team,
+		TotalRows:    j.TotalRows,
+		ImportedRows: j.ImportedRows,
+		FailedRows:   j.FailedRows,
+		Report:       report,
+		ErrorMessage: j.ErrorMessage,
+		StartedAt:    j.StartedAt,
+		FinishedAt:   j.FinishedAt,
+		CreatedAt:    j.CreatedAt,
+		UpdatedAt:    j.UpdatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"taskmanager/internal/platform/errors"
+)
+
+// MoveTaskRequest represents the payload for moving a task within its status column
+// previous_uuid is the task that ends up right before it and next_uuid the one right after; one is enough
+type MoveTaskRequest struct {
+	PreviousUUID *string `json:"previous_uuid"`
+	NextUUID     *string `json:"next_uuid"`
+}
+
+// ToNeighbors parses the neighbor UUIDs, nil when absent
+// Returns BadRequestError for malformed UUIDs
+func (r *MoveTaskRequest) ToNeighbors() (*uuid.UUID, *uuid.UUID, error) {
+	previous, err := parseOptionalUUID(r.PreviousUUID, "previous_uuid")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next, err := parseOptionalUUID(r.NextUUID, "next_uuid")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return previous, next, nil
+}
+
+// parseOptionalUUID parses value as a UUID, nil when value is nil
+func parseOptionalUUID(value *string, field string) (*uuid.UUID, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(*value)
+	if err != nil {
+		return nil, &errors.BadRequestError{Message: "invalid " + field + " format", Field: field}
+	}
+
+	return &parsed, nil
+}
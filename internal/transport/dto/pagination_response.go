@@ -0,0 +1,29 @@
+package dto
+
+import "taskmanager/internal/platform/pagination"
+
+// encodeCursor converts a pagination cursor to its opaque string form, or nil when absent
+func encodeCursor(c *pagination.Cursor) *string {
+	if c == nil {
+		return nil
+	}
+	encoded := c.Encode()
+	return &encoded
+}
+
+// totalItems returns a pointer to the total count when it was requested, or nil otherwise
+func totalItems(total int, withTotal bool) *int {
+	if !withTotal {
+		return nil
+	}
+	return &total
+}
+
+// totalPages returns the number of pages of limit items needed for totalItems, at least 1
+func totalPages(totalItems, limit int) int {
+	pages := (totalItems + limit - 1) / limit
+	if pages == 0 {
+		return 1
+	}
+	return pages
+}
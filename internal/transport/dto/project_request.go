@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/project"
+	"taskmanager/internal/platform/errors"
+)
+
+// CreateProjectRequest represents the payload for creating a new project
+type CreateProjectRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ToProject converts CreateProjectRequest to project.Project
+func (r *CreateProjectRequest) ToProject() *project.Project {
+	return &project.Project{
+		Name:        r.Name,
+		Description: r.Description,
+	}
+}
+
+// CreateMilestoneRequest represents the payload for creating a milestone of a project
+// target_date is a calendar date (YYYY-MM-DD)
+type CreateMilestoneRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	TargetDate  string `json:"target_date"`
+}
+
+// ToMilestone converts CreateMilestoneRequest to project.Milestone
+// Returns an error for a malformed date; a missing date is left zero for validation
+func (r *CreateMilestoneRequest) ToMilestone() (*project.Milestone, error) {
+	targetDate, err := parseDate(r.TargetDate, "target_date")
+	if err != nil {
+		return nil, err
+	}
+
+	return &project.Milestone{
+		Name:        r.Name,
+		Description: r.Description,
+		TargetDate:  targetDate,
+	}, nil
+}
+
+// AddProjectTaskRequest represents the payload for adding a task to a project
+// milestone_uuid is optional; without it the task is in no milestone of the project
+type AddProjectTaskRequest struct {
+	TaskUUID      string  `json:"task_uuid"`
+	MilestoneUUID *string `json:"milestone_uuid"`
+}
+
+// ToUUIDs parses the task and milestone UUIDs of AddProjectTaskRequest
+func (r *AddProjectTaskRequest) ToUUIDs() (uuid.UUID, *uuid.UUID, error) {
+	taskUUID, err := uuid.Parse(r.TaskUUID)
+	if err != nil {
+		return uuid.UUID{}, nil, &errors.BadRequestError{
+			Message: "invalid task_uuid format",
+			Field:   "task_uuid",
+		}
+	}
+
+	if r.MilestoneUUID == nil {
+		return taskUUID, nil, nil
+	}
+
+	milestoneUUID, err := uuid.Parse(*r.MilestoneUUID)
+	if err != nil {
+		return uuid.UUID{}, nil, &errors.BadRequestError{
+			Message: "invalid milestone_uuid format",
+			Field:   "milestone_uuid",
+		}
+	}
+
+	return taskUUID, &milestoneUUID, nil
+}
+
+// ToUUIDFilter converts a UUID query parameter to a list filter
+// Returns nil if the string is empty and an error if it is not a UUID
+func ToUUIDFilter(value, field string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, &errors.BadRequestError{
+			Message: fmt.Sprintf("invalid %s format", field),
+			Field:   field,
+		}
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,169 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/project"
+)
+
+// ProjectResponse represents the API response for a project
+type ProjectResponse struct {
+	UUID        uuid.UUID `json:"uuid"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ToProjectResponse converts a project.Project to ProjectResponse
+func ToProjectResponse(p project.Project) ProjectResponse {
+	return ProjectResponse{
+		UUID:        p.UUID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+// MilestoneResponse represents the API response for a milestone
+type MilestoneResponse struct {
+	UUID        uuid.UUID `json:"uuid"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	TargetDate  string    `json:"target_date"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ToMilestoneResponse converts a project.Milestone to MilestoneResponse
+func ToMilestoneResponse(m project.Milestone) MilestoneResponse {
+	return MilestoneResponse{
+		UUID:        m.UUID,
+		Name:        m.Name,
+		Description: m.Description,
+		TargetDate:  m.TargetDate.Format(project.DateLayout),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+// ProjectWithMilestonesResponse represents a project with its milestones, in target date order
+type ProjectWithMilestonesResponse struct {
+	ProjectResponse
+	Milestones []MilestoneResponse `json:"milestones"`
+}
+
+// ToProjectWithMilestonesResponse converts a project and its milestones to ProjectWithMilestonesResponse
+func ToProjectWithMilestonesResponse(p project.Project) ProjectWithMilestonesResponse {
+	milestones := make([]MilestoneResponse, len(p.Milestones))
+	for i, m := range p.Milestones {
+		milestones[i] = ToMilestoneResponse(m)
+	}
+
+	return ProjectWithMilestonesResponse{
+		ProjectResponse: ToProjectResponse(p),
+		Milestones:      milestones,
+	}
+}
+
+// PaginatedProjectsResponse represents a paginated list of projects
+type PaginatedProjectsResponse struct {
+	Page         int               `json:"page"`
+	ItemsPerPage int               `json:"items_per_page"`
+	TotalItems   int               `json:"total_items"`
+	TotalPages   int               `json:"total_pages"`
+	Items        []ProjectResponse `json:"items"`
+}
+
+// ToPaginatedProjectsResponse converts a paginated project list to PaginatedProjectsResponse
+func ToPaginatedProjectsResponse(list project.ListProjects) PaginatedProjectsResponse {
+	totalPages := (list.TotalItems + list.Limit - 1) / list.Limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	data := make([]ProjectResponse, len(list.Projects))
+	for i, p := range list.Projects {
+		data[i] = ToProjectResponse(p)
+	}
+
+	return PaginatedProjectsResponse{
+		Page:         list.Page,
+		ItemsPerPage: list.Limit,
+		TotalItems:   list.TotalItems,
+		TotalPages:   totalPages,
+		Items:        data,
+	}
+}
+
+// CountResponse represents the number of done tasks out of the total
+type CountResponse struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// MilestoneProgressResponse represents the progress of the tasks planned in a milestone
+type MilestoneProgressResponse struct {
+	UUID       uuid.UUID `json:"uuid"`
+	Name       string    `json:"name"`
+	TargetDate string    `json:"target_date"`
+	CountResponse
+}
+
+// TeamProgressResponse represents the progress of the tasks of a team in a project
+type TeamProgressResponse struct {
+	UUID uuid.UUID `json:"uuid"`
+	Name string    `json:"name"`
+	CountResponse
+}
+
+// ProgressResponse represents the progress of a project, per milestone and per team
+type ProgressResponse struct {
+	ProjectUUID      uuid.UUID                   `json:"project_uuid"`
+	Done             int                         `json:"done"`
+	Total            int                         `json:"total"`
+	Milestones       []MilestoneProgressResponse `json:"milestones"`
+	WithoutMilestone CountResponse               `json:"without_milestone"`
+	Teams            []TeamProgressResponse      `json:"teams"`
+	WithoutTeam      CountResponse               `json:"without_team"`
+}
+
+// ToProgressResponse converts a project.Progress to ProgressResponse
+func ToProgressResponse(p project.Progress) ProgressResponse {
+	milestones := make([]MilestoneProgressResponse, len(p.Milestones))
+	for i, m := range p.Milestones {
+		milestones[i] = MilestoneProgressResponse{
+			UUID:          m.Milestone.UUID,
+			Name:          m.Milestone.Name,
+			TargetDate:    m.Milestone.TargetDate.Format(project.DateLayout),
+			CountResponse: toCountResponse(m.Count),
+		}
+	}
+
+	teams := make([]TeamProgressResponse, len(p.Teams))
+	for i, t := range p.Teams {
+		teams[i] = TeamProgressResponse{
+			UUID:          t.Team.UUID,
+			Name:          t.Team.Name,
+			CountResponse: toCountResponse(t.Count),
+		}
+	}
+
+	return ProgressResponse{
+		ProjectUUID:      p.Project.UUID,
+		Done:             p.Done,
+		Total:            p.Total,
+		Milestones:       milestones,
+		WithoutMilestone: toCountResponse(p.WithoutMilestone),
+		Teams:            teams,
+		WithoutTeam:      toCountResponse(p.WithoutTeam),
+	}
+}
+
+// toCountResponse converts a project.Count to CountResponse
+func toCountResponse(c project.Count) CountResponse {
+	return CountResponse{Done: c.Done, Total: c.Total}
+}
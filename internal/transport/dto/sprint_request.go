@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"taskmanager/internal/entity/sprint"
+	"taskmanager/internal/platform/errors"
+)
+
+// CreateSprintRequest represents the payload for creating a new sprint
+// start_date and end_date are calendar dates (YYYY-MM-DD)
+type CreateSprintRequest struct {
+	Name      string `json:"name"`
+	Goal      string `json:"goal"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// ToSprint converts CreateSprintRequest to sprint.Sprint
+// Returns an error for malformed dates; missing dates are left zero for validation
+func (r *CreateSprintRequest) ToSprint() (*sprint.Sprint, error) {
+	startDate, err := parseDate(r.StartDate, "start_date")
+	if err != nil {
+		return nil, err
+	}
+
+	endDate, err := parseDate(r.EndDate, "end_date")
+	if err != nil {
+		return nil, err
+	}
+
+	return &sprint.Sprint{
+		Name:      r.Name,
+		Goal:      r.Goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}, nil
+}
+
+// ToVelocitySprints converts the sprints query parameter to the number of sprints of a velocity
+// Returns 0 (use default) if the string is empty and an error if it is not a positive integer
+func ToVelocitySprints(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	sprints, err := strconv.Atoi(value)
+	if err != nil || sprints < 1 {
+		return 0, &errors.BadRequestError{
+			Message: "invalid sprints value",
+			Field:   "sprints",
+		}
+	}
+
+	return sprints, nil
+}
+
+// parseDate parses a calendar date of the request body; an empty value is the zero time
+func parseDate(value, field string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(sprint.DateLayout, value)
+	if err != nil {
+		return time.Time{}, &errors.BadRequestError{
+			Message: fmt.Sprintf("invalid %s format", field),
+			Field:   field,
+		}
+	}
+
+	return date, nil
+}
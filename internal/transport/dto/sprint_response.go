@@ -0,0 +1,157 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/sprint"
+)
+
+// SprintResponse represents the API response for a sprint
+type SprintResponse struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	TeamUUID    uuid.UUID  `json:"team_uuid"`
+	Name        string     `json:"name"`
+	Goal        string     `json:"goal"`
+	StartDate   string     `json:"start_date"`
+	EndDate     string     `json:"end_date"`
+	State       string     `json:"state"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ToSprintResponse converts a sprint.Sprint to SprintResponse
+func ToSprintResponse(s sprint.Sprint) SprintResponse {
+	var teamUUID uuid.UUID
+	if s.Team != nil {
+		teamUUID = s.Team.UUID
+	}
+
+	return SprintResponse{
+		UUID:        s.UUID,
+		TeamUUID:    teamUUID,
+		Name:        s.Name,
+		Goal:        s.Goal,
+		StartDate:   s.StartDate.Format(sprint.DateLayout),
+		EndDate:     s.EndDate.Format(sprint.DateLayout),
+		State:       string(s.State),
+		StartedAt:   s.StartedAt,
+		CompletedAt: s.CompletedAt,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// SprintWithTasksResponse represents a sprint with its tasks
+type SprintWithTasksResponse struct {
+	SprintResponse
+	Tasks []TaskResponse `json:"tasks"`
+}
+
+// ToSprintWithTasksResponse converts a sprint and its tasks to SprintWithTasksResponse
+func ToSprintWithTasksResponse(s sprint.Sprint) SprintWithTasksResponse {
+	tasks := make([]TaskResponse, len(s.Tasks))
+	for i, t := range s.Tasks {
+		tasks[i] = ToTaskResponse(t)
+	}
+
+	return SprintWithTasksResponse{
+		SprintResponse: ToSprintResponse(s),
+		Tasks:          tasks,
+	}
+}
+
+// PaginatedSprintsResponse represents a paginated list of sprints
+type PaginatedSprintsResponse struct {
+	Page         int              `json:"page"`
+	ItemsPerPage int              `json:"items_per_page"`
+	TotalItems   int              `json:"total_items"`
+	TotalPages   int              `json:"total_pages"`
+	Items        []SprintResponse `json:"items"`
+}
+
+// ToPaginatedSprintsResponse converts a paginated sprint list to PaginatedSprintsResponse
+func ToPaginatedSprintsResponse(list sprint.ListSprints) PaginatedSprintsResponse {
+	totalPages := (list.TotalItems + list.Limit - 1) / list.Limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	data := make([]SprintResponse, len(list.Sprints))
+	for i, s := range list.Sprints {
+		data[i] = ToSprintResponse(s)
+	}
+
+	return PaginatedSprintsResponse{
+		Page:         list.Page,
+		ItemsPerPage: list.Limit,
+		TotalItems:   list.TotalItems,
+		TotalPages:   totalPages,
+		Items:        data,
+	}
+}
+
+// SprintCompletionResponse represents a completed sprint and where its unfinished tasks went
+// next_sprint_uuid is null when they went back to the backlog
+type SprintCompletionResponse struct {
+	Sprint          SprintResponse `json:"sprint"`
+	NextSprintUUID  *uuid.UUID     `json:"next_sprint_uuid"`
+	RolledOverTasks int            `json:"rolled_over_tasks"`
+}
+
+// ToSprintCompletionResponse converts a sprint.Completion to SprintCompletionResponse
+func ToSprintCompletionResponse(c sprint.Completion) SprintCompletionResponse {
+	var nextSprintUUID *uuid.UUID
+	if c.Next != nil {
+		nextSprintUUID = &c.Next.UUID
+	}
+
+	return SprintCompletionResponse{
+		Sprint:          ToSprintResponse(c.Sprint),
+		NextSprintUUID:  nextSprintUUID,
+		RolledOverTasks: c.RolledOver,
+	}
+}
+
+// VelocityResponse represents the velocity of a team over its last completed sprints
+type VelocityResponse struct {
+	TeamUUID uuid.UUID                `json:"team_uuid"`
+	Average  float64                  `json:"average"`
+	Sprints  []SprintVelocityResponse `json:"sprints"`
+}
+
+// SprintVelocityResponse represents the work finished by a completed sprint
+type SprintVelocityResponse struct {
+	UUID            uuid.UUID  `json:"uuid"`
+	Name            string     `json:"name"`
+	StartDate       string     `json:"start_date"`
+	EndDate         string     `json:"end_date"`
+	CompletedAt     *time.Time `json:"completed_at"`
+	CompletedPoints int        `json:"completed_points"`
+	CompletedTasks  int        `json:"completed_tasks"`
+}
+
+// ToVelocityResponse converts the velocity of a team to VelocityResponse
+func ToVelocityResponse(teamUUID uuid.UUID, v sprint.Velocity) VelocityResponse {
+	sprints := make([]SprintVelocityResponse, len(v.Sprints))
+	for i, s := range v.Sprints {
+		sprints[i] = SprintVelocityResponse{
+			UUID:            s.Sprint.UUID,
+			Name:            s.Sprint.Name,
+			StartDate:       s.Sprint.StartDate.Format(sprint.DateLayout),
+			EndDate:         s.Sprint.EndDate.Format(sprint.DateLayout),
+			CompletedAt:     s.Sprint.CompletedAt,
+			CompletedPoints: s.CompletedPoints,
+			CompletedTasks:  s.CompletedTasks,
+		}
+	}
+
+	return VelocityResponse{
+		TeamUUID: teamUUID,
+		Average:  v.Average,
+		Sprints:  sprints,
+	}
+}
@@ -1,6 +1,8 @@
 package dto
 
 // StatusUpdateRequest represents the payload for status update
+// override_wip_limit moves the task even when the status reached the WIP limit of its team
 type StatusUpdateRequest struct {
-	Status string `json:"status"`
+	Status           string `json:"status"`
+	OverrideWIPLimit bool   `json:"override_wip_limit"`
 }
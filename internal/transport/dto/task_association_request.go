@@ -0,0 +1,6 @@
+package dto
+
+// TaskAssociationRequest represents the payload for associating a task with a team or sprint
+type TaskAssociationRequest struct {
+	TaskUUID string `json:"task_uuid"`
+}
@@ -3,12 +3,15 @@ package dto
 import (
 	"taskmanager/internal/entity/task"
 	"taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
+	"taskmanager/internal/platform/patch"
 )
 
 // CreateTaskRequest represents the payload for creating a new task
 type CreateTaskRequest struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	Estimate    *int   `json:"estimate"`
 }
 
 // ToTask converts CreateTaskRequest to task.Task
@@ -16,13 +19,41 @@ func (r *CreateTaskRequest) ToTask() *task.Task {
 	return &task.Task{
 		Title:       r.Title,
 		Description: r.Description,
+		Estimate:    r.Estimate,
 	}
 }
 
 // UpdateTaskRequest represents the payload for updating a task
+// A missing or null estimate clears it
 type UpdateTaskRequest struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	Estimate    *int   `json:"estimate"`
+}
+
+// TaskPatchDocument represents the editable fields of a task, the document a PATCH applies to
+// Members the patch removes decode to their zero value, so a removed estimate is cleared
+type TaskPatchDocument struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Estimate    *int   `json:"estimate"`
+}
+
+// PatchTask applies a merge patch or JSON patch to the editable fields of t
+func PatchTask(t *task.Task, p patch.Patch) error {
+	doc, err := patch.ApplyTo(p, TaskPatchDocument{
+		Title:       t.Title,
+		Description: t.Description,
+		Estimate:    t.Estimate,
+	})
+	if err != nil {
+		return err
+	}
+
+	t.Title = doc.Title
+	t.Description = doc.Description
+	t.Estimate = doc.Estimate
+	return nil
 }
 
 // ToTaskStatus converts a status filter string to *task.TaskStatus
@@ -42,6 +73,31 @@ func ToTaskStatus(status string) (*task.TaskStatus, error) {
 	return &taskStatus, nil
 }
 
+// ToTaskSort converts the sort query parameter to task.ListSort, defaulting to task.SortCreatedAt
+// Returns an error for unknown values and for a cursor taken from a list in another order
+func ToTaskSort(sort string, cursor *pagination.Cursor) (task.ListSort, error) {
+	listSort := task.ListSort(sort)
+	switch listSort {
+	case "":
+		listSort = task.SortCreatedAt
+	case task.SortCreatedAt, task.SortRank:
+	default:
+		return "", &errors.BadRequestError{
+			Message: "invalid sort value",
+			Field:   "sort",
+		}
+	}
+
+	if cursor != nil && cursor.IsRank() != (listSort == task.SortRank) {
+		return "", &errors.BadRequestError{
+			Message: "invalid cursor",
+			Field:   "cursor",
+		}
+	}
+
+	return listSort, nil
+}
+
 // isValidTaskStatus validates if a TaskStatus is valid
 // Returns true if valid, false otherwise
 func isValidTaskStatus(taskStatus task.TaskStatus) bool {
@@ -14,6 +14,8 @@ type TaskResponse struct {
 	Title       string     `json:"title"`
 	Description string     `json:"description"`
 	Status      string     `json:"status"`
+	Rank        string     `json:"rank"`
+	Estimate    *int       `json:"estimate"`
 	FinishedAt  *time.Time `json:"finished_at,omitempty"`
 	StartedAt   *time.Time `json:"started_at,omitempty"`
 	CreatedAt   time.Time  `json:"created_at"`
@@ -27,6 +29,8 @@ func ToTaskResponse(t task.Task) TaskResponse {
 		Title:       t.Title,
 		Description: t.Description,
 		Status:      string(t.Status),
+		Rank:        t.Rank,
+		Estimate:    t.Estimate,
 		FinishedAt:  t.FinishedAt,
 		StartedAt:   t.StartedAt,
 		CreatedAt:   t.CreatedAt,
@@ -68,3 +72,79 @@ func ToPaginatedTasksResponse(page, limit, totalItems int, tasks []task.Task) Pa
 		Items:        data,
 	}
 }
+
+// TaskHighlightResponse represents the highlighted snippets of a search match
+type TaskHighlightResponse struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// TaskSearchResultResponse represents a task matched by full-text search
+type TaskSearchResultResponse struct {
+	TaskResponse
+	Rank       float64               `json:"rank"`
+	Highlights TaskHighlightResponse `json:"highlights"`
+}
+
+// PaginatedTaskSearchResponse represents a paginated list of search results
+type PaginatedTaskSearchResponse struct {
+	Page         int                        `json:"page"`
+	ItemsPerPage int                        `json:"items_per_page"`
+	TotalItems   int                        `json:"total_items"`
+	TotalPages   int                        `json:"total_pages"`
+	Items        []TaskSearchResultResponse `json:"items"`
+}
+
+// ToPaginatedTaskSearchResponse converts pagination info and search results to PaginatedTaskSearchResponse
+func ToPaginatedTaskSearchResponse(page, limit, totalItems int, results []task.SearchResult) PaginatedTaskSearchResponse {
+	totalPages := (totalItems + limit - 1) / limit
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	data := make([]TaskSearchResultResponse, len(results))
+	for i, r := range results {
+		data[i] = TaskSearchResultResponse{
+			TaskResponse: ToTaskResponse(r.Task),
+			Rank:         r.Rank,
+			Highlights: TaskHighlightResponse{
+				Title:       r.TitleHighlight,
+				Description: r.DescriptionHighlight,
+			},
+		}
+	}
+
+	return PaginatedTaskSearchResponse{
+		Page:         page,
+		ItemsPerPage: limit,
+		TotalItems:   totalItems,
+		TotalPages:   totalPages,
+		Items:        data,
+	}
+}
+
+// CursorTasksResponse represents a cursor-paginated list of tasks
+type CursorTasksResponse struct {
+	ItemsPerPage int            `json:"items_per_page"`
+	TotalItems   *int           `json:"total_items,omitempty"`
+	NextCursor   *string        `json:"next_cursor"`
+	PrevCursor   *string        `json:"prev_cursor"`
+	Items        []TaskResponse `json:"items"`
+}
+
+// ToCursorTasksResponse converts a cursor-paginated task list to CursorTasksResponse
+// The total count is only included when withTotal is true
+func ToCursorTasksResponse(list task.ListTasks, withTotal bool) CursorTasksResponse {
+	data := make([]TaskResponse, len(list.Tasks))
+	for i, t := range list.Tasks {
+		data[i] = ToTaskResponse(t)
+	}
+
+	return CursorTasksResponse{
+		ItemsPerPage: list.Limit,
+		TotalItems:   totalItems(list.TotalItems, withTotal),
+		NextCursor:   encodeCursor(list.NextCursor),
+		PrevCursor:   encodeCursor(list.PrevCursor),
+		Items:        data,
+	}
+}
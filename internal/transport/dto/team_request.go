@@ -1,6 +1,10 @@
 package dto
 
-import "taskmanager/internal/entity/team"
+import (
+	"taskmanager/internal/entity/task"
+	"taskmanager/internal/entity/team"
+	"taskmanager/internal/platform/patch"
+)
 
 // CreateTeamRequest represents the payload for creating a new team
 type CreateTeamRequest struct {
@@ -15,3 +19,47 @@ func (r *CreateTeamRequest) ToTeam() *team.Team {
 		Description: r.Description,
 	}
 }
+
+// WIPLimitsRequest represents the payload replacing the WIP limits of a team
+// Statuses left out of wip_limits have no limit
+type WIPLimitsRequest struct {
+	WIPLimits map[string]int `json:"wip_limits"`
+}
+
+// ToWIPLimits converts WIPLimitsRequest to team.WIPLimits
+func (r *WIPLimitsRequest) ToWIPLimits() team.WIPLimits {
+	if r.WIPLimits == nil {
+		return nil
+	}
+
+	limits := make(team.WIPLimits, len(r.WIPLimits))
+	for status, limit := range r.WIPLimits {
+		limits[task.TaskStatus(status)] = limit
+	}
+	return limits
+}
+
+// TeamPatchDocument represents the editable fields of a team, the document a PATCH applies to
+// A merge patch merges wip_limits, so null removes the limit of a single status
+type TeamPatchDocument struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	WIPLimits   team.WIPLimits `json:"wip_limits"`
+}
+
+// PatchTeam applies a merge patch or JSON patch to the editable fields of t
+func PatchTeam(t *team.Team, p patch.Patch) error {
+	doc, err := patch.ApplyTo(p, TeamPatchDocument{
+		Name:        t.Name,
+		Description: t.Description,
+		WIPLimits:   t.WIPLimits,
+	})
+	if err != nil {
+		return err
+	}
+
+	t.Name = doc.Name
+	t.Description = doc.Description
+	t.WIPLimits = doc.WIPLimits
+	return nil
+}
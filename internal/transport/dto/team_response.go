@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	taskEntity "taskmanager/internal/entity/task"
 	"taskmanager/internal/entity/team"
 )
 
@@ -28,6 +29,20 @@ func ToTeamResponse(t team.Team) TeamResponse {
 	}
 }
 
+// PatchedTeamResponse represents the API response for a patched team, with its WIP limits
+type PatchedTeamResponse struct {
+	TeamResponse
+	WIPLimits map[taskEntity.TaskStatus]int `json:"wip_limits"`
+}
+
+// ToPatchedTeamResponse converts a team.Team to PatchedTeamResponse
+func ToPatchedTeamResponse(t team.Team) PatchedTeamResponse {
+	return PatchedTeamResponse{
+		TeamResponse: ToTeamResponse(t),
+		WIPLimits:    t.WIPLimits,
+	}
+}
+
 // TeamsResponse represents a list of teams
 type TeamsResponse struct {
 	Teams []TeamResponse `json:"teams"`
@@ -63,6 +78,32 @@ func ToPaginatedTeamsResponse(page, limit, totalItems int, teams []team.Team) Pa
 	}
 }
 
+// CursorTeamsResponse represents a cursor-paginated list of teams
+type CursorTeamsResponse struct {
+	ItemsPerPage int            `json:"items_per_page"`
+	TotalItems   *int           `json:"total_items,omitempty"`
+	NextCursor   *string        `json:"next_cursor"`
+	PrevCursor   *string        `json:"prev_cursor"`
+	Items        []TeamResponse `json:"items"`
+}
+
+// ToCursorTeamsResponse converts a cursor-paginated team list to CursorTeamsResponse
+// The total count is only included when withTotal is true
+func ToCursorTeamsResponse(list team.ListTeams, withTotal bool) CursorTeamsResponse {
+	data := make([]TeamResponse, len(list.Teams))
+	for i, t := range list.Teams {
+		data[i] = ToTeamResponse(t)
+	}
+
+	return CursorTeamsResponse{
+		ItemsPerPage: list.Limit,
+		TotalItems:   totalItems(list.TotalItems, withTotal),
+		NextCursor:   encodeCursor(list.NextCursor),
+		PrevCursor:   encodeCursor(list.PrevCursor),
+		Items:        data,
+	}
+}
+
 // TeamWithTasksResponse represents a team with its associated tasks
 type TeamWithTasksResponse struct {
 	TeamResponse
@@ -81,3 +122,61 @@ func ToTeamWithTasksResponse(t team.Team) TeamWithTasksResponse {
 		Tasks:        taskResponses,
 	}
 }
+
+// WIPLimitsResponse represents the WIP limits of a team
+type WIPLimitsResponse struct {
+	TeamUUID  uuid.UUID                     `json:"team_uuid"`
+	WIPLimits map[taskEntity.TaskStatus]int `json:"wip_limits"`
+}
+
+// ToWIPLimitsResponse converts the WIP limits of a team to WIPLimitsResponse
+func ToWIPLimitsResponse(teamUUID uuid.UUID, limits team.WIPLimits) WIPLimitsResponse {
+	return WIPLimitsResponse{
+		TeamUUID:  teamUUID,
+		WIPLimits: limits,
+	}
+}
+
+// BoardResponse represents the kanban board of a team
+type BoardResponse struct {
+	TeamUUID uuid.UUID             `json:"team_uuid"`
+	Columns  []BoardColumnResponse `json:"columns"`
+}
+
+// BoardColumnResponse represents a cursor-paginated column of a team board
+// count is the total of tasks in the status and wip_limit is null when the status has no limit
+type BoardColumnResponse struct {
+	Status       taskEntity.TaskStatus `json:"status"`
+	Count        int                   `json:"count"`
+	WIPLimit     *int                  `json:"wip_limit"`
+	ItemsPerPage int                   `json:"items_per_page"`
+	NextCursor   *string               `json:"next_cursor"`
+	PrevCursor   *string               `json:"prev_cursor"`
+	Tasks        []TaskResponse        `json:"tasks"`
+}
+
+// ToBoardResponse converts a team.Board to BoardResponse
+func ToBoardResponse(b team.Board) BoardResponse {
+	columns := make([]BoardColumnResponse, len(b.Columns))
+	for i, c := range b.Columns {
+		tasks := make([]TaskResponse, len(c.Tasks))
+		for j, t := range c.Tasks {
+			tasks[j] = ToTaskResponse(t)
+		}
+
+		columns[i] = BoardColumnResponse{
+			Status:       c.Status,
+			Count:        c.Count,
+			WIPLimit:     c.WIPLimit,
+			ItemsPerPage: c.Limit,
+			NextCursor:   encodeCursor(c.NextCursor),
+			PrevCursor:   encodeCursor(c.PrevCursor),
+			Tasks:        tasks,
+		}
+	}
+
+	return BoardResponse{
+		TeamUUID: b.Team.UUID,
+		Columns:  columns,
+	}
+}
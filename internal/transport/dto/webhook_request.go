@@ -0,0 +1,25 @@
+package dto
+
+import "taskmanager/internal/entity/webhook"
+
+// CreateWebhookRequest represents the payload for creating a webhook subscription
+// Secret is optional; when empty one is generated and returned once in the response
+type CreateWebhookRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret"`
+}
+
+// ToSubscription converts CreateWebhookRequest to webhook.Subscription
+func (r *CreateWebhookRequest) ToSubscription() *webhook.Subscription {
+	events := make([]webhook.EventType, len(r.Events))
+	for i, event := range r.Events {
+		events[i] = webhook.EventType(event)
+	}
+
+	return &webhook.Subscription{
+		URL:    r.URL,
+		Events: events,
+		Secret: r.Secret,
+	}
+}
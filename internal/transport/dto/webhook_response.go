@@ -0,0 +1,147 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/entity/webhook"
+)
+
+// WebhookResponse represents the API response for a webhook subscription
+// The secret is never included; it is only returned on creation
+type WebhookResponse struct {
+	UUID      uuid.UUID `json:"uuid"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToWebhookResponse converts a webhook.Subscription to WebhookResponse
+func ToWebhookResponse(s webhook.Subscription) WebhookResponse {
+	events := make([]string, len(s.Events))
+	for i, event := range s.Events {
+		events[i] = string(event)
+	}
+
+	return WebhookResponse{
+		UUID:      s.UUID,
+		URL:       s.URL,
+		Events:    events,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+// CreatedWebhookResponse represents a newly created webhook subscription with its signing secret
+type CreatedWebhookResponse struct {
+	WebhookResponse
+	Secret string `json:"secret"`
+}
+
+// ToCreatedWebhookResponse converts a newly created webhook.Subscription to CreatedWebhookResponse
+func ToCreatedWebhookResponse(s webhook.Subscription) CreatedWebhookResponse {
+	return CreatedWebhookResponse{
+		WebhookResponse: ToWebhookResponse(s),
+		Secret:          s.Secret,
+	}
+}
+
+// PaginatedWebhooksResponse represents a paginated list of webhook subscriptions
+type PaginatedWebhooksResponse struct {
+	Page         int               `json:"page"`
+	ItemsPerPage int               `json:"items_per_page"`
+	TotalItems   int               `json:"total_items"`
+	TotalPages   int               `json:"total_pages"`
+	Items        []WebhookResponse `json:"items"`
+}
+
+// ToPaginatedWebhooksResponse converts a paginated subscription list to PaginatedWebhooksResponse
+func ToPaginatedWebhooksResponse(list webhook.ListSubscriptions) PaginatedWebhooksResponse {
+	data := make([]WebhookResponse, len(list.Subscriptions))
+	for i, s := range list.Subscriptions {
+		data[i] = ToWebhookResponse(s)
+	}
+
+	return PaginatedWebhooksResponse{
+		Page:         list.Page,
+		ItemsPerPage: list.Limit,
+		TotalItems:   list.TotalItems,
+		TotalPages:   totalPages(list.TotalItems, list.Limit),
+		Items:        data,
+	}
+}
+
+// WebhookDeliveryResponse represents an entry of the delivery log of a webhook subscription
+// next_attempt_at is only set while the delivery is pending
+type WebhookDeliveryResponse struct {
+	UUID           uuid.UUID  `json:"uuid"`
+	EventID        uuid.UUID  `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	ResponseStatus int        `json:"response_status"`
+	LastError      string     `json:"last_error"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// ToWebhookDeliveryResponse converts a webhook.Delivery to WebhookDeliveryResponse
+func ToWebhookDeliveryResponse(d webhook.Delivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		UUID:           d.UUID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+// WebhookDeliveryWithPayloadResponse represents a delivery with the JSON body sent to the subscriber
+type WebhookDeliveryWithPayloadResponse struct {
+	WebhookDeliveryResponse
+	Payload json.RawMessage `json:"payload"`
+}
+
+// ToWebhookDeliveryWithPayloadResponse converts a webhook.Delivery to WebhookDeliveryWithPayloadResponse
+func ToWebhookDeliveryWithPayloadResponse(d webhook.Delivery) WebhookDeliveryWithPayloadResponse {
+	return WebhookDeliveryWithPayloadResponse{
+		WebhookDeliveryResponse: ToWebhookDeliveryResponse(d),
+		Payload:                 json.RawMessage(d.Payload),
+	}
+}
+
+// PaginatedWebhookDeliveriesResponse represents a paginated delivery log
+type PaginatedWebhookDeliveriesResponse struct {
+	Page         int                       `json:"page"`
+	ItemsPerPage int                       `json:"items_per_page"`
+	TotalItems   int                       `json:"total_items"`
+	TotalPages   int                       `json:"total_pages"`
+	Items        []WebhookDeliveryResponse `json:"items"`
+}
+
+// ToPaginatedWebhookDeliveriesResponse converts a paginated delivery list to PaginatedWebhookDeliveriesResponse
+func ToPaginatedWebhookDeliveriesResponse(list webhook.ListDeliveries) PaginatedWebhookDeliveriesResponse {
+	data := make([]WebhookDeliveryResponse, len(list.Deliveries))
+	for i, d := range list.Deliveries {
+		data[i] = ToWebhookDeliveryResponse(d)
+	}
+
+	return PaginatedWebhookDeliveriesResponse{
+		Page:         list.Page,
+		ItemsPerPage: list.Limit,
+		TotalItems:   list.TotalItems,
+		TotalPages:   totalPages(list.TotalItems, list.Limit),
+		Items:        data,
+	}
+}
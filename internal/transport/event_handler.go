@@ -0,0 +1,99 @@
+package transport
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	webhookEntity "taskmanager/internal/entity/webhook"
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/platform/sse"
+	"taskmanager/internal/usecase/eventstream"
+)
+
+// StreamEvents streams the task and team events as Server-Sent Events
+// The team query parameter restricts the stream to one team; the Last-Event-ID header
+// sent by reconnecting clients replays the events published after that one
+func StreamEvents(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	var teamUUID *uuid.UUID
+	if team := httputil.QueryParam(r, "team"); team != "" {
+		parsed, err := uuid.Parse(team)
+		if err != nil {
+			slog.Error("error parsing team UUID for event stream", "error", err)
+			return httputil.BadRequest("invalid team format", "team")
+		}
+		teamUUID = &parsed
+	}
+
+	var lastEventID *uuid.UUID
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		parsed, err := uuid.Parse(header)
+		if err != nil {
+			slog.Error("error parsing Last-Event-ID for event stream", "error", err)
+			return httputil.BadRequest("invalid uuid format", "Last-Event-ID")
+		}
+		lastEventID = &parsed
+	}
+
+	stream, ok := sse.NewWriter(w)
+	if !ok {
+		slog.Error("error streaming events: response does not support flushing")
+		return http.StatusInternalServerError, nil
+	}
+
+	// Subscribe before replaying, so events published meanwhile are not lost
+	sub, err := eventstream.Subscribe(r.Context(), teamUUID)
+	if err != nil {
+		slog.Error("error subscribing to event stream", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+	defer sub.Close()
+
+	var replay []webhookEntity.Event
+	if lastEventID != nil {
+		replay, err = sub.Replay(r.Context(), *lastEventID)
+		if err != nil {
+			slog.Error("error replaying event stream", "error", err)
+			return httputil.HandleErrorResponse(err, nil)
+		}
+	}
+
+	stream.Start()
+	replayed := make(map[uuid.UUID]struct{}, len(replay))
+	for _, e := range replay {
+		if err := stream.Event(e.ID.String(), string(e.Type), e.Payload); err != nil {
+			slog.Error("error writing event stream", "error", err)
+			return http.StatusOK, nil
+		}
+		replayed[e.ID] = struct{}{}
+	}
+
+	heartbeat := time.NewTicker(eventstream.Config.Heartbeat())
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return http.StatusOK, nil
+		case <-heartbeat.C:
+			if err := stream.Comment("heartbeat"); err != nil {
+				slog.Error("error writing event stream heartbeat", "error", err)
+				return http.StatusOK, nil
+			}
+		case e, ok := <-sub.Events():
+			if !ok {
+				// The subscription lagged behind; the client reconnects and replays from its last event
+				return http.StatusOK, nil
+			}
+			if _, ok := replayed[e.ID]; ok {
+				continue
+			}
+			if err := stream.Event(e.ID.String(), string(e.Type), e.Payload); err != nil {
+				slog.Error("error writing event stream", "error", err)
+				return http.StatusOK, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+//go:build test
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/internal/platform/testing/venomtest"
+	"taskmanager/internal/usecase/eventstream"
+)
+
+func TestStreamEvents(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/events/stream/bad_request.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/events/stream/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Stream events "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+// TestStreamEventsResume reads the stream directly, as venom waits for the end of the body
+func TestStreamEventsResume(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+	)
+
+	tests := []struct {
+		name         string
+		query        string
+		lastEventID  string
+		wantContains []string
+		wantMissing  []string
+	}{
+		{
+			"Resume replays the events published after Last-Event-ID",
+			"",
+			"eeee4567-e89b-12d3-a456-426614174000",
+			[]string{
+				"id: eeee4567-e89b-12d3-a456-426614174003\nevent: team.task_associated\ndata: {",
+				": heartbeat\n\n",
+			},
+			[]string{"id: eeee4567-e89b-12d3-a456-426614174000"},
+		},
+		{
+			"Resume skips the events of other teams",
+			"?team=222e4567-e89b-12d3-a456-426614174000",
+			"eeee4567-e89b-12d3-a456-426614174000",
+			[]string{": heartbeat\n\n"},
+			[]string{"id: eeee4567-e89b-12d3-a456-426614174003"},
+		},
+		{
+			"Resume from an unknown event replays nothing",
+			"",
+			"00000000-0000-0000-0000-000000000000",
+			[]string{": heartbeat\n\n"},
+			[]string{"id: "},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "outbox.sql")
+
+			// Stop the stream after the first heartbeat
+			ctx, cancel := context.WithTimeout(context.Background(), eventstream.Config.Heartbeat()+500*time.Millisecond)
+			defer cancel()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/events"+tt.query, nil).WithContext(ctx)
+			req.Header.Set("Last-Event-ID", tt.lastEventID)
+			rec := httptest.NewRecorder()
+
+			Routes(dbConnector).ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("StreamEvents() status = %d, want %d", rec.Code, http.StatusOK)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "text/event-stream" {
+				t.Errorf("StreamEvents() content type = %q, want text/event-stream", got)
+			}
+
+			body := rec.Body.String()
+			for _, want := range tt.wantContains {
+				if !strings.Contains(body, want) {
+					t.Errorf("StreamEvents() body = %q, want it to contain %q", body, want)
+				}
+			}
+			for _, missing := range tt.wantMissing {
+				if strings.Contains(body, missing) {
+					t.Errorf("StreamEvents() body = %q, want it not to contain %q", body, missing)
+				}
+			}
+		})
+	}
+}
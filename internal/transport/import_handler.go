@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"log/slog"
+	"net/http"
+
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/transport/dto"
+	"taskmanager/internal/usecase/importjob"
+)
+
+// CreateImport submits a task import for asynchronous processing
+// Responds 202 with the import job; its progress is read from RetrieveImport
+func CreateImport(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	var req dto.CreateImportRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for create import", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	j := req.ToImportJob()
+	if err := importjob.Submit(r.Context(), j); err != nil {
+		slog.Error("error submitting import", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	w.Header().Set("Location", "/api/imports/"+j.UUID.String())
+	return httputil.Accepted(dto.ToImportResponse(*j))
+}
+
+// RetrieveImport retrieves an import job by UUID with its counters and per-row report
+func RetrieveImport(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	jobUUID := httputil.URLParamUUID(r, "uuid")
+
+	j, err := importjob.RetrieveByUUID(r.Context(), jobUUID)
+	if err != nil {
+		slog.Error("error retrieving import", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToImportResponse(*j))
+}
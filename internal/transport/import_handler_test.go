@@ -0,0 +1,83 @@
+//go:build test
+
+package transport
+
+import (
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/internal/platform/testing/venomtest"
+)
+
+func TestCreateImport(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/imports/create/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/imports/create/bad_request.yml"},
+		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/imports/create/validation_errors.yml"},
+		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/imports/create/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Create import "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveImport(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/imports/retrieve/bad_request.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/imports/retrieve/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve import "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
@@ -15,10 +15,19 @@ import (
 	"taskmanager/internal/platform/testing/dbtest"
 	"taskmanager/internal/platform/testing/redistest"
 	"taskmanager/internal/platform/testing/testenv"
+	analyticsRepo "taskmanager/internal/repository/analytics"
 	taskRepo "taskmanager/internal/repository/task"
 	"taskmanager/internal/testing/configtest"
+	"taskmanager/internal/usecase/analytics"
+	"taskmanager/internal/usecase/board"
+	"taskmanager/internal/usecase/eventstream"
+	"taskmanager/internal/usecase/idempotency"
+	"taskmanager/internal/usecase/importjob"
+	"taskmanager/internal/usecase/project"
+	"taskmanager/internal/usecase/sprint"
 	"taskmanager/internal/usecase/task"
 	"taskmanager/internal/usecase/team"
+	"taskmanager/internal/usecase/webhook"
 )
 
 var databaseTest *dbtest.Container
@@ -28,10 +37,18 @@ var dbConnector database.Connector
 func TestMain(m *testing.M) {
 	os.Exit(func(m *testing.M) int {
 		appConfig := struct {
-			Database database.Configuration `toml:"database"`
-			Logger   logger.Configuration   `toml:"logger"`
-			Task     task.Configuration     `toml:"task"`
-			Team     team.Configuration     `toml:"team"`
+			Database    database.Configuration    `toml:"database"`
+			Logger      logger.Configuration      `toml:"logger"`
+			Task        task.Configuration        `toml:"task"`
+			Team        team.Configuration        `toml:"team"`
+			Sprint      sprint.Configuration      `toml:"sprint"`
+			Project     project.Configuration     `toml:"project"`
+			Analytics   analytics.Configuration   `toml:"analytics"`
+			Import      importjob.Configuration   `toml:"import"`
+			Webhook     webhook.Configuration     `toml:"webhook"`
+			Events      eventstream.Configuration `toml:"events"`
+			Board       board.Configuration       `toml:"board"`
+			Idempotency idempotency.Configuration `toml:"idempotency"`
 		}{}
 
 		// Loading configs
@@ -52,6 +69,46 @@ func TestMain(m *testing.M) {
 			log.Fatalf("Error on load team config. Err: %s", err)
 		}
 
+		// Load sprint config
+		if err := sprint.LoadConfig(&appConfig.Sprint); err != nil {
+			log.Fatalf("Error on load sprint config. Err: %s", err)
+		}
+
+		// Load project config
+		if err := project.LoadConfig(&appConfig.Project); err != nil {
+			log.Fatalf("Error on load project config. Err: %s", err)
+		}
+
+		// Load analytics config
+		if err := analytics.LoadConfig(&appConfig.Analytics); err != nil {
+			log.Fatalf("Error on load analytics config. Err: %s", err)
+		}
+
+		// Load import config
+		if err := importjob.LoadConfig(&appConfig.Import); err != nil {
+			log.Fatalf("Error on load import config. Err: %s", err)
+		}
+
+		// Load webhook config
+		if err := webhook.LoadConfig(&appConfig.Webhook); err != nil {
+			log.Fatalf("Error on load webhook config. Err: %s", err)
+		}
+
+		// Load event stream config
+		if err := eventstream.LoadConfig(&appConfig.Events); err != nil {
+			log.Fatalf("Error on load event stream config. Err: %s", err)
+		}
+
+		// Load board config
+		if err := board.LoadConfig(&appConfig.Board); err != nil {
+			log.Fatalf("Error on load board config. Err: %s", err)
+		}
+
+		// Load idempotency config
+		if err := idempotency.LoadConfig(&appConfig.Idempotency); err != nil {
+			log.Fatalf("Error on load idempotency config. Err: %s", err)
+		}
+
 		// Setup database container for all tests in this package
 		var err error
 		if databaseTest, err = dbtest.SetupDatabase(nil,
@@ -85,6 +142,11 @@ func TestMain(m *testing.M) {
 			redisTest.Client(),
 			5*time.Minute,
 		))
+		analyticsRepo.SetPersist(analyticsRepo.NewCachedPersist(
+			analyticsRepo.Persist(),
+			redisTest.Client(),
+			5*time.Minute,
+		))
 
 		return m.Run()
 	}(m))
@@ -94,3 +156,23 @@ func resetWithMinimalData(env *testenv.Environment) {
 	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql")
 	env.FlushRedis()
 }
+
+func resetWithSprints(env *testenv.Environment) {
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql")
+	env.FlushRedis()
+}
+
+func resetWithProjects(env *testenv.Environment) {
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "projects.sql")
+	env.FlushRedis()
+}
+
+func resetWithSnapshots(env *testenv.Environment) {
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "sprints.sql", "snapshots.sql")
+	env.FlushRedis()
+}
+
+func resetWithWebhooks(env *testenv.Environment) {
+	dbtest.ResetWithFixtures(env.DB(), paths.FixtureDir(), "tasks_minimal.sql", "webhooks.sql")
+	env.FlushRedis()
+}
@@ -1,14 +1,33 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/platform/patch"
+)
 
 // RequireContentTypeJSON returns 415 when Content-Type is not application/json.
 func RequireContentTypeJSON(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Header.Get("Content-Type") != "application/json" {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusUnsupportedMediaType)
-			w.Write([]byte(`{"message":"Content-Type must be application/json"}`))
+			statusCode, body := httputil.UnsupportedMediaType("Content-Type must be application/json")
+			httputil.WriteResponse(w, r, statusCode, body)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RequireContentTypePatch returns 415 when Content-Type is neither application/merge-patch+json nor
+// application/json-patch+json, listing both in Accept-Patch.
+func RequireContentTypePatch(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := r.Header.Get("Content-Type")
+		if contentType != patch.MergePatchType && contentType != patch.JSONPatchType {
+			w.Header().Set("Accept-Patch", patch.MergePatchType+", "+patch.JSONPatchType)
+			statusCode, body := httputil.UnsupportedMediaType("Content-Type must be application/merge-patch+json or application/json-patch+json")
+			httputil.WriteResponse(w, r, statusCode, body)
 			return
 		}
 		next.ServeHTTP(w, r)
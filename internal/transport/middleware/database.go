@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"taskmanager/internal/platform/database"
+	httputil "taskmanager/internal/platform/http"
 )
 
 type handlerFunc func(http.ResponseWriter, *http.Request) (int, []byte)
@@ -17,9 +18,8 @@ func DatabaseWithTransaction(dbConnector database.Connector) func(handlerFunc) h
 			ctx, err := dbConnector.InjectDBsIntoContext(r.Context(), database.WithDBTransaction())
 			if err != nil {
 				slog.Error("Failed to inject database transaction", "error", err)
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusInternalServerError)
-				w.Write([]byte(`{"error":"internal server error"}`))
+				statusCode, body := httputil.HandleErrorResponse(err, nil)
+				httputil.WriteResponse(w, r, statusCode, body)
 				return
 			}
 			r = r.WithContext(ctx)
@@ -37,32 +37,86 @@ func DatabaseWithTransaction(dbConnector database.Connector) func(handlerFunc) h
 				}
 			}
 
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(statusCode)
-			w.Write(body)
+			httputil.WriteResponse(w, r, statusCode, body)
 		})
 	}
 }
 
-// DatabaseWithoutTransaction inserts a database without transaction into the request context
+// DatabaseWithoutTransaction inserts a database without transaction into the request context.
+// Successful responses whose ETag or Last-Modified satisfy the conditional headers of the
+// request are answered with 304 Not Modified and no body
 func DatabaseWithoutTransaction(dbConnector database.Connector) func(handlerFunc) http.HandlerFunc {
 	return func(next handlerFunc) http.HandlerFunc {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx, err := dbConnector.InjectDBsIntoContext(r.Context(), database.WithDBWithoutTransaction())
 			if err != nil {
 				slog.Error("Failed to inject database without transaction", "error", err)
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusInternalServerError)
-				w.Write([]byte(`{"error":"internal server error"}`))
+				statusCode, body := httputil.HandleErrorResponse(err, nil)
+				httputil.WriteResponse(w, r, statusCode, body)
 				return
 			}
 			r = r.WithContext(ctx)
 
 			statusCode, body := next(w, r)
 
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(statusCode)
-			w.Write(body)
+			if statusCode == http.StatusOK && httputil.NotModified(r, w.Header()) {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			httputil.WriteResponse(w, r, statusCode, body)
+		})
+	}
+}
+
+// DatabaseStreamWithoutTransaction is DatabaseWithoutTransaction for handlers that stream
+// the response body themselves. The returned status code and body are only written when
+// the handler did not start the response, which lets it report errors before streaming
+func DatabaseStreamWithoutTransaction(dbConnector database.Connector) func(handlerFunc) http.HandlerFunc {
+	return func(next handlerFunc) http.HandlerFunc {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, err := dbConnector.InjectDBsIntoContext(r.Context(), database.WithDBWithoutTransaction())
+			if err != nil {
+				slog.Error("Failed to inject database without transaction", "error", err)
+				statusCode, body := httputil.HandleErrorResponse(err, nil)
+				httputil.WriteResponse(w, r, statusCode, body)
+				return
+			}
+			r = r.WithContext(ctx)
+
+			sw := &streamWriter{ResponseWriter: w}
+			statusCode, body := next(sw, r)
+			if sw.started {
+				return
+			}
+
+			httputil.WriteResponse(w, r, statusCode, body)
 		})
 	}
 }
+
+// streamWriter records whether a handler started writing the response
+type streamWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+// WriteHeader marks the response as started and writes the status code
+func (s *streamWriter) WriteHeader(statusCode int) {
+	s.started = true
+	s.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write marks the response as started and writes the body
+func (s *streamWriter) Write(b []byte) (int, error) {
+	s.started = true
+	return s.ResponseWriter.Write(b)
+}
+
+// Flush sends buffered data to the client when the underlying writer supports it
+func (s *streamWriter) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		s.started = true
+		f.Flush()
+	}
+}
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+
+	idempotencyEntity "taskmanager/internal/entity/idempotency"
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/usecase/idempotency"
+)
+
+// replayedHeaders are the response headers stored with the response and set again on its replays
+var replayedHeaders = []string{"ETag", "Last-Modified", "Location"}
+
+// Idempotent replays the stored response to requests retried with the same Idempotency-Key header.
+// The key, a hash of the method, path and body of the request and its 2xx response,
+// with the headers in replayedHeaders, are stored in the transaction of the request,
+// so it must be wrapped by DatabaseWithTransaction; a failed request leaves the key free.
+// Replays carry the Idempotent-Replayed header.
+// A key reused for a different request is refused with 422.
+// Requests without the header run as usual
+func Idempotent(next handlerFunc) handlerFunc {
+	return idempotent(next, true)
+}
+
+// IdempotentWithoutReplay is Idempotent for routes whose response carries a secret, such as a
+// token: the key is stored without the response, and retries with it are refused with 409 rather
+// than running the request again or returning the secret once more
+func IdempotentWithoutReplay(next handlerFunc) handlerFunc {
+	return idempotent(next, false)
+}
+
+// idempotent wraps next with the Idempotency-Key handling, storing its response when replay is set
+func idempotent(next handlerFunc, replay bool) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) (int, []byte) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" {
+			return next(w, r)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			slog.Error("error reading body for idempotency key", "error", err)
+			return httputil.BadRequest("invalid request body", "")
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		stored, err := idempotency.Begin(r.Context(), key, idempotencyEntity.HashRequest(r.Method, r.URL.RequestURI(), body))
+		if err != nil {
+			slog.Error("error reserving idempotency key", "error", err)
+			return httputil.HandleErrorResponse(err, nil)
+		}
+		if stored != nil {
+			if !replay {
+				return httputil.Conflict("the request with this Idempotency-Key already succeeded and its response is not replayed")
+			}
+			for name, value := range stored.ResponseHeaders {
+				w.Header().Set(name, value)
+			}
+			w.Header().Set("Idempotent-Replayed", "true")
+			return stored.StatusCode, stored.ResponseBody
+		}
+
+		statusCode, respBody := next(w, r)
+		if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+			return statusCode, respBody
+		}
+
+		headers := map[string]string{}
+		storedBody := []byte{}
+		if replay {
+			for _, name := range replayedHeaders {
+				if value := w.Header().Get(name); value != "" {
+					headers[name] = value
+				}
+			}
+			storedBody = respBody
+		}
+		if err := idempotency.Complete(r.Context(), key, statusCode, headers, storedBody); err != nil {
+			slog.Error("error storing idempotent response", "error", err)
+			return httputil.HandleErrorResponse(err, nil)
+		}
+
+		return statusCode, respBody
+	}
+}
@@ -5,12 +5,22 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	chimw "github.com/go-chi/chi/v5/middleware"
 )
 
+// secretQueryParams are the query params whose values grant access, such as the calendar feed token
+// Their values are replaced by redactedValue in the request log
+var secretQueryParams = []string{"token"}
+
+// redactedValue replaces the value of a secret query param in the request log
+const redactedValue = "REDACTED"
+
 // JSONLogFormatter implements chimw.LogFormatter and emits one JSON object per
 // request on a single line (NDJSON).
 type JSONLogFormatter struct {
@@ -31,7 +41,7 @@ func (f *JSONLogFormatter) NewLogEntry(r *http.Request) chimw.LogEntry {
 		formatter:  f,
 		method:     r.Method,
 		path:       r.URL.Path,
-		rawQuery:   r.URL.RawQuery,
+		rawQuery:   redactQuery(r.URL.RawQuery),
 		remoteAddr: r.RemoteAddr,
 		requestID:  chimw.GetReqID(r.Context()),
 		userAgent:  r.UserAgent(),
@@ -98,6 +108,24 @@ func (e *jsonLogEntry) Panic(v interface{}, stack []byte) {
 	_ = writeJSONLine(e.formatter.Out, &line)
 }
 
+// redactQuery returns rawQuery with the values of the secret query params replaced by redactedValue
+// The other params are kept as sent, in their order
+func redactQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+
+	pairs := strings.Split(rawQuery, "&")
+	for i, pair := range pairs {
+		key, _, found := strings.Cut(pair, "=")
+		name, err := url.QueryUnescape(key)
+		if found && err == nil && slices.Contains(secretQueryParams, name) {
+			pairs[i] = key + "=" + redactedValue
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
 // writeJSONLine writes a JSON object to the writer.
 func writeJSONLine(w io.Writer, v interface{}) error {
 	b, err := json.Marshal(v)
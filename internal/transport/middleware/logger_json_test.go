@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestJSONLogFormatter_RedactsSecretQueryParams(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		wantQuery string
+	}{
+		{
+			"Calendar feed token",
+			"/api/teams/111e4567-e89b-12d3-a456-426614174000/calendar.ics?token=feed-secret",
+			"token=REDACTED",
+		},
+		{
+			"Board viewer token",
+			"/api/board/ws?token=feed-secret",
+			"token=REDACTED",
+		},
+		{
+			"Token among other params",
+			"/api/tasks/export?format=csv&token=feed-secret&status=done",
+			"format=csv&token=REDACTED&status=done",
+		},
+		{
+			"Escaped token name",
+			"/api/tasks?%74oken=feed-secret",
+			"%74oken=REDACTED",
+		},
+		{
+			"Query without secrets",
+			"/api/tasks?status=done&limit=10",
+			"status=done&limit=10",
+		},
+		{
+			"Without query",
+			"/api/tasks",
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			entry := NewJSONLogFormatter(&out).NewLogEntry(httptest.NewRequest(http.MethodGet, tt.target, nil))
+			entry.Write(http.StatusOK, 0, nil, time.Millisecond, nil)
+
+			var line jsonLogLine
+			if err := json.Unmarshal(out.Bytes(), &line); err != nil {
+				t.Fatalf("log line %q is not JSON: %v", out.String(), err)
+			}
+			if line.Query != tt.wantQuery {
+				t.Errorf("log query = %q, want %q", line.Query, tt.wantQuery)
+			}
+			if bytes.Contains(out.Bytes(), []byte("feed-secret")) {
+				t.Errorf("log line %q contains the token", out.String())
+			}
+		})
+	}
+}
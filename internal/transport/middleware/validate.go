@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/platform/openapi"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ValidateRequest returns 400 when the path params, query params or JSON body of a request
+// violate the operation of its route in doc, before the handler runs.
+// Requests without a documented operation go through, so unknown routes still answer 404 or 405
+func ValidateRequest(doc *openapi.Document) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rctx := chi.RouteContext(r.Context())
+			if rctx == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			routeCtx := chi.NewRouteContext()
+			pattern := rctx.Routes.Find(routeCtx, r.Method, r.URL.Path)
+			op := doc.Operation(r.Method, pattern)
+			if op == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			pathParams := make(map[string]string, len(routeCtx.URLParams.Keys))
+			for i, key := range routeCtx.URLParams.Keys {
+				pathParams[key] = routeCtx.URLParams.Values[i]
+			}
+
+			if err := doc.ValidateRequest(op, r, pathParams); err != nil {
+				statusCode, body := httputil.HandleErrorResponse(err, nil)
+				httputil.WriteResponse(w, r, statusCode, body)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
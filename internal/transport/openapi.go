@@ -0,0 +1,237 @@
+package transport
+
+import (
+	"net/http"
+	"slices"
+
+	analyticsEntity "taskmanager/internal/entity/analytics"
+	taskEntity "taskmanager/internal/entity/task"
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/platform/openapi"
+	"taskmanager/internal/platform/patch"
+	"taskmanager/internal/transport/dto"
+)
+
+// Tags of the operations, one per resource
+const (
+	tagTasks     = "Tasks"
+	tagTeams     = "Teams"
+	tagSprints   = "Sprints"
+	tagProjects  = "Projects"
+	tagImports   = "Imports"
+	tagWebhooks  = "Webhooks"
+	tagAnalytics = "Analytics"
+	tagRealtime  = "Realtime"
+	tagMeta      = "Meta"
+)
+
+// Error statuses of the operations, by kind of route
+var (
+	listErrors   = []int{http.StatusBadRequest, http.StatusInternalServerError}
+	readErrors   = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError}
+	createErrors = []int{http.StatusBadRequest, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity, http.StatusInternalServerError}
+	writeErrors  = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity, http.StatusInternalServerError}
+	guardErrors  = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusPreconditionFailed, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity, http.StatusInternalServerError}
+	// Routes whose response carries a secret refuse retries with the same Idempotency-Key with 409
+	createOnceErrors = []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity, http.StatusInternalServerError}
+	writeOnceErrors  = []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity, http.StatusInternalServerError}
+)
+
+// Parameters shared by several operations
+var (
+	idempotencyKeyParam  = openapi.HeaderParam("Idempotency-Key", "Replays the stored response to retries of the request with the same key")
+	idempotencyOnceParam = openapi.HeaderParam("Idempotency-Key", "Refuses retries of the request with the same key with 409, without replaying its secret")
+	ifMatchParam         = openapi.HeaderParam("If-Match", "Applies the change only when the ETag matches the current version")
+	ifNoneMatchParam     = openapi.HeaderParam("If-None-Match", "Answers with 304 when the ETag still matches")
+	pageParams           = []openapi.Parameter{
+		openapi.QueryParam("page", "Page number, from 1", openapi.IntegerSchema(1)),
+		openapi.QueryParam("limit", "Items per page", openapi.IntegerSchema(1)),
+	}
+	cursorParams = []openapi.Parameter{
+		openapi.QueryParam("pagination", "Set to cursor for keyset pagination", openapi.EnumSchema("offset", "cursor")),
+		openapi.QueryParam("cursor", "Opaque cursor of the next or previous page", openapi.StringSchema("")),
+		openapi.QueryParam("include_total", "Counts the total items in cursor mode", openapi.BooleanSchema()),
+	}
+	statusParam  = openapi.QueryParam("status", "Filters tasks by status", openapi.EnumSchema(taskEntity.Statuses...))
+	sortParam    = openapi.QueryParam("sort", "Order of the tasks", openapi.EnumSchema(taskEntity.SortCreatedAt, taskEntity.SortRank))
+	periodParams = []openapi.Parameter{
+		openapi.QueryParam("from", "First day of the period", openapi.StringSchema("date")),
+		openapi.QueryParam("to", "Last day of the period", openapi.StringSchema("date")),
+	}
+)
+
+// apiRoutes annotates every route of Routes for the OpenAPI document
+// A route registered without an entry here fails TestOpenAPIRoutes
+var apiRoutes = []openapi.Route{
+	// Meta routes
+	{Method: http.MethodGet, Path: "/healthcheck", OperationID: "Healthcheck", Summary: "Check that the API is up", Tag: tagMeta},
+	{Method: http.MethodGet, Path: "/api/openapi.json", OperationID: "RetrieveOpenAPI", Summary: "Retrieve this OpenAPI document", Tag: tagMeta,
+		Response: map[string]any{}},
+	{Method: http.MethodGet, Path: "/api/docs", OperationID: "RetrieveAPIDocs", Summary: "Browse the API reference", Tag: tagMeta,
+		Response: "", ResponseMediaTypes: []string{"text/html"}},
+
+	// Task routes
+	{Method: http.MethodPost, Path: "/api/tasks", OperationID: "CreateTask", Summary: "Create a task", Tag: tagTasks,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.CreateTaskRequest{}, Response: dto.TaskResponse{},
+		ResponseHeaders: map[string]string{"ETag": "Version of the task"}, Errors: createErrors},
+	{Method: http.MethodGet, Path: "/api/tasks/{uuid}", OperationID: "RetrieveTask", Summary: "Retrieve a task", Tag: tagTasks,
+		Parameters: []openapi.Parameter{ifNoneMatchParam}, Response: dto.TaskResponse{},
+		ResponseHeaders: map[string]string{"ETag": "Version of the task", "Last-Modified": "Last update of the task"}, Errors: readErrors},
+	{Method: http.MethodPut, Path: "/api/tasks/{uuid}", OperationID: "UpdateTask", Summary: "Update a task", Tag: tagTasks,
+		Parameters: []openapi.Parameter{ifMatchParam}, Request: dto.UpdateTaskRequest{}, Response: dto.TaskResponse{},
+		ResponseHeaders: map[string]string{"ETag": "Version of the task"}, Errors: guardErrors},
+	{Method: http.MethodPatch, Path: "/api/tasks/{uuid}", OperationID: "PatchTask", Summary: "Patch the title, description and estimate of a task", Tag: tagTasks,
+		Parameters: []openapi.Parameter{ifMatchParam}, Request: dto.TaskPatchDocument{}, RequestMediaTypes: []string{patch.MergePatchType, patch.JSONPatchType},
+		Response: dto.TaskResponse{}, ResponseHeaders: map[string]string{"ETag": "Version of the task"}, Errors: guardErrors},
+	{Method: http.MethodDelete, Path: "/api/tasks/{uuid}", OperationID: "DeleteTask", Summary: "Delete a task", Tag: tagTasks,
+		Parameters: []openapi.Parameter{ifMatchParam}, Errors: guardErrors},
+	{Method: http.MethodGet, Path: "/api/tasks", OperationID: "ListTasks", Summary: "List tasks by page or cursor", Tag: tagTasks,
+		Parameters:      slices.Concat([]openapi.Parameter{statusParam, sortParam, ifNoneMatchParam}, pageParams, cursorParams),
+		Response:        openapi.OneOf{dto.PaginatedTasksResponse{}, dto.CursorTasksResponse{}},
+		ResponseHeaders: map[string]string{"ETag": "Digest of the page", "Last-Modified": "Last update of the tasks of the page"}, Errors: listErrors},
+	{Method: http.MethodGet, Path: "/api/tasks/search", OperationID: "SearchTasks", Summary: "Search tasks by full text", Tag: tagTasks,
+		Parameters: slices.Concat([]openapi.Parameter{openapi.QueryParam("q", "Search terms", openapi.StringSchema(""))}, pageParams),
+		Response:   dto.PaginatedTaskSearchResponse{}, Errors: listErrors},
+	{Method: http.MethodGet, Path: "/api/tasks/export", OperationID: "ExportTasks", Summary: "Export tasks with their team", Tag: tagTasks,
+		Parameters: []openapi.Parameter{statusParam, sortParam, openapi.QueryParam("format", "Format of the file", openapi.EnumSchema(dto.ExportFormatCSV, dto.ExportFormatNDJSON, dto.ExportFormatMarkdown))},
+		Response:   dto.TaskExportResponse{}, ResponseMediaTypes: []string{"text/csv", "application/x-ndjson", "text/markdown"},
+		ResponseHeaders: map[string]string{"Content-Disposition": "Attachment with the file name"}, Errors: listErrors},
+	{Method: http.MethodPost, Path: "/api/tasks/{uuid}/status", OperationID: "UpdateTaskStatus", Summary: "Move a task to a status", Tag: tagTasks,
+		Parameters: []openapi.Parameter{ifMatchParam, idempotencyKeyParam}, Request: dto.StatusUpdateRequest{}, Errors: guardErrors},
+	{Method: http.MethodPost, Path: "/api/tasks/{uuid}/move", OperationID: "MoveTask", Summary: "Rank a task between two neighbors", Tag: tagTasks,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.MoveTaskRequest{}, Response: dto.TaskResponse{},
+		ResponseHeaders: map[string]string{"ETag": "Version of the task"}, Errors: writeErrors},
+	{Method: http.MethodPost, Path: "/api/tasks/bulk", OperationID: "BulkTasks", Summary: "Apply operations to several tasks", Tag: tagTasks,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.BulkTaskRequest{}, Response: dto.BulkTaskResponse{}, Errors: createErrors},
+
+	// Team routes
+	{Method: http.MethodPost, Path: "/api/teams", OperationID: "CreateTeam", Summary: "Create a team", Tag: tagTeams,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.CreateTeamRequest{}, Response: dto.TeamResponse{}, Errors: createErrors},
+	{Method: http.MethodGet, Path: "/api/teams", OperationID: "ListTeams", Summary: "List teams by page or cursor", Tag: tagTeams,
+		Parameters: slices.Concat(pageParams, cursorParams), Response: openapi.OneOf{dto.PaginatedTeamsResponse{}, dto.CursorTeamsResponse{}},
+		Errors: listErrors},
+	{Method: http.MethodGet, Path: "/api/teams/{uuid}", OperationID: "RetrieveTeam", Summary: "Retrieve a team with its tasks", Tag: tagTeams,
+		Parameters: []openapi.Parameter{ifNoneMatchParam}, Response: dto.TeamWithTasksResponse{},
+		ResponseHeaders: map[string]string{"ETag": "Version of the team and digest of its tasks", "Last-Modified": "Last update of the team and its tasks"}, Errors: readErrors},
+	{Method: http.MethodPatch, Path: "/api/teams/{uuid}", OperationID: "PatchTeam", Summary: "Patch the name, description and WIP limits of a team", Tag: tagTeams,
+		Parameters: []openapi.Parameter{ifMatchParam}, Request: dto.TeamPatchDocument{}, RequestMediaTypes: []string{patch.MergePatchType, patch.JSONPatchType},
+		Response: dto.PatchedTeamResponse{}, ResponseHeaders: map[string]string{"ETag": "Version of the team"}, Errors: guardErrors},
+	{Method: http.MethodPost, Path: "/api/teams/{uuid}/tasks", OperationID: "AssociateTaskToTeam", Summary: "Associate a task with a team", Tag: tagTeams,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.TaskAssociationRequest{}, Errors: writeErrors},
+	{Method: http.MethodDelete, Path: "/api/teams/{uuid}/tasks/{task_uuid}", OperationID: "DisassociateTaskFromTeam", Summary: "Disassociate a task from a team", Tag: tagTeams,
+		Errors: writeErrors},
+	{Method: http.MethodGet, Path: "/api/teams/{uuid}/calendar.ics", OperationID: "RetrieveTeamCalendar", Summary: "Subscribe to the tasks of a team as an iCalendar feed", Tag: tagTeams,
+		Parameters: []openapi.Parameter{openapi.QueryParam("token", "Calendar token of the team", openapi.StringSchema(""))},
+		Response:   "", ResponseMediaTypes: []string{"text/calendar"},
+		Errors: []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound, http.StatusInternalServerError}},
+	{Method: http.MethodPost, Path: "/api/teams/{uuid}/calendar/token", OperationID: "RotateTeamCalendarToken", Summary: "Rotate the calendar token of a team", Tag: tagTeams,
+		Parameters: []openapi.Parameter{idempotencyOnceParam}, Response: dto.CalendarTokenResponse{}, Errors: writeOnceErrors},
+	{Method: http.MethodGet, Path: "/api/teams/{uuid}/board", OperationID: "RetrieveTeamBoard", Summary: "Retrieve the board columns of a team", Tag: tagTeams,
+		Parameters: []openapi.Parameter{statusParam, sortParam, pageParams[1], cursorParams[1]}, Response: dto.BoardResponse{}, Errors: readErrors},
+	{Method: http.MethodPut, Path: "/api/teams/{uuid}/wip-limits", OperationID: "UpdateTeamWIPLimits", Summary: "Replace the WIP limits of a team", Tag: tagTeams,
+		Parameters: []openapi.Parameter{ifMatchParam}, Request: dto.WIPLimitsRequest{}, Response: dto.WIPLimitsResponse{}, Errors: guardErrors},
+	{Method: http.MethodPost, Path: "/api/teams/{uuid}/sprints", OperationID: "CreateSprint", Summary: "Plan a sprint of a team", Tag: tagSprints,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.CreateSprintRequest{}, Response: dto.SprintResponse{}, Errors: writeErrors},
+	{Method: http.MethodGet, Path: "/api/teams/{uuid}/sprints", OperationID: "ListTeamSprints", Summary: "List the sprints of a team", Tag: tagSprints,
+		Parameters: pageParams, Response: dto.PaginatedSprintsResponse{}, Errors: readErrors},
+	{Method: http.MethodGet, Path: "/api/teams/{uuid}/velocity", OperationID: "RetrieveTeamVelocity", Summary: "Retrieve the velocity of the last sprints of a team", Tag: tagSprints,
+		Parameters: []openapi.Parameter{openapi.QueryParam("sprints", "Number of completed sprints", openapi.IntegerSchema(1))},
+		Response:   dto.VelocityResponse{}, Errors: readErrors},
+	{Method: http.MethodGet, Path: "/api/teams/{uuid}/metrics", OperationID: "RetrieveTeamMetrics", Summary: "Retrieve the flow metrics of a team", Tag: tagAnalytics,
+		Parameters: slices.Concat(periodParams, []openapi.Parameter{openapi.QueryParam("bucket", "Length of the throughput buckets", openapi.EnumSchema(analyticsEntity.BucketDay, analyticsEntity.BucketWeek, analyticsEntity.BucketMonth))}),
+		Response:   dto.TeamMetricsResponse{}, Errors: readErrors},
+	{Method: http.MethodGet, Path: "/api/teams/{uuid}/cfd", OperationID: "RetrieveTeamCFD", Summary: "Retrieve the cumulative flow diagram of a team", Tag: tagAnalytics,
+		Parameters: periodParams, Response: dto.CFDResponse{}, Errors: readErrors},
+
+	// Sprint routes
+	{Method: http.MethodGet, Path: "/api/sprints/{uuid}", OperationID: "RetrieveSprint", Summary: "Retrieve a sprint with its tasks", Tag: tagSprints,
+		Response: dto.SprintWithTasksResponse{}, Errors: readErrors},
+	{Method: http.MethodPost, Path: "/api/sprints/{uuid}/tasks", OperationID: "AssignTaskToSprint", Summary: "Plan a task in a sprint", Tag: tagSprints,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.TaskAssociationRequest{}, Errors: writeErrors},
+	{Method: http.MethodDelete, Path: "/api/sprints/{uuid}/tasks/{task_uuid}", OperationID: "UnassignTaskFromSprint", Summary: "Move a task of a sprint back to the backlog", Tag: tagSprints,
+		Errors: writeErrors},
+	{Method: http.MethodPost, Path: "/api/sprints/{uuid}/start", OperationID: "StartSprint", Summary: "Start a planned sprint", Tag: tagSprints,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Response: dto.SprintResponse{}, Errors: writeErrors},
+	{Method: http.MethodPost, Path: "/api/sprints/{uuid}/complete", OperationID: "CompleteSprint", Summary: "Complete the active sprint, rolling over its unfinished tasks", Tag: tagSprints,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Response: dto.SprintCompletionResponse{}, Errors: writeErrors},
+	{Method: http.MethodGet, Path: "/api/sprints/{uuid}/burndown", OperationID: "RetrieveSprintBurndown", Summary: "Retrieve the burndown of a sprint", Tag: tagAnalytics,
+		Response: dto.BurndownResponse{}, Errors: readErrors},
+
+	// Project routes
+	{Method: http.MethodPost, Path: "/api/projects", OperationID: "CreateProject", Summary: "Create a project", Tag: tagProjects,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.CreateProjectRequest{}, Response: dto.ProjectResponse{}, Errors: createErrors},
+	{Method: http.MethodGet, Path: "/api/projects", OperationID: "ListProjects", Summary: "List projects", Tag: tagProjects,
+		Parameters: pageParams, Response: dto.PaginatedProjectsResponse{}, Errors: listErrors},
+	{Method: http.MethodGet, Path: "/api/projects/{uuid}", OperationID: "RetrieveProject", Summary: "Retrieve a project with its milestones", Tag: tagProjects,
+		Response: dto.ProjectWithMilestonesResponse{}, Errors: readErrors},
+	{Method: http.MethodPost, Path: "/api/projects/{uuid}/milestones", OperationID: "CreateMilestone", Summary: "Create a milestone of a project", Tag: tagProjects,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.CreateMilestoneRequest{}, Response: dto.MilestoneResponse{}, Errors: writeErrors},
+	{Method: http.MethodPost, Path: "/api/projects/{uuid}/tasks", OperationID: "AddTaskToProject", Summary: "Add a task to a project", Tag: tagProjects,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.AddProjectTaskRequest{}, Errors: writeErrors},
+	{Method: http.MethodDelete, Path: "/api/projects/{uuid}/tasks/{task_uuid}", OperationID: "RemoveTaskFromProject", Summary: "Remove a task from a project", Tag: tagProjects,
+		Errors: writeErrors},
+	{Method: http.MethodGet, Path: "/api/projects/{uuid}/tasks", OperationID: "ListProjectTasks", Summary: "List the tasks of a project", Tag: tagProjects,
+		Parameters: slices.Concat([]openapi.Parameter{
+			statusParam,
+			sortParam,
+			openapi.QueryParam("team", "Filters tasks by team", openapi.StringSchema("uuid")),
+			openapi.QueryParam("milestone", "Filters tasks by milestone", openapi.StringSchema("uuid")),
+		}, pageParams),
+		Response: dto.PaginatedTasksResponse{}, Errors: readErrors},
+	{Method: http.MethodGet, Path: "/api/projects/{uuid}/progress", OperationID: "RetrieveProjectProgress", Summary: "Retrieve the progress of a project", Tag: tagProjects,
+		Response: dto.ProgressResponse{}, Errors: readErrors},
+
+	// Import routes
+	{Method: http.MethodPost, Path: "/api/imports", OperationID: "CreateImport", Summary: "Import tasks from a CSV or JSON file", Tag: tagImports,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Request: dto.CreateImportRequest{}, Status: http.StatusAccepted, Response: dto.ImportResponse{},
+		ResponseHeaders: map[string]string{"Location": "Status resource of the import"}, Errors: createErrors},
+	{Method: http.MethodGet, Path: "/api/imports/{uuid}", OperationID: "RetrieveImport", Summary: "Retrieve the status and report of an import", Tag: tagImports,
+		Response: dto.ImportResponse{}, Errors: readErrors},
+
+	// Webhook routes
+	{Method: http.MethodPost, Path: "/api/webhooks", OperationID: "CreateWebhook", Summary: "Subscribe to task events", Tag: tagWebhooks,
+		Parameters: []openapi.Parameter{idempotencyOnceParam}, Request: dto.CreateWebhookRequest{}, Response: dto.CreatedWebhookResponse{}, Errors: createOnceErrors},
+	{Method: http.MethodGet, Path: "/api/webhooks", OperationID: "ListWebhooks", Summary: "List webhook subscriptions", Tag: tagWebhooks,
+		Parameters: pageParams, Response: dto.PaginatedWebhooksResponse{}, Errors: listErrors},
+	{Method: http.MethodGet, Path: "/api/webhooks/{uuid}", OperationID: "RetrieveWebhook", Summary: "Retrieve a webhook subscription", Tag: tagWebhooks,
+		Response: dto.WebhookResponse{}, Errors: readErrors},
+	{Method: http.MethodDelete, Path: "/api/webhooks/{uuid}", OperationID: "DeleteWebhook", Summary: "Delete a webhook subscription", Tag: tagWebhooks,
+		Errors: writeErrors},
+	{Method: http.MethodGet, Path: "/api/webhooks/{uuid}/deliveries", OperationID: "ListWebhookDeliveries", Summary: "List the deliveries of a webhook subscription", Tag: tagWebhooks,
+		Parameters: pageParams, Response: dto.PaginatedWebhookDeliveriesResponse{}, Errors: readErrors},
+	{Method: http.MethodGet, Path: "/api/webhooks/{uuid}/deliveries/{delivery_uuid}", OperationID: "RetrieveWebhookDelivery", Summary: "Retrieve a delivery with its payload", Tag: tagWebhooks,
+		Response: dto.WebhookDeliveryWithPayloadResponse{}, Errors: readErrors},
+	{Method: http.MethodPost, Path: "/api/webhooks/{uuid}/deliveries/{delivery_uuid}/redeliver", OperationID: "RedeliverWebhook", Summary: "Send a delivery again", Tag: tagWebhooks,
+		Parameters: []openapi.Parameter{idempotencyKeyParam}, Status: http.StatusAccepted, Response: dto.WebhookDeliveryResponse{},
+		ResponseHeaders: map[string]string{"Location": "The new delivery"}, Errors: writeErrors},
+
+	// Event stream routes
+	{Method: http.MethodGet, Path: "/api/events", OperationID: "StreamEvents", Summary: "Stream task events as server-sent events", Tag: tagRealtime,
+		Parameters: []openapi.Parameter{
+			openapi.QueryParam("team", "Streams only the events of a team", openapi.StringSchema("uuid")),
+			openapi.HeaderParam("Last-Event-ID", "Resumes the stream after this event"),
+		},
+		Response: "", ResponseMediaTypes: []string{"text/event-stream"}, Errors: listErrors},
+
+	// Board channel routes
+	{Method: http.MethodGet, Path: "/api/board/ws", OperationID: "BoardChannel", Summary: "Open the WebSocket collaboration channel of the team boards", Tag: tagRealtime,
+		Parameters: []openapi.Parameter{
+			openapi.QueryParam("token", "Viewer token, when not sent as an Authorization bearer", openapi.StringSchema("")),
+			openapi.HeaderParam("Authorization", "Viewer token as a bearer"),
+		},
+		Status: http.StatusSwitchingProtocols, Errors: []int{http.StatusUnauthorized}},
+}
+
+// apiSpec builds the OpenAPI document of the API from apiRoutes
+var apiSpec = openapi.Spec{
+	Info: openapi.Info{
+		Title:   "Task Manager API",
+		Version: "1.0.0",
+		Description: "Errors are problem details (RFC 9457); send Prefer: legacy-errors for the previous bodies. " +
+			"Validation messages follow Accept-Language (en, pt-BR).",
+	},
+	PathFormat:     "uuid",
+	ErrorBody:      httputil.Problem{},
+	ErrorMediaType: httputil.ProblemContentType,
+	Routes:         apiRoutes,
+}
@@ -0,0 +1,30 @@
+package transport
+
+import (
+	_ "embed"
+	"net/http"
+	"sync"
+
+	httputil "taskmanager/internal/platform/http"
+)
+
+// apiDocsPage is the API reference page, which renders /api/openapi.json with Redoc
+//
+//go:embed apidocs.html
+var apiDocsPage []byte
+
+// openAPIDocument builds the OpenAPI document once, on its first request
+var openAPIDocument = sync.OnceValue(apiSpec.Build)
+
+// RetrieveOpenAPI returns the OpenAPI 3.1 document of the API, built from apiRoutes and the dto types
+func RetrieveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	statusCode, body := httputil.HandleErrorResponse(nil, openAPIDocument())
+	httputil.WriteResponse(w, r, statusCode, body)
+}
+
+// RetrieveAPIDocs returns the API reference page
+func RetrieveAPIDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(apiDocsPage)
+}
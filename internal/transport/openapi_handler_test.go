@@ -0,0 +1,95 @@
+//go:build test
+
+package transport
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/internal/platform/testing/venomtest"
+)
+
+func TestOpenAPIRoutes(t *testing.T) {
+	routes := map[string]bool{}
+	err := chi.Walk(Routes(nil).(chi.Routes), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		routes[method+" "+route] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("chi.Walk() error = %v", err)
+	}
+
+	documented := map[string]bool{}
+	operationIDs := map[string]bool{}
+	for _, route := range apiRoutes {
+		key := route.Method + " " + route.Path
+		if documented[key] {
+			t.Errorf("route %s is documented twice", key)
+		}
+		documented[key] = true
+
+		if operationIDs[route.OperationID] {
+			t.Errorf("operation ID %s of route %s is not unique", route.OperationID, key)
+		}
+		operationIDs[route.OperationID] = true
+	}
+
+	for key := range routes {
+		if !documented[key] {
+			t.Errorf("route %s has no entry in apiRoutes", key)
+		}
+	}
+	for key := range documented {
+		if !routes[key] {
+			t.Errorf("apiRoutes documents %s, which is not a route", key)
+		}
+	}
+
+	doc := apiSpec.Build()
+	for path, item := range doc.Paths {
+		for method := range item {
+			if !routes[strings.ToUpper(method)+" "+path] {
+				t.Errorf("document has operation %s %s, which is not a route", method, path)
+			}
+		}
+	}
+}
+
+func TestRetrieveOpenAPI(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", nil, "success/openapi/retrieve.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve OpenAPI "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
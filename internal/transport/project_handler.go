@@ -0,0 +1,172 @@
+package transport
+
+import (
+	"log/slog"
+	"net/http"
+
+	projectEntity "taskmanager/internal/entity/project"
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/transport/dto"
+	"taskmanager/internal/usecase/project"
+)
+
+// CreateProject creates a new project
+func CreateProject(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	var req dto.CreateProjectRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for create project", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	p := req.ToProject()
+	if err := project.Create(r.Context(), p); err != nil {
+		slog.Error("error creating project", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToProjectResponse(*p))
+}
+
+// ListProjects lists projects with pagination
+func ListProjects(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	page, limit := httputil.PaginationParams(r)
+
+	result, err := project.ListPaginated(r.Context(), page, limit)
+	if err != nil {
+		slog.Error("error listing projects", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToPaginatedProjectsResponse(*result))
+}
+
+// RetrieveProject retrieves a project by UUID with its milestones
+func RetrieveProject(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	projectUUID := httputil.URLParamUUID(r, "uuid")
+
+	p, err := project.RetrieveByUUID(r.Context(), projectUUID)
+	if err != nil {
+		slog.Error("error retrieving project", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToProjectWithMilestonesResponse(*p))
+}
+
+// CreateMilestone creates a milestone of a project
+func CreateMilestone(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	projectUUID := httputil.URLParamUUID(r, "uuid")
+
+	var req dto.CreateMilestoneRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for create milestone", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	m, err := req.ToMilestone()
+	if err != nil {
+		slog.Error("error parsing target date for create milestone", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	if err := project.CreateMilestone(r.Context(), projectUUID, m); err != nil {
+		slog.Error("error creating milestone", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToMilestoneResponse(*m))
+}
+
+// AddTaskToProject adds a task of any team to a project, optionally in one of its milestones
+func AddTaskToProject(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	projectUUID := httputil.URLParamUUID(r, "uuid")
+
+	var req dto.AddProjectTaskRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for add task to project", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	taskUUID, milestoneUUID, err := req.ToUUIDs()
+	if err != nil {
+		slog.Error("error parsing UUIDs for add task to project", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	if err := project.AddTask(r.Context(), projectUUID, taskUUID, milestoneUUID); err != nil {
+		slog.Error("error adding task to project", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return http.StatusOK, []byte{}
+}
+
+// RemoveTaskFromProject removes a task from a project and from its milestone
+func RemoveTaskFromProject(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	projectUUID := httputil.URLParamUUID(r, "uuid")
+	taskUUID := httputil.URLParamUUID(r, "task_uuid")
+
+	if err := project.RemoveTask(r.Context(), projectUUID, taskUUID); err != nil {
+		slog.Error("error removing task from project", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return http.StatusOK, []byte{}
+}
+
+// ListProjectTasks lists the tasks of a project across its teams, with the status, team and milestone filters
+func ListProjectTasks(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	projectUUID := httputil.URLParamUUID(r, "uuid")
+
+	page, limit := httputil.PaginationParams(r)
+
+	var (
+		filter projectEntity.TaskFilter
+		err    error
+	)
+
+	filter.Status, err = dto.ToTaskStatus(httputil.QueryParam(r, "status"))
+	if err != nil {
+		slog.Error("error parsing status filter for list project tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	filter.TeamUUID, err = dto.ToUUIDFilter(httputil.QueryParam(r, "team"), "team")
+	if err != nil {
+		slog.Error("error parsing team filter for list project tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	filter.MilestoneUUID, err = dto.ToUUIDFilter(httputil.QueryParam(r, "milestone"), "milestone")
+	if err != nil {
+		slog.Error("error parsing milestone filter for list project tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	sort, err := dto.ToTaskSort(httputil.QueryParam(r, "sort"), nil)
+	if err != nil {
+		slog.Error("error parsing sort for list project tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	result, err := project.ListTasks(r.Context(), projectUUID, filter, sort, page, limit)
+	if err != nil {
+		slog.Error("error listing project tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToPaginatedTasksResponse(result.Page, result.Limit, result.TotalItems, result.Tasks))
+}
+
+// RetrieveProjectProgress retrieves the done and total tasks of a project, per milestone and per team
+func RetrieveProjectProgress(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	projectUUID := httputil.URLParamUUID(r, "uuid")
+
+	progress, err := project.Progress(r.Context(), projectUUID)
+	if err != nil {
+		slog.Error("error retrieving project progress", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToProgressResponse(*progress))
+}
@@ -0,0 +1,303 @@
+//go:build test
+
+package transport
+
+import (
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/internal/platform/testing/venomtest"
+)
+
+func TestCreateProject(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithProjects(env) }, "success/projects/create/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithProjects(env) }, "failure/projects/create/bad_request.yml"},
+		{"with validation errors", func() { resetWithProjects(env) }, "failure/projects/create/validation_errors.yml"},
+		{"with missing content type", func() { resetWithProjects(env) }, "failure/projects/create/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Create project "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestListProjects(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithProjects(env) }, "success/projects/list/basic.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("List projects "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveProject(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithProjects(env) }, "success/projects/retrieve/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithProjects(env) }, "failure/projects/retrieve/bad_request.yml"},
+		{"with not found", func() { resetWithProjects(env) }, "failure/projects/retrieve/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve project "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestCreateMilestone(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithProjects(env) }, "success/projects/milestones/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithProjects(env) }, "failure/projects/milestones/bad_request.yml"},
+		{"with validation errors", func() { resetWithProjects(env) }, "failure/projects/milestones/validation_errors.yml"},
+		{"with not found", func() { resetWithProjects(env) }, "failure/projects/milestones/not_found.yml"},
+		{"with missing content type", func() { resetWithProjects(env) }, "failure/projects/milestones/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Create milestone "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestAddTaskToProject(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithProjects(env) }, "success/projects/add_task/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithProjects(env) }, "failure/projects/add_task/bad_request.yml"},
+		{"with validation errors", func() { resetWithProjects(env) }, "failure/projects/add_task/validation_errors.yml"},
+		{"with not found", func() { resetWithProjects(env) }, "failure/projects/add_task/not_found.yml"},
+		{"with missing content type", func() { resetWithProjects(env) }, "failure/projects/add_task/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Add task to project "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRemoveTaskFromProject(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithProjects(env) }, "success/projects/remove_task/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithProjects(env) }, "failure/projects/remove_task/bad_request.yml"},
+		{"with validation errors", func() { resetWithProjects(env) }, "failure/projects/remove_task/validation_errors.yml"},
+		{"with not found", func() { resetWithProjects(env) }, "failure/projects/remove_task/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Remove task from project "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestListProjectTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithProjects(env) }, "success/projects/tasks/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithProjects(env) }, "failure/projects/tasks/bad_request.yml"},
+		{"with not found", func() { resetWithProjects(env) }, "failure/projects/tasks/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("List project tasks "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveProjectProgress(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithProjects(env) }, "success/projects/progress/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithProjects(env) }, "failure/projects/progress/bad_request.yml"},
+		{"with not found", func() { resetWithProjects(env) }, "failure/projects/progress/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve project progress "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
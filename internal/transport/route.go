@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"taskmanager/internal/platform/database"
+	httputil "taskmanager/internal/platform/http"
 	"taskmanager/internal/transport/middleware"
 
 	"github.com/go-chi/chi/v5"
@@ -13,29 +14,97 @@ import (
 // Routes defines the routes for the application
 func Routes(dbConnector database.Connector) http.Handler {
 	r := chi.NewRouter()
+	r.Use(chimw.RequestID)
 	r.Use(chimw.RequestLogger(middleware.NewJSONLogFormatter(nil)))
+	// Path params, query params and JSON bodies are validated against the OpenAPI document
+	r.Use(middleware.ValidateRequest(openAPIDocument()))
 	r.Get("/healthcheck", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	// Unknown routes and methods answer with problem details like the handlers
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		httputil.WriteResponse(w, r, http.StatusNotFound, nil)
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		httputil.WriteResponse(w, r, http.StatusMethodNotAllowed, nil)
+	})
+
 	dbTx := middleware.DatabaseWithTransaction(dbConnector)
 	dbNoTx := middleware.DatabaseWithoutTransaction(dbConnector)
+	dbStream := middleware.DatabaseStreamWithoutTransaction(dbConnector)
 
 	r.Route("/api", func(r chi.Router) {
+		// Documentation routes
+		r.Get("/openapi.json", RetrieveOpenAPI)
+		r.Get("/docs", RetrieveAPIDocs)
+
 		// Task routes
-		r.With(middleware.RequireContentTypeJSON).Post("/tasks", dbTx(CreateTask))
+		r.With(middleware.RequireContentTypeJSON).Post("/tasks", dbTx(middleware.Idempotent(CreateTask)))
 		r.Get("/tasks/{uuid}", dbNoTx(RetrieveByUUID))
 		r.With(middleware.RequireContentTypeJSON).Put("/tasks/{uuid}", dbTx(UpdateTask))
+		r.With(middleware.RequireContentTypePatch).Patch("/tasks/{uuid}", dbTx(PatchTask))
 		r.With(middleware.RequireContentTypeJSON).Delete("/tasks/{uuid}", dbTx(DeleteTask))
 		r.Get("/tasks", dbNoTx(ListTasks))
-		r.With(middleware.RequireContentTypeJSON).Post("/tasks/{uuid}/status", dbTx(UpdateTaskStatus))
+		r.Get("/tasks/search", dbNoTx(SearchTasks))
+		r.Get("/tasks/export", dbStream(ExportTasks))
+		r.With(middleware.RequireContentTypeJSON).Post("/tasks/{uuid}/status", dbTx(middleware.Idempotent(UpdateTaskStatus)))
+		r.With(middleware.RequireContentTypeJSON).Post("/tasks/{uuid}/move", dbTx(middleware.Idempotent(MoveTask)))
+		r.With(middleware.RequireContentTypeJSON).Post("/tasks/bulk", dbTx(middleware.Idempotent(BulkTasks)))
 
 		// Team routes
-		r.With(middleware.RequireContentTypeJSON).Post("/teams", dbTx(CreateTeam))
+		r.With(middleware.RequireContentTypeJSON).Post("/teams", dbTx(middleware.Idempotent(CreateTeam)))
 		r.Get("/teams", dbNoTx(ListTeams))
 		r.Get("/teams/{uuid}", dbNoTx(RetrieveTeamByUUID))
-		r.With(middleware.RequireContentTypeJSON).Post("/teams/{uuid}/tasks", dbTx(AssociateTaskToTeam))
+		r.With(middleware.RequireContentTypePatch).Patch("/teams/{uuid}", dbTx(PatchTeam))
+		r.With(middleware.RequireContentTypeJSON).Post("/teams/{uuid}/tasks", dbTx(middleware.Idempotent(AssociateTaskToTeam)))
 		r.With(middleware.RequireContentTypeJSON).Delete("/teams/{uuid}/tasks/{task_uuid}", dbTx(DisassociateTaskFromTeam))
+		r.Get("/teams/{uuid}/calendar.ics", dbStream(RetrieveTeamCalendar))
+		r.With(middleware.RequireContentTypeJSON).Post("/teams/{uuid}/calendar/token", dbTx(middleware.IdempotentWithoutReplay(RotateTeamCalendarToken)))
+		r.Get("/teams/{uuid}/board", dbNoTx(RetrieveTeamBoard))
+		r.With(middleware.RequireContentTypeJSON).Put("/teams/{uuid}/wip-limits", dbTx(UpdateTeamWIPLimits))
+		r.With(middleware.RequireContentTypeJSON).Post("/teams/{uuid}/sprints", dbTx(middleware.Idempotent(CreateSprint)))
+		r.Get("/teams/{uuid}/sprints", dbNoTx(ListTeamSprints))
+		r.Get("/teams/{uuid}/velocity", dbNoTx(RetrieveTeamVelocity))
+		r.Get("/teams/{uuid}/metrics", dbNoTx(RetrieveTeamMetrics))
+		r.Get("/teams/{uuid}/cfd", dbNoTx(RetrieveTeamCFD))
+
+		// Sprint routes
+		r.Get("/sprints/{uuid}", dbNoTx(RetrieveSprint))
+		r.With(middleware.RequireContentTypeJSON).Post("/sprints/{uuid}/tasks", dbTx(middleware.Idempotent(AssignTaskToSprint)))
+		r.With(middleware.RequireContentTypeJSON).Delete("/sprints/{uuid}/tasks/{task_uuid}", dbTx(UnassignTaskFromSprint))
+		r.With(middleware.RequireContentTypeJSON).Post("/sprints/{uuid}/start", dbTx(middleware.Idempotent(StartSprint)))
+		r.With(middleware.RequireContentTypeJSON).Post("/sprints/{uuid}/complete", dbTx(middleware.Idempotent(CompleteSprint)))
+		r.Get("/sprints/{uuid}/burndown", dbNoTx(RetrieveSprintBurndown))
+
+		// Project routes
+		r.With(middleware.RequireContentTypeJSON).Post("/projects", dbTx(middleware.Idempotent(CreateProject)))
+		r.Get("/projects", dbNoTx(ListProjects))
+		r.Get("/projects/{uuid}", dbNoTx(RetrieveProject))
+		r.With(middleware.RequireContentTypeJSON).Post("/projects/{uuid}/milestones", dbTx(middleware.Idempotent(CreateMilestone)))
+		r.With(middleware.RequireContentTypeJSON).Post("/projects/{uuid}/tasks", dbTx(middleware.Idempotent(AddTaskToProject)))
+		r.With(middleware.RequireContentTypeJSON).Delete("/projects/{uuid}/tasks/{task_uuid}", dbTx(RemoveTaskFromProject))
+		r.Get("/projects/{uuid}/tasks", dbNoTx(ListProjectTasks))
+		r.Get("/projects/{uuid}/progress", dbNoTx(RetrieveProjectProgress))
+
+		// Import routes
+		r.With(middleware.RequireContentTypeJSON).Post("/imports", dbTx(middleware.Idempotent(CreateImport)))
+		r.Get("/imports/{uuid}", dbNoTx(RetrieveImport))
+
+		// Webhook routes
+		r.With(middleware.RequireContentTypeJSON).Post("/webhooks", dbTx(middleware.IdempotentWithoutReplay(CreateWebhook)))
+		r.Get("/webhooks", dbNoTx(ListWebhooks))
+		r.Get("/webhooks/{uuid}", dbNoTx(RetrieveWebhook))
+		r.With(middleware.RequireContentTypeJSON).Delete("/webhooks/{uuid}", dbTx(DeleteWebhook))
+		r.Get("/webhooks/{uuid}/deliveries", dbNoTx(ListWebhookDeliveries))
+		r.Get("/webhooks/{uuid}/deliveries/{delivery_uuid}", dbNoTx(RetrieveWebhookDelivery))
+		r.With(middleware.RequireContentTypeJSON).Post("/webhooks/{uuid}/deliveries/{delivery_uuid}/redeliver", dbTx(middleware.Idempotent(RedeliverWebhook)))
+
+		// Event stream routes
+		r.Get("/events", dbStream(StreamEvents))
+
+		// Board channel routes
+		r.Get("/board/ws", BoardChannel(dbConnector))
 	})
 	return r
 }
@@ -0,0 +1,146 @@
+package transport
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/transport/dto"
+	"taskmanager/internal/usecase/sprint"
+)
+
+// CreateSprint creates a planned sprint for a team
+func CreateSprint(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	var req dto.CreateSprintRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for create sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	s, err := req.ToSprint()
+	if err != nil {
+		slog.Error("error parsing dates for create sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	if err := sprint.Create(r.Context(), teamUUID, s); err != nil {
+		slog.Error("error creating sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToSprintResponse(*s))
+}
+
+// ListTeamSprints lists the sprints of a team with pagination, in start date order
+func ListTeamSprints(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	page, limit := httputil.PaginationParams(r)
+
+	result, err := sprint.ListByTeam(r.Context(), teamUUID, page, limit)
+	if err != nil {
+		slog.Error("error listing team sprints", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToPaginatedSprintsResponse(*result))
+}
+
+// RetrieveTeamVelocity retrieves the velocity of a team over its last completed sprints
+func RetrieveTeamVelocity(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	sprints, err := dto.ToVelocitySprints(httputil.QueryParam(r, "sprints"))
+	if err != nil {
+		slog.Error("error parsing sprints for retrieve team velocity", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	v, err := sprint.Velocity(r.Context(), teamUUID, sprints)
+	if err != nil {
+		slog.Error("error retrieving team velocity", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToVelocityResponse(teamUUID, *v))
+}
+
+// RetrieveSprint retrieves a sprint by UUID with its tasks
+func RetrieveSprint(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	sprintUUID := httputil.URLParamUUID(r, "uuid")
+
+	s, err := sprint.RetrieveWithTasks(r.Context(), sprintUUID)
+	if err != nil {
+		slog.Error("error retrieving sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToSprintWithTasksResponse(*s))
+}
+
+// AssignTaskToSprint plans a task of the sprint team in a sprint
+func AssignTaskToSprint(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	sprintUUID := httputil.URLParamUUID(r, "uuid")
+
+	var req dto.TaskAssociationRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for assign task to sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	taskUUID, err := uuid.Parse(req.TaskUUID)
+	if err != nil {
+		slog.Error("error parsing task UUID for assign task to sprint", "error", err)
+		return httputil.BadRequest("invalid task_uuid format", "task_uuid")
+	}
+
+	if err := sprint.AssignTask(r.Context(), sprintUUID, taskUUID); err != nil {
+		slog.Error("error assigning task to sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return http.StatusOK, []byte{}
+}
+
+// UnassignTaskFromSprint moves a task of a sprint back to the team backlog
+func UnassignTaskFromSprint(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	sprintUUID := httputil.URLParamUUID(r, "uuid")
+	taskUUID := httputil.URLParamUUID(r, "task_uuid")
+
+	if err := sprint.UnassignTask(r.Context(), sprintUUID, taskUUID); err != nil {
+		slog.Error("error unassigning task from sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return http.StatusOK, []byte{}
+}
+
+// StartSprint starts a planned sprint; a team has one active sprint at a time
+func StartSprint(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	sprintUUID := httputil.URLParamUUID(r, "uuid")
+
+	s, err := sprint.Start(r.Context(), sprintUUID)
+	if err != nil {
+		slog.Error("error starting sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToSprintResponse(*s))
+}
+
+// CompleteSprint completes an active sprint, rolling its unfinished tasks into the next planned sprint
+func CompleteSprint(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	sprintUUID := httputil.URLParamUUID(r, "uuid")
+
+	c, err := sprint.Complete(r.Context(), sprintUUID)
+	if err != nil {
+		slog.Error("error completing sprint", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToSprintCompletionResponse(*c))
+}
@@ -0,0 +1,267 @@
+//go:build test
+
+package transport
+
+import (
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/internal/platform/testing/venomtest"
+)
+
+func TestCreateSprint(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSprints(env) }, "success/sprints/create/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithSprints(env) }, "failure/sprints/create/bad_request.yml"},
+		{"with validation errors", func() { resetWithSprints(env) }, "failure/sprints/create/validation_errors.yml"},
+		{"with not found", func() { resetWithSprints(env) }, "failure/sprints/create/not_found.yml"},
+		{"with missing content type", func() { resetWithSprints(env) }, "failure/sprints/create/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Create sprint "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestListTeamSprints(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSprints(env) }, "success/sprints/list/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithSprints(env) }, "failure/sprints/list/bad_request.yml"},
+		{"with not found", func() { resetWithSprints(env) }, "failure/sprints/list/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("List team sprints "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveSprint(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSprints(env) }, "success/sprints/retrieve/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithSprints(env) }, "failure/sprints/retrieve/bad_request.yml"},
+		{"with not found", func() { resetWithSprints(env) }, "failure/sprints/retrieve/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve sprint "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestSprintTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSprints(env) }, "success/sprints/tasks/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithSprints(env) }, "failure/sprints/tasks/bad_request.yml"},
+		{"with validation errors", func() { resetWithSprints(env) }, "failure/sprints/tasks/validation_errors.yml"},
+		{"with missing content type", func() { resetWithSprints(env) }, "failure/sprints/tasks/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Sprint tasks "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestStartSprint(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSprints(env) }, "success/sprints/start/basic.yml"},
+		// Failure
+		{"with validation errors", func() { resetWithSprints(env) }, "failure/sprints/start/validation_errors.yml"},
+		{"with not found", func() { resetWithSprints(env) }, "failure/sprints/start/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Start sprint "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestCompleteSprint(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSprints(env) }, "success/sprints/complete/basic.yml"},
+		// Failure
+		{"with validation errors", func() { resetWithSprints(env) }, "failure/sprints/complete/validation_errors.yml"},
+		{"with not found", func() { resetWithSprints(env) }, "failure/sprints/complete/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Complete sprint "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveTeamVelocity(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithSprints(env) }, "success/sprints/velocity/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithSprints(env) }, "failure/sprints/velocity/bad_request.yml"},
+		{"with not found", func() { resetWithSprints(env) }, "failure/sprints/velocity/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve team velocity "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
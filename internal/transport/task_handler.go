@@ -1,12 +1,10 @@
 package transport
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
-	"strconv"
-
-	"github.com/go-chi/chi/v5"
-	"github.com/google/uuid"
+	"strings"
 
 	taskEntity "taskmanager/internal/entity/task"
 	httputil "taskmanager/internal/platform/http"
@@ -28,16 +26,14 @@ func CreateTask(w http.ResponseWriter, r *http.Request) (int, []byte) {
 		return httputil.HandleErrorResponse(err, nil)
 	}
 
+	httputil.SetETag(w, t.Version)
 	return httputil.HandleErrorResponse(nil, dto.ToTaskResponse(*t))
 }
 
-// RetrieveByUUID retrieves a task by UUID
+// RetrieveByUUID retrieves a task by UUID, with its version as the ETag and its updated_at as Last-Modified
+// Answered with 304 when If-None-Match or If-Modified-Since hold (see middleware.DatabaseWithoutTransaction)
 func RetrieveByUUID(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	taskUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
-	if err != nil {
-		slog.Error("error parsing UUID from path for retrieve task", "error", err)
-		return httputil.BadRequest("invalid uuid format", "uuid")
-	}
+	taskUUID := httputil.URLParamUUID(r, "uuid")
 
 	t, err := task.RetrieveByUUID(r.Context(), taskUUID)
 	if err != nil {
@@ -45,15 +41,20 @@ func RetrieveByUUID(w http.ResponseWriter, r *http.Request) (int, []byte) {
 		return httputil.HandleErrorResponse(err, nil)
 	}
 
+	httputil.SetETag(w, t.Version)
+	httputil.SetLastModified(w, t.UpdatedAt)
 	return httputil.HandleErrorResponse(nil, dto.ToTaskResponse(*t))
 }
 
 // UpdateTask updates an existing task
+// Refused with 412 when If-Match does not match the current version
 func UpdateTask(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	taskUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	taskUUID := httputil.URLParamUUID(r, "uuid")
+
+	ifMatch, err := httputil.IfMatchVersion(r)
 	if err != nil {
-		slog.Error("error parsing UUID from path for update task", "error", err)
-		return httputil.BadRequest("invalid uuid format", "uuid")
+		slog.Error("error parsing If-Match for update task", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
 	}
 
 	var req dto.UpdateTaskRequest
@@ -65,26 +66,60 @@ func UpdateTask(w http.ResponseWriter, r *http.Request) (int, []byte) {
 	updates := map[string]any{
 		"title":       req.Title,
 		"description": req.Description,
+		"estimate":    req.Estimate,
 	}
 
-	t, err := task.Update(r.Context(), taskUUID, updates)
+	t, err := task.Update(r.Context(), taskUUID, updates, ifMatch)
 	if err != nil {
 		slog.Error("error updating task", "error", err)
 		return httputil.HandleErrorResponse(err, nil)
 	}
 
+	httputil.SetETag(w, t.Version)
+	return httputil.HandleErrorResponse(nil, dto.ToTaskResponse(*t))
+}
+
+// PatchTask partially updates a task with a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902)
+// Refused with 412 when If-Match does not match the current version
+func PatchTask(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	taskUUID := httputil.URLParamUUID(r, "uuid")
+
+	ifMatch, err := httputil.IfMatchVersion(r)
+	if err != nil {
+		slog.Error("error parsing If-Match for patch task", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	p, err := httputil.DecodePatchBody(r)
+	if err != nil {
+		slog.Error("error decoding patch body for patch task", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	t, err := task.Patch(r.Context(), taskUUID, func(t *taskEntity.Task) error {
+		return dto.PatchTask(t, p)
+	}, ifMatch)
+	if err != nil {
+		slog.Error("error patching task", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	httputil.SetETag(w, t.Version)
 	return httputil.HandleErrorResponse(nil, dto.ToTaskResponse(*t))
 }
 
 // DeleteTask deletes a task (soft delete)
+// Refused with 412 when If-Match does not match the current version
 func DeleteTask(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	taskUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	taskUUID := httputil.URLParamUUID(r, "uuid")
+
+	ifMatch, err := httputil.IfMatchVersion(r)
 	if err != nil {
-		slog.Error("error parsing UUID from path for delete task", "error", err)
-		return httputil.BadRequest("invalid uuid format", "uuid")
+		slog.Error("error parsing If-Match for delete task", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
 	}
 
-	if err := task.Delete(r.Context(), taskUUID); err != nil {
+	if err := task.Delete(r.Context(), taskUUID, ifMatch); err != nil {
 		slog.Error("error deleting task", "error", err)
 		return httputil.HandleErrorResponse(err, nil)
 	}
@@ -93,45 +128,139 @@ func DeleteTask(w http.ResponseWriter, r *http.Request) (int, []byte) {
 }
 
 // ListTasks lists all tasks with optional status filter and pagination
+// Uses keyset (cursor) pagination when requested through the cursor query params
+// The ETag is a digest of the page, so conditional requests are answered with 304 while it is unchanged
 func ListTasks(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	pageParam := httputil.QueryParam(r, "page")
-	page := 1
-	if pageParam != "" {
-		if parsedPage, err := strconv.Atoi(pageParam); err == nil && parsedPage > 0 {
-			page = parsedPage
-		}
+	page, limit := httputil.PaginationParams(r)
+
+	statusFilter := httputil.QueryParam(r, "status")
+	status, err := dto.ToTaskStatus(statusFilter)
+	if err != nil {
+		slog.Error("error listing tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
 	}
 
-	limitParam := httputil.QueryParam(r, "limit")
-	limit := 0
-	if limitParam != "" {
-		if parsedLimit, err := strconv.Atoi(limitParam); err == nil {
-			limit = parsedLimit
+	cursorQuery, err := httputil.CursorParams(r)
+	if err != nil {
+		slog.Error("error parsing cursor params for list tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	sort, err := dto.ToTaskSort(httputil.QueryParam(r, "sort"), cursorQuery.Cursor)
+	if err != nil {
+		slog.Error("error parsing sort for list tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	if cursorQuery.Enabled {
+		result, err := task.ListByCursor(r.Context(), status, sort, cursorQuery.Cursor, limit, cursorQuery.WithTotal)
+		if err != nil {
+			slog.Error("error listing tasks by cursor", "error", err)
+			return httputil.HandleErrorResponse(err, nil)
 		}
+
+		statusCode, body := httputil.HandleErrorResponse(nil, dto.ToCursorTasksResponse(*result, cursorQuery.WithTotal))
+		httputil.SetContentETag(w, body)
+		httputil.SetLastModified(w, result.LastModified())
+		return statusCode, body
 	}
 
-	statusFilter := httputil.QueryParam(r, "status")
-	status, err := dto.ToTaskStatus(statusFilter)
+	result, err := task.ListPaginated(r.Context(), status, sort, page, limit)
 	if err != nil {
 		slog.Error("error listing tasks", "error", err)
 		return httputil.HandleErrorResponse(err, nil)
 	}
 
-	result, err := task.ListPaginated(r.Context(), status, page, limit)
+	statusCode, body := httputil.HandleErrorResponse(nil, dto.ToPaginatedTasksResponse(result.Page, result.Limit, result.TotalItems, result.Tasks))
+	httputil.SetContentETag(w, body)
+	httputil.SetLastModified(w, result.LastModified())
+	return statusCode, body
+}
+
+// SearchTasks searches tasks by title and description using full-text search
+func SearchTasks(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	term := strings.TrimSpace(httputil.QueryParam(r, "q"))
+	if term == "" {
+		return httputil.BadRequest("q is required", "q")
+	}
+
+	page, limit := httputil.PaginationParams(r)
+
+	result, err := task.Search(r.Context(), term, page, limit)
 	if err != nil {
-		slog.Error("error listing tasks", "error", err)
+		slog.Error("error searching tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToPaginatedTaskSearchResponse(result.Page, result.Limit, result.TotalItems, result.Results))
+}
+
+// ExportTasks streams the tasks matching the list filters as CSV, NDJSON or Markdown
+// Headers are sent with the first batch, so errors before it are still returned as JSON;
+// an error after it can only abort the stream
+func ExportTasks(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	status, err := dto.ToTaskStatus(httputil.QueryParam(r, "status"))
+	if err != nil {
+		slog.Error("error exporting tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	sort, err := dto.ToTaskSort(httputil.QueryParam(r, "sort"), nil)
+	if err != nil {
+		slog.Error("error exporting tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	format, err := dto.ToExportFormat(httputil.QueryParam(r, "format"))
+	if err != nil {
+		slog.Error("error exporting tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	writer := dto.NewTaskExportWriter(format, w)
+	started := false
+	start := func() {
+		if started {
+			return
+		}
+		started = true
+		w.Header().Set("Content-Type", writer.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="tasks.%s"`, writer.FileExtension()))
+		w.WriteHeader(http.StatusOK)
+	}
+
+	err = task.Export(r.Context(), status, sort, func(items []taskEntity.ExportItem) error {
+		start()
+		if err := writer.Write(items); err != nil {
+			return err
+		}
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		slog.Error("error exporting tasks", "error", err, "started", started)
 		return httputil.HandleErrorResponse(err, nil)
 	}
 
-	return httputil.HandleErrorResponse(nil, dto.ToPaginatedTasksResponse(result.Page, result.Limit, result.TotalItems, result.Tasks))
+	start()
+	if err := writer.Close(); err != nil {
+		slog.Error("error finishing tasks export", "error", err)
+	}
+
+	return http.StatusOK, nil
 }
 
 // UpdateTaskStatus updates the status of a task
+// Refused with 412 when If-Match does not match the current version
 func UpdateTaskStatus(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	taskUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	taskUUID := httputil.URLParamUUID(r, "uuid")
+
+	ifMatch, err := httputil.IfMatchVersion(r)
 	if err != nil {
-		slog.Error("error parsing UUID from path for update task status", "error", err)
-		return httputil.BadRequest("invalid uuid format", "uuid")
+		slog.Error("error parsing If-Match for update task status", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
 	}
 
 	var req dto.StatusUpdateRequest
@@ -142,10 +271,61 @@ func UpdateTaskStatus(w http.ResponseWriter, r *http.Request) (int, []byte) {
 
 	newStatus := taskEntity.TaskStatus(req.Status)
 
-	if err := task.UpdateStatus(r.Context(), taskUUID, newStatus); err != nil {
+	if err := task.UpdateStatus(r.Context(), taskUUID, newStatus, req.OverrideWIPLimit, ifMatch); err != nil {
 		slog.Error("error updating task status", "error", err)
 		return httputil.HandleErrorResponse(err, nil)
 	}
 
 	return http.StatusOK, []byte{}
 }
+
+// MoveTask moves a task within its status column, between the given neighbors
+func MoveTask(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	taskUUID := httputil.URLParamUUID(r, "uuid")
+
+	var req dto.MoveTaskRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for move task", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	previousUUID, nextUUID, err := req.ToNeighbors()
+	if err != nil {
+		slog.Error("error parsing neighbors for move task", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	t, err := task.Move(r.Context(), taskUUID, previousUUID, nextUUID)
+	if err != nil {
+		slog.Error("error moving task", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	httputil.SetETag(w, t.Version)
+	return httputil.HandleErrorResponse(nil, dto.ToTaskResponse(*t))
+}
+
+// BulkTasks applies a batch of operations to tasks
+// all_or_nothing rolls back the whole batch on the first failure; best_effort reports failures per item
+func BulkTasks(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	var req dto.BulkTaskRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for bulk tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	operations, err := req.ToBulkOperations()
+	if err != nil {
+		slog.Error("error parsing operations for bulk tasks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	mode := req.ToBulkMode()
+	results, err := task.Bulk(r.Context(), mode, operations)
+	if err != nil {
+		slog.Error("error applying bulk task operations", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToBulkTaskResponse(mode, results))
+}
@@ -34,10 +34,13 @@ func TestCreateTask(t *testing.T) {
 		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/create/basic.yml"},
 		{"with success (edge cases)", func() { resetWithMinimalData(env) }, "success/tasks/create/edge_cases.yml"},
 		{"with success (corner cases)", func() { resetWithMinimalData(env) }, "success/tasks/create/corner_cases.yml"},
+		{"with success (idempotency)", func() { resetWithMinimalData(env) }, "success/tasks/create/idempotency.yml"},
 		// Failure
 		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/create/bad_request.yml"},
 		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/tasks/create/validation_errors.yml"},
+		{"with localized validation errors", func() { resetWithMinimalData(env) }, "failure/tasks/create/localized_errors.yml"},
 		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/tasks/create/missing_content_type.yml"},
+		{"with idempotency key errors", func() { resetWithMinimalData(env) }, "failure/tasks/create/idempotency.yml"},
 	}
 
 	for _, tc := range tests {
@@ -73,11 +76,15 @@ func TestUpdateTask(t *testing.T) {
 		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/update/basic.yml"},
 		{"with success (edge cases)", func() { resetWithMinimalData(env) }, "success/tasks/update/edge_cases.yml"},
 		{"with success (corner cases)", func() { resetWithMinimalData(env) }, "success/tasks/update/corner_cases.yml"},
+		{"with success (if match)", func() { resetWithMinimalData(env) }, "success/tasks/update/if_match.yml"},
 		// Failure
 		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/update/bad_request.yml"},
 		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/tasks/update/validation_errors.yml"},
 		{"with not found", func() { resetWithMinimalData(env) }, "failure/tasks/update/not_found.yml"},
+		{"with precondition failed", func() { resetWithMinimalData(env) }, "failure/tasks/update/precondition_failed.yml"},
 		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/tasks/update/missing_content_type.yml"},
+		{"with problem details", func() { resetWithMinimalData(env) }, "failure/tasks/update/problem_details.yml"},
+		{"with legacy errors", func() { resetWithMinimalData(env) }, "failure/tasks/update/legacy_errors.yml"},
 	}
 
 	for _, tc := range tests {
@@ -90,6 +97,46 @@ func TestUpdateTask(t *testing.T) {
 	}
 }
 
+func TestPatchTask(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (merge patch)", func() { resetWithMinimalData(env) }, "success/tasks/patch/merge_patch.yml"},
+		{"with success (json patch)", func() { resetWithMinimalData(env) }, "success/tasks/patch/json_patch.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/patch/bad_request.yml"},
+		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/tasks/patch/validation_errors.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/tasks/patch/not_found.yml"},
+		{"with precondition failed", func() { resetWithMinimalData(env) }, "failure/tasks/patch/precondition_failed.yml"},
+		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/tasks/patch/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Patch task "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
 func TestDeleteTask(t *testing.T) {
 	env := testenv.Setup(t,
 		testenv.WithDatabase(
@@ -112,9 +159,11 @@ func TestDeleteTask(t *testing.T) {
 		// Success
 		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/delete/basic.yml"},
 		{"with success (corner cases)", func() { resetWithMinimalData(env) }, "success/tasks/delete/corner_cases.yml"},
+		{"with success (if match)", func() { resetWithMinimalData(env) }, "success/tasks/delete/if_match.yml"},
 		// Failure
 		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/delete/bad_request.yml"},
 		{"with not found", func() { resetWithMinimalData(env) }, "failure/tasks/delete/not_found.yml"},
+		{"with precondition failed", func() { resetWithMinimalData(env) }, "failure/tasks/delete/precondition_failed.yml"},
 		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/tasks/delete/missing_content_type.yml"},
 	}
 
@@ -150,6 +199,7 @@ func TestRetrieveTask(t *testing.T) {
 		// Success
 		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/retrieve/basic.yml"},
 		{"with success (corner cases)", func() { resetWithMinimalData(env) }, "success/tasks/retrieve/corner_cases.yml"},
+		{"with success (conditional)", func() { resetWithMinimalData(env) }, "success/tasks/retrieve/conditional.yml"},
 		// Failure
 		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/retrieve/bad_request.yml"},
 		{"with not found", func() { resetWithMinimalData(env) }, "failure/tasks/retrieve/not_found.yml"},
@@ -189,6 +239,9 @@ func TestListTasks(t *testing.T) {
 		{"with success (edge cases)", func() { resetWithMinimalData(env) }, "success/tasks/list/edge_cases.yml"},
 		{"with success (corner cases)", func() { resetWithMinimalData(env) }, "success/tasks/list/corner_cases.yml"},
 		{"with success (data consistency)", func() { resetWithMinimalData(env) }, "success/tasks/list/list_data_consistency.yml"},
+		{"with success (cursor)", func() { resetWithMinimalData(env) }, "success/tasks/list/cursor.yml"},
+		{"with success (rank)", func() { resetWithMinimalData(env) }, "success/tasks/list/rank.yml"},
+		{"with success (conditional)", func() { resetWithMinimalData(env) }, "success/tasks/list/conditional.yml"},
 		// Failure
 		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/list/bad_request.yml"},
 	}
@@ -203,6 +256,41 @@ func TestListTasks(t *testing.T) {
 	}
 }
 
+func TestSearchTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/search/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/search/bad_request.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Search tasks "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
 func TestUpdateTaskStatus(t *testing.T) {
 	env := testenv.Setup(t,
 		testenv.WithDatabase(
@@ -224,10 +312,12 @@ func TestUpdateTaskStatus(t *testing.T) {
 	}{
 		// Success
 		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/status/basic.yml"},
+		{"with success (if match)", func() { resetWithMinimalData(env) }, "success/tasks/status/if_match.yml"},
 		// Failure
 		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/status/bad_request.yml"},
 		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/tasks/status/validation_errors.yml"},
 		{"with not found", func() { resetWithMinimalData(env) }, "failure/tasks/status/not_found.yml"},
+		{"with precondition failed", func() { resetWithMinimalData(env) }, "failure/tasks/status/precondition_failed.yml"},
 		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/tasks/status/missing_content_type.yml"},
 	}
 
@@ -240,3 +330,113 @@ func TestUpdateTaskStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMoveTask(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/move/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/move/bad_request.yml"},
+		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/tasks/move/validation_errors.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/tasks/move/not_found.yml"},
+		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/tasks/move/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Move task "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestBulkTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/bulk/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/bulk/bad_request.yml"},
+		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/tasks/bulk/validation_errors.yml"},
+		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/tasks/bulk/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Bulk tasks "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestExportTasks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/tasks/export/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/tasks/export/bad_request.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Export tasks "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
@@ -3,12 +3,12 @@ package transport
 import (
 	"log/slog"
 	"net/http"
-	"strconv"
 
-	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	teamEntity "taskmanager/internal/entity/team"
 	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/platform/pagination"
 	"taskmanager/internal/transport/dto"
 	"taskmanager/internal/usecase/team"
 )
@@ -30,13 +30,11 @@ func CreateTeam(w http.ResponseWriter, r *http.Request) (int, []byte) {
 	return httputil.HandleErrorResponse(nil, dto.ToTeamResponse(*t))
 }
 
-// RetrieveTeamByUUID retrieves a team by UUID with its tasks
+// RetrieveTeamByUUID retrieves a team by UUID with its tasks, with its version and a digest of the
+// response as the ETag and the latest updated_at of the team and its tasks as Last-Modified
+// Answered with 304 when If-None-Match or If-Modified-Since hold (see middleware.DatabaseWithoutTransaction)
 func RetrieveTeamByUUID(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	teamUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
-	if err != nil {
-		slog.Error("error parsing UUID from path for retrieve team", "error", err)
-		return httputil.BadRequest("invalid uuid format", "uuid")
-	}
+	teamUUID := httputil.URLParamUUID(r, "uuid")
 
 	t, err := team.RetrieveByUUIDWithTasks(r.Context(), teamUUID)
 	if err != nil {
@@ -44,25 +42,33 @@ func RetrieveTeamByUUID(w http.ResponseWriter, r *http.Request) (int, []byte) {
 		return httputil.HandleErrorResponse(err, nil)
 	}
 
-	return httputil.HandleErrorResponse(nil, dto.ToTeamWithTasksResponse(*t))
+	// The response embeds the tasks, so the ETag also carries a digest of the body; If-Match
+	// on the WIP limits still compares only the version
+	statusCode, body := httputil.HandleErrorResponse(nil, dto.ToTeamWithTasksResponse(*t))
+	httputil.SetVersionedContentETag(w, t.Version, body)
+	httputil.SetLastModified(w, t.LastModified())
+	return statusCode, body
 }
 
 // ListTeams lists all teams with pagination
+// Uses keyset (cursor) pagination when requested through the cursor query params
 func ListTeams(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	pageParam := httputil.QueryParam(r, "page")
-	page := 1
-	if pageParam != "" {
-		if parsedPage, err := strconv.Atoi(pageParam); err == nil && parsedPage > 0 {
-			page = parsedPage
-		}
+	page, limit := httputil.PaginationParams(r)
+
+	cursorQuery, err := httputil.CursorParams(r)
+	if err != nil {
+		slog.Error("error parsing cursor params for list teams", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
 	}
 
-	limitParam := httputil.QueryParam(r, "limit")
-	limit := 0
-	if limitParam != "" {
-		if parsedLimit, err := strconv.Atoi(limitParam); err == nil {
-			limit = parsedLimit
+	if cursorQuery.Enabled {
+		result, err := team.ListByCursor(r.Context(), cursorQuery.Cursor, limit, cursorQuery.WithTotal)
+		if err != nil {
+			slog.Error("error listing teams by cursor", "error", err)
+			return httputil.HandleErrorResponse(err, nil)
 		}
+
+		return httputil.HandleErrorResponse(nil, dto.ToCursorTeamsResponse(*result, cursorQuery.WithTotal))
 	}
 
 	result, err := team.ListPaginated(r.Context(), page, limit)
@@ -76,15 +82,9 @@ func ListTeams(w http.ResponseWriter, r *http.Request) (int, []byte) {
 
 // AssociateTaskToTeam associates a task with a team
 func AssociateTaskToTeam(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	teamUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
-	if err != nil {
-		slog.Error("error parsing UUID from path for associate task to team", "error", err)
-		return httputil.BadRequest("invalid uuid format", "uuid")
-	}
+	teamUUID := httputil.URLParamUUID(r, "uuid")
 
-	var req struct {
-		TaskUUID string `json:"task_uuid"`
-	}
+	var req dto.TaskAssociationRequest
 	if err := httputil.DecodeJSONBody(r, &req); err != nil {
 		slog.Error("error decoding JSON body for associate task to team", "error", err)
 		return httputil.HandleErrorResponse(err, nil)
@@ -106,26 +106,142 @@ func AssociateTaskToTeam(w http.ResponseWriter, r *http.Request) (int, []byte) {
 
 // DisassociateTaskFromTeam disassociates a task from a team
 func DisassociateTaskFromTeam(w http.ResponseWriter, r *http.Request) (int, []byte) {
-	teamUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+	taskUUID := httputil.URLParamUUID(r, "task_uuid")
+
+	if err := team.DisassociateTask(r.Context(), teamUUID, taskUUID); err != nil {
+		slog.Error("error disassociating task from team", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return http.StatusOK, []byte{}
+}
+
+// RetrieveTeamCalendar renders the tasks of a team as an iCalendar (RFC 5545) feed
+// Access is granted by the feed token query param, so calendar clients need no auth headers
+func RetrieveTeamCalendar(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	t, err := team.RetrieveCalendar(r.Context(), teamUUID, httputil.QueryParam(r, "token"))
 	if err != nil {
-		slog.Error("error parsing UUID from path for disassociate task from team", "error", err)
-		return httputil.BadRequest("invalid uuid format", "uuid")
+		slog.Error("error retrieving team calendar", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
 	}
-	taskUUIDStr := chi.URLParam(r, "task_uuid")
-	if taskUUIDStr == "" {
-		return httputil.BadRequest("task_uuid is required", "task_uuid")
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(dto.ToTeamCalendar(*t).Marshal()); err != nil {
+		slog.Error("error writing team calendar", "error", err)
 	}
 
-	taskUUID, err := uuid.Parse(taskUUIDStr)
+	return http.StatusOK, nil
+}
+
+// RotateTeamCalendarToken generates a new calendar feed token for a team, revoking the previous one
+func RotateTeamCalendarToken(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	token, err := team.RotateCalendarToken(r.Context(), teamUUID)
 	if err != nil {
-		slog.Error("error parsing task UUID for disassociate task from team", "error", err)
-		return httputil.BadRequest("invalid task_uuid format", "task_uuid")
+		slog.Error("error rotating team calendar token", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
 	}
 
-	if err := team.DisassociateTask(r.Context(), teamUUID, taskUUID); err != nil {
-		slog.Error("error disassociating task from team", "error", err)
+	return httputil.HandleErrorResponse(nil, dto.ToCalendarTokenResponse(teamUUID, token))
+}
+
+// RetrieveTeamBoard retrieves the kanban board of a team, one cursor-paginated column per status
+// A column is paged by sending its status along with the cursor
+func RetrieveTeamBoard(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	status, err := dto.ToTaskStatus(httputil.QueryParam(r, "status"))
+	if err != nil {
+		slog.Error("error parsing status filter for retrieve team board", "error", err)
 		return httputil.HandleErrorResponse(err, nil)
 	}
 
-	return http.StatusOK, []byte{}
+	_, limit := httputil.PaginationParams(r)
+
+	cursor, err := pagination.DecodeCursor(httputil.QueryParam(r, "cursor"))
+	if err != nil {
+		slog.Error("error parsing cursor for retrieve team board", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	// Each column has its own cursor, so it only makes sense for a single column
+	if cursor != nil && status == nil {
+		return httputil.BadRequest("status is required with cursor", "status")
+	}
+
+	sort, err := dto.ToTaskSort(httputil.QueryParam(r, "sort"), cursor)
+	if err != nil {
+		slog.Error("error parsing sort for retrieve team board", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	board, err := team.RetrieveBoard(r.Context(), teamUUID, status, sort, cursor, limit)
+	if err != nil {
+		slog.Error("error retrieving team board", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToBoardResponse(*board))
+}
+
+// UpdateTeamWIPLimits replaces the WIP limits of a team
+// Refused with 412 when If-Match does not match the current version
+func UpdateTeamWIPLimits(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	ifMatch, err := httputil.IfMatchVersion(r)
+	if err != nil {
+		slog.Error("error parsing If-Match for update team wip limits", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	var req dto.WIPLimitsRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for update team wip limits", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	limits, err := team.UpdateWIPLimits(r.Context(), teamUUID, req.ToWIPLimits(), ifMatch)
+	if err != nil {
+		slog.Error("error updating team wip limits", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToWIPLimitsResponse(teamUUID, limits))
+}
+
+// PatchTeam partially updates the name, description and WIP limits of a team with a JSON Merge Patch
+// (RFC 7396) or a JSON Patch (RFC 6902)
+// Refused with 412 when If-Match does not match the current version
+func PatchTeam(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	teamUUID := httputil.URLParamUUID(r, "uuid")
+
+	ifMatch, err := httputil.IfMatchVersion(r)
+	if err != nil {
+		slog.Error("error parsing If-Match for patch team", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	p, err := httputil.DecodePatchBody(r)
+	if err != nil {
+		slog.Error("error decoding patch body for patch team", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	t, err := team.Patch(r.Context(), teamUUID, func(t *teamEntity.Team) error {
+		return dto.PatchTeam(t, p)
+	}, ifMatch)
+	if err != nil {
+		slog.Error("error patching team", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	httputil.SetETag(w, t.Version)
+	return httputil.HandleErrorResponse(nil, dto.ToPatchedTeamResponse(*t))
 }
@@ -73,6 +73,9 @@ func TestListTeams(t *testing.T) {
 		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/teams/list/basic.yml"},
 		{"with success (edge cases)", func() { resetWithMinimalData(env) }, "success/teams/list/edge_cases.yml"},
 		{"with success (corner cases)", func() { resetWithMinimalData(env) }, "success/teams/list/corner_cases.yml"},
+		{"with success (cursor)", func() { resetWithMinimalData(env) }, "success/teams/list/cursor.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/teams/list/bad_request.yml"},
 	}
 
 	for _, tc := range tests {
@@ -107,6 +110,7 @@ func TestRetrieveTeam(t *testing.T) {
 		// Success
 		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/teams/retrieve/basic.yml"},
 		{"with success (corner cases)", func() { resetWithMinimalData(env) }, "success/teams/retrieve/corner_cases.yml"},
+		{"with success (conditional)", func() { resetWithMinimalData(env) }, "success/teams/retrieve/conditional.yml"},
 		// Failure
 		{"with bad request", func() { resetWithMinimalData(env) }, "failure/teams/retrieve/bad_request.yml"},
 		{"with not found", func() { resetWithMinimalData(env) }, "failure/teams/retrieve/not_found.yml"},
@@ -197,3 +201,156 @@ func TestDisassociateTaskFromTeam(t *testing.T) {
 		})
 	}
 }
+
+func TestRetrieveTeamCalendar(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/teams/calendar/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/teams/calendar/bad_request.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/teams/calendar/not_found.yml"},
+		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/teams/calendar/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Team calendar "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveTeamBoard(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/teams/board/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/teams/board/bad_request.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/teams/board/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Team board "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestUpdateTeamWIPLimits(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/teams/wip_limits/basic.yml"},
+		{"with success (if match)", func() { resetWithMinimalData(env) }, "success/teams/wip_limits/if_match.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/teams/wip_limits/bad_request.yml"},
+		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/teams/wip_limits/validation_errors.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/teams/wip_limits/not_found.yml"},
+		{"with precondition failed", func() { resetWithMinimalData(env) }, "failure/teams/wip_limits/precondition_failed.yml"},
+		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/teams/wip_limits/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Team WIP limits "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestPatchTeam(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (merge patch)", func() { resetWithMinimalData(env) }, "success/teams/patch/merge_patch.yml"},
+		{"with success (json patch)", func() { resetWithMinimalData(env) }, "success/teams/patch/json_patch.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/teams/patch/bad_request.yml"},
+		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/teams/patch/validation_errors.yml"},
+		{"with not found", func() { resetWithMinimalData(env) }, "failure/teams/patch/not_found.yml"},
+		{"with precondition failed", func() { resetWithMinimalData(env) }, "failure/teams/patch/precondition_failed.yml"},
+		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/teams/patch/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Patch team "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
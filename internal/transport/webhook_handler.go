@@ -0,0 +1,111 @@
+package transport
+
+import (
+	"log/slog"
+	"net/http"
+
+	httputil "taskmanager/internal/platform/http"
+	"taskmanager/internal/transport/dto"
+	"taskmanager/internal/usecase/webhook"
+)
+
+// CreateWebhook creates a webhook subscription
+// The signing secret is only returned in this response
+func CreateWebhook(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	var req dto.CreateWebhookRequest
+	if err := httputil.DecodeJSONBody(r, &req); err != nil {
+		slog.Error("error decoding JSON body for create webhook", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	s := req.ToSubscription()
+	if err := webhook.CreateSubscription(r.Context(), s); err != nil {
+		slog.Error("error creating webhook", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToCreatedWebhookResponse(*s))
+}
+
+// ListWebhooks lists webhook subscriptions with pagination
+func ListWebhooks(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	page, limit := httputil.PaginationParams(r)
+
+	result, err := webhook.ListSubscriptions(r.Context(), page, limit)
+	if err != nil {
+		slog.Error("error listing webhooks", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToPaginatedWebhooksResponse(*result))
+}
+
+// RetrieveWebhook retrieves a webhook subscription by UUID
+func RetrieveWebhook(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	subscriptionUUID := httputil.URLParamUUID(r, "uuid")
+
+	s, err := webhook.RetrieveSubscription(r.Context(), subscriptionUUID)
+	if err != nil {
+		slog.Error("error retrieving webhook", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToWebhookResponse(*s))
+}
+
+// DeleteWebhook deletes a webhook subscription; its pending deliveries are not sent
+func DeleteWebhook(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	subscriptionUUID := httputil.URLParamUUID(r, "uuid")
+
+	if err := webhook.DeleteSubscription(r.Context(), subscriptionUUID); err != nil {
+		slog.Error("error deleting webhook", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return http.StatusOK, []byte{}
+}
+
+// ListWebhookDeliveries lists the delivery log of a webhook subscription with pagination, newest first
+func ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	subscriptionUUID := httputil.URLParamUUID(r, "uuid")
+
+	page, limit := httputil.PaginationParams(r)
+
+	result, err := webhook.ListDeliveries(r.Context(), subscriptionUUID, page, limit)
+	if err != nil {
+		slog.Error("error listing webhook deliveries", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToPaginatedWebhookDeliveriesResponse(*result))
+}
+
+// RetrieveWebhookDelivery retrieves a delivery of a webhook subscription with its payload
+func RetrieveWebhookDelivery(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	subscriptionUUID := httputil.URLParamUUID(r, "uuid")
+	deliveryUUID := httputil.URLParamUUID(r, "delivery_uuid")
+
+	d, err := webhook.RetrieveDelivery(r.Context(), subscriptionUUID, deliveryUUID)
+	if err != nil {
+		slog.Error("error retrieving webhook delivery", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	return httputil.HandleErrorResponse(nil, dto.ToWebhookDeliveryWithPayloadResponse(*d))
+}
+
+// RedeliverWebhook schedules a new delivery of the event of an existing delivery
+// Responds 202 with the new delivery, sent in the background by the webhook worker
+func RedeliverWebhook(w http.ResponseWriter, r *http.Request) (int, []byte) {
+	subscriptionUUID := httputil.URLParamUUID(r, "uuid")
+	deliveryUUID := httputil.URLParamUUID(r, "delivery_uuid")
+
+	d, err := webhook.Redeliver(r.Context(), subscriptionUUID, deliveryUUID)
+	if err != nil {
+		slog.Error("error redelivering webhook", "error", err)
+		return httputil.HandleErrorResponse(err, nil)
+	}
+
+	w.Header().Set("Location", "/api/webhooks/"+subscriptionUUID.String()+"/deliveries/"+d.UUID.String())
+	return httputil.Accepted(dto.ToWebhookDeliveryResponse(*d))
+}
@@ -0,0 +1,226 @@
+//go:build test
+
+package transport
+
+import (
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/platform/testing/dbtest"
+	"taskmanager/internal/platform/testing/testenv"
+	"taskmanager/internal/platform/testing/venomtest"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithMinimalData(env) }, "success/webhooks/create/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithMinimalData(env) }, "failure/webhooks/create/bad_request.yml"},
+		{"with validation errors", func() { resetWithMinimalData(env) }, "failure/webhooks/create/validation_errors.yml"},
+		{"with missing content type", func() { resetWithMinimalData(env) }, "failure/webhooks/create/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Create webhook "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithWebhooks(env) }, "success/webhooks/list/basic.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("List webhooks "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRetrieveWebhook(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithWebhooks(env) }, "success/webhooks/retrieve/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithWebhooks(env) }, "failure/webhooks/retrieve/bad_request.yml"},
+		{"with not found", func() { resetWithWebhooks(env) }, "failure/webhooks/retrieve/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Retrieve webhook "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithWebhooks(env) }, "success/webhooks/delete/basic.yml"},
+		// Failure
+		{"with not found", func() { resetWithWebhooks(env) }, "failure/webhooks/delete/not_found.yml"},
+		{"with missing content type", func() { resetWithWebhooks(env) }, "failure/webhooks/delete/missing_content_type.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Delete webhook "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithWebhooks(env) }, "success/webhooks/deliveries/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithWebhooks(env) }, "failure/webhooks/deliveries/bad_request.yml"},
+		{"with not found", func() { resetWithWebhooks(env) }, "failure/webhooks/deliveries/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Webhook deliveries "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	env := testenv.Setup(t,
+		testenv.WithDatabase(
+			databaseTest,
+			dbtest.WithMigrations(paths.MigrationDir()),
+		),
+		testenv.WithRedis(redisTest),
+		testenv.WithHTTPServer(Routes(dbConnector)),
+		testenv.WithAPITest(
+			venomtest.WithSuiteRoot(paths.APITestDir()),
+			venomtest.WithVerbose(1),
+		),
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		suitePath string
+	}{
+		// Success
+		{"with success (basic)", func() { resetWithWebhooks(env) }, "success/webhooks/redeliver/basic.yml"},
+		// Failure
+		{"with bad request", func() { resetWithWebhooks(env) }, "failure/webhooks/redeliver/bad_request.yml"},
+		{"with not found", func() { resetWithWebhooks(env) }, "failure/webhooks/redeliver/not_found.yml"},
+	}
+
+	for _, tc := range tests {
+		t.Run("Redeliver webhook "+tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+			env.RunAPISuite(t, tc.suitePath)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	analyticsEntity "taskmanager/internal/entity/analytics"
+	sprintEntity "taskmanager/internal/entity/sprint"
+	webhookEntity "taskmanager/internal/entity/webhook"
+	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/publisher"
+	analyticsRepo "taskmanager/internal/repository/analytics"
+	sprintRepo "taskmanager/internal/repository/sprint"
+	teamRepo "taskmanager/internal/repository/team"
+)
+
+// Invalidator drops the cached metrics of the team an event relayed from the outbox refers to
+// Events of tasks without a team leave the cache untouched
+type Invalidator struct{}
+
+// TeamMetrics computes the metrics of a team over a period
+// A zero To defaults to today and a zero From to the default period ending at To, in UTC;
+// an empty bucket defaults to week
+func TeamMetrics(ctx context.Context, teamUUID uuid.UUID, period analyticsEntity.Period) (*analyticsEntity.TeamMetrics, error) {
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if period.Bucket == "" {
+		period.Bucket = analyticsEntity.BucketWeek
+	}
+
+	period, err = resolvePeriod(period)
+	if err != nil {
+		return nil, err
+	}
+
+	return analyticsRepo.Persist().TeamMetrics(ctx, t.ID, period)
+}
+
+// CFD lists the daily status snapshots of a team over a period, bounded as in TeamMetrics
+func CFD(ctx context.Context, teamUUID uuid.UUID, period analyticsEntity.Period) (*analyticsEntity.CFD, error) {
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	period.Bucket = analyticsEntity.BucketDay
+
+	period, err = resolvePeriod(period)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := analyticsRepo.Persist().ListStatusSnapshots(ctx, t.ID, period.From, period.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return &analyticsEntity.CFD{Period: period, Days: days}, nil
+}
+
+// Burndown builds the burndown of a sprint out of its daily snapshots
+// Sprints never started have no snapshots, so only their ideal line is returned
+func Burndown(ctx context.Context, sprintUUID uuid.UUID) (*sprintEntity.Sprint, *analyticsEntity.Burndown, error) {
+	s, err := sprintRepo.Persist().RetrieveByUUID(ctx, sprintUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshots, err := analyticsRepo.Persist().ListSprintSnapshots(ctx, s.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := analyticsEntity.NewBurndown(s.StartDate, s.EndDate, snapshots)
+	return s, &b, nil
+}
+
+// RecordSnapshots records the snapshots of the day of now, in UTC, replacing those already taken on it
+func RecordSnapshots(ctx context.Context, now time.Time) error {
+	return analyticsRepo.Persist().RecordSnapshots(ctx, today(now))
+}
+
+// resolvePeriod fills the missing bounds of a period and validates it
+// A zero To defaults to today and a zero From to the default period ending at To, in UTC
+func resolvePeriod(period analyticsEntity.Period) (analyticsEntity.Period, error) {
+	if period.To.IsZero() {
+		period.To = today(time.Now())
+	}
+	if period.From.IsZero() {
+		period.From = period.To.AddDate(0, 0, 1-Config.DefaultPeriodDays)
+	}
+
+	if err := period.Validate(Config.MaxPeriodDays); err != nil {
+		return analyticsEntity.Period{}, err
+	}
+
+	return period, nil
+}
+
+// today returns the day of t at midnight UTC
+func today(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Publish implements publisher.Publisher, invalidating the cached metrics of the event team
+// The error is returned so the relay retries the event when the cache is unreachable
+func (Invalidator) Publish(ctx context.Context, m publisher.Message) error {
+	eventID, err := uuid.Parse(m.ID)
+	if err != nil {
+		return fmt.Errorf("invalid event id %q: %w", m.ID, err)
+	}
+
+	teamUUID := webhookEntity.Event{
+		ID:      eventID,
+		Type:    webhookEntity.EventType(m.Type),
+		Payload: string(m.Payload),
+	}.TeamUUID()
+	if teamUUID == nil {
+		return nil
+	}
+
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, *teamUUID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return analyticsRepo.Persist().InvalidateTeam(ctx, t.ID)
+}
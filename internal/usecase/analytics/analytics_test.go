@@ -0,0 +1,381 @@
+//go:build test
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	analyticsEntity "taskmanager/internal/entity/analytics"
+	sprintEntity "taskmanager/internal/entity/sprint"
+	teamEntity "taskmanager/internal/entity/team"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/publisher"
+	"taskmanager/internal/platform/testing/assert"
+	analyticsRepo "taskmanager/internal/repository/analytics"
+	sprintRepo "taskmanager/internal/repository/sprint"
+	teamRepo "taskmanager/internal/repository/team"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	teamUUID   = uuid.MustParse("222e4567-e89b-12d3-a456-426614174000")
+	sprintUUID = uuid.MustParse("555e4567-e89b-12d3-a456-426614174003")
+	eventUUID  = uuid.MustParse("888e4567-e89b-12d3-a456-426614174000")
+)
+
+// mockTeam returns a team repository holding team 2
+func mockTeam() *teamRepo.MockPersistent {
+	return &teamRepo.MockPersistent{
+		FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+			if id != teamUUID {
+				return nil, errs.ErrNotFound
+			}
+			return &teamEntity.Team{Model: gorm.Model{ID: 2}, UUID: teamUUID}, nil
+		},
+	}
+}
+
+// date returns the given day at midnight UTC
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestTeamMetrics(t *testing.T) {
+	originalAnalyticsPersist := analyticsRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	now := time.Now().UTC()
+	today := date(now.Year(), now.Month(), now.Day())
+
+	tests := []struct {
+		name       string
+		teamUUID   uuid.UUID
+		period     analyticsEntity.Period
+		wantPeriod analyticsEntity.Period
+		wantErr    error
+	}{
+		{
+			"Team metrics over the requested period",
+			teamUUID,
+			analyticsEntity.Period{From: date(2025, 11, 1), To: date(2025, 11, 30), Bucket: analyticsEntity.BucketDay},
+			analyticsEntity.Period{From: date(2025, 11, 1), To: date(2025, 11, 30), Bucket: analyticsEntity.BucketDay},
+			nil,
+		},
+		{
+			"Team metrics over the default period ending today",
+			teamUUID,
+			analyticsEntity.Period{},
+			analyticsEntity.Period{From: today.AddDate(0, 0, 1-Config.DefaultPeriodDays), To: today, Bucket: analyticsEntity.BucketWeek},
+			nil,
+		},
+		{
+			"Team metrics over the default period ending at the requested day",
+			teamUUID,
+			analyticsEntity.Period{To: date(2025, 11, 30)},
+			analyticsEntity.Period{From: date(2025, 11, 30).AddDate(0, 0, 1-Config.DefaultPeriodDays), To: date(2025, 11, 30), Bucket: analyticsEntity.BucketWeek},
+			nil,
+		},
+		{
+			"Team metrics of a period ending before it starts",
+			teamUUID,
+			analyticsEntity.Period{From: date(2025, 11, 30), To: date(2025, 11, 1)},
+			analyticsEntity.Period{},
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "to", Type: errs.TypeInvalid, Code: errs.CodeNotBefore, Message: "to must not be before from", Params: map[string]any{"other": "from"}},
+			}},
+		},
+		{
+			"Team metrics of a team not found",
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			analyticsEntity.Period{},
+			analyticsEntity.Period{},
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer analyticsRepo.SetPersist(originalAnalyticsPersist)
+			defer teamRepo.SetPersist(originalTeamPersist)
+
+			var gotTeamID uint
+			var gotPeriod analyticsEntity.Period
+			teamRepo.SetPersist(mockTeam())
+			analyticsRepo.SetPersist(&analyticsRepo.MockPersistent{
+				FnTeamMetrics: func(ctx context.Context, teamID uint, period analyticsEntity.Period) (*analyticsEntity.TeamMetrics, error) {
+					gotTeamID = teamID
+					gotPeriod = period
+					return &analyticsEntity.TeamMetrics{Period: period}, nil
+				},
+			})
+
+			_, err := TeamMetrics(context.Background(), tt.teamUUID, tt.period)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("TeamMetrics() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if gotTeamID != 2 {
+				t.Errorf("TeamMetrics() team id = %d, want 2", gotTeamID)
+			}
+			if diff := cmp.Diff(tt.wantPeriod, gotPeriod); diff != "" {
+				t.Errorf("TeamMetrics() period mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInvalidator_Publish(t *testing.T) {
+	originalAnalyticsPersist := analyticsRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	errCache := errors.New("cache unavailable")
+
+	tests := []struct {
+		name            string
+		message         publisher.Message
+		invalidateErr   error
+		wantInvalidated []uint
+		wantErr         error
+	}{
+		{
+			"Invalidate the team of a task event",
+			publisher.Message{ID: eventUUID.String(), Type: "task.updated", Payload: []byte(`{"data":{"task":{"team_uuid":"` + teamUUID.String() + `"}}}`)},
+			nil,
+			[]uint{2},
+			nil,
+		},
+		{
+			"Invalidate the team of a team event",
+			publisher.Message{ID: eventUUID.String(), Type: "team.task_associated", Payload: []byte(`{"data":{"team_uuid":"` + teamUUID.String() + `"}}`)},
+			nil,
+			[]uint{2},
+			nil,
+		},
+		{
+			"Skip the event of a task without team",
+			publisher.Message{ID: eventUUID.String(), Type: "task.created", Payload: []byte(`{"data":{"task":{"team_uuid":null}}}`)},
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"Skip the event of a team not found",
+			publisher.Message{ID: eventUUID.String(), Type: "team.task_associated", Payload: []byte(`{"data":{"team_uuid":"00000000-0000-0000-0000-000000000000"}}`)},
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"Return the cache error so the event is retried",
+			publisher.Message{ID: eventUUID.String(), Type: "task.deleted", Payload: []byte(`{"data":{"team_uuid":"` + teamUUID.String() + `"}}`)},
+			errCache,
+			[]uint{2},
+			errCache,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer analyticsRepo.SetPersist(originalAnalyticsPersist)
+			defer teamRepo.SetPersist(originalTeamPersist)
+
+			var invalidated []uint
+			teamRepo.SetPersist(mockTeam())
+			analyticsRepo.SetPersist(&analyticsRepo.MockPersistent{
+				FnInvalidateTeam: func(ctx context.Context, teamID uint) error {
+					invalidated = append(invalidated, teamID)
+					return tt.invalidateErr
+				},
+			})
+
+			err := Invalidator{}.Publish(context.Background(), tt.message)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Invalidator.Publish() error diff: %s", diff)
+			}
+			if diff := cmp.Diff(tt.wantInvalidated, invalidated); diff != "" {
+				t.Errorf("Invalidator.Publish() invalidated mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCFD(t *testing.T) {
+	originalAnalyticsPersist := analyticsRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	now := time.Now().UTC()
+	today := date(now.Year(), now.Month(), now.Day())
+	days := []analyticsEntity.StatusSnapshot{{Date: date(2025, 11, 24), ToDo: 3, InProgress: 2}}
+
+	tests := []struct {
+		name     string
+		teamUUID uuid.UUID
+		period   analyticsEntity.Period
+		want     *analyticsEntity.CFD
+		wantErr  error
+	}{
+		{
+			"CFD over the requested period",
+			teamUUID,
+			analyticsEntity.Period{From: date(2025, 11, 24), To: date(2025, 11, 30)},
+			&analyticsEntity.CFD{
+				Period: analyticsEntity.Period{From: date(2025, 11, 24), To: date(2025, 11, 30), Bucket: analyticsEntity.BucketDay},
+				Days:   days,
+			},
+			nil,
+		},
+		{
+			"CFD over the default period ending today",
+			teamUUID,
+			analyticsEntity.Period{},
+			&analyticsEntity.CFD{
+				Period: analyticsEntity.Period{From: today.AddDate(0, 0, 1-Config.DefaultPeriodDays), To: today, Bucket: analyticsEntity.BucketDay},
+				Days:   days,
+			},
+			nil,
+		},
+		{
+			"CFD over a period longer than the maximum",
+			teamUUID,
+			analyticsEntity.Period{From: date(2024, 1, 1), To: date(2025, 11, 30)},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "from", Type: errs.TypeMaxLength, Code: errs.CodeMaxPeriod, Message: fmt.Sprintf("period must not exceed %d days", Config.MaxPeriodDays), Params: map[string]any{"max": Config.MaxPeriodDays}},
+			}},
+		},
+		{
+			"CFD of a team not found",
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			analyticsEntity.Period{},
+			nil,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer analyticsRepo.SetPersist(originalAnalyticsPersist)
+			defer teamRepo.SetPersist(originalTeamPersist)
+
+			teamRepo.SetPersist(mockTeam())
+			analyticsRepo.SetPersist(&analyticsRepo.MockPersistent{
+				FnListStatusSnapshots: func(ctx context.Context, teamID uint, from, to time.Time) ([]analyticsEntity.StatusSnapshot, error) {
+					if teamID != 2 {
+						return nil, errors.New("unexpected team")
+					}
+					return days, nil
+				},
+			})
+
+			got, err := CFD(context.Background(), tt.teamUUID, tt.period)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("CFD() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("CFD() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBurndown(t *testing.T) {
+	originalAnalyticsPersist := analyticsRepo.Persist()
+	originalSprintPersist := sprintRepo.Persist()
+
+	start := date(2025, 11, 24)
+	snapshots := []analyticsEntity.SprintSnapshot{
+		{Date: start, TotalTasks: 3, RemainingTasks: 3, TotalPoints: 10, RemainingPoints: 10},
+	}
+
+	tests := []struct {
+		name       string
+		sprintUUID uuid.UUID
+		want       *analyticsEntity.Burndown
+		wantErr    error
+	}{
+		{
+			"Burndown of a sprint",
+			sprintUUID,
+			&analyticsEntity.Burndown{
+				CommittedPoints: 10,
+				Ideal: []analyticsEntity.IdealPoint{
+					{Date: start, RemainingPoints: 10},
+					{Date: start.AddDate(0, 0, 1), RemainingPoints: 5},
+					{Date: start.AddDate(0, 0, 2), RemainingPoints: 0},
+				},
+				Actual: snapshots,
+			},
+			nil,
+		},
+		{
+			"Burndown of a sprint not found",
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			nil,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer analyticsRepo.SetPersist(originalAnalyticsPersist)
+			defer sprintRepo.SetPersist(originalSprintPersist)
+
+			sprintRepo.SetPersist(&sprintRepo.MockPersistent{
+				FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*sprintEntity.Sprint, error) {
+					if id != sprintUUID {
+						return nil, errs.ErrNotFound
+					}
+					return &sprintEntity.Sprint{Model: gorm.Model{ID: 4}, UUID: sprintUUID, StartDate: start, EndDate: start.AddDate(0, 0, 2)}, nil
+				},
+			})
+			analyticsRepo.SetPersist(&analyticsRepo.MockPersistent{
+				FnListSprintSnapshots: func(ctx context.Context, sprintID uint) ([]analyticsEntity.SprintSnapshot, error) {
+					if sprintID != 4 {
+						return nil, errors.New("unexpected sprint")
+					}
+					return snapshots, nil
+				},
+			})
+
+			s, got, err := Burndown(context.Background(), tt.sprintUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Burndown() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr == nil && s.UUID != sprintUUID {
+				t.Errorf("Burndown() sprint = %v, want %v", s.UUID, sprintUUID)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Burndown() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordSnapshots(t *testing.T) {
+	originalAnalyticsPersist := analyticsRepo.Persist()
+	defer analyticsRepo.SetPersist(originalAnalyticsPersist)
+
+	var got time.Time
+	analyticsRepo.SetPersist(&analyticsRepo.MockPersistent{
+		FnRecordSnapshots: func(ctx context.Context, date time.Time) error {
+			got = date
+			return nil
+		},
+	})
+
+	now := time.Date(2025, 12, 1, 23, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+	if err := RecordSnapshots(context.Background(), now); err != nil {
+		t.Fatalf("RecordSnapshots() error = %v", err)
+	}
+	if want := date(2025, 12, 2); !got.Equal(want) {
+		t.Errorf("RecordSnapshots() date = %v, want %v", got, want)
+	}
+}
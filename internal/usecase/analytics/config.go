@@ -0,0 +1,37 @@
+package analytics
+
+import (
+	"log"
+	"time"
+)
+
+var Config Configuration
+
+type Configuration struct {
+	DefaultPeriodDays       int `toml:"default_period_days"`
+	MaxPeriodDays           int `toml:"max_period_days"`
+	SnapshotIntervalSeconds int `toml:"snapshot_interval_seconds"`
+}
+
+func LoadConfig(cfg *Configuration) error {
+	Config = *cfg
+
+	if Config.DefaultPeriodDays == 0 {
+		log.Fatal("Analytics default period days is required")
+	}
+
+	if Config.MaxPeriodDays == 0 {
+		log.Fatal("Analytics max period days is required")
+	}
+
+	if Config.SnapshotIntervalSeconds == 0 {
+		log.Fatal("Analytics snapshot interval is required")
+	}
+
+	return nil
+}
+
+// SnapshotInterval returns the interval between the snapshots of the day taken by the worker
+func (c Configuration) SnapshotInterval() time.Duration {
+	return time.Duration(c.SnapshotIntervalSeconds) * time.Second
+}
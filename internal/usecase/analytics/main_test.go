@@ -0,0 +1,32 @@
+//go:build test
+
+package analytics
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/testing/configtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Analytics Configuration `toml:"analytics"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load analytics config
+		if err := LoadConfig(&appConfig.Analytics); err != nil {
+			log.Fatalf("Error on load analytics config. Err: %s", err)
+		}
+
+		return m.Run()
+	}(m))
+}
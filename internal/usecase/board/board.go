@@ -0,0 +1,394 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	boardEntity "taskmanager/internal/entity/board"
+	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/publisher"
+	"taskmanager/internal/platform/token"
+	boardRepo "taskmanager/internal/repository/board"
+	teamRepo "taskmanager/internal/repository/team"
+	"taskmanager/internal/usecase/eventstream"
+	taskUsecase "taskmanager/internal/usecase/task"
+	teamUsecase "taskmanager/internal/usecase/team"
+)
+
+// presenceMessageType is the type of the messages announcing a presence change on a team board
+const presenceMessageType = "board.presence"
+
+// Session is the board channel of one connected viewer
+// Messages are buffered up to Config.SendBufferSize; a session falling behind is lost and must reconnect
+type Session struct {
+	Viewer boardEntity.Viewer
+
+	messages chan boardEntity.Message
+	lost     chan struct{}
+	lostOnce sync.Once
+
+	mu     sync.Mutex
+	joined map[uuid.UUID]*eventstream.Subscription
+}
+
+// rooms holds the sessions of this replica that joined each team board
+var (
+	mu    sync.Mutex
+	rooms = map[uuid.UUID]map[*Session]struct{}{}
+)
+
+// presencePublisher announces presence changes to every replica
+// Defaults to this replica alone, which is enough for a single instance and for tests
+var presencePublisher publisher.Publisher = localPublisher{}
+
+// SetPresencePublisher sets the publisher announcing presence changes
+func SetPresencePublisher(p publisher.Publisher) {
+	presencePublisher = p
+}
+
+// localPublisher delivers presence changes to the sessions of this replica only
+type localPublisher struct{}
+
+// Publish implements publisher.Publisher by receiving the message right away
+func (localPublisher) Publish(ctx context.Context, m publisher.Message) error {
+	return ReceivePresence(ctx, m)
+}
+
+// IssueToken returns a viewer token for name valid for Config.TokenTTL from now
+func IssueToken(name string, now time.Time) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.ContainsAny(name, "\n") {
+		return "", &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("name", apperrors.CodeRequiredSingleLine, nil),
+		}}
+	}
+
+	return token.Sign([]byte(Config.Secret), name, now.Add(Config.TokenTTL())), nil
+}
+
+// CheckOrigin verifies the Origin header of a channel upgrade received on host
+// Clients other than browsers send no origin; browsers must be on the API's own origin or on one of
+// Config.Origins(), so a page of another site cannot drive the boards with a leaked viewer token.
+// Returns ErrForbidden otherwise
+func CheckOrigin(origin, host string) error {
+	if origin == "" {
+		return nil
+	}
+
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, host) {
+		return nil
+	}
+
+	if slices.ContainsFunc(Config.Origins(), func(allowed string) bool { return strings.EqualFold(allowed, origin) }) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: origin %s", apperrors.ErrForbidden, origin)
+}
+
+// Authenticate verifies a viewer token at now and opens a session for its viewer
+// Returns ErrUnauthorized when the token is missing, invalid or expired
+func Authenticate(viewerToken string, now time.Time) (*Session, error) {
+	if viewerToken == "" {
+		return nil, fmt.Errorf("%w: missing token", apperrors.ErrUnauthorized)
+	}
+
+	name, err := token.Verify([]byte(Config.Secret), viewerToken, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", apperrors.ErrUnauthorized, err)
+	}
+
+	connectionID, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		Viewer:   boardEntity.Viewer{ConnectionID: connectionID, Name: name},
+		messages: make(chan boardEntity.Message, Config.SendBufferSize),
+		lost:     make(chan struct{}),
+		joined:   map[uuid.UUID]*eventstream.Subscription{},
+	}, nil
+}
+
+// Messages returns the channel of messages pushed to the session
+func (s *Session) Messages() <-chan boardEntity.Message {
+	return s.messages
+}
+
+// Lost returns a channel closed when the session fell behind and its connection must be closed
+func (s *Session) Lost() <-chan struct{} {
+	return s.lost
+}
+
+// Handle runs a command of the viewer
+// Task commands are only accepted on joined team boards
+func (s *Session) Handle(ctx context.Context, cmd boardEntity.Command) error {
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	switch cmd.Type {
+	case boardEntity.CommandJoin:
+		return s.join(ctx, cmd.TeamUUID)
+	case boardEntity.CommandLeave:
+		if !s.hasJoined(cmd.TeamUUID) {
+			return notJoinedError()
+		}
+		return s.leave(ctx, cmd.TeamUUID)
+	case boardEntity.CommandMoveTask:
+		if err := s.validateTaskCommand(ctx, cmd); err != nil {
+			return err
+		}
+		return taskUsecase.UpdateStatus(ctx, cmd.TaskUUID, cmd.Status, cmd.OverrideWIPLimit, nil)
+	case boardEntity.CommandAssociateTask:
+		if !s.hasJoined(cmd.TeamUUID) {
+			return notJoinedError()
+		}
+		return teamUsecase.AssociateTask(ctx, cmd.TeamUUID, cmd.TaskUUID)
+	}
+
+	return nil
+}
+
+// Refresh extends the presence of the viewer on every joined team board
+func (s *Session) Refresh(ctx context.Context) error {
+	expiresAt := time.Now().Add(Config.PresenceTTL())
+	for _, teamUUID := range s.joinedTeams() {
+		if err := boardRepo.Persist().AddViewer(ctx, teamUUID, s.Viewer, expiresAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close leaves every joined team board
+func (s *Session) Close(ctx context.Context) {
+	for _, teamUUID := range s.joinedTeams() {
+		if err := s.leave(ctx, teamUUID); err != nil {
+			slog.Error("error leaving team board", "team_uuid", teamUUID, "error", err)
+		}
+	}
+}
+
+// join subscribes the session to the events of a team board and announces the viewer
+// Joining a board twice is a no-op
+func (s *Session) join(ctx context.Context, teamUUID uuid.UUID) error {
+	if s.hasJoined(teamUUID) {
+		return nil
+	}
+
+	sub, err := eventstream.Subscribe(ctx, &teamUUID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+				apperrors.NewValidationError("team_uuid", apperrors.CodeTeamNotFound, nil),
+			}}
+		}
+		return err
+	}
+
+	if err := boardRepo.Persist().AddViewer(ctx, teamUUID, s.Viewer, time.Now().Add(Config.PresenceTTL())); err != nil {
+		sub.Close()
+		return err
+	}
+
+	s.mu.Lock()
+	s.joined[teamUUID] = sub
+	s.mu.Unlock()
+
+	mu.Lock()
+	if rooms[teamUUID] == nil {
+		rooms[teamUUID] = map[*Session]struct{}{}
+	}
+	rooms[teamUUID][s] = struct{}{}
+	mu.Unlock()
+
+	go s.forward(teamUUID, sub)
+
+	return announce(ctx, teamUUID)
+}
+
+// leave unsubscribes the session from a team board and announces the viewer left
+func (s *Session) leave(ctx context.Context, teamUUID uuid.UUID) error {
+	s.mu.Lock()
+	sub, ok := s.joined[teamUUID]
+	delete(s.joined, teamUUID)
+	s.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	sub.Close()
+
+	mu.Lock()
+	delete(rooms[teamUUID], s)
+	if len(rooms[teamUUID]) == 0 {
+		delete(rooms, teamUUID)
+	}
+	mu.Unlock()
+
+	if err := boardRepo.Persist().RemoveViewer(ctx, teamUUID, s.Viewer); err != nil {
+		return err
+	}
+
+	return announce(ctx, teamUUID)
+}
+
+// validateTaskCommand validates the board was joined and the task belongs to its team
+func (s *Session) validateTaskCommand(ctx context.Context, cmd boardEntity.Command) error {
+	if !s.hasJoined(cmd.TeamUUID) {
+		return notJoinedError()
+	}
+
+	taskTeamUUID, err := teamRepo.Persist().RetrieveTaskTeamUUID(ctx, cmd.TaskUUID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+				apperrors.NewValidationError("task_uuid", apperrors.CodeTaskNotFound, nil),
+			}}
+		}
+		return err
+	}
+
+	if taskTeamUUID == nil || *taskTeamUUID != cmd.TeamUUID {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("task_uuid", apperrors.CodeTaskNotOnBoard, nil),
+		}}
+	}
+
+	return nil
+}
+
+// forward pushes the events of a joined team board to the session
+// The session is lost when the subscription lagged behind while the board is still joined
+func (s *Session) forward(teamUUID uuid.UUID, sub *eventstream.Subscription) {
+	for e := range sub.Events() {
+		event := e
+		s.deliver(boardEntity.Message{Type: boardEntity.MessageEvent, TeamUUID: teamUUID, Event: &event})
+	}
+
+	s.mu.Lock()
+	current, ok := s.joined[teamUUID]
+	s.mu.Unlock()
+
+	if ok && current == sub {
+		s.markLost()
+	}
+}
+
+// deliver pushes a message to the session without blocking, marking it lost when its buffer is full
+func (s *Session) deliver(m boardEntity.Message) {
+	select {
+	case s.messages <- m:
+	default:
+		slog.Warn("Closing lagging board session", "connection_id", s.Viewer.ConnectionID)
+		s.markLost()
+	}
+}
+
+// markLost closes the lost channel once
+func (s *Session) markLost() {
+	s.lostOnce.Do(func() { close(s.lost) })
+}
+
+// hasJoined reports whether the session joined a team board
+func (s *Session) hasJoined(teamUUID uuid.UUID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.joined[teamUUID]
+	return ok
+}
+
+// joinedTeams returns the teams of the joined boards
+func (s *Session) joinedTeams() []uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	teams := make([]uuid.UUID, 0, len(s.joined))
+	for teamUUID := range s.joined {
+		teams = append(teams, teamUUID)
+	}
+	return teams
+}
+
+// notJoinedError is returned for commands on a team board the session did not join
+func notJoinedError() error {
+	return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+		apperrors.NewValidationError("team_uuid", apperrors.CodeBoardNotJoined, nil),
+	}}
+}
+
+// announce tells every replica the viewers of a team board changed
+func announce(ctx context.Context, teamUUID uuid.UUID) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	return presencePublisher.Publish(ctx, publisher.Message{
+		ID:      id.String(),
+		Type:    presenceMessageType,
+		Payload: []byte(teamUUID.String()),
+	})
+}
+
+// ReceivePresence pushes the current viewers of a team board to its sessions on this replica
+// Viewers are listed by name, once per name however many connections they have
+func ReceivePresence(ctx context.Context, m publisher.Message) error {
+	teamUUID, err := uuid.Parse(string(m.Payload))
+	if err != nil {
+		return fmt.Errorf("invalid presence team %q: %w", m.Payload, err)
+	}
+
+	mu.Lock()
+	sessions := make([]*Session, 0, len(rooms[teamUUID]))
+	for s := range rooms[teamUUID] {
+		sessions = append(sessions, s)
+	}
+	mu.Unlock()
+
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	viewers, err := boardRepo.Persist().ListViewers(ctx, teamUUID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	names := viewerNames(viewers)
+	for _, s := range sessions {
+		s.deliver(boardEntity.Message{Type: boardEntity.MessagePresence, TeamUUID: teamUUID, Viewers: names})
+	}
+
+	return nil
+}
+
+// viewerNames returns the distinct names of the viewers, sorted
+func viewerNames(viewers []boardEntity.Viewer) []string {
+	seen := make(map[string]struct{}, len(viewers))
+	names := make([]string, 0, len(viewers))
+	for _, v := range viewers {
+		if _, ok := seen[v.Name]; ok {
+			continue
+		}
+		seen[v.Name] = struct{}{}
+		names = append(names, v.Name)
+	}
+
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,327 @@
+//go:build test
+
+package board
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	boardEntity "taskmanager/internal/entity/board"
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/publisher"
+	"taskmanager/internal/platform/testing/assert"
+	"taskmanager/internal/platform/token"
+	boardRepo "taskmanager/internal/repository/board"
+	teamRepo "taskmanager/internal/repository/team"
+)
+
+var (
+	teamUUID      = uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+	otherTeamUUID = uuid.MustParse("222e4567-e89b-12d3-a456-426614174000")
+	taskUUID      = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+)
+
+// presenceStore is an in-memory board presence persistence
+type presenceStore map[uuid.UUID][]boardEntity.Viewer
+
+// mock returns a MockPersistent backed by the store
+func (p presenceStore) mock() *boardRepo.MockPersistent {
+	return &boardRepo.MockPersistent{
+		FnAddViewer: func(ctx context.Context, team uuid.UUID, v boardEntity.Viewer, expiresAt time.Time) error {
+			for _, existing := range p[team] {
+				if existing == v {
+					return nil
+				}
+			}
+			p[team] = append(p[team], v)
+			return nil
+		},
+		FnRemoveViewer: func(ctx context.Context, team uuid.UUID, v boardEntity.Viewer) error {
+			viewers := p[team][:0]
+			for _, existing := range p[team] {
+				if existing != v {
+					viewers = append(viewers, existing)
+				}
+			}
+			p[team] = viewers
+			return nil
+		},
+		FnListViewers: func(ctx context.Context, team uuid.UUID, now time.Time) ([]boardEntity.Viewer, error) {
+			return p[team], nil
+		},
+	}
+}
+
+// setupMocks replaces the board and team persistence for a test
+func setupMocks(t *testing.T) {
+	originalBoard := boardRepo.Persist()
+	originalTeam := teamRepo.Persist()
+	t.Cleanup(func() {
+		boardRepo.SetPersist(originalBoard)
+		teamRepo.SetPersist(originalTeam)
+	})
+
+	boardRepo.SetPersist(presenceStore{}.mock())
+	teamRepo.SetPersist(&teamRepo.MockPersistent{
+		FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+			if id != teamUUID && id != otherTeamUUID {
+				return nil, apperrors.ErrNotFound
+			}
+			return &teamEntity.Team{UUID: id}, nil
+		},
+		FnRetrieveTaskTeamUUID: func(ctx context.Context, id uuid.UUID) (*uuid.UUID, error) {
+			if id != taskUUID {
+				return nil, apperrors.ErrNotFound
+			}
+			return &otherTeamUUID, nil
+		},
+	})
+}
+
+// newSession opens a session for a viewer named name
+func newSession(t *testing.T, name string) *Session {
+	t.Helper()
+
+	viewerToken, err := IssueToken(name, time.Now())
+	if err != nil {
+		t.Fatalf("IssueToken() error: %v", err)
+	}
+	s, err := Authenticate(viewerToken, time.Now())
+	if err != nil {
+		t.Fatalf("Authenticate() error: %v", err)
+	}
+	t.Cleanup(func() { s.Close(context.Background()) })
+
+	return s
+}
+
+// presence drains the presence messages already pushed to a session
+func presence(s *Session) [][]string {
+	got := [][]string{}
+	for {
+		select {
+		case m := <-s.Messages():
+			if m.Type == boardEntity.MessagePresence {
+				got = append(got, m.Viewers)
+			}
+		default:
+			return got
+		}
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Now()
+	valid, err := IssueToken("Ada", now)
+	if err != nil {
+		t.Fatalf("IssueToken() error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		now      time.Time
+		wantName string
+		wantErr  bool
+	}{
+		{"Authenticate with a valid token", valid, now, "Ada", false},
+		{"Authenticate without token", "", now, "", true},
+		{"Authenticate with a token signed by another secret", token.Sign([]byte("another-secret"), "Ada", now.Add(time.Hour)), now, "", true},
+		{"Authenticate with an expired token", valid, now.Add(Config.TokenTTL() + time.Second), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Authenticate(tt.token, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, apperrors.ErrUnauthorized) {
+					t.Errorf("Authenticate() error = %v, want ErrUnauthorized", err)
+				}
+				return
+			}
+			if s.Viewer.Name != tt.wantName {
+				t.Errorf("Authenticate() name = %q, want %q", s.Viewer.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestCheckOrigin(t *testing.T) {
+	tests := []struct {
+		name    string
+		origin  string
+		host    string
+		wantErr bool
+	}{
+		{"CheckOrigin without origin", "", "api.test", false},
+		{"CheckOrigin with the origin of the API", "https://api.test", "api.test", false},
+		{"CheckOrigin with an allowed origin", "http://board.test", "api.test", false},
+		{"CheckOrigin with another origin", "https://evil.test", "api.test", true},
+		{"CheckOrigin with an allowed host on another scheme", "https://board.test", "api.test", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckOrigin(tt.origin, tt.host)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckOrigin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, apperrors.ErrForbidden) {
+				t.Errorf("CheckOrigin() error = %v, want ErrForbidden", err)
+			}
+		})
+	}
+}
+
+func TestSession_Handle(t *testing.T) {
+	tests := []struct {
+		name    string
+		joined  uuid.UUID
+		cmd     boardEntity.Command
+		wantErr error
+	}{
+		{
+			"Join a team board",
+			uuid.Nil,
+			boardEntity.Command{Type: boardEntity.CommandJoin, TeamUUID: teamUUID},
+			nil,
+		},
+		{
+			"Join a team board twice",
+			teamUUID,
+			boardEntity.Command{Type: boardEntity.CommandJoin, TeamUUID: teamUUID},
+			nil,
+		},
+		{
+			"Join a team not found",
+			uuid.Nil,
+			boardEntity.Command{Type: boardEntity.CommandJoin, TeamUUID: uuid.MustParse("999e4567-e89b-12d3-a456-426614174000")},
+			&apperrors.ValidationErrors{Errors: []apperrors.ValidationError{{Field: "team_uuid", Type: apperrors.TypeNotFound, Code: apperrors.CodeTeamNotFound, Message: "team not found"}}},
+		},
+		{
+			"Leave a team board not joined",
+			uuid.Nil,
+			boardEntity.Command{Type: boardEntity.CommandLeave, TeamUUID: teamUUID},
+			&apperrors.ValidationErrors{Errors: []apperrors.ValidationError{{Field: "team_uuid", Type: apperrors.TypeState, Code: apperrors.CodeBoardNotJoined, Message: "team board not joined"}}},
+		},
+		{
+			"Move a task of a team board not joined",
+			uuid.Nil,
+			boardEntity.Command{Type: boardEntity.CommandMoveTask, TeamUUID: otherTeamUUID, TaskUUID: taskUUID, Status: taskEntity.StatusDone},
+			&apperrors.ValidationErrors{Errors: []apperrors.ValidationError{{Field: "team_uuid", Type: apperrors.TypeState, Code: apperrors.CodeBoardNotJoined, Message: "team board not joined"}}},
+		},
+		{
+			"Move a task of another team",
+			teamUUID,
+			boardEntity.Command{Type: boardEntity.CommandMoveTask, TeamUUID: teamUUID, TaskUUID: taskUUID, Status: taskEntity.StatusDone},
+			&apperrors.ValidationErrors{Errors: []apperrors.ValidationError{{Field: "task_uuid", Type: apperrors.TypeState, Code: apperrors.CodeTaskNotOnBoard, Message: "task does not belong to the team"}}},
+		},
+		{
+			"Move a task not found",
+			teamUUID,
+			boardEntity.Command{Type: boardEntity.CommandMoveTask, TeamUUID: teamUUID, TaskUUID: uuid.MustParse("999e4567-e89b-12d3-a456-426614174000"), Status: taskEntity.StatusDone},
+			&apperrors.ValidationErrors{Errors: []apperrors.ValidationError{{Field: "task_uuid", Type: apperrors.TypeNotFound, Code: apperrors.CodeTaskNotFound, Message: "task not found"}}},
+		},
+		{
+			"Associate a task to a team board not joined",
+			uuid.Nil,
+			boardEntity.Command{Type: boardEntity.CommandAssociateTask, TeamUUID: teamUUID, TaskUUID: taskUUID},
+			&apperrors.ValidationErrors{Errors: []apperrors.ValidationError{{Field: "team_uuid", Type: apperrors.TypeState, Code: apperrors.CodeBoardNotJoined, Message: "team board not joined"}}},
+		},
+		{
+			"Invalid command",
+			uuid.Nil,
+			boardEntity.Command{Type: "unknown", TeamUUID: teamUUID},
+			&apperrors.ValidationErrors{Errors: []apperrors.ValidationError{{Field: "type", Type: apperrors.TypeInvalid, Code: apperrors.CodeInvalidCommand, Message: "invalid command type"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupMocks(t)
+			s := newSession(t, "Ada")
+			if tt.joined != uuid.Nil {
+				if err := s.Handle(context.Background(), boardEntity.Command{Type: boardEntity.CommandJoin, TeamUUID: tt.joined}); err != nil {
+					t.Fatalf("Handle() join error: %v", err)
+				}
+			}
+
+			err := s.Handle(context.Background(), tt.cmd)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Handle() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSession_Presence(t *testing.T) {
+	setupMocks(t)
+	ada := newSession(t, "Ada")
+	grace := newSession(t, "Grace")
+	otherAda := newSession(t, "Ada")
+	ctx := context.Background()
+
+	for _, step := range []struct {
+		s   *Session
+		cmd boardEntity.CommandType
+	}{
+		{ada, boardEntity.CommandJoin},
+		{grace, boardEntity.CommandJoin},
+		{otherAda, boardEntity.CommandJoin},
+		{grace, boardEntity.CommandLeave},
+	} {
+		if err := step.s.Handle(ctx, boardEntity.Command{Type: step.cmd, TeamUUID: teamUUID}); err != nil {
+			t.Fatalf("Handle() %s error: %v", step.cmd, err)
+		}
+	}
+
+	// A viewer with two connections is listed once
+	want := [][]string{{"Ada"}, {"Ada", "Grace"}, {"Ada", "Grace"}, {"Ada"}}
+	if diff := cmp.Diff(presence(ada), want); diff != "" {
+		t.Errorf("Handle() presence diff: %s", diff)
+	}
+
+	// Closing the session leaves the board
+	ada.Close(ctx)
+	if diff := cmp.Diff(presence(otherAda), [][]string{{"Ada", "Grace"}, {"Ada"}, {"Ada"}}); diff != "" {
+		t.Errorf("Close() presence diff: %s", diff)
+	}
+}
+
+func TestReceivePresence(t *testing.T) {
+	setupMocks(t)
+	s := newSession(t, "Ada")
+	if err := s.Handle(context.Background(), boardEntity.Command{Type: boardEntity.CommandJoin, TeamUUID: teamUUID}); err != nil {
+		t.Fatalf("Handle() join error: %v", err)
+	}
+	presence(s)
+
+	tests := []struct {
+		name    string
+		message publisher.Message
+		want    [][]string
+		wantErr bool
+	}{
+		{"Receive presence of a joined board", publisher.Message{Payload: []byte(teamUUID.String())}, [][]string{{"Ada"}}, false},
+		{"Receive presence of another board", publisher.Message{Payload: []byte(otherTeamUUID.String())}, [][]string{}, false},
+		{"Receive presence with invalid team", publisher.Message{Payload: []byte("invalid")}, [][]string{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ReceivePresence(context.Background(), tt.message)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReceivePresence() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(presence(s), tt.want); diff != "" {
+				t.Errorf("ReceivePresence() diff: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package board
+
+import (
+	"log"
+	"strings"
+	"time"
+)
+
+var Config Configuration
+
+type Configuration struct {
+	Secret              string `toml:"secret"`
+	TokenTTLHours       int    `toml:"token_ttl_hours"`
+	PingIntervalSeconds int    `toml:"ping_interval_seconds"`
+	PresenceTTLSeconds  int    `toml:"presence_ttl_seconds"`
+	MaxMessageBytes     int64  `toml:"max_message_bytes"`
+	SendBufferSize      int    `toml:"send_buffer_size"`
+	PresenceChannel     string `toml:"presence_channel"`
+	AllowedOrigins      string `toml:"allowed_origins"`
+}
+
+// minSecretLength is the shortest secret accepted for signing viewer tokens
+const minSecretLength = 32
+
+func LoadConfig(cfg *Configuration) error {
+	Config = *cfg
+
+	if len(Config.Secret) < minSecretLength {
+		log.Fatalf("Board secret must have at least %d characters", minSecretLength)
+	}
+
+	if Config.TokenTTLHours == 0 {
+		log.Fatal("Board token TTL is required")
+	}
+
+	if Config.PingIntervalSeconds == 0 {
+		log.Fatal("Board ping interval is required")
+	}
+
+	if Config.PresenceTTLSeconds <= Config.PingIntervalSeconds {
+		log.Fatal("Board presence TTL must be greater than the ping interval")
+	}
+
+	if Config.MaxMessageBytes == 0 {
+		log.Fatal("Board max message size is required")
+	}
+
+	if Config.SendBufferSize == 0 {
+		log.Fatal("Board send buffer size is required")
+	}
+
+	if Config.PresenceChannel == "" {
+		log.Fatal("Board presence channel is required")
+	}
+
+	return nil
+}
+
+// TokenTTL returns how long the issued viewer tokens are valid
+func (c Configuration) TokenTTL() time.Duration {
+	return time.Duration(c.TokenTTLHours) * time.Hour
+}
+
+// PingInterval returns the interval between pings, which also refresh the presence of the viewer
+func (c Configuration) PingInterval() time.Duration {
+	return time.Duration(c.PingIntervalSeconds) * time.Second
+}
+
+// PresenceTTL returns how long a viewer is listed on a board without a refresh
+func (c Configuration) PresenceTTL() time.Duration {
+	return time.Duration(c.PresenceTTLSeconds) * time.Second
+}
+
+// Origins returns the browser origins, other than the API's own, allowed to open board channels
+// AllowedOrigins lists them separated by commas
+func (c Configuration) Origins() []string {
+	var origins []string
+	for origin := range strings.SplitSeq(c.AllowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
@@ -0,0 +1,39 @@
+//go:build test
+
+package board
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/testing/configtest"
+	"taskmanager/internal/usecase/eventstream"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Board  Configuration             `toml:"board"`
+			Events eventstream.Configuration `toml:"events"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load board config
+		if err := LoadConfig(&appConfig.Board); err != nil {
+			log.Fatalf("Error on load board config. Err: %s", err)
+		}
+
+		// Load event stream config, which feeds the events of the joined boards
+		if err := eventstream.LoadConfig(&appConfig.Events); err != nil {
+			log.Fatalf("Error on load event stream config. Err: %s", err)
+		}
+
+		return m.Run()
+	}(m))
+}
@@ -0,0 +1,42 @@
+package eventstream
+
+import (
+	"log"
+	"time"
+)
+
+var Config Configuration
+
+type Configuration struct {
+	Channel          string `toml:"channel"`
+	BufferSize       int    `toml:"buffer_size"`
+	HeartbeatSeconds int    `toml:"heartbeat_seconds"`
+	ReplayLimit      int    `toml:"replay_limit"`
+}
+
+func LoadConfig(cfg *Configuration) error {
+	Config = *cfg
+
+	if Config.Channel == "" {
+		log.Fatal("Event stream channel is required")
+	}
+
+	if Config.BufferSize == 0 {
+		log.Fatal("Event stream buffer size is required")
+	}
+
+	if Config.HeartbeatSeconds == 0 {
+		log.Fatal("Event stream heartbeat is required")
+	}
+
+	if Config.ReplayLimit == 0 {
+		log.Fatal("Event stream replay limit is required")
+	}
+
+	return nil
+}
+
+// Heartbeat returns the interval between keep-alive comments sent to idle streams
+func (c Configuration) Heartbeat() time.Duration {
+	return time.Duration(c.HeartbeatSeconds) * time.Second
+}
@@ -0,0 +1,147 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/google/uuid"
+
+	webhookEntity "taskmanager/internal/entity/webhook"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/publisher"
+	outboxRepo "taskmanager/internal/repository/outbox"
+	teamRepo "taskmanager/internal/repository/team"
+)
+
+// Subscription receives the events broadcast on this replica, optionally restricted to one team
+// Its channel is closed when the subscriber falls more than Config.BufferSize events behind
+type Subscription struct {
+	teamUUID *uuid.UUID
+	events   chan webhookEntity.Event
+}
+
+// subscriptions holds the open subscriptions of this replica
+var (
+	mu            sync.Mutex
+	subscriptions = map[*Subscription]struct{}{}
+)
+
+// Subscribe opens a subscription to the events of a team, or of every team when teamUUID is nil
+// Returns ErrNotFound when the team does not exist
+func Subscribe(ctx context.Context, teamUUID *uuid.UUID) (*Subscription, error) {
+	if teamUUID != nil {
+		if _, err := teamRepo.Persist().RetrieveByUUID(ctx, *teamUUID); err != nil {
+			return nil, err
+		}
+	}
+
+	s := &Subscription{
+		teamUUID: teamUUID,
+		events:   make(chan webhookEntity.Event, Config.BufferSize),
+	}
+
+	mu.Lock()
+	subscriptions[s] = struct{}{}
+	mu.Unlock()
+
+	return s, nil
+}
+
+// Events returns the channel of events broadcast to the subscription
+func (s *Subscription) Events() <-chan webhookEntity.Event {
+	return s.events
+}
+
+// Close unregisters the subscription and closes its channel; safe to call more than once
+func (s *Subscription) Close() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	remove(s)
+}
+
+// Replay returns the events published after lastEventID that match the subscription, oldest first
+// At most Config.ReplayLimit events are read; nothing is replayed when lastEventID is unknown or was purged
+func (s *Subscription) Replay(ctx context.Context, lastEventID uuid.UUID) ([]webhookEntity.Event, error) {
+	messages, err := outboxRepo.Persist().ListPublishedAfter(ctx, lastEventID, Config.ReplayLimit)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			slog.Warn("Event stream resume point not found, nothing to replay", "last_event_id", lastEventID)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	events := make([]webhookEntity.Event, 0, len(messages))
+	for _, m := range messages {
+		event := webhookEntity.Event{
+			ID:      m.UUID,
+			Type:    webhookEntity.EventType(m.EventType),
+			Payload: m.Payload,
+		}
+		if s.matches(event.TeamUUID()) {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// matches reports whether an event of the given team is delivered to the subscription
+func (s *Subscription) matches(teamUUID *uuid.UUID) bool {
+	if s.teamUUID == nil {
+		return true
+	}
+	return teamUUID != nil && *teamUUID == *s.teamUUID
+}
+
+// Broadcast sends an event to the matching subscriptions of this replica without blocking
+// Subscriptions with a full buffer are closed, so their clients reconnect and replay what they missed
+func Broadcast(e webhookEntity.Event) {
+	teamUUID := e.TeamUUID()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for s := range subscriptions {
+		if !s.matches(teamUUID) {
+			continue
+		}
+
+		select {
+		case s.events <- e:
+		default:
+			slog.Warn("Closing lagging event stream subscription", "event_id", e.ID)
+			remove(s)
+		}
+	}
+}
+
+// Receive broadcasts an event relayed through pub/sub by any replica, this one included
+func Receive(m publisher.Message) error {
+	eventID, err := uuid.Parse(m.ID)
+	if err != nil {
+		return fmt.Errorf("invalid event id %q: %w", m.ID, err)
+	}
+
+	Broadcast(webhookEntity.Event{
+		ID:      eventID,
+		Type:    webhookEntity.EventType(m.Type),
+		Payload: string(m.Payload),
+	})
+
+	return nil
+}
+
+// remove unregisters and closes a subscription; the caller must hold mu
+func remove(s *Subscription) {
+	if _, ok := subscriptions[s]; !ok {
+		return
+	}
+
+	delete(subscriptions, s)
+	close(s.events)
+}
@@ -0,0 +1,267 @@
+//go:build test
+
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	outboxEntity "taskmanager/internal/entity/outbox"
+	teamEntity "taskmanager/internal/entity/team"
+	webhookEntity "taskmanager/internal/entity/webhook"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/publisher"
+	"taskmanager/internal/platform/testing/assert"
+	outboxRepo "taskmanager/internal/repository/outbox"
+	teamRepo "taskmanager/internal/repository/team"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+var (
+	teamUUID      = uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+	otherTeamUUID = uuid.MustParse("222e4567-e89b-12d3-a456-426614174000")
+	taskUUID      = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+)
+
+// newEvent creates an event of a task in the given team
+func newEvent(t *testing.T, team *uuid.UUID) webhookEntity.Event {
+	event, err := webhookEntity.NewEvent(webhookEntity.EventTaskUpdated, webhookEntity.TaskData{UUID: taskUUID, TeamUUID: team}, time.Now())
+	if err != nil {
+		t.Fatalf("NewEvent() error: %v", err)
+	}
+	return event
+}
+
+// receivedIDs drains the events already sent to a subscription
+func receivedIDs(s *Subscription) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for {
+		select {
+		case e, ok := <-s.Events():
+			if !ok {
+				return ids
+			}
+			ids = append(ids, e.ID)
+		default:
+			return ids
+		}
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	originalPersist := teamRepo.Persist()
+	teamRepo.SetPersist(&teamRepo.MockPersistent{
+		FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+			if id != teamUUID {
+				return nil, errs.ErrNotFound
+			}
+			return &teamEntity.Team{UUID: id}, nil
+		},
+	})
+	defer teamRepo.SetPersist(originalPersist)
+
+	tests := []struct {
+		name     string
+		teamUUID *uuid.UUID
+		wantErr  error
+	}{
+		{"Subscribe to every team", nil, nil},
+		{"Subscribe to a team", &teamUUID, nil},
+		{"Subscribe to a team not found", &otherTeamUUID, errs.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Subscribe(context.Background(), tt.teamUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Subscribe() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			s.Close()
+			s.Close()
+			if _, ok := <-s.Events(); ok {
+				t.Errorf("Subscribe() channel open after Close()")
+			}
+		})
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	all := &Subscription{events: make(chan webhookEntity.Event, Config.BufferSize)}
+	team := &Subscription{teamUUID: &teamUUID, events: make(chan webhookEntity.Event, Config.BufferSize)}
+	other := &Subscription{teamUUID: &otherTeamUUID, events: make(chan webhookEntity.Event, Config.BufferSize)}
+	for _, s := range []*Subscription{all, team, other} {
+		mu.Lock()
+		subscriptions[s] = struct{}{}
+		mu.Unlock()
+		defer s.Close()
+	}
+
+	inTeam := newEvent(t, &teamUUID)
+	noTeam := newEvent(t, nil)
+	Broadcast(inTeam)
+	Broadcast(noTeam)
+
+	if diff := cmp.Diff(receivedIDs(all), []uuid.UUID{inTeam.ID, noTeam.ID}); diff != "" {
+		t.Errorf("Broadcast() all teams diff: %s", diff)
+	}
+	if diff := cmp.Diff(receivedIDs(team), []uuid.UUID{inTeam.ID}); diff != "" {
+		t.Errorf("Broadcast() team diff: %s", diff)
+	}
+	if diff := cmp.Diff(receivedIDs(other), []uuid.UUID{}); diff != "" {
+		t.Errorf("Broadcast() other team diff: %s", diff)
+	}
+}
+
+func TestBroadcast_LaggingSubscription(t *testing.T) {
+	s := &Subscription{events: make(chan webhookEntity.Event, Config.BufferSize)}
+	mu.Lock()
+	subscriptions[s] = struct{}{}
+	mu.Unlock()
+	defer s.Close()
+
+	for i := 0; i <= Config.BufferSize; i++ {
+		Broadcast(newEvent(t, nil))
+	}
+
+	// The buffered events are still delivered before the channel is closed
+	received := 0
+	for range s.Events() {
+		received++
+	}
+	if received != Config.BufferSize {
+		t.Errorf("Broadcast() delivered %d events, want %d", received, Config.BufferSize)
+	}
+
+	mu.Lock()
+	_, ok := subscriptions[s]
+	mu.Unlock()
+	if ok {
+		t.Errorf("Broadcast() kept the lagging subscription registered")
+	}
+}
+
+func TestReceive(t *testing.T) {
+	s := &Subscription{events: make(chan webhookEntity.Event, Config.BufferSize)}
+	mu.Lock()
+	subscriptions[s] = struct{}{}
+	mu.Unlock()
+	defer s.Close()
+
+	event := newEvent(t, nil)
+
+	tests := []struct {
+		name    string
+		message publisher.Message
+		want    []uuid.UUID
+		wantErr bool
+	}{
+		{
+			"Receive message with success",
+			publisher.Message{ID: event.ID.String(), Type: string(event.Type), Payload: []byte(event.Payload)},
+			[]uuid.UUID{event.ID},
+			false,
+		},
+		{
+			"Receive message with invalid ID",
+			publisher.Message{ID: "invalid", Type: string(event.Type), Payload: []byte(event.Payload)},
+			[]uuid.UUID{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Receive(tt.message)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Receive() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(receivedIDs(s), tt.want); diff != "" {
+				t.Errorf("Receive() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSubscription_Replay(t *testing.T) {
+	originalPersist := outboxRepo.Persist()
+	defer outboxRepo.SetPersist(originalPersist)
+
+	inTeam := newEvent(t, &teamUUID)
+	noTeam := newEvent(t, nil)
+	published := []outboxEntity.Message{
+		{UUID: inTeam.ID, EventType: string(inTeam.Type), Payload: inTeam.Payload},
+		{UUID: noTeam.ID, EventType: string(noTeam.Type), Payload: noTeam.Payload},
+	}
+	lastEventID := uuid.MustParse("eeee4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name         string
+		subscription *Subscription
+		listErr      error
+		want         []webhookEntity.Event
+		wantErr      error
+	}{
+		{
+			"Replay events of every team",
+			&Subscription{},
+			nil,
+			[]webhookEntity.Event{inTeam, noTeam},
+			nil,
+		},
+		{
+			"Replay events of a team",
+			&Subscription{teamUUID: &teamUUID},
+			nil,
+			[]webhookEntity.Event{inTeam},
+			nil,
+		},
+		{
+			"Replay from an event purged or unknown",
+			&Subscription{},
+			errs.ErrNotFound,
+			nil,
+			nil,
+		},
+		{
+			"Replay with persistence error",
+			&Subscription{},
+			errors.New("query failed"),
+			nil,
+			errors.New("query failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+				FnListPublishedAfter: func(ctx context.Context, messageUUID uuid.UUID, limit int) ([]outboxEntity.Message, error) {
+					if messageUUID != lastEventID || limit != Config.ReplayLimit {
+						t.Errorf("ListPublishedAfter() called with %v, %d", messageUUID, limit)
+					}
+					if tt.listErr != nil {
+						return nil, tt.listErr
+					}
+					return published, nil
+				},
+			})
+
+			got, err := tt.subscription.Replay(context.Background(), lastEventID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Subscription.Replay() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Subscription.Replay() diff: %s", diff)
+			}
+		})
+	}
+}
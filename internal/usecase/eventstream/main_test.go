@@ -0,0 +1,32 @@
+//go:build test
+
+package eventstream
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/testing/configtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Events Configuration `toml:"events"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load event stream config
+		if err := LoadConfig(&appConfig.Events); err != nil {
+			log.Fatalf("Error on load event stream config. Err: %s", err)
+		}
+
+		return m.Run()
+	}(m))
+}
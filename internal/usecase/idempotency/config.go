@@ -0,0 +1,37 @@
+package idempotency
+
+import (
+	"log"
+	"time"
+)
+
+var Config Configuration
+
+type Configuration struct {
+	TTLHours             int `toml:"ttl_hours"`
+	PurgeIntervalMinutes int `toml:"purge_interval_minutes"`
+}
+
+func LoadConfig(cfg *Configuration) error {
+	Config = *cfg
+
+	if Config.TTLHours == 0 {
+		log.Fatal("Idempotency TTL is required")
+	}
+
+	if Config.PurgeIntervalMinutes == 0 {
+		log.Fatal("Idempotency purge interval is required")
+	}
+
+	return nil
+}
+
+// TTL returns how long a key is replayed after the request that first used it
+func (c Configuration) TTL() time.Duration {
+	return time.Duration(c.TTLHours) * time.Hour
+}
+
+// PurgeInterval returns the interval between purges of the expired keys
+func (c Configuration) PurgeInterval() time.Duration {
+	return time.Duration(c.PurgeIntervalMinutes) * time.Minute
+}
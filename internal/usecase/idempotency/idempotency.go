@@ -0,0 +1,55 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	idempotencyEntity "taskmanager/internal/entity/idempotency"
+	idempotencyRepo "taskmanager/internal/repository/idempotency"
+)
+
+// Begin reserves the key for the request with the given hash in the transaction held by ctx
+// Returns the stored key, whose response is replayed, when a committed request with the same hash
+// already used it, and ValidationErrors when that request was a different one. A request holding
+// the key concurrently is waited for; a request that fails leaves the key free, as its transaction
+// is rolled back with the reservation
+func Begin(ctx context.Context, key, requestHash string) (*idempotencyEntity.Key, error) {
+	if err := idempotencyEntity.ValidateKey(key); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reserved, err := idempotencyRepo.Persist().Reserve(ctx, &idempotencyEntity.Key{
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(Config.TTL()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	stored, err := idempotencyRepo.Persist().RetrieveByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stored.CheckRequest(requestHash); err != nil {
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+// Complete stores the response replayed for a key reserved by Begin
+func Complete(ctx context.Context, key string, statusCode int, headers map[string]string, body []byte) error {
+	return idempotencyRepo.Persist().SaveResponse(ctx, key, statusCode, headers, body)
+}
+
+// Purge removes the expired keys
+func Purge(ctx context.Context) (int64, error) {
+	return idempotencyRepo.Persist().DeleteExpired(ctx, time.Now())
+}
@@ -0,0 +1,156 @@
+//go:build test
+
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	idempotencyEntity "taskmanager/internal/entity/idempotency"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	idempotencyRepo "taskmanager/internal/repository/idempotency"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBegin(t *testing.T) {
+	originalPersist := idempotencyRepo.Persist()
+
+	stored := &idempotencyEntity.Key{
+		Key:          "key",
+		RequestHash:  "hash",
+		StatusCode:   200,
+		ResponseBody: []byte(`{"uuid":"123e4567-e89b-12d3-a456-426614174000"}`),
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		requestHash string
+		reserved    bool
+		reserveErr  error
+		retrieveErr error
+		want        *idempotencyEntity.Key
+		wantErr     error
+	}{
+		{"Reserve a new key", "key", "hash", true, nil, nil, nil, nil},
+		{"Replay the response of the same request", "key", "hash", false, nil, nil, stored, nil},
+		{
+			"Refuse a key used for a different request",
+			"key", "other", false, nil, nil, nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "Idempotency-Key", Type: errs.TypeConflict, Code: errs.CodeIdempotencyKeyReused, Message: "Idempotency-Key was already used for a different request"},
+			}},
+		},
+		{
+			"Refuse a key over the maximum length",
+			strings.Repeat("k", idempotencyEntity.MaxKeyLength+1), "hash", true, nil, nil, nil,
+			&errs.BadRequestError{Message: "Idempotency-Key must not exceed 255 characters", Field: "Idempotency-Key"},
+		},
+		{"Reserve with persistence error", "key", "hash", false, errors.New("insert failed"), nil, nil, errors.New("insert failed")},
+		{"Retrieve with persistence error", "key", "hash", false, nil, errors.New("select failed"), nil, errors.New("select failed")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reservedKey *idempotencyEntity.Key
+			idempotencyRepo.SetPersist(&idempotencyRepo.MockPersistent{
+				FnReserve: func(ctx context.Context, k *idempotencyEntity.Key) (bool, error) {
+					reservedKey = k
+					return tt.reserved, tt.reserveErr
+				},
+				FnRetrieveByKey: func(ctx context.Context, key string) (*idempotencyEntity.Key, error) {
+					if tt.retrieveErr != nil {
+						return nil, tt.retrieveErr
+					}
+					return stored, nil
+				},
+			})
+			defer idempotencyRepo.SetPersist(originalPersist)
+
+			got, err := Begin(context.Background(), tt.key, tt.requestHash)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Begin() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Begin() diff: %s", diff)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if reservedKey.Key != tt.key || reservedKey.RequestHash != tt.requestHash {
+				t.Errorf("Begin() reserved key=%q hash=%q", reservedKey.Key, reservedKey.RequestHash)
+			}
+			if ttl := reservedKey.ExpiresAt.Sub(reservedKey.CreatedAt); ttl != Config.TTL() {
+				t.Errorf("Begin() reserved for %v, want %v", ttl, Config.TTL())
+			}
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	originalPersist := idempotencyRepo.Persist()
+
+	tests := []struct {
+		name    string
+		saveErr error
+		wantErr error
+	}{
+		{"Complete with success", nil, nil},
+		{"Complete with persistence error", errors.New("update failed"), errors.New("update failed")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotKey string
+			var gotStatus int
+			var gotHeaders map[string]string
+			var gotBody []byte
+			idempotencyRepo.SetPersist(&idempotencyRepo.MockPersistent{
+				FnSaveResponse: func(ctx context.Context, key string, statusCode int, headers map[string]string, body []byte) error {
+					gotKey, gotStatus, gotHeaders, gotBody = key, statusCode, headers, body
+					return tt.saveErr
+				},
+			})
+			defer idempotencyRepo.SetPersist(originalPersist)
+
+			err := Complete(context.Background(), "key", 202, map[string]string{"Location": "/api/imports/1"}, []byte(`{"status":"pending"}`))
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Complete() error diff: %s", diff)
+				return
+			}
+			if gotKey != "key" || gotStatus != 202 || gotHeaders["Location"] != "/api/imports/1" || string(gotBody) != `{"status":"pending"}` {
+				t.Errorf("Complete() saved key=%q status=%d headers=%v body=%s", gotKey, gotStatus, gotHeaders, gotBody)
+			}
+		})
+	}
+}
+
+func TestPurge(t *testing.T) {
+	originalPersist := idempotencyRepo.Persist()
+
+	var gotNow time.Time
+	idempotencyRepo.SetPersist(&idempotencyRepo.MockPersistent{
+		FnDeleteExpired: func(ctx context.Context, now time.Time) (int64, error) {
+			gotNow = now
+			return 3, nil
+		},
+	})
+	defer idempotencyRepo.SetPersist(originalPersist)
+
+	before := time.Now()
+	got, err := Purge(context.Background())
+	if err != nil {
+		t.Fatalf("Purge() error: %v", err)
+	}
+	if got != 3 {
+		t.Errorf("Purge() = %d, want 3", got)
+	}
+	if gotNow.Before(before) {
+		t.Errorf("Purge() deleted keys expired at %v, want after %v", gotNow, before)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build test
+
+package idempotency
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/testing/configtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Idempotency Configuration `toml:"idempotency"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load idempotency config
+		if err := LoadConfig(&appConfig.Idempotency); err != nil {
+			log.Fatalf("Error on load idempotency config. Err: %s", err)
+		}
+
+		return m.Run()
+	}(m))
+}
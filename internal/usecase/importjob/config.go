@@ -0,0 +1,32 @@
+package importjob
+
+import (
+	"log"
+	"time"
+)
+
+var Config Configuration
+
+type Configuration struct {
+	MaxRows                   int `toml:"max_rows"`
+	WorkerPollIntervalSeconds int `toml:"worker_poll_interval_seconds"`
+}
+
+func LoadConfig(cfg *Configuration) error {
+	Config = *cfg
+
+	if Config.MaxRows == 0 {
+		log.Fatal("Import max rows is required")
+	}
+
+	if Config.WorkerPollIntervalSeconds == 0 {
+		log.Fatal("Import worker poll interval is required")
+	}
+
+	return nil
+}
+
+// WorkerPollInterval returns the interval between polls for pending import jobs
+func (c Configuration) WorkerPollInterval() time.Duration {
+	return time.Duration(c.WorkerPollIntervalSeconds) * time.Second
+}
@@ -0,0 +1,220 @@
+package importjob
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	importEntity "taskmanager/internal/entity/importjob"
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	apperrors "taskmanager/internal/platform/errors"
+	importRepo "taskmanager/internal/repository/importjob"
+	taskRepo "taskmanager/internal/repository/task"
+	teamRepo "taskmanager/internal/repository/team"
+	taskUsecase "taskmanager/internal/usecase/task"
+)
+
+// Submit validates an import job and stores it as pending for asynchronous processing
+// The content is parsed up front so structural problems are reported immediately
+func Submit(ctx context.Context, j *importEntity.ImportJob) error {
+	if err := j.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := parseRows(j.Format, j.Mapping, j.Content); err != nil {
+		return err
+	}
+
+	j.Team = strings.TrimSpace(j.Team)
+	j.Status = importEntity.StatusPending
+	j.Report = []importEntity.RowError{}
+
+	return importRepo.Persist().Create(ctx, j)
+}
+
+// RetrieveByUUID retrieves an import job by UUID
+func RetrieveByUUID(ctx context.Context, jobUUID uuid.UUID) (*importEntity.ImportJob, error) {
+	return importRepo.Persist().RetrieveByUUID(ctx, jobUUID)
+}
+
+// Run imports the rows of a job and fills its counters and per-row report
+// Invalid rows are skipped and reported; in dry-run mode rows are validated and
+// teams resolved without creating tasks. The list cache is invalidated once.
+func Run(ctx context.Context, j *importEntity.ImportJob) error {
+	if err := j.Validate(); err != nil {
+		return err
+	}
+
+	rows, err := parseRows(j.Format, j.Mapping, j.Content)
+	if err != nil {
+		return err
+	}
+
+	teams := teamResolver{}
+	defaultTeamID, err := teams.resolve(ctx, strings.TrimSpace(j.Team))
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+				apperrors.NewValidationError("team", apperrors.CodeTeamNotFound, nil),
+			}}
+		}
+		return err
+	}
+
+	ctx, flushCache := taskRepo.DeferListCacheInvalidation(ctx)
+
+	j.TotalRows = len(rows)
+	j.ImportedRows = 0
+	j.FailedRows = 0
+	j.Report = []importEntity.RowError{}
+
+	for _, r := range rows {
+		rowErrs, err := importRow(ctx, j.DryRun, r, defaultTeamID, teams)
+		if err != nil {
+			return err
+		}
+		if len(rowErrs) > 0 {
+			j.FailedRows++
+			j.Report = append(j.Report, importEntity.RowError{Row: r.number, Errors: rowErrs})
+			continue
+		}
+		j.ImportedRows++
+	}
+
+	flushCache()
+
+	return nil
+}
+
+// ProcessNext claims the oldest pending import job, runs it and stores the outcome
+// A job whose content or team is invalid is stored as failed; unexpected errors are
+// returned with the claimed job so the caller can record them after rolling back
+// Returns ErrNotFound when no job is pending
+func ProcessNext(ctx context.Context) (*importEntity.ImportJob, error) {
+	j, err := importRepo.Persist().ClaimNextPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt := time.Now()
+	j.StartedAt = &startedAt
+	j.Status = importEntity.StatusCompleted
+
+	if err := Run(ctx, j); err != nil {
+		var validationErrs *apperrors.ValidationErrors
+		if !errors.As(err, &validationErrs) {
+			return j, err
+		}
+		j.Status = importEntity.StatusFailed
+		j.ErrorMessage = validationErrs.Error()
+	}
+
+	finishedAt := time.Now()
+	j.FinishedAt = &finishedAt
+
+	return j, importRepo.Persist().Update(ctx, j)
+}
+
+// MarkFailed records an unexpected processing error on an import job
+func MarkFailed(ctx context.Context, jobUUID uuid.UUID, cause error) error {
+	j, err := importRepo.Persist().RetrieveByUUID(ctx, jobUUID)
+	if err != nil {
+		return err
+	}
+
+	finishedAt := time.Now()
+	j.Status = importEntity.StatusFailed
+	j.ErrorMessage = cause.Error()
+	j.FinishedAt = &finishedAt
+
+	return importRepo.Persist().Update(ctx, j)
+}
+
+// importRow validates a single row and creates its task unless dryRun is set
+// Returns the row's validation errors, or an error for unexpected failures
+func importRow(ctx context.Context, dryRun bool, r row, defaultTeamID *uint, teams teamResolver) ([]apperrors.ValidationError, error) {
+	if len(r.errs) > 0 {
+		return r.errs, nil
+	}
+
+	t := &taskEntity.Task{
+		Title:       r.title,
+		Description: r.description,
+		TeamID:      defaultTeamID,
+	}
+
+	var rowErrs []apperrors.ValidationError
+	if err := t.Validate(); err != nil {
+		rowErrs = append(rowErrs, err.Errors...)
+	}
+
+	if team := strings.TrimSpace(r.team); team != "" {
+		teamID, err := teams.resolve(ctx, team)
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			rowErrs = append(rowErrs, apperrors.NewValidationError("team", apperrors.CodeTeamNotFound, nil))
+		case err != nil:
+			return nil, err
+		default:
+			t.TeamID = teamID
+		}
+	}
+
+	if len(rowErrs) > 0 || dryRun {
+		return rowErrs, nil
+	}
+
+	if err := taskUsecase.Create(ctx, t); err != nil {
+		var validationErrs *apperrors.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			return validationErrs.Errors, nil
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// teamResolver resolves team references by UUID or name, remembering previous lookups
+type teamResolver map[string]*uint
+
+// resolve returns the ID of the team referenced by a UUID or a name
+// Returns nil for an empty reference and ErrNotFound when no team matches
+func (tr teamResolver) resolve(ctx context.Context, reference string) (*uint, error) {
+	if reference == "" {
+		return nil, nil
+	}
+
+	key := strings.ToLower(reference)
+	if teamID, ok := tr[key]; ok {
+		if teamID == nil {
+			return nil, apperrors.ErrNotFound
+		}
+		return teamID, nil
+	}
+
+	var (
+		team *teamEntity.Team
+		err  error
+	)
+	if teamUUID, parseErr := uuid.Parse(reference); parseErr == nil {
+		team, err = teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	} else {
+		team, err = teamRepo.Persist().RetrieveByName(ctx, reference)
+	}
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		tr[key] = nil
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tr[key] = &team.ID
+	return &team.ID, nil
+}
@@ -0,0 +1,371 @@
+//go:build test
+
+package importjob
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	importEntity "taskmanager/internal/entity/importjob"
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	importRepo "taskmanager/internal/repository/importjob"
+	taskRepo "taskmanager/internal/repository/task"
+	teamRepo "taskmanager/internal/repository/team"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+var backendUUID = uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+
+// mockTeams returns a team repository with the Backend team (ID 1)
+func mockTeams() *teamRepo.MockPersistent {
+	backend := &teamEntity.Team{UUID: backendUUID, Name: "Backend"}
+	backend.ID = 1
+	return &teamRepo.MockPersistent{
+		FnRetrieveByUUID: func(ctx context.Context, teamUUID uuid.UUID) (*teamEntity.Team, error) {
+			if teamUUID != backendUUID {
+				return nil, errs.ErrNotFound
+			}
+			return backend, nil
+		},
+		FnRetrieveByName: func(ctx context.Context, name string) (*teamEntity.Team, error) {
+			if !strings.EqualFold(name, "Backend") {
+				return nil, errs.ErrNotFound
+			}
+			return backend, nil
+		},
+		FnRetrieveTaskTeamUUID: func(ctx context.Context, taskUUID uuid.UUID) (*uuid.UUID, error) {
+			return &backend.UUID, nil
+		},
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	originalPersist := importRepo.Persist()
+
+	tests := []struct {
+		name    string
+		setup   func()
+		job     *importEntity.ImportJob
+		want    *importEntity.ImportJob
+		wantErr error
+	}{
+		{
+			"Submit import with success",
+			func() {
+				importRepo.SetPersist(&importRepo.MockPersistent{
+					FnCreate: func(ctx context.Context, j *importEntity.ImportJob) error {
+						return nil
+					},
+				})
+			},
+			&importEntity.ImportJob{
+				Format:  importEntity.FormatCSV,
+				Content: "title,description\nTarefa,Descrição\n",
+				Team:    "  Backend ",
+			},
+			&importEntity.ImportJob{
+				Format:  importEntity.FormatCSV,
+				Status:  importEntity.StatusPending,
+				Content: "title,description\nTarefa,Descrição\n",
+				Team:    "Backend",
+				Report:  []importEntity.RowError{},
+			},
+			nil,
+		},
+		{
+			"Submit import with invalid job",
+			nil,
+			&importEntity.ImportJob{Format: "xlsx"},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "format", Type: errs.TypeInvalid, Code: errs.CodeInvalidValue, Message: "invalid format value"},
+				{Field: "content", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "content is required"},
+			}},
+		},
+		{
+			"Submit import with missing columns",
+			nil,
+			&importEntity.ImportJob{
+				Format:  importEntity.FormatCSV,
+				Content: "name,description\nTarefa,Descrição\n",
+			},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "mapping.title", Type: errs.TypeNotFound, Code: errs.CodeColumnNotFound, Message: `column "title" not found`, Params: map[string]any{"column": "title"}},
+			}},
+		},
+		{
+			"Submit import with repository error",
+			func() {
+				importRepo.SetPersist(&importRepo.MockPersistent{
+					FnCreate: func(ctx context.Context, j *importEntity.ImportJob) error {
+						return errors.New("database connection failed")
+					},
+				})
+			},
+			&importEntity.ImportJob{
+				Format:  importEntity.FormatNDJSON,
+				Content: `{"title":"Tarefa","description":"Descrição"}`,
+			},
+			nil,
+			errors.New("database connection failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer importRepo.SetPersist(originalPersist)
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			err := Submit(context.Background(), tt.job)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Submit() error diff: %s", diff)
+				return
+			}
+			if tt.want == nil {
+				return
+			}
+			if diff := cmp.Diff(tt.job, tt.want); diff != "" {
+				t.Errorf("Submit() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	originalTaskPersist := taskRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	backendID := uint(1)
+
+	tests := []struct {
+		name        string
+		job         *importEntity.ImportJob
+		wantCreated []taskEntity.Task
+		wantJob     func(j *importEntity.ImportJob) *importEntity.ImportJob
+		wantErr     error
+	}{
+		{
+			"Run import with default team and per-row errors",
+			&importEntity.ImportJob{
+				Format:  importEntity.FormatCSV,
+				Mapping: importEntity.Mapping{"team": "equipe"},
+				Team:    backendUUID.String(),
+				Content: "title,description,equipe\nImplementar autenticação,Criar sistema JWT,\n,Sem título,\nConfigurar CI/CD,Pipeline,backend\nDocumentar API,Swagger,Mobile\n",
+			},
+			[]taskEntity.Task{
+				{Title: "Implementar autenticação", Description: "Criar sistema JWT", Status: taskEntity.StatusTodo, TeamID: &backendID, Rank: "00000001"},
+				{Title: "Configurar CI/CD", Description: "Pipeline", Status: taskEntity.StatusTodo, TeamID: &backendID, Rank: "00000002"},
+			},
+			func(j *importEntity.ImportJob) *importEntity.ImportJob {
+				j.TotalRows = 4
+				j.ImportedRows = 2
+				j.FailedRows = 2
+				j.Report = []importEntity.RowError{
+					{Row: 2, Errors: []errs.ValidationError{{Field: "title", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "title is required"}}},
+					{Row: 4, Errors: []errs.ValidationError{{Field: "team", Type: errs.TypeNotFound, Code: errs.CodeTeamNotFound, Message: "team not found"}}},
+				}
+				return j
+			},
+			nil,
+		},
+		{
+			"Run import in dry-run mode",
+			&importEntity.ImportJob{
+				Format:  importEntity.FormatNDJSON,
+				DryRun:  true,
+				Content: "{\"title\":\"Implementar autenticação\",\"description\":\"Criar sistema JWT\",\"team\":\"Backend\"}\n{\"title\":\"Tarefa\"}\n",
+			},
+			nil,
+			func(j *importEntity.ImportJob) *importEntity.ImportJob {
+				j.TotalRows = 2
+				j.ImportedRows = 1
+				j.FailedRows = 1
+				j.Report = []importEntity.RowError{
+					{Row: 2, Errors: []errs.ValidationError{{Field: "description", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "description is required"}}},
+				}
+				return j
+			},
+			nil,
+		},
+		{
+			"Run import with unknown default team",
+			&importEntity.ImportJob{
+				Format:  importEntity.FormatNDJSON,
+				Team:    "Mobile",
+				Content: `{"title":"Tarefa","description":"Descrição"}`,
+			},
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "team", Type: errs.TypeNotFound, Code: errs.CodeTeamNotFound, Message: "team not found"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				taskRepo.SetPersist(originalTaskPersist)
+				teamRepo.SetPersist(originalTeamPersist)
+			}()
+
+			var created []taskEntity.Task
+			taskRepo.SetPersist(&taskRepo.MockPersistent{
+				FnCreate: func(ctx context.Context, t *taskEntity.Task) error {
+					created = append(created, *t)
+					return nil
+				},
+				FnLastRank: func(ctx context.Context) (string, error) {
+					if len(created) == 0 {
+						return "", nil
+					}
+					return created[len(created)-1].Rank, nil
+				},
+			})
+			teamRepo.SetPersist(mockTeams())
+
+			var want *importEntity.ImportJob
+			if tt.wantJob != nil {
+				copied := *tt.job
+				want = tt.wantJob(&copied)
+			}
+
+			err := Run(context.Background(), tt.job)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Run() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(created, tt.wantCreated); diff != "" {
+				t.Errorf("Run() created tasks diff: %s", diff)
+			}
+			if want == nil {
+				return
+			}
+			if diff := cmp.Diff(tt.job, want); diff != "" {
+				t.Errorf("Run() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProcessNext(t *testing.T) {
+	originalImportPersist := importRepo.Persist()
+	originalTaskPersist := taskRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	jobUUID := uuid.MustParse("222e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name       string
+		setup      func(updated **importEntity.ImportJob)
+		wantStatus importEntity.Status
+		wantMsg    string
+		wantErr    error
+	}{
+		{
+			"Process next import with success",
+			func(updated **importEntity.ImportJob) {
+				importRepo.SetPersist(&importRepo.MockPersistent{
+					FnClaimNextPending: func(ctx context.Context) (*importEntity.ImportJob, error) {
+						return &importEntity.ImportJob{
+							UUID:    jobUUID,
+							Format:  importEntity.FormatNDJSON,
+							Status:  importEntity.StatusPending,
+							Content: `{"title":"Tarefa","description":"Descrição"}`,
+						}, nil
+					},
+					FnUpdate: func(ctx context.Context, j *importEntity.ImportJob) error {
+						*updated = j
+						return nil
+					},
+				})
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnCreate: func(ctx context.Context, t *taskEntity.Task) error {
+						return nil
+					},
+				})
+			},
+			importEntity.StatusCompleted,
+			"",
+			nil,
+		},
+		{
+			"Process next import with unknown team",
+			func(updated **importEntity.ImportJob) {
+				importRepo.SetPersist(&importRepo.MockPersistent{
+					FnClaimNextPending: func(ctx context.Context) (*importEntity.ImportJob, error) {
+						return &importEntity.ImportJob{
+							UUID:    jobUUID,
+							Format:  importEntity.FormatNDJSON,
+							Status:  importEntity.StatusPending,
+							Team:    "Mobile",
+							Content: `{"title":"Tarefa","description":"Descrição"}`,
+						}, nil
+					},
+					FnUpdate: func(ctx context.Context, j *importEntity.ImportJob) error {
+						*updated = j
+						return nil
+					},
+				})
+			},
+			importEntity.StatusFailed,
+			"team not found",
+			nil,
+		},
+		{
+			"Process next import without pending jobs",
+			func(updated **importEntity.ImportJob) {
+				importRepo.SetPersist(&importRepo.MockPersistent{
+					FnClaimNextPending: func(ctx context.Context) (*importEntity.ImportJob, error) {
+						return nil, errs.ErrNotFound
+					},
+				})
+			},
+			"",
+			"",
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				importRepo.SetPersist(originalImportPersist)
+				taskRepo.SetPersist(originalTaskPersist)
+				teamRepo.SetPersist(originalTeamPersist)
+			}()
+
+			teamRepo.SetPersist(mockTeams())
+			var updated *importEntity.ImportJob
+			tt.setup(&updated)
+
+			_, err := ProcessNext(context.Background())
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("ProcessNext() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if updated == nil {
+				t.Fatal("ProcessNext() did not update the job")
+			}
+			if updated.Status != tt.wantStatus {
+				t.Errorf("ProcessNext() status = %v, want %v", updated.Status, tt.wantStatus)
+			}
+			if updated.ErrorMessage != tt.wantMsg {
+				t.Errorf("ProcessNext() error message = %q, want %q", updated.ErrorMessage, tt.wantMsg)
+			}
+			if updated.StartedAt == nil || updated.FinishedAt == nil {
+				t.Errorf("ProcessNext() timestamps not set: started_at=%v finished_at=%v", updated.StartedAt, updated.FinishedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+//go:build test
+
+package importjob
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	outboxEntity "taskmanager/internal/entity/outbox"
+	"taskmanager/internal/paths"
+	outboxRepo "taskmanager/internal/repository/outbox"
+	"taskmanager/internal/testing/configtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Import Configuration `toml:"import"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load import config
+		if err := LoadConfig(&appConfig.Import); err != nil {
+			log.Fatalf("Error on load import config. Err: %s", err)
+		}
+
+		// Events are recorded in the outbox, which these tests do not persist
+		outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+			FnCreate: func(ctx context.Context, m *outboxEntity.Message) error {
+				return nil
+			},
+		})
+
+		return m.Run()
+	}(m))
+}
@@ -0,0 +1,174 @@
+package importjob
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	importEntity "taskmanager/internal/entity/importjob"
+	apperrors "taskmanager/internal/platform/errors"
+)
+
+// maxNDJSONLineBytes bounds the size of a single NDJSON line
+const maxNDJSONLineBytes = 1024 * 1024
+
+// row holds the values of an imported row mapped to task fields
+// errs is set when the row itself could not be read
+type row struct {
+	number      int
+	title       string
+	description string
+	team        string
+	errs        []apperrors.ValidationError
+}
+
+// parseRows reads the rows of an import content according to its format and mapping
+// Returns ValidationErrors for structural problems that prevent reading the file
+func parseRows(format importEntity.Format, mapping importEntity.Mapping, content string) ([]row, error) {
+	var (
+		rows []row
+		err  error
+	)
+
+	switch format {
+	case importEntity.FormatCSV:
+		rows, err = parseCSV(mapping, content)
+	case importEntity.FormatNDJSON:
+		rows, err = parseNDJSON(mapping, content)
+	default:
+		return nil, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("format", apperrors.CodeInvalidValue, nil),
+		}}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) > Config.MaxRows {
+		return nil, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("content", apperrors.CodeMaxRows, map[string]any{"max": Config.MaxRows}),
+		}}
+	}
+
+	return rows, nil
+}
+
+// parseCSV reads a CSV content whose first record is the header
+// The title and description columns are required; the team column only when mapped
+func parseCSV(mapping importEntity.Mapping, content string) ([]row, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, invalidContent(err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	var errs []apperrors.ValidationError
+	indexes := map[string]int{}
+	for _, field := range []string{importEntity.FieldTitle, importEntity.FieldDescription, importEntity.FieldTeam} {
+		index, ok := columns[mapping.Column(field)]
+		if ok {
+			indexes[field] = index
+			continue
+		}
+		if _, mapped := mapping[field]; field != importEntity.FieldTeam || mapped {
+			errs = append(errs, apperrors.NewValidationError("mapping."+field, apperrors.CodeColumnNotFound, map[string]any{"column": mapping.Column(field)}))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, &apperrors.ValidationErrors{Errors: errs}
+	}
+
+	value := func(record []string, field string) string {
+		index, ok := indexes[field]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return record[index]
+	}
+
+	var rows []row
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, invalidContent(err)
+		}
+
+		rows = append(rows, row{
+			number:      number,
+			title:       value(record, importEntity.FieldTitle),
+			description: value(record, importEntity.FieldDescription),
+			team:        value(record, importEntity.FieldTeam),
+		})
+	}
+
+	return rows, nil
+}
+
+// parseNDJSON reads a content with one JSON object per line; blank lines are skipped
+// A line that is not a JSON object is reported as a row error instead of failing the file
+func parseNDJSON(mapping importEntity.Mapping, content string) ([]row, error) {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLineBytes)
+
+	var rows []row
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var object map[string]any
+		if err := json.Unmarshal([]byte(line), &object); err != nil {
+			rows = append(rows, row{
+				number: number,
+				errs:   []apperrors.ValidationError{apperrors.NewValidationError("content", apperrors.CodeInvalidJSON, nil)},
+			})
+			continue
+		}
+
+		rows = append(rows, row{
+			number:      number,
+			title:       stringValue(object[mapping.Column(importEntity.FieldTitle)]),
+			description: stringValue(object[mapping.Column(importEntity.FieldDescription)]),
+			team:        stringValue(object[mapping.Column(importEntity.FieldTeam)]),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, invalidContent(err)
+	}
+
+	return rows, nil
+}
+
+// stringValue converts a decoded JSON value to string; null and absent values become empty
+func stringValue(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// invalidContent wraps a read error of the import content as a validation error
+func invalidContent(err error) *apperrors.ValidationErrors {
+	return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+		apperrors.NewValidationError("content", apperrors.CodeInvalidContent, map[string]any{"reason": err.Error()}),
+	}}
+}
@@ -0,0 +1,123 @@
+//go:build test
+
+package importjob
+
+import (
+	"testing"
+
+	importEntity "taskmanager/internal/entity/importjob"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_parseRows(t *testing.T) {
+	tests := []struct {
+		name    string
+		maxRows int
+		format  importEntity.Format
+		mapping importEntity.Mapping
+		content string
+		want    []row
+		wantErr error
+	}{
+		{
+			"Parse CSV with default columns",
+			10,
+			importEntity.FormatCSV,
+			importEntity.Mapping{},
+			"title,description\nImplementar autenticação,Criar sistema JWT\n\"Configurar CI, CD\",Pipeline\n",
+			[]row{
+				{number: 1, title: "Implementar autenticação", description: "Criar sistema JWT"},
+				{number: 2, title: "Configurar CI, CD", description: "Pipeline"},
+			},
+			nil,
+		},
+		{
+			"Parse CSV with mapped columns",
+			10,
+			importEntity.FormatCSV,
+			importEntity.Mapping{"title": "Nome", "description": "Detalhes", "team": "Equipe"},
+			"Nome,Detalhes,Equipe\nImplementar autenticação,Criar sistema JWT,Backend\n",
+			[]row{
+				{number: 1, title: "Implementar autenticação", description: "Criar sistema JWT", team: "Backend"},
+			},
+			nil,
+		},
+		{
+			"Parse CSV with missing mapped columns",
+			10,
+			importEntity.FormatCSV,
+			importEntity.Mapping{"team": "Equipe"},
+			"title,Detalhes\nTarefa,Descrição\n",
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "mapping.description", Type: errs.TypeNotFound, Code: errs.CodeColumnNotFound, Message: `column "description" not found`, Params: map[string]any{"column": "description"}},
+				{Field: "mapping.team", Type: errs.TypeNotFound, Code: errs.CodeColumnNotFound, Message: `column "Equipe" not found`, Params: map[string]any{"column": "Equipe"}},
+			}},
+		},
+		{
+			"Parse CSV with invalid content",
+			10,
+			importEntity.FormatCSV,
+			importEntity.Mapping{},
+			"title,description\n\"Tarefa,Descrição\n",
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "content", Type: errs.TypeFormat, Code: errs.CodeInvalidContent, Message: "invalid content: parse error on line 2, column 21: extraneous or missing \" in quoted-field", Params: map[string]any{"reason": "parse error on line 2, column 21: extraneous or missing \" in quoted-field"}},
+			}},
+		},
+		{
+			"Parse NDJSON with mapped keys, blank lines and invalid objects",
+			10,
+			importEntity.FormatNDJSON,
+			importEntity.Mapping{"title": "name"},
+			"{\"name\":\"Implementar autenticação\",\"description\":\"Criar sistema JWT\",\"team\":\"Backend\"}\n\nnot json\n{\"name\":42,\"description\":null}\n",
+			[]row{
+				{number: 1, title: "Implementar autenticação", description: "Criar sistema JWT", team: "Backend"},
+				{number: 3, errs: []errs.ValidationError{{Field: "content", Type: errs.TypeFormat, Code: errs.CodeInvalidJSON, Message: "invalid JSON object"}}},
+				{number: 4, title: "42"},
+			},
+			nil,
+		},
+		{
+			"Parse content exceeding max rows",
+			1,
+			importEntity.FormatNDJSON,
+			importEntity.Mapping{},
+			"{\"title\":\"A\",\"description\":\"A\"}\n{\"title\":\"B\",\"description\":\"B\"}\n",
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "content", Type: errs.TypeMaxLength, Code: errs.CodeMaxRows, Message: "content must not exceed 1 rows", Params: map[string]any{"max": 1}},
+			}},
+		},
+		{
+			"Parse content with invalid format",
+			10,
+			"xlsx",
+			importEntity.Mapping{},
+			"title,description\n",
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "format", Type: errs.TypeInvalid, Code: errs.CodeInvalidValue, Message: "invalid format value"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := Config.MaxRows
+			defer func() { Config.MaxRows = original }()
+			Config.MaxRows = tt.maxRows
+
+			got, err := parseRows(tt.format, tt.mapping, tt.content)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("parseRows() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(row{})); diff != "" {
+				t.Errorf("parseRows() diff: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"log"
+	"time"
+
+	"taskmanager/internal/platform/retry"
+)
+
+var Config Configuration
+
+type Configuration struct {
+	BatchSize                 int    `toml:"batch_size"`
+	MaxAttempts               int    `toml:"max_attempts"`
+	BackoffBaseSeconds        int    `toml:"backoff_base_seconds"`
+	BackoffMaxSeconds         int    `toml:"backoff_max_seconds"`
+	WorkerPollIntervalSeconds int    `toml:"worker_poll_interval_seconds"`
+	RetentionHours            int    `toml:"retention_hours"`
+	Stream                    string `toml:"stream"`
+	StreamMaxLen              int64  `toml:"stream_max_len"`
+}
+
+func LoadConfig(cfg *Configuration) error {
+	Config = *cfg
+
+	if Config.BatchSize == 0 {
+		log.Fatal("Outbox batch size is required")
+	}
+
+	if Config.MaxAttempts == 0 {
+		log.Fatal("Outbox max attempts is required")
+	}
+
+	if Config.BackoffBaseSeconds == 0 {
+		log.Fatal("Outbox backoff base is required")
+	}
+
+	if Config.BackoffMaxSeconds == 0 {
+		log.Fatal("Outbox backoff max is required")
+	}
+
+	if Config.WorkerPollIntervalSeconds == 0 {
+		log.Fatal("Outbox worker poll interval is required")
+	}
+
+	if Config.RetentionHours == 0 {
+		log.Fatal("Outbox retention is required")
+	}
+
+	if Config.Stream == "" {
+		log.Fatal("Outbox stream is required")
+	}
+
+	if Config.StreamMaxLen == 0 {
+		log.Fatal("Outbox stream max length is required")
+	}
+
+	return nil
+}
+
+// WorkerPollInterval returns the interval between polls for due messages
+func (c Configuration) WorkerPollInterval() time.Duration {
+	return time.Duration(c.WorkerPollIntervalSeconds) * time.Second
+}
+
+// Retention returns how long published messages are kept
+func (c Configuration) Retention() time.Duration {
+	return time.Duration(c.RetentionHours) * time.Hour
+}
+
+// backoff returns the wait before the next attempt after the given number of failed attempts
+func (c Configuration) backoff(attempts int) time.Duration {
+	return retry.Backoff(attempts, time.Duration(c.BackoffBaseSeconds)*time.Second, time.Duration(c.BackoffMaxSeconds)*time.Second)
+}
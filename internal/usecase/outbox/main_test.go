@@ -0,0 +1,32 @@
+//go:build test
+
+package outbox
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/testing/configtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Outbox Configuration `toml:"outbox"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load outbox config
+		if err := LoadConfig(&appConfig.Outbox); err != nil {
+			log.Fatalf("Error on load outbox config. Err: %s", err)
+		}
+
+		return m.Run()
+	}(m))
+}
@@ -0,0 +1,135 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	outboxEntity "taskmanager/internal/entity/outbox"
+	"taskmanager/internal/platform/database"
+	"taskmanager/internal/platform/publisher"
+	outboxRepo "taskmanager/internal/repository/outbox"
+)
+
+// publishers receive every relayed message, in order
+var publishers []publisher.Publisher
+
+// wake signals the relay that messages were committed; buffered so signals coalesce
+var wake = make(chan struct{}, 1)
+
+// SetPublishers sets the publishers the relay sends each message to, in order
+func SetPublishers(p ...publisher.Publisher) {
+	publishers = p
+}
+
+// Wake returns a channel signaled when new messages are committed to the outbox
+func Wake() <-chan struct{} {
+	return wake
+}
+
+// Record writes an event to the outbox in the transaction held by ctx
+// It is relayed only if that transaction commits; eventID is the deduplication ID seen by consumers
+func Record(ctx context.Context, eventID uuid.UUID, eventType string, payload string) error {
+	m := &outboxEntity.Message{
+		UUID:          eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        outboxEntity.MessageStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+
+	if err := outboxRepo.Persist().Create(ctx, m); err != nil {
+		return err
+	}
+
+	database.AfterCommit(ctx, notify)
+
+	return nil
+}
+
+// notify wakes the relay without blocking the committing request
+func notify() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// RelayBatch claims up to Config.BatchSize due messages and sends each one to the publishers, in order
+// A message is marked published only when every publisher accepts it; otherwise the work of the
+// publishers is rolled back to a savepoint and the message is retried with exponential backoff, so
+// delivery is at least once and consumers deduplicate by the message UUID.
+// A message retried later stops the batch, holding back the messages after it until it is published
+// or marked failed after Config.MaxAttempts; messages of the same task or team are thus relayed in order
+// Returns the number of messages claimed
+func RelayBatch(ctx context.Context) (int, error) {
+	messages, err := outboxRepo.Persist().ClaimDue(ctx, time.Now(), Config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range messages {
+		m := &messages[i]
+
+		err := database.Savepoint(ctx, fmt.Sprintf("outbox_%d", m.ID), func() error {
+			return publish(ctx, m)
+		})
+		if err != nil {
+			recordFailure(m, time.Now(), err)
+			if err := outboxRepo.Persist().UpdateAttempt(ctx, m); err != nil {
+				return 0, err
+			}
+			if m.Status == outboxEntity.MessageStatusFailed {
+				slog.Error("Outbox message failed after the last attempt", "uuid", m.UUID, "type", m.EventType, "attempts", m.Attempts, "error", err)
+				continue
+			}
+			slog.Warn("Error relaying outbox message", "uuid", m.UUID, "type", m.EventType, "attempts", m.Attempts, "error", err)
+			break
+		}
+
+		if err := outboxRepo.Persist().MarkPublished(ctx, m.ID, time.Now()); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(messages), nil
+}
+
+// Purge removes the messages published longer than Config.Retention ago
+func Purge(ctx context.Context) (int64, error) {
+	return outboxRepo.Persist().DeletePublishedBefore(ctx, time.Now().Add(-Config.Retention()))
+}
+
+// publish sends a message to every publisher, stopping at the first failure
+func publish(ctx context.Context, m *outboxEntity.Message) error {
+	msg := publisher.Message{
+		ID:      m.UUID.String(),
+		Type:    m.EventType,
+		Payload: []byte(m.Payload),
+	}
+
+	for _, p := range publishers {
+		if err := p.Publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordFailure schedules the next attempt of a message that failed at attemptedAt
+// The message is marked failed once Config.MaxAttempts is reached
+func recordFailure(m *outboxEntity.Message, attemptedAt time.Time, err error) {
+	m.Attempts++
+	m.LastError = err.Error()
+
+	if m.Attempts >= Config.MaxAttempts {
+		m.Status = outboxEntity.MessageStatusFailed
+		return
+	}
+
+	m.NextAttemptAt = attemptedAt.Add(Config.backoff(m.Attempts))
+}
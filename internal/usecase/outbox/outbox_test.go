@@ -0,0 +1,250 @@
+//go:build test
+
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	outboxEntity "taskmanager/internal/entity/outbox"
+	"taskmanager/internal/platform/publisher"
+	"taskmanager/internal/platform/testing/assert"
+	outboxRepo "taskmanager/internal/repository/outbox"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+// fakePublisher records the published messages and fails with err when set
+type fakePublisher struct {
+	published []publisher.Message
+	err       error
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, m publisher.Message) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, m)
+	return nil
+}
+
+var (
+	firstUUID  = uuid.MustParse("eeee4567-e89b-12d3-a456-426614174001")
+	secondUUID = uuid.MustParse("eeee4567-e89b-12d3-a456-426614174002")
+	thirdUUID  = uuid.MustParse("eeee4567-e89b-12d3-a456-426614174003")
+)
+
+func TestRecord(t *testing.T) {
+	originalPersist := outboxRepo.Persist()
+
+	tests := []struct {
+		name      string
+		createErr error
+		wantErr   error
+	}{
+		{"Record event with success", nil, nil},
+		{"Record event with persistence error", errors.New("insert failed"), errors.New("insert failed")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *outboxEntity.Message
+			outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+				FnCreate: func(ctx context.Context, m *outboxEntity.Message) error {
+					created = m
+					return tt.createErr
+				},
+			})
+			defer outboxRepo.SetPersist(originalPersist)
+
+			err := Record(context.Background(), firstUUID, "task.created", `{"type":"task.created"}`)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Record() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if created.UUID != firstUUID || created.EventType != "task.created" || created.Payload != `{"type":"task.created"}` || created.Status != outboxEntity.MessageStatusPending {
+				t.Errorf("Record() created = %+v", created)
+			}
+			if created.NextAttemptAt.IsZero() {
+				t.Errorf("Record() next attempt is zero")
+			}
+
+			// Without a transaction the message is committed and the relay is woken right away
+			select {
+			case <-Wake():
+			default:
+				t.Errorf("Record() did not wake the relay")
+			}
+		})
+	}
+}
+
+func TestRelayBatch(t *testing.T) {
+	originalPersist := outboxRepo.Persist()
+	defer SetPublishers()
+
+	newMessages := func() []outboxEntity.Message {
+		return []outboxEntity.Message{
+			{ID: 2, UUID: firstUUID, EventType: "task.updated", Payload: `{"n":1}`, Status: outboxEntity.MessageStatusPending, Attempts: 2, LastError: "timeout"},
+			{ID: 3, UUID: secondUUID, EventType: "task.deleted", Payload: `{"n":2}`, Status: outboxEntity.MessageStatusPending},
+			{ID: 4, UUID: thirdUUID, EventType: "task.created", Payload: `{"n":3}`, Status: outboxEntity.MessageStatusPending},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		publishers        []*fakePublisher
+		claimErr          error
+		want              int
+		wantPublishedIDs  []uint
+		wantFailedIDs     []uint
+		wantAttempts      []int
+		wantStatuses      []outboxEntity.MessageStatus
+		wantNextAttemptIn []time.Duration
+		wantErr           error
+	}{
+		{
+			"Relay batch with success",
+			[]*fakePublisher{{}, {}},
+			nil,
+			3,
+			[]uint{2, 3, 4},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"Relay batch with failing publisher marks the last attempt failed and stops at the retry",
+			[]*fakePublisher{{}, {err: errors.New("connection refused")}},
+			nil,
+			3,
+			nil,
+			[]uint{2, 3},
+			[]int{3, 1},
+			[]outboxEntity.MessageStatus{outboxEntity.MessageStatusFailed, outboxEntity.MessageStatusPending},
+			[]time.Duration{time.Second},
+			nil,
+		},
+		{
+			"Relay batch with claim error",
+			[]*fakePublisher{{}},
+			errors.New("claim failed"),
+			0,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			errors.New("claim failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var publishedIDs, failedIDs []uint
+			var attempts []int
+			var statuses []outboxEntity.MessageStatus
+			var nextAttemptIn []time.Duration
+			outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+				FnClaimDue: func(ctx context.Context, now time.Time, limit int) ([]outboxEntity.Message, error) {
+					if limit != Config.BatchSize {
+						t.Errorf("ClaimDue() limit = %d, want %d", limit, Config.BatchSize)
+					}
+					if tt.claimErr != nil {
+						return nil, tt.claimErr
+					}
+					return newMessages(), nil
+				},
+				FnMarkPublished: func(ctx context.Context, id uint, publishedAt time.Time) error {
+					publishedIDs = append(publishedIDs, id)
+					return nil
+				},
+				FnUpdateAttempt: func(ctx context.Context, m *outboxEntity.Message) error {
+					failedIDs = append(failedIDs, m.ID)
+					attempts = append(attempts, m.Attempts)
+					statuses = append(statuses, m.Status)
+					if m.Status == outboxEntity.MessageStatusPending {
+						nextAttemptIn = append(nextAttemptIn, time.Until(m.NextAttemptAt).Round(time.Second))
+					}
+					if m.LastError != "connection refused" {
+						t.Errorf("UpdateAttempt() last error = %q", m.LastError)
+					}
+					return nil
+				},
+			})
+			defer outboxRepo.SetPersist(originalPersist)
+
+			publishers := make([]publisher.Publisher, len(tt.publishers))
+			for i, p := range tt.publishers {
+				publishers[i] = p
+			}
+			SetPublishers(publishers...)
+
+			got, err := RelayBatch(context.Background())
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("RelayBatch() error diff: %s", diff)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RelayBatch() = %d, want %d", got, tt.want)
+			}
+			if diff := cmp.Diff(publishedIDs, tt.wantPublishedIDs); diff != "" {
+				t.Errorf("RelayBatch() published ids diff: %s", diff)
+			}
+			if diff := cmp.Diff(failedIDs, tt.wantFailedIDs); diff != "" {
+				t.Errorf("RelayBatch() failed ids diff: %s", diff)
+			}
+			if diff := cmp.Diff(attempts, tt.wantAttempts); diff != "" {
+				t.Errorf("RelayBatch() attempts diff: %s", diff)
+			}
+			if diff := cmp.Diff(statuses, tt.wantStatuses); diff != "" {
+				t.Errorf("RelayBatch() statuses diff: %s", diff)
+			}
+			if diff := cmp.Diff(nextAttemptIn, tt.wantNextAttemptIn); diff != "" {
+				t.Errorf("RelayBatch() next attempt diff: %s", diff)
+			}
+
+			if len(tt.wantPublishedIDs) > 0 {
+				want := []publisher.Message{
+					{ID: firstUUID.String(), Type: "task.updated", Payload: []byte(`{"n":1}`)},
+					{ID: secondUUID.String(), Type: "task.deleted", Payload: []byte(`{"n":2}`)},
+					{ID: thirdUUID.String(), Type: "task.created", Payload: []byte(`{"n":3}`)},
+				}
+				for _, p := range tt.publishers {
+					if diff := cmp.Diff(p.published, want); diff != "" {
+						t.Errorf("RelayBatch() published messages diff: %s", diff)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPurge(t *testing.T) {
+	originalPersist := outboxRepo.Persist()
+	defer outboxRepo.SetPersist(originalPersist)
+
+	outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+		FnDeletePublishedBefore: func(ctx context.Context, before time.Time) (int64, error) {
+			if got := time.Since(before).Round(time.Hour); got != Config.Retention() {
+				t.Errorf("DeletePublishedBefore() before is %v ago, want %v", got, Config.Retention())
+			}
+			return 4, nil
+		},
+	})
+
+	got, err := Purge(context.Background())
+	if err != nil {
+		t.Fatalf("Purge() error: %v", err)
+	}
+	if got != 4 {
+		t.Errorf("Purge() = %d, want 4", got)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build test
+
+package project
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/testing/configtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Project Configuration `toml:"project"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load project config
+		if err := LoadConfig(&appConfig.Project); err != nil {
+			log.Fatalf("Error on load project config. Err: %s", err)
+		}
+
+		return m.Run()
+	}(m))
+}
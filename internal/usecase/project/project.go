@@ -0,0 +1,168 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	projectEntity "taskmanager/internal/entity/project"
+	taskEntity "taskmanager/internal/entity/task"
+	apperrors "taskmanager/internal/platform/errors"
+	projectRepo "taskmanager/internal/repository/project"
+)
+
+// Create creates a new project
+func Create(ctx context.Context, p *projectEntity.Project) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	p.Name = strings.TrimSpace(p.Name)
+	p.Description = strings.TrimSpace(p.Description)
+
+	return projectRepo.Persist().Create(ctx, p)
+}
+
+// RetrieveByUUID retrieves a project by UUID with its milestones
+func RetrieveByUUID(ctx context.Context, projectUUID uuid.UUID) (*projectEntity.Project, error) {
+	return projectRepo.Persist().RetrieveByUUID(ctx, projectUUID)
+}
+
+// ListPaginated lists projects with pagination
+func ListPaginated(ctx context.Context, page, limit int) (*projectEntity.ListProjects, error) {
+	return projectRepo.Persist().ListPaginated(ctx, page, listLimit(limit))
+}
+
+// CreateMilestone creates a milestone of a project
+func CreateMilestone(ctx context.Context, projectUUID uuid.UUID, m *projectEntity.Milestone) error {
+	p, err := projectRepo.Persist().RetrieveByUUID(ctx, projectUUID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	m.Name = strings.TrimSpace(m.Name)
+	m.Description = strings.TrimSpace(m.Description)
+	m.ProjectID = p.ID
+
+	return projectRepo.Persist().CreateMilestone(ctx, m)
+}
+
+// AddTask adds a task of any team to a project, planning it in one of the project milestones when
+// milestoneUUID is given
+// Adding a task already in the project again moves it to the given milestone, or out of its milestone
+func AddTask(ctx context.Context, projectUUID, taskUUID uuid.UUID, milestoneUUID *uuid.UUID) error {
+	p, err := projectRepo.Persist().RetrieveByUUID(ctx, projectUUID)
+	if err != nil {
+		return err
+	}
+
+	taskProjectID, err := projectRepo.Persist().RetrieveTaskProjectID(ctx, taskUUID)
+	if err != nil {
+		return taskError(err)
+	}
+
+	if taskProjectID != nil && *taskProjectID != p.ID {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("task", apperrors.CodeTaskInAnotherProject, nil),
+		}}
+	}
+
+	var milestoneID *uint
+	if milestoneUUID != nil {
+		m, err := projectRepo.Persist().RetrieveMilestoneByUUID(ctx, *milestoneUUID)
+		if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+			return err
+		}
+
+		if m == nil || m.ProjectID != p.ID {
+			return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+				apperrors.NewValidationError("milestone", apperrors.CodeMilestoneNotFound, nil),
+			}}
+		}
+
+		milestoneID = &m.ID
+	}
+
+	return projectRepo.Persist().UpdateTaskProject(ctx, taskUUID, &p.ID, milestoneID)
+}
+
+// RemoveTask removes a task from a project and from its milestone
+func RemoveTask(ctx context.Context, projectUUID, taskUUID uuid.UUID) error {
+	p, err := projectRepo.Persist().RetrieveByUUID(ctx, projectUUID)
+	if err != nil {
+		return err
+	}
+
+	taskProjectID, err := projectRepo.Persist().RetrieveTaskProjectID(ctx, taskUUID)
+	if err != nil {
+		return taskError(err)
+	}
+
+	if taskProjectID == nil || *taskProjectID != p.ID {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("task", apperrors.CodeTaskNotInProject, nil),
+		}}
+	}
+
+	return projectRepo.Persist().UpdateTaskProject(ctx, taskUUID, nil, nil)
+}
+
+// ListTasks lists the tasks of a project with pagination and the list filters, across its teams
+func ListTasks(ctx context.Context, projectUUID uuid.UUID, filter projectEntity.TaskFilter, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
+	p, err := projectRepo.Persist().RetrieveByUUID(ctx, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectRepo.Persist().ListTasks(ctx, p.ID, filter, sort, page, listLimit(limit))
+}
+
+// Progress computes the done and total tasks of a project, per milestone and per team
+func Progress(ctx context.Context, projectUUID uuid.UUID) (*projectEntity.Progress, error) {
+	p, err := projectRepo.Persist().RetrieveByUUID(ctx, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := projectRepo.Persist().CountTasks(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := projectRepo.Persist().ListTeams(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := projectEntity.NewProgress(*p, teams, counts)
+	return &progress, nil
+}
+
+// taskError reports a missing task as a validation error of the request body
+func taskError(err error) error {
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("task", apperrors.CodeTaskNotFound, nil),
+		}}
+	}
+	return err
+}
+
+// listLimit applies the configured default and maximum to a requested page size
+func listLimit(limit int) int {
+	if limit <= 0 {
+		return Config.ListDefaultLimit
+	}
+
+	if limit > Config.ListMaxLimit {
+		return Config.ListMaxLimit
+	}
+
+	return limit
+}
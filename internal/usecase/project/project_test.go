@@ -0,0 +1,399 @@
+//go:build test
+
+package project
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	projectEntity "taskmanager/internal/entity/project"
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	projectRepo "taskmanager/internal/repository/project"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	projectUUID   = uuid.MustParse("666e4567-e89b-12d3-a456-426614174000")
+	milestoneUUID = uuid.MustParse("777e4567-e89b-12d3-a456-426614174000")
+	taskUUID      = uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	missingUUID   = uuid.MustParse("00000000-0000-0000-0000-000000000000")
+)
+
+// mockProject returns a project repository holding project 1 with its milestone 1
+func mockProject() *projectRepo.MockPersistent {
+	return &projectRepo.MockPersistent{
+		FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*projectEntity.Project, error) {
+			if id != projectUUID {
+				return nil, errs.ErrNotFound
+			}
+			return &projectEntity.Project{Model: gorm.Model{ID: 1}, UUID: projectUUID}, nil
+		},
+		FnRetrieveMilestoneByUUID: func(ctx context.Context, id uuid.UUID) (*projectEntity.Milestone, error) {
+			if id != milestoneUUID {
+				return nil, errs.ErrNotFound
+			}
+			return &projectEntity.Milestone{Model: gorm.Model{ID: 1}, UUID: milestoneUUID, ProjectID: 1}, nil
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	originalPersist := projectRepo.Persist()
+
+	tests := []struct {
+		name        string
+		project     *projectEntity.Project
+		wantProject *projectEntity.Project
+		wantErr     error
+	}{
+		{
+			"Create project with success",
+			&projectEntity.Project{Name: "  Lançamento v2  ", Description: "  Nova versão do produto  "},
+			&projectEntity.Project{Name: "Lançamento v2", Description: "Nova versão do produto"},
+			nil,
+		},
+		{
+			"Create project with validation errors",
+			&projectEntity.Project{Name: "", Description: "Nova versão do produto"},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "name", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "name is required"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer projectRepo.SetPersist(originalPersist)
+
+			projectRepo.SetPersist(&projectRepo.MockPersistent{
+				FnCreate: func(ctx context.Context, p *projectEntity.Project) error { return nil },
+			})
+
+			err := Create(context.Background(), tt.project)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Create() error diff: %s", diff)
+				return
+			}
+			if tt.wantProject != nil {
+				if diff := cmp.Diff(tt.wantProject, tt.project); diff != "" {
+					t.Errorf("Create() project mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateMilestone(t *testing.T) {
+	originalPersist := projectRepo.Persist()
+
+	targetDate := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		projectUUID   uuid.UUID
+		milestone     *projectEntity.Milestone
+		wantMilestone *projectEntity.Milestone
+		wantErr       error
+	}{
+		{
+			"Create milestone with success",
+			projectUUID,
+			&projectEntity.Milestone{Name: "  Beta  ", Description: "  Versão beta  ", TargetDate: targetDate},
+			&projectEntity.Milestone{ProjectID: 1, Name: "Beta", Description: "Versão beta", TargetDate: targetDate},
+			nil,
+		},
+		{
+			"Create milestone with validation errors",
+			projectUUID,
+			&projectEntity.Milestone{Name: "Beta"},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "target_date", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "target_date is required"},
+			}},
+		},
+		{
+			"Create milestone of missing project",
+			missingUUID,
+			&projectEntity.Milestone{Name: "Beta", TargetDate: targetDate},
+			nil,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer projectRepo.SetPersist(originalPersist)
+
+			mock := mockProject()
+			mock.FnCreateMilestone = func(ctx context.Context, m *projectEntity.Milestone) error { return nil }
+			projectRepo.SetPersist(mock)
+
+			err := CreateMilestone(context.Background(), tt.projectUUID, tt.milestone)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("CreateMilestone() error diff: %s", diff)
+				return
+			}
+			if tt.wantMilestone != nil {
+				if diff := cmp.Diff(tt.wantMilestone, tt.milestone); diff != "" {
+					t.Errorf("CreateMilestone() milestone mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAddTask(t *testing.T) {
+	originalPersist := projectRepo.Persist()
+
+	projectID := uint(1)
+	otherProjectID := uint(2)
+	milestoneID := uint(1)
+	otherMilestoneUUID := uuid.MustParse("777e4567-e89b-12d3-a456-426614174002")
+
+	tests := []struct {
+		name            string
+		projectUUID     uuid.UUID
+		milestoneUUID   *uuid.UUID
+		taskProjectID   *uint
+		taskErr         error
+		wantProjectID   *uint
+		wantMilestoneID *uint
+		wantErr         error
+	}{
+		{
+			"Add task without milestone",
+			projectUUID,
+			nil,
+			nil,
+			nil,
+			&projectID,
+			nil,
+			nil,
+		},
+		{
+			"Add task in a milestone",
+			projectUUID,
+			&milestoneUUID,
+			nil,
+			nil,
+			&projectID,
+			&milestoneID,
+			nil,
+		},
+		{
+			"Add task of the project to a milestone",
+			projectUUID,
+			&milestoneUUID,
+			&projectID,
+			nil,
+			&projectID,
+			&milestoneID,
+			nil,
+		},
+		{
+			"Add task of another project",
+			projectUUID,
+			nil,
+			&otherProjectID,
+			nil,
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "task", Type: errs.TypeConflict, Code: errs.CodeTaskInAnotherProject, Message: "task already belongs to another project"},
+			}},
+		},
+		{
+			"Add task in a milestone of another project",
+			projectUUID,
+			&otherMilestoneUUID,
+			nil,
+			nil,
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "milestone", Type: errs.TypeNotFound, Code: errs.CodeMilestoneNotFound, Message: "milestone not found in the project"},
+			}},
+		},
+		{
+			"Add missing task",
+			projectUUID,
+			nil,
+			nil,
+			errs.ErrNotFound,
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "task", Type: errs.TypeNotFound, Code: errs.CodeTaskNotFound, Message: "task not found"},
+			}},
+		},
+		{
+			"Add task to a missing project",
+			missingUUID,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer projectRepo.SetPersist(originalPersist)
+
+			var gotProjectID, gotMilestoneID *uint
+			mock := mockProject()
+			mock.FnRetrieveTaskProjectID = func(ctx context.Context, id uuid.UUID) (*uint, error) {
+				return tt.taskProjectID, tt.taskErr
+			}
+			mock.FnUpdateTaskProject = func(ctx context.Context, id uuid.UUID, projectID, milestoneID *uint) error {
+				gotProjectID, gotMilestoneID = projectID, milestoneID
+				return nil
+			}
+			projectRepo.SetPersist(mock)
+
+			err := AddTask(context.Background(), tt.projectUUID, taskUUID, tt.milestoneUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("AddTask() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(tt.wantProjectID, gotProjectID); diff != "" {
+				t.Errorf("AddTask() project_id mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantMilestoneID, gotMilestoneID); diff != "" {
+				t.Errorf("AddTask() milestone_id mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveTask(t *testing.T) {
+	originalPersist := projectRepo.Persist()
+
+	projectID := uint(1)
+	otherProjectID := uint(2)
+
+	tests := []struct {
+		name          string
+		taskProjectID *uint
+		taskErr       error
+		wantUpdated   bool
+		wantErr       error
+	}{
+		{"Remove task of the project", &projectID, nil, true, nil},
+		{"Remove task of another project", &otherProjectID, nil, false, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "task", Type: errs.TypeState, Code: errs.CodeTaskNotInProject, Message: "task is not in this project"},
+		}}},
+		{"Remove task without project", nil, nil, false, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "task", Type: errs.TypeState, Code: errs.CodeTaskNotInProject, Message: "task is not in this project"},
+		}}},
+		{"Remove missing task", nil, errs.ErrNotFound, false, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "task", Type: errs.TypeNotFound, Code: errs.CodeTaskNotFound, Message: "task not found"},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer projectRepo.SetPersist(originalPersist)
+
+			updated := false
+			mock := mockProject()
+			mock.FnRetrieveTaskProjectID = func(ctx context.Context, id uuid.UUID) (*uint, error) {
+				return tt.taskProjectID, tt.taskErr
+			}
+			mock.FnUpdateTaskProject = func(ctx context.Context, id uuid.UUID, projectID, milestoneID *uint) error {
+				updated = projectID == nil && milestoneID == nil
+				return nil
+			}
+			projectRepo.SetPersist(mock)
+
+			err := RemoveTask(context.Background(), projectUUID, taskUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("RemoveTask() error diff: %s", diff)
+				return
+			}
+			if updated != tt.wantUpdated {
+				t.Errorf("RemoveTask() updated = %v, want %v", updated, tt.wantUpdated)
+			}
+		})
+	}
+}
+
+func TestListTasks(t *testing.T) {
+	originalPersist := projectRepo.Persist()
+
+	tests := []struct {
+		name      string
+		limit     int
+		wantLimit int
+	}{
+		{"List tasks with default limit", 0, Config.ListDefaultLimit},
+		{"List tasks with given limit", 5, 5},
+		{"List tasks above max limit", Config.ListMaxLimit + 1, Config.ListMaxLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer projectRepo.SetPersist(originalPersist)
+
+			var gotLimit int
+			mock := mockProject()
+			mock.FnListTasks = func(ctx context.Context, projectID uint, filter projectEntity.TaskFilter, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
+				gotLimit = limit
+				return &taskEntity.ListTasks{Page: page, Limit: limit}, nil
+			}
+			projectRepo.SetPersist(mock)
+
+			if _, err := ListTasks(context.Background(), projectUUID, projectEntity.TaskFilter{}, taskEntity.SortCreatedAt, 1, tt.limit); err != nil {
+				t.Fatalf("ListTasks() error = %v", err)
+			}
+			if gotLimit != tt.wantLimit {
+				t.Errorf("ListTasks() limit = %d, want %d", gotLimit, tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	originalPersist := projectRepo.Persist()
+	defer projectRepo.SetPersist(originalPersist)
+
+	teamID := uint(1)
+	team := teamEntity.Team{Model: gorm.Model{ID: teamID}, Name: "Time de Desenvolvimento"}
+
+	mock := mockProject()
+	mock.FnCountTasks = func(ctx context.Context, projectID uint) ([]projectEntity.TaskCount, error) {
+		return []projectEntity.TaskCount{
+			{TeamID: &teamID, Status: taskEntity.StatusDone, Count: 1},
+			{TeamID: &teamID, Status: taskEntity.StatusTodo, Count: 2},
+		}, nil
+	}
+	mock.FnListTeams = func(ctx context.Context, projectID uint) ([]teamEntity.Team, error) {
+		return []teamEntity.Team{team}, nil
+	}
+	projectRepo.SetPersist(mock)
+
+	got, err := Progress(context.Background(), projectUUID)
+	if err != nil {
+		t.Fatalf("Progress() error = %v", err)
+	}
+
+	want := &projectEntity.Progress{
+		Count:            projectEntity.Count{Done: 1, Total: 3},
+		Project:          projectEntity.Project{Model: gorm.Model{ID: 1}, UUID: projectUUID},
+		Milestones:       []projectEntity.MilestoneProgress{},
+		WithoutMilestone: projectEntity.Count{Done: 1, Total: 3},
+		Teams:            []projectEntity.TeamProgress{{Team: team, Count: projectEntity.Count{Done: 1, Total: 3}}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Progress() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := Progress(context.Background(), missingUUID); err != errs.ErrNotFound {
+		t.Errorf("Progress() error = %v, want %v", err, errs.ErrNotFound)
+	}
+}
@@ -0,0 +1,31 @@
+package sprint
+
+import (
+	"log"
+)
+
+var Config Configuration
+
+type Configuration struct {
+	ListDefaultLimit int `toml:"list_default_limit"`
+	ListMaxLimit     int `toml:"list_max_limit"`
+	VelocitySprints  int `toml:"velocity_sprints"`
+}
+
+func LoadConfig(cfg *Configuration) error {
+	Config = *cfg
+
+	if Config.ListDefaultLimit == 0 {
+		log.Fatal("List default limit is required")
+	}
+
+	if Config.ListMaxLimit == 0 {
+		log.Fatal("List max limit is required")
+	}
+
+	if Config.VelocitySprints == 0 {
+		log.Fatal("Sprint velocity sprints is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+//go:build test
+
+package sprint
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	outboxEntity "taskmanager/internal/entity/outbox"
+	"taskmanager/internal/paths"
+	outboxRepo "taskmanager/internal/repository/outbox"
+	"taskmanager/internal/testing/configtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Sprint Configuration `toml:"sprint"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load sprint config
+		if err := LoadConfig(&appConfig.Sprint); err != nil {
+			log.Fatalf("Error on load sprint config. Err: %s", err)
+		}
+
+		// Events are recorded in the outbox, which these tests do not persist
+		outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+			FnCreate: func(ctx context.Context, m *outboxEntity.Message) error {
+				return nil
+			},
+		})
+
+		return m.Run()
+	}(m))
+}
@@ -0,0 +1,279 @@
+package sprint
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	sprintEntity "taskmanager/internal/entity/sprint"
+	taskEntity "taskmanager/internal/entity/task"
+	webhookEntity "taskmanager/internal/entity/webhook"
+	apperrors "taskmanager/internal/platform/errors"
+	sprintRepo "taskmanager/internal/repository/sprint"
+	teamRepo "taskmanager/internal/repository/team"
+	webhookUsecase "taskmanager/internal/usecase/webhook"
+)
+
+// Create creates a planned sprint for a team
+func Create(ctx context.Context, teamUUID uuid.UUID, s *sprintEntity.Sprint) error {
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	s.Name = strings.TrimSpace(s.Name)
+	s.Goal = strings.TrimSpace(s.Goal)
+	s.TeamID = t.ID
+	s.State = sprintEntity.StatePlanned
+
+	if err := sprintRepo.Persist().Create(ctx, s); err != nil {
+		return err
+	}
+
+	s.Team = t
+
+	return nil
+}
+
+// RetrieveWithTasks retrieves a sprint by UUID with its tasks
+func RetrieveWithTasks(ctx context.Context, sprintUUID uuid.UUID) (*sprintEntity.Sprint, error) {
+	s, err := sprintRepo.Persist().RetrieveByUUID(ctx, sprintUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := sprintRepo.Persist().ListTasks(ctx, s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Tasks = tasks
+
+	return s, nil
+}
+
+// ListByTeam lists the sprints of a team with pagination, in start date order
+func ListByTeam(ctx context.Context, teamUUID uuid.UUID, page, limit int) (*sprintEntity.ListSprints, error) {
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sprintRepo.Persist().ListByTeamID(ctx, t.ID, page, listLimit(limit))
+}
+
+// AssignTask plans a task of the sprint team in the sprint, moving it out of any other sprint
+// Publishes task.updated
+func AssignTask(ctx context.Context, sprintUUID, taskUUID uuid.UUID) error {
+	s, err := lockSprint(ctx, sprintUUID)
+	if err != nil {
+		return err
+	}
+
+	if s.State == sprintEntity.StateCompleted {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("sprint", apperrors.CodeSprintCompleted, nil),
+		}}
+	}
+
+	taskTeamID, err := teamRepo.Persist().RetrieveTaskTeamID(ctx, taskUUID)
+	if err != nil {
+		return taskError(err)
+	}
+
+	if taskTeamID == nil || *taskTeamID != s.TeamID {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("task", apperrors.CodeTaskNotInSprintTeam, nil),
+		}}
+	}
+
+	t, err := sprintRepo.Persist().UpdateTaskSprintID(ctx, taskUUID, &s.ID)
+	if err != nil {
+		return err
+	}
+
+	return publishTasksUpdated(ctx, s, []taskEntity.Task{*t})
+}
+
+// UnassignTask moves a task of the sprint back to the team backlog
+// Publishes task.updated
+func UnassignTask(ctx context.Context, sprintUUID, taskUUID uuid.UUID) error {
+	s, err := lockSprint(ctx, sprintUUID)
+	if err != nil {
+		return err
+	}
+
+	if s.State == sprintEntity.StateCompleted {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("sprint", apperrors.CodeSprintCompleted, nil),
+		}}
+	}
+
+	taskSprintID, err := sprintRepo.Persist().RetrieveTaskSprintID(ctx, taskUUID)
+	if err != nil {
+		return taskError(err)
+	}
+
+	if taskSprintID == nil || *taskSprintID != s.ID {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("task", apperrors.CodeTaskNotInSprint, nil),
+		}}
+	}
+
+	t, err := sprintRepo.Persist().UpdateTaskSprintID(ctx, taskUUID, nil)
+	if err != nil {
+		return err
+	}
+
+	return publishTasksUpdated(ctx, s, []taskEntity.Task{*t})
+}
+
+// Start moves a planned sprint to active
+// A team has one active sprint at a time
+func Start(ctx context.Context, sprintUUID uuid.UUID) (*sprintEntity.Sprint, error) {
+	s, err := lockSprint(ctx, sprintUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Start(time.Now()); err != nil {
+		return nil, err
+	}
+
+	active, err := sprintRepo.Persist().RetrieveActiveByTeamID(ctx, s.TeamID)
+	switch {
+	case err == nil && active.ID != s.ID:
+		return nil, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("state", apperrors.CodeActiveSprintExists, nil),
+		}}
+	case err != nil && !errors.Is(err, apperrors.ErrNotFound):
+		return nil, err
+	}
+
+	if err := sprintRepo.Persist().UpdateState(ctx, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Complete moves an active sprint to completed, rolling its unfinished tasks into the planned sprint
+// of the team that starts first, or back to the backlog when there is none
+// Publishes task.updated for each task rolled over
+func Complete(ctx context.Context, sprintUUID uuid.UUID) (*sprintEntity.Completion, error) {
+	s, err := lockSprint(ctx, sprintUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Complete(time.Now()); err != nil {
+		return nil, err
+	}
+
+	next, err := sprintRepo.Persist().RetrieveNextPlanned(ctx, s.TeamID, s.ID)
+	if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+		return nil, err
+	}
+
+	var nextID *uint
+	if next != nil {
+		nextID = &next.ID
+	}
+
+	rolledOver, err := sprintRepo.Persist().MoveUnfinishedTasks(ctx, s.ID, nextID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := publishTasksUpdated(ctx, s, rolledOver); err != nil {
+		return nil, err
+	}
+
+	if err := sprintRepo.Persist().UpdateState(ctx, s); err != nil {
+		return nil, err
+	}
+
+	return &sprintEntity.Completion{Sprint: *s, Next: next, RolledOver: len(rolledOver)}, nil
+}
+
+// Velocity computes the velocity of a team over its last completed sprints
+// sprints defaults to the configured number of sprints and is capped by the list maximum
+func Velocity(ctx context.Context, teamUUID uuid.UUID, sprints int) (*sprintEntity.Velocity, error) {
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sprints <= 0 {
+		sprints = Config.VelocitySprints
+	}
+
+	velocities, err := sprintRepo.Persist().ListVelocity(ctx, t.ID, listLimit(sprints))
+	if err != nil {
+		return nil, err
+	}
+
+	v := sprintEntity.NewVelocity(velocities)
+	return &v, nil
+}
+
+// lockSprint retrieves a sprint holding the lock of its team, so its state is read after
+// any concurrent change to the team sprints has committed
+func lockSprint(ctx context.Context, sprintUUID uuid.UUID) (*sprintEntity.Sprint, error) {
+	s, err := sprintRepo.Persist().RetrieveByUUID(ctx, sprintUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sprintRepo.Persist().LockTeam(ctx, s.TeamID); err != nil {
+		return nil, err
+	}
+
+	return sprintRepo.Persist().RetrieveByUUID(ctx, sprintUUID)
+}
+
+// publishTasksUpdated records task.updated for tasks moved in or out of a sprint of the team of s
+func publishTasksUpdated(ctx context.Context, s *sprintEntity.Sprint, tasks []taskEntity.Task) error {
+	var teamUUID *uuid.UUID
+	if s.Team != nil {
+		teamUUID = &s.Team.UUID
+	}
+
+	for _, t := range tasks {
+		if err := webhookUsecase.Publish(ctx, webhookEntity.EventTaskUpdated, webhookEntity.NewTaskData(t, teamUUID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// taskError reports a missing task as a validation error of the request body
+func taskError(err error) error {
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("task", apperrors.CodeTaskNotFound, nil),
+		}}
+	}
+	return err
+}
+
+// listLimit applies the configured default and maximum to a requested page size
+func listLimit(limit int) int {
+	if limit <= 0 {
+		return Config.ListDefaultLimit
+	}
+
+	if limit > Config.ListMaxLimit {
+		return Config.ListMaxLimit
+	}
+
+	return limit
+}
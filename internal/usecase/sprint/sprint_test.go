@@ -0,0 +1,493 @@
+//go:build test
+
+package sprint
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	outboxEntity "taskmanager/internal/entity/outbox"
+	sprintEntity "taskmanager/internal/entity/sprint"
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	webhookEntity "taskmanager/internal/entity/webhook"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	outboxRepo "taskmanager/internal/repository/outbox"
+	sprintRepo "taskmanager/internal/repository/sprint"
+	teamRepo "taskmanager/internal/repository/team"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	teamUUID   = uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+	sprintUUID = uuid.MustParse("555e4567-e89b-12d3-a456-426614174000")
+	taskUUID   = uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+)
+
+// recordEvents replaces the outbox with one collecting the types of the recorded events
+// Returns the collected types and a function restoring the previous outbox
+func recordEvents() (*[]string, func()) {
+	original := outboxRepo.Persist()
+	var events []string
+	outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+		FnCreate: func(ctx context.Context, m *outboxEntity.Message) error {
+			events = append(events, m.EventType)
+			return nil
+		},
+	})
+	return &events, func() { outboxRepo.SetPersist(original) }
+}
+
+// mockSprint returns a sprint repository holding one sprint of team 1 in the given state
+func mockSprint(state sprintEntity.State) *sprintRepo.MockPersistent {
+	return &sprintRepo.MockPersistent{
+		FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*sprintEntity.Sprint, error) {
+			if id != sprintUUID {
+				return nil, errs.ErrNotFound
+			}
+			return &sprintEntity.Sprint{Model: gorm.Model{ID: 1}, UUID: sprintUUID, TeamID: 1, State: state}, nil
+		},
+		FnLockTeam: func(ctx context.Context, teamID uint) error {
+			return nil
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	originalSprintPersist := sprintRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	startDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 12, 12, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		teamUUID   uuid.UUID
+		sprint     *sprintEntity.Sprint
+		wantSprint *sprintEntity.Sprint
+		wantErr    error
+	}{
+		{
+			"Create sprint with success",
+			teamUUID,
+			&sprintEntity.Sprint{Name: "  Sprint 1  ", Goal: "  Ship the board  ", StartDate: startDate, EndDate: endDate},
+			&sprintEntity.Sprint{
+				TeamID:    1,
+				Team:      &teamEntity.Team{Model: gorm.Model{ID: 1}, UUID: teamUUID},
+				Name:      "Sprint 1",
+				Goal:      "Ship the board",
+				StartDate: startDate,
+				EndDate:   endDate,
+				State:     sprintEntity.StatePlanned,
+			},
+			nil,
+		},
+		{
+			"Create sprint with validation errors",
+			teamUUID,
+			&sprintEntity.Sprint{Name: "Sprint 1", StartDate: endDate, EndDate: startDate},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "end_date", Type: errs.TypeInvalid, Code: errs.CodeNotBefore, Message: "end_date must not be before start_date", Params: map[string]any{"other": "start_date"}},
+			}},
+		},
+		{
+			"Create sprint of missing team",
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			&sprintEntity.Sprint{Name: "Sprint 1", StartDate: startDate, EndDate: endDate},
+			nil,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer sprintRepo.SetPersist(originalSprintPersist)
+			defer teamRepo.SetPersist(originalTeamPersist)
+
+			teamRepo.SetPersist(&teamRepo.MockPersistent{
+				FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+					if id != teamUUID {
+						return nil, errs.ErrNotFound
+					}
+					return &teamEntity.Team{Model: gorm.Model{ID: 1}, UUID: teamUUID}, nil
+				},
+			})
+			sprintRepo.SetPersist(&sprintRepo.MockPersistent{
+				FnCreate: func(ctx context.Context, s *sprintEntity.Sprint) error { return nil },
+			})
+
+			err := Create(context.Background(), tt.teamUUID, tt.sprint)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Create() error diff: %s", diff)
+				return
+			}
+			if tt.wantSprint != nil {
+				if diff := cmp.Diff(tt.wantSprint, tt.sprint); diff != "" {
+					t.Errorf("Create() sprint mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAssignTask(t *testing.T) {
+	originalSprintPersist := sprintRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	teamID := uint(1)
+	otherTeamID := uint(2)
+
+	tests := []struct {
+		name         string
+		state        sprintEntity.State
+		sprintUUID   uuid.UUID
+		taskTeamID   *uint
+		taskErr      error
+		wantSprintID *uint
+		wantErr      error
+	}{
+		{
+			"Assign task of the sprint team",
+			sprintEntity.StatePlanned,
+			sprintUUID,
+			&teamID,
+			nil,
+			&teamID,
+			nil,
+		},
+		{
+			"Assign task to an active sprint",
+			sprintEntity.StateActive,
+			sprintUUID,
+			&teamID,
+			nil,
+			&teamID,
+			nil,
+		},
+		{
+			"Assign task to a completed sprint",
+			sprintEntity.StateCompleted,
+			sprintUUID,
+			&teamID,
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "sprint", Type: errs.TypeState, Code: errs.CodeSprintCompleted, Message: "sprint is already completed"},
+			}},
+		},
+		{
+			"Assign task of another team",
+			sprintEntity.StatePlanned,
+			sprintUUID,
+			&otherTeamID,
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "task", Type: errs.TypeState, Code: errs.CodeTaskNotInSprintTeam, Message: "task is not associated with the sprint team"},
+			}},
+		},
+		{
+			"Assign task without team",
+			sprintEntity.StatePlanned,
+			sprintUUID,
+			nil,
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "task", Type: errs.TypeState, Code: errs.CodeTaskNotInSprintTeam, Message: "task is not associated with the sprint team"},
+			}},
+		},
+		{
+			"Assign missing task",
+			sprintEntity.StatePlanned,
+			sprintUUID,
+			nil,
+			errs.ErrNotFound,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "task", Type: errs.TypeNotFound, Code: errs.CodeTaskNotFound, Message: "task not found"},
+			}},
+		},
+		{
+			"Assign task to a missing sprint",
+			sprintEntity.StatePlanned,
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			&teamID,
+			nil,
+			nil,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer sprintRepo.SetPersist(originalSprintPersist)
+			defer teamRepo.SetPersist(originalTeamPersist)
+			events, restore := recordEvents()
+			defer restore()
+
+			var gotSprintID *uint
+			mock := mockSprint(tt.state)
+			mock.FnUpdateTaskSprintID = func(ctx context.Context, id uuid.UUID, sprintID *uint) (*taskEntity.Task, error) {
+				gotSprintID = sprintID
+				return &taskEntity.Task{UUID: id, SprintID: sprintID, Version: 2}, nil
+			}
+			sprintRepo.SetPersist(mock)
+			teamRepo.SetPersist(&teamRepo.MockPersistent{
+				FnRetrieveTaskTeamID: func(ctx context.Context, id uuid.UUID) (*uint, error) {
+					return tt.taskTeamID, tt.taskErr
+				},
+			})
+
+			err := AssignTask(context.Background(), tt.sprintUUID, taskUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("AssignTask() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(tt.wantSprintID, gotSprintID); diff != "" {
+				t.Errorf("AssignTask() sprint_id mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff([]string{string(webhookEntity.EventTaskUpdated)}, *events); diff != "" {
+					t.Errorf("AssignTask() events mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUnassignTask(t *testing.T) {
+	originalPersist := sprintRepo.Persist()
+
+	sprintID := uint(1)
+	otherSprintID := uint(2)
+
+	tests := []struct {
+		name         string
+		state        sprintEntity.State
+		taskSprintID *uint
+		taskErr      error
+		wantUpdated  bool
+		wantErr      error
+	}{
+		{"Unassign task of the sprint", sprintEntity.StateActive, &sprintID, nil, true, nil},
+		{"Unassign task of another sprint", sprintEntity.StateActive, &otherSprintID, nil, false, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "task", Type: errs.TypeState, Code: errs.CodeTaskNotInSprint, Message: "task is not in this sprint"},
+		}}},
+		{"Unassign task in the backlog", sprintEntity.StateActive, nil, nil, false, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "task", Type: errs.TypeState, Code: errs.CodeTaskNotInSprint, Message: "task is not in this sprint"},
+		}}},
+		{"Unassign missing task", sprintEntity.StateActive, nil, errs.ErrNotFound, false, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "task", Type: errs.TypeNotFound, Code: errs.CodeTaskNotFound, Message: "task not found"},
+		}}},
+		{"Unassign task of a completed sprint", sprintEntity.StateCompleted, &sprintID, nil, false, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "sprint", Type: errs.TypeState, Code: errs.CodeSprintCompleted, Message: "sprint is already completed"},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer sprintRepo.SetPersist(originalPersist)
+			events, restore := recordEvents()
+			defer restore()
+
+			var updated bool
+			mock := mockSprint(tt.state)
+			mock.FnRetrieveTaskSprintID = func(ctx context.Context, id uuid.UUID) (*uint, error) {
+				return tt.taskSprintID, tt.taskErr
+			}
+			mock.FnUpdateTaskSprintID = func(ctx context.Context, id uuid.UUID, sprintID *uint) (*taskEntity.Task, error) {
+				updated = sprintID == nil
+				return &taskEntity.Task{UUID: id, Version: 2}, nil
+			}
+			sprintRepo.SetPersist(mock)
+
+			err := UnassignTask(context.Background(), sprintUUID, taskUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("UnassignTask() error diff: %s", diff)
+				return
+			}
+			if updated != tt.wantUpdated {
+				t.Errorf("UnassignTask() updated = %t, want %t", updated, tt.wantUpdated)
+			}
+			if updated && len(*events) != 1 {
+				t.Errorf("UnassignTask() recorded %d events, want 1", len(*events))
+			}
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	originalPersist := sprintRepo.Persist()
+
+	tests := []struct {
+		name      string
+		state     sprintEntity.State
+		activeID  uint
+		activeErr error
+		wantErr   error
+	}{
+		{"Start planned sprint", sprintEntity.StatePlanned, 0, errs.ErrNotFound, nil},
+		{"Start sprint while another one is active", sprintEntity.StatePlanned, 2, nil, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "state", Type: errs.TypeConflict, Code: errs.CodeActiveSprintExists, Message: "team already has an active sprint"},
+		}}},
+		{"Start active sprint", sprintEntity.StateActive, 1, nil, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "state", Type: errs.TypeState, Code: errs.CodeSprintNotPlanned, Message: "only a planned sprint can be started"},
+		}}},
+		{"Start sprint with active sprint error", sprintEntity.StatePlanned, 0, errors.New("database error"), errors.New("database error")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer sprintRepo.SetPersist(originalPersist)
+
+			var updated *sprintEntity.Sprint
+			mock := mockSprint(tt.state)
+			mock.FnRetrieveActiveByTeamID = func(ctx context.Context, teamID uint) (*sprintEntity.Sprint, error) {
+				if tt.activeErr != nil {
+					return nil, tt.activeErr
+				}
+				return &sprintEntity.Sprint{Model: gorm.Model{ID: tt.activeID}, TeamID: teamID, State: sprintEntity.StateActive}, nil
+			}
+			mock.FnUpdateState = func(ctx context.Context, s *sprintEntity.Sprint) error {
+				updated = s
+				return nil
+			}
+			sprintRepo.SetPersist(mock)
+
+			got, err := Start(context.Background(), sprintUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Start() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				if updated != nil {
+					t.Errorf("Start() updated the sprint state on error")
+				}
+				return
+			}
+			if got.State != sprintEntity.StateActive || got.StartedAt == nil || updated != got {
+				t.Errorf("Start() = %+v, want an active sprint saved with its start time", got)
+			}
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	originalPersist := sprintRepo.Persist()
+
+	nextSprint := &sprintEntity.Sprint{Model: gorm.Model{ID: 2}, TeamID: 1, State: sprintEntity.StatePlanned}
+
+	tests := []struct {
+		name           string
+		state          sprintEntity.State
+		next           *sprintEntity.Sprint
+		wantMovedTo    *uint
+		wantRolledOver int
+		wantErr        error
+	}{
+		{"Complete sprint rolls unfinished tasks into the next sprint", sprintEntity.StateActive, nextSprint, &nextSprint.ID, 2, nil},
+		{"Complete sprint without next sprint moves unfinished tasks to the backlog", sprintEntity.StateActive, nil, nil, 2, nil},
+		{"Complete planned sprint", sprintEntity.StatePlanned, nextSprint, nil, 0, &errs.ValidationErrors{Errors: []errs.ValidationError{
+			{Field: "state", Type: errs.TypeState, Code: errs.CodeSprintNotActive, Message: "only an active sprint can be completed"},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer sprintRepo.SetPersist(originalPersist)
+			events, restore := recordEvents()
+			defer restore()
+
+			var movedTo *uint
+			var updated bool
+			mock := mockSprint(tt.state)
+			mock.FnRetrieveNextPlanned = func(ctx context.Context, teamID, excludeID uint) (*sprintEntity.Sprint, error) {
+				if tt.next == nil {
+					return nil, errs.ErrNotFound
+				}
+				return tt.next, nil
+			}
+			mock.FnMoveUnfinishedTasks = func(ctx context.Context, fromID uint, toID *uint) ([]taskEntity.Task, error) {
+				movedTo = toID
+				return []taskEntity.Task{
+					{UUID: taskUUID, SprintID: toID, Version: 2},
+					{UUID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174004"), SprintID: toID, Version: 2},
+				}, nil
+			}
+			mock.FnUpdateState = func(ctx context.Context, s *sprintEntity.Sprint) error {
+				updated = s.State == sprintEntity.StateCompleted && s.CompletedAt != nil
+				return nil
+			}
+			sprintRepo.SetPersist(mock)
+
+			got, err := Complete(context.Background(), sprintUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Complete() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wantMovedTo, movedTo); diff != "" {
+				t.Errorf("Complete() moved to mismatch (-want +got):\n%s", diff)
+			}
+			if got.RolledOver != tt.wantRolledOver || got.Next != tt.next {
+				t.Errorf("Complete() = %+v, want %d tasks rolled over into %+v", got, tt.wantRolledOver, tt.next)
+			}
+			if !updated {
+				t.Errorf("Complete() did not save the completed sprint")
+			}
+			if len(*events) != tt.wantRolledOver {
+				t.Errorf("Complete() recorded %d events, want one per task rolled over", len(*events))
+			}
+		})
+	}
+}
+
+func TestVelocity(t *testing.T) {
+	originalSprintPersist := sprintRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	tests := []struct {
+		name      string
+		sprints   int
+		wantLimit int
+	}{
+		{"Velocity over the default number of sprints", 0, Config.VelocitySprints},
+		{"Velocity over the requested number of sprints", 5, 5},
+		{"Velocity over more sprints than the maximum", Config.ListMaxLimit + 1, Config.ListMaxLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer sprintRepo.SetPersist(originalSprintPersist)
+			defer teamRepo.SetPersist(originalTeamPersist)
+
+			var gotLimit int
+			teamRepo.SetPersist(&teamRepo.MockPersistent{
+				FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+					return &teamEntity.Team{Model: gorm.Model{ID: 1}, UUID: id}, nil
+				},
+			})
+			sprintRepo.SetPersist(&sprintRepo.MockPersistent{
+				FnListVelocity: func(ctx context.Context, teamID uint, limit int) ([]sprintEntity.SprintVelocity, error) {
+					gotLimit = limit
+					return []sprintEntity.SprintVelocity{{CompletedPoints: 8}, {CompletedPoints: 4}}, nil
+				},
+			})
+
+			got, err := Velocity(context.Background(), teamUUID, tt.sprints)
+			if err != nil {
+				t.Fatalf("Velocity() error = %v", err)
+			}
+			if gotLimit != tt.wantLimit {
+				t.Errorf("Velocity() limit = %d, want %d", gotLimit, tt.wantLimit)
+			}
+			if got.Average != 6 {
+				t.Errorf("Velocity() average = %v, want 6", got.Average)
+			}
+		})
+	}
+}
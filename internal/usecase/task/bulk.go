@@ -0,0 +1,140 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	taskEntity "taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/database"
+	apperrors "taskmanager/internal/platform/errors"
+	taskRepo "taskmanager/internal/repository/task"
+	teamUsecase "taskmanager/internal/usecase/team"
+)
+
+// Bulk applies a batch of operations to tasks using the existing use case rules
+// In all-or-nothing mode the first failure is returned with its fields prefixed by the
+// operation index; in best-effort mode each operation runs in its own savepoint and
+// failures are reported per item. Unexpected errors abort the batch in both modes.
+// The list cache is invalidated once for the whole batch.
+func Bulk(ctx context.Context, mode taskEntity.BulkMode, operations []taskEntity.BulkOperation) ([]taskEntity.BulkResult, error) {
+	if err := validateBulk(mode, operations); err != nil {
+		return nil, err
+	}
+
+	ctx, flushCache := taskRepo.DeferListCacheInvalidation(ctx)
+
+	results := make([]taskEntity.BulkResult, len(operations))
+	for i, op := range operations {
+		results[i] = taskEntity.BulkResult{Index: i, Operation: op}
+
+		var err error
+		if mode == taskEntity.BulkModeBestEffort {
+			err = database.Savepoint(ctx, fmt.Sprintf("bulk_operation_%d", i), func() error {
+				return applyBulkOperation(ctx, op)
+			})
+		} else {
+			err = applyBulkOperation(ctx, op)
+		}
+		if err == nil {
+			continue
+		}
+
+		itemErrs := bulkItemErrors(err)
+		if itemErrs == nil {
+			return nil, err
+		}
+		if mode == taskEntity.BulkModeAllOrNothing {
+			return nil, prefixBulkErrors(itemErrs, i)
+		}
+		results[i].Errors = itemErrs
+	}
+
+	flushCache()
+
+	return results, nil
+}
+
+// validateBulk validates the mode and the number of operations of a bulk request
+func validateBulk(mode taskEntity.BulkMode, operations []taskEntity.BulkOperation) error {
+	var errs []apperrors.ValidationError
+
+	if mode != taskEntity.BulkModeAllOrNothing && mode != taskEntity.BulkModeBestEffort {
+		errs = append(errs, apperrors.NewValidationError("mode", apperrors.CodeInvalidValue, nil))
+	}
+
+	if len(operations) == 0 {
+		errs = append(errs, apperrors.NewValidationError("operations", apperrors.CodeRequired, nil))
+	} else if len(operations) > Config.BulkMaxOperations {
+		errs = append(errs, apperrors.NewValidationError("operations", apperrors.CodeMaxItems, map[string]any{"max": Config.BulkMaxOperations}))
+	}
+
+	if len(errs) > 0 {
+		return &apperrors.ValidationErrors{Errors: errs}
+	}
+
+	return nil
+}
+
+// applyBulkOperation dispatches a bulk operation to the matching use case
+func applyBulkOperation(ctx context.Context, op taskEntity.BulkOperation) error {
+	switch op.Type {
+	case taskEntity.BulkOpUpdateStatus:
+		return UpdateStatus(ctx, op.TaskUUID, op.Status, op.OverrideWIPLimit, nil)
+	case taskEntity.BulkOpAssociateTeam:
+		return teamUsecase.AssociateTask(ctx, op.TeamUUID, op.TaskUUID)
+	case taskEntity.BulkOpDisassociateTeam:
+		return teamUsecase.DisassociateTask(ctx, op.TeamUUID, op.TaskUUID)
+	case taskEntity.BulkOpDelete:
+		return Delete(ctx, op.TaskUUID, nil)
+	case taskEntity.BulkOpUpdate:
+		_, err := Update(ctx, op.TaskUUID, op.Updates, nil)
+		return err
+	default:
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("op", apperrors.CodeInvalidOperation, nil),
+		}}
+	}
+}
+
+// bulkFields maps the fields reported by the team use cases to the fields of a bulk operation
+var bulkFields = map[string]string{
+	"team": "team_uuid",
+	"task": "task_uuid",
+}
+
+// bulkItemErrors converts an operation error to validation errors reported for the item
+// Team and task lookups are reported on the team_uuid and task_uuid fields of the operation;
+// a bare not found comes from a task lookup, as the team use cases report a missing team themselves.
+// Returns nil for unexpected errors, which must abort the batch
+func bulkItemErrors(err error) *apperrors.ValidationErrors {
+	var validationErrs *apperrors.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		renamed := make([]apperrors.ValidationError, len(validationErrs.Errors))
+		for i, e := range validationErrs.Errors {
+			if field, ok := bulkFields[e.Field]; ok {
+				e.Field = field
+			}
+			renamed[i] = e
+		}
+		return &apperrors.ValidationErrors{Errors: renamed}
+	}
+
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("task_uuid", apperrors.CodeTaskNotFound, nil),
+		}}
+	}
+
+	return nil
+}
+
+// prefixBulkErrors scopes the fields of an operation's validation errors to its index in the batch
+func prefixBulkErrors(errs *apperrors.ValidationErrors, index int) *apperrors.ValidationErrors {
+	prefixed := make([]apperrors.ValidationError, len(errs.Errors))
+	for i, e := range errs.Errors {
+		e.Field = fmt.Sprintf("operations[%d].%s", index, e.Field)
+		prefixed[i] = e
+	}
+	return &apperrors.ValidationErrors{Errors: prefixed}
+}
@@ -0,0 +1,325 @@
+//go:build test
+
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/testing/assert"
+	taskRepo "taskmanager/internal/repository/task"
+	teamRepo "taskmanager/internal/repository/team"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestBulk(t *testing.T) {
+	originalTaskPersist := taskRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	todoUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	doneUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	missingUUID := uuid.MustParse("00000000-0000-0000-0000-000000000000")
+	teamUUID := uuid.MustParse("111e4567-e89b-12d3-a456-426614174000")
+
+	mockTasks := func() *taskRepo.MockPersistent {
+		tasks := map[uuid.UUID]taskEntity.Task{
+			todoUUID: {UUID: todoUUID, Title: "Implementar autenticação", Description: "Criar sistema JWT", Status: taskEntity.StatusTodo},
+			doneUUID: {UUID: doneUUID, Title: "Configurar CI/CD", Description: "Pipeline", Status: taskEntity.StatusDone},
+		}
+		return &taskRepo.MockPersistent{
+			FnRetrieveByUUID: func(ctx context.Context, taskUUID uuid.UUID) (*taskEntity.Task, error) {
+				t, ok := tasks[taskUUID]
+				if !ok {
+					return nil, errs.ErrNotFound
+				}
+				return &t, nil
+			},
+			FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
+				return nil
+			},
+			FnUpdate: func(ctx context.Context, taskUUID uuid.UUID, t *taskEntity.Task) error {
+				return nil
+			},
+			FnDelete: func(ctx context.Context, taskUUID uuid.UUID, version int) error {
+				if _, ok := tasks[taskUUID]; !ok {
+					return errs.ErrNotFound
+				}
+				return nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		ctx        context.Context
+		mode       taskEntity.BulkMode
+		operations []taskEntity.BulkOperation
+		want       []taskEntity.BulkResult
+		wantErr    error
+	}{
+		{
+			"Bulk all or nothing with success",
+			func() {
+				taskRepo.SetPersist(mockTasks())
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+						team := &teamEntity.Team{UUID: id}
+						team.ID = 1
+						return team, nil
+					},
+					FnRetrieveTaskTeamID: func(ctx context.Context, taskUUID uuid.UUID) (*uint, error) {
+						return nil, nil
+					},
+					FnRetrieveTaskTeamUUID: func(ctx context.Context, taskUUID uuid.UUID) (*uuid.UUID, error) {
+						return &teamUUID, nil
+					},
+					FnUpdateTaskTeamID: func(ctx context.Context, taskUUID uuid.UUID, teamID *uint) error {
+						return nil
+					},
+				})
+			},
+			context.Background(),
+			taskEntity.BulkModeAllOrNothing,
+			[]taskEntity.BulkOperation{
+				{Type: taskEntity.BulkOpUpdateStatus, TaskUUID: todoUUID, Status: taskEntity.StatusInProgress},
+				{Type: taskEntity.BulkOpAssociateTeam, TaskUUID: todoUUID, TeamUUID: teamUUID},
+				{Type: taskEntity.BulkOpUpdate, TaskUUID: todoUUID, Updates: map[string]any{"title": "Novo título"}},
+				{Type: taskEntity.BulkOpDelete, TaskUUID: doneUUID},
+			},
+			[]taskEntity.BulkResult{
+				{Index: 0, Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpUpdateStatus, TaskUUID: todoUUID, Status: taskEntity.StatusInProgress}},
+				{Index: 1, Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpAssociateTeam, TaskUUID: todoUUID, TeamUUID: teamUUID}},
+				{Index: 2, Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpUpdate, TaskUUID: todoUUID, Updates: map[string]any{"title": "Novo título"}}},
+				{Index: 3, Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpDelete, TaskUUID: doneUUID}},
+			},
+			nil,
+		},
+		{
+			"Bulk all or nothing aborts on first failure",
+			func() {
+				taskRepo.SetPersist(mockTasks())
+			},
+			context.Background(),
+			taskEntity.BulkModeAllOrNothing,
+			[]taskEntity.BulkOperation{
+				{Type: taskEntity.BulkOpUpdateStatus, TaskUUID: todoUUID, Status: taskEntity.StatusInProgress},
+				{Type: taskEntity.BulkOpUpdateStatus, TaskUUID: doneUUID, Status: taskEntity.StatusTodo},
+				{Type: taskEntity.BulkOpDelete, TaskUUID: todoUUID},
+			},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "operations[1].status", Type: errs.TypeState, Code: errs.CodeInvalidTransition, Message: "invalid status transition"},
+			}},
+		},
+		{
+			"Bulk all or nothing reports task not found",
+			func() {
+				taskRepo.SetPersist(mockTasks())
+			},
+			context.Background(),
+			taskEntity.BulkModeAllOrNothing,
+			[]taskEntity.BulkOperation{
+				{Type: taskEntity.BulkOpDelete, TaskUUID: missingUUID},
+			},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "operations[0].task_uuid", Type: errs.TypeNotFound, Code: errs.CodeTaskNotFound, Message: "task not found"},
+			}},
+		},
+		{
+			"Bulk all or nothing reports team not found",
+			func() {
+				taskRepo.SetPersist(mockTasks())
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+						return nil, errs.ErrNotFound
+					},
+				})
+			},
+			context.Background(),
+			taskEntity.BulkModeAllOrNothing,
+			[]taskEntity.BulkOperation{
+				{Type: taskEntity.BulkOpAssociateTeam, TaskUUID: todoUUID, TeamUUID: missingUUID},
+			},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "operations[0].team_uuid", Type: errs.TypeNotFound, Code: errs.CodeTeamNotFound, Message: "team not found"},
+			}},
+		},
+		{
+			"Bulk best effort reports team and task not found per item",
+			func() {
+				taskRepo.SetPersist(mockTasks())
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+						if id != teamUUID {
+							return nil, errs.ErrNotFound
+						}
+						team := &teamEntity.Team{UUID: id}
+						team.ID = 1
+						return team, nil
+					},
+					FnRetrieveTaskTeamID: func(ctx context.Context, taskUUID uuid.UUID) (*uint, error) {
+						return nil, errs.ErrNotFound
+					},
+				})
+			},
+			context.Background(),
+			taskEntity.BulkModeBestEffort,
+			[]taskEntity.BulkOperation{
+				{Type: taskEntity.BulkOpAssociateTeam, TaskUUID: todoUUID, TeamUUID: missingUUID},
+				{Type: taskEntity.BulkOpDisassociateTeam, TaskUUID: missingUUID, TeamUUID: teamUUID},
+			},
+			[]taskEntity.BulkResult{
+				{
+					Index:     0,
+					Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpAssociateTeam, TaskUUID: todoUUID, TeamUUID: missingUUID},
+					Errors: &errs.ValidationErrors{Errors: []errs.ValidationError{
+						{Field: "team_uuid", Type: errs.TypeNotFound, Code: errs.CodeTeamNotFound, Message: "team not found"},
+					}},
+				},
+				{
+					Index:     1,
+					Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpDisassociateTeam, TaskUUID: missingUUID, TeamUUID: teamUUID},
+					Errors: &errs.ValidationErrors{Errors: []errs.ValidationError{
+						{Field: "task_uuid", Type: errs.TypeNotFound, Code: errs.CodeTaskNotFound, Message: "task not found"},
+					}},
+				},
+			},
+			nil,
+		},
+		{
+			"Bulk best effort reports failures per item",
+			func() {
+				taskRepo.SetPersist(mockTasks())
+			},
+			context.Background(),
+			taskEntity.BulkModeBestEffort,
+			[]taskEntity.BulkOperation{
+				{Type: taskEntity.BulkOpUpdateStatus, TaskUUID: doneUUID, Status: taskEntity.StatusTodo},
+				{Type: taskEntity.BulkOpUpdate, TaskUUID: todoUUID, Updates: map[string]any{"title": "   "}},
+				{Type: taskEntity.BulkOpDelete, TaskUUID: missingUUID},
+				{Type: taskEntity.BulkOpDelete, TaskUUID: todoUUID},
+			},
+			[]taskEntity.BulkResult{
+				{
+					Index:     0,
+					Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpUpdateStatus, TaskUUID: doneUUID, Status: taskEntity.StatusTodo},
+					Errors: &errs.ValidationErrors{Errors: []errs.ValidationError{
+						{Field: "status", Type: errs.TypeState, Code: errs.CodeInvalidTransition, Message: "invalid status transition"},
+					}},
+				},
+				{
+					Index:     1,
+					Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpUpdate, TaskUUID: todoUUID, Updates: map[string]any{"title": "   "}},
+					Errors: &errs.ValidationErrors{Errors: []errs.ValidationError{
+						{Field: "title", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "title is required"},
+					}},
+				},
+				{
+					Index:     2,
+					Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpDelete, TaskUUID: missingUUID},
+					Errors: &errs.ValidationErrors{Errors: []errs.ValidationError{
+						{Field: "task_uuid", Type: errs.TypeNotFound, Code: errs.CodeTaskNotFound, Message: "task not found"},
+					}},
+				},
+				{
+					Index:     3,
+					Operation: taskEntity.BulkOperation{Type: taskEntity.BulkOpDelete, TaskUUID: todoUUID},
+				},
+			},
+			nil,
+		},
+		{
+			"Bulk best effort aborts on unexpected error",
+			func() {
+				mock := mockTasks()
+				mock.FnDelete = func(ctx context.Context, taskUUID uuid.UUID, version int) error {
+					return errors.New("database connection failed")
+				}
+				taskRepo.SetPersist(mock)
+			},
+			context.Background(),
+			taskEntity.BulkModeBestEffort,
+			[]taskEntity.BulkOperation{
+				{Type: taskEntity.BulkOpUpdateStatus, TaskUUID: todoUUID, Status: taskEntity.StatusInProgress},
+				{Type: taskEntity.BulkOpDelete, TaskUUID: todoUUID},
+			},
+			nil,
+			errors.New("database connection failed"),
+		},
+		{
+			"Bulk with invalid operation type",
+			func() {
+				taskRepo.SetPersist(mockTasks())
+			},
+			context.Background(),
+			taskEntity.BulkModeAllOrNothing,
+			[]taskEntity.BulkOperation{
+				{Type: "archive", TaskUUID: todoUUID},
+			},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "operations[0].op", Type: errs.TypeInvalid, Code: errs.CodeInvalidOperation, Message: "invalid operation type"},
+			}},
+		},
+		{
+			"Bulk with invalid mode and without operations",
+			nil,
+			context.Background(),
+			"sometimes",
+			[]taskEntity.BulkOperation{},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "mode", Type: errs.TypeInvalid, Code: errs.CodeInvalidValue, Message: "invalid mode value"},
+				{Field: "operations", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "operations is required"},
+			}},
+		},
+		{
+			"Bulk with too many operations",
+			func() {
+				Config.BulkMaxOperations = 2
+			},
+			context.Background(),
+			taskEntity.BulkModeBestEffort,
+			[]taskEntity.BulkOperation{
+				{Type: taskEntity.BulkOpDelete, TaskUUID: todoUUID},
+				{Type: taskEntity.BulkOpDelete, TaskUUID: doneUUID},
+				{Type: taskEntity.BulkOpDelete, TaskUUID: missingUUID},
+			},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "operations", Type: errs.TypeMaxLength, Code: errs.CodeMaxItems, Message: "operations must not exceed 2 items", Params: map[string]any{"max": 2}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				taskRepo.SetPersist(originalTaskPersist)
+				teamRepo.SetPersist(originalTeamPersist)
+			}()
+
+			Config.BulkMaxOperations = 100
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := Bulk(tt.ctx, tt.mode, tt.operations)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Bulk() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Bulk() diff: %s", diff)
+			}
+		})
+	}
+}
@@ -7,8 +7,11 @@ import (
 var Config Configuration
 
 type Configuration struct {
-	ListDefaultLimit int `toml:"list_default_limit"`
-	ListMaxLimit     int `toml:"list_max_limit"`
+	ListDefaultLimit  int    `toml:"list_default_limit"`
+	ListMaxLimit      int    `toml:"list_max_limit"`
+	SearchLanguage    string `toml:"search_language"`
+	BulkMaxOperations int    `toml:"bulk_max_operations"`
+	ExportBatchSize   int    `toml:"export_batch_size"`
 }
 
 func LoadConfig(cfg *Configuration) error {
@@ -22,5 +25,17 @@ func LoadConfig(cfg *Configuration) error {
 		log.Fatal("List max limit is required")
 	}
 
+	if Config.SearchLanguage == "" {
+		log.Fatal("Search language is required")
+	}
+
+	if Config.BulkMaxOperations == 0 {
+		log.Fatal("Bulk max operations is required")
+	}
+
+	if Config.ExportBatchSize == 0 {
+		log.Fatal("Export batch size is required")
+	}
+
 	return nil
 }
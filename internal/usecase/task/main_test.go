@@ -3,13 +3,19 @@
 package task
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
+	"github.com/google/uuid"
+
+	outboxEntity "taskmanager/internal/entity/outbox"
 	"taskmanager/internal/paths"
 	"taskmanager/internal/platform/database"
 	"taskmanager/internal/platform/testing/dbtest"
+	outboxRepo "taskmanager/internal/repository/outbox"
+	teamRepo "taskmanager/internal/repository/team"
 	"taskmanager/internal/testing/configtest"
 )
 
@@ -26,6 +32,20 @@ func TestMain(m *testing.M) {
 			log.Fatalf("Error on load config on struct. Err: %s", err)
 		}
 
+		// Events are recorded in the outbox, which these tests do not persist
+		outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+			FnCreate: func(ctx context.Context, m *outboxEntity.Message) error {
+				return nil
+			},
+		})
+
+		// Event payloads read the team of each task, which these tests do not persist
+		teamRepo.SetPersist(&teamRepo.MockPersistent{
+			FnRetrieveTaskTeamUUID: func(ctx context.Context, taskUUID uuid.UUID) (*uuid.UUID, error) {
+				return nil, nil
+			},
+		})
+
 		return m.Run()
 	}(m))
 }
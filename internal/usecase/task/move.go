@@ -0,0 +1,167 @@
+package task
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	taskEntity "taskmanager/internal/entity/task"
+	webhookEntity "taskmanager/internal/entity/webhook"
+	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
+	"taskmanager/internal/platform/rank"
+	taskRepo "taskmanager/internal/repository/task"
+	webhookUsecase "taskmanager/internal/usecase/webhook"
+)
+
+// Move ranks a task between two neighbors of its status column: previous sorts before it and next after it
+// Either neighbor may be nil; the other side is then the task adjacent to the given neighbor, so a move
+// with only next puts the task right before it. All tasks are rebalanced when the neighbors leave no room
+// between their ranks. Publishes task.updated once the transaction commits
+func Move(ctx context.Context, taskUUID uuid.UUID, previousUUID, nextUUID *uuid.UUID) (*taskEntity.Task, error) {
+	if previousUUID == nil && nextUUID == nil {
+		return nil, moveError("previous_uuid", apperrors.CodeNeighborRequired)
+	}
+
+	// Moves are serialized so neighbors cannot be given the same rank concurrently
+	if err := taskRepo.Persist().LockRanks(ctx); err != nil {
+		return nil, err
+	}
+
+	t, err := taskRepo.Persist().RetrieveByUUID(ctx, taskUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, next, err := moveNeighbors(ctx, t, previousUUID, nextUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := rankBetween(previous, next)
+	if err != nil {
+		if err := taskRepo.Persist().Rebalance(ctx); err != nil {
+			return nil, err
+		}
+		// The rebalance may have given the task a new rank
+		if t, err = taskRepo.Persist().RetrieveByUUID(ctx, taskUUID); err != nil {
+			return nil, err
+		}
+		if previous, next, err = moveNeighbors(ctx, t, previousUUID, nextUUID); err != nil {
+			return nil, err
+		}
+		if r, err = rankBetween(previous, next); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := taskRepo.Persist().UpdateRank(ctx, taskUUID, r); err != nil {
+		return nil, err
+	}
+	t.Rank = r
+	t.Version++
+
+	teamUUID, err := taskTeamUUID(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := webhookUsecase.Publish(ctx, webhookEntity.EventTaskUpdated, webhookEntity.NewTaskData(*t, teamUUID)); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// moveNeighbors retrieves the neighbors of a move and fills the missing one with the task adjacent to the other
+// Neighbors must be other tasks of the column of t, and previous must sort before next
+func moveNeighbors(ctx context.Context, t *taskEntity.Task, previousUUID, nextUUID *uuid.UUID) (*taskEntity.Task, *taskEntity.Task, error) {
+	previous, err := moveNeighbor(ctx, t, previousUUID, "previous_uuid")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next, err := moveNeighbor(ctx, t, nextUUID, "next_uuid")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case previous == nil:
+		previous, err = taskRepo.Persist().RetrieveNeighborByRank(ctx, next, pagination.DirectionPrev, t.ID)
+	case next == nil:
+		next, err = taskRepo.Persist().RetrieveNeighborByRank(ctx, previous, pagination.DirectionNext, t.ID)
+	case !sortsBefore(previous, next):
+		return nil, nil, moveError("previous_uuid", apperrors.CodeNeighborsOutOfOrder)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return previous, next, nil
+}
+
+// moveNeighbor retrieves a neighbor given by the client, nil when neighborUUID is nil
+func moveNeighbor(ctx context.Context, t *taskEntity.Task, neighborUUID *uuid.UUID, field string) (*taskEntity.Task, error) {
+	if neighborUUID == nil {
+		return nil, nil
+	}
+
+	if *neighborUUID == t.UUID {
+		return nil, moveError(field, apperrors.CodeSelfNeighbor)
+	}
+
+	neighbor, err := taskRepo.Persist().RetrieveByUUID(ctx, *neighborUUID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, moveError(field, apperrors.CodeTaskNotFound)
+		}
+		return nil, err
+	}
+
+	if neighbor.Status != t.Status || !sameTeam(neighbor.TeamID, t.TeamID) {
+		return nil, moveError(field, apperrors.CodeNeighborNotInColumn)
+	}
+
+	return neighbor, nil
+}
+
+// rankBetween returns a rank between two neighbors, either of which may be nil
+// Neighbors ranked before ranks existed have an empty rank, which only a rebalance fixes
+func rankBetween(previous, next *taskEntity.Task) (string, error) {
+	var previousRank, nextRank string
+	if previous != nil {
+		previousRank = previous.Rank
+	}
+	if next != nil {
+		nextRank = next.Rank
+	}
+
+	if (previous != nil && previousRank == "") || (next != nil && nextRank == "") {
+		return "", rank.ErrInvalid
+	}
+
+	return rank.Between(previousRank, nextRank)
+}
+
+// sortsBefore reports whether a comes before b in the (rank, id) order
+func sortsBefore(a, b *taskEntity.Task) bool {
+	if a.Rank != b.Rank {
+		return a.Rank < b.Rank
+	}
+	return a.ID < b.ID
+}
+
+func sameTeam(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func moveError(field, code string) error {
+	return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+		apperrors.NewValidationError(field, code, nil),
+	}}
+}
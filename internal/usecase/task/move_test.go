@@ -0,0 +1,241 @@
+//go:build test
+
+package task
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	taskEntity "taskmanager/internal/entity/task"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
+	"taskmanager/internal/platform/rank"
+	"taskmanager/internal/platform/testing/assert"
+	taskRepo "taskmanager/internal/repository/task"
+
+	"github.com/google/uuid"
+)
+
+func TestMove(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+
+	teamID := uint(1)
+	firstUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	secondUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	thirdUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174003")
+	tiedUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174004")
+	tiedAgainUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174005")
+	movedUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174006")
+	inProgressUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174007")
+	missingUUID := uuid.MustParse("00000000-0000-0000-0000-000000000000")
+
+	// mockColumn persists the to_do column of team 1, with two tasks tied on the same rank,
+	// and records the rank given to the moved task and whether ranks were rebalanced
+	mockColumn := func(gotRank *string, rebalanced *bool) *taskRepo.MockPersistent {
+		tasks := []taskEntity.Task{
+			{UUID: firstUUID, Status: taskEntity.StatusTodo, TeamID: &teamID, Rank: "1"},
+			{UUID: secondUUID, Status: taskEntity.StatusTodo, TeamID: &teamID, Rank: "2"},
+			{UUID: thirdUUID, Status: taskEntity.StatusTodo, TeamID: &teamID, Rank: "3"},
+			{UUID: tiedUUID, Status: taskEntity.StatusTodo, TeamID: &teamID, Rank: "4"},
+			{UUID: tiedAgainUUID, Status: taskEntity.StatusTodo, TeamID: &teamID, Rank: "4"},
+			{UUID: movedUUID, Status: taskEntity.StatusTodo, TeamID: &teamID, Rank: "5"},
+			{UUID: inProgressUUID, Status: taskEntity.StatusInProgress, TeamID: &teamID, Rank: "6"},
+		}
+		for i := range tasks {
+			tasks[i].ID = uint(i + 1)
+		}
+
+		return &taskRepo.MockPersistent{
+			FnLockRanks: func(ctx context.Context) error {
+				return nil
+			},
+			FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*taskEntity.Task, error) {
+				for _, t := range tasks {
+					if t.UUID == id {
+						return &t, nil
+					}
+				}
+				return nil, errs.ErrNotFound
+			},
+			FnRetrieveNeighborByRank: func(ctx context.Context, t *taskEntity.Task, direction pagination.Direction, excludeID uint) (*taskEntity.Task, error) {
+				var neighbor *taskEntity.Task
+				for _, other := range tasks {
+					if other.ID == excludeID || other.Status != t.Status {
+						continue
+					}
+					switch {
+					case direction == pagination.DirectionNext && sortsBefore(t, &other) && (neighbor == nil || sortsBefore(&other, neighbor)):
+						neighbor = &other
+					case direction == pagination.DirectionPrev && sortsBefore(&other, t) && (neighbor == nil || sortsBefore(neighbor, &other)):
+						neighbor = &other
+					}
+				}
+				return neighbor, nil
+			},
+			FnRebalance: func(ctx context.Context) error {
+				*rebalanced = true
+				slices.SortFunc(tasks, func(a, b taskEntity.Task) int {
+					if sortsBefore(&a, &b) {
+						return -1
+					}
+					return 1
+				})
+				last := ""
+				for i := range tasks {
+					last = rank.After(last)
+					tasks[i].Rank = last
+				}
+				return nil
+			},
+			FnUpdateRank: func(ctx context.Context, id uuid.UUID, r string) error {
+				*gotRank = r
+				return nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		taskUUID       uuid.UUID
+		previousUUID   *uuid.UUID
+		nextUUID       *uuid.UUID
+		wantRank       string
+		wantRebalanced bool
+		wantErr        error
+	}{
+		{
+			"Move between two neighbors",
+			movedUUID,
+			&firstUUID,
+			&secondUUID,
+			"1i",
+			false,
+			nil,
+		},
+		{
+			"Move after a neighbor takes the task that follows it as next",
+			movedUUID,
+			&secondUUID,
+			nil,
+			"2i",
+			false,
+			nil,
+		},
+		{
+			"Move before a neighbor takes the task that precedes it as previous",
+			movedUUID,
+			nil,
+			&thirdUUID,
+			"2i",
+			false,
+			nil,
+		},
+		{
+			"Move to the start of the column",
+			movedUUID,
+			nil,
+			&firstUUID,
+			rank.Before("1"),
+			false,
+			nil,
+		},
+		{
+			"Move between tied neighbors rebalances the ranks",
+			movedUUID,
+			&tiedUUID,
+			&tiedAgainUUID,
+			"00000004i",
+			true,
+			nil,
+		},
+		{
+			"Move without neighbors",
+			movedUUID,
+			nil,
+			nil,
+			"",
+			false,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "previous_uuid", Type: errs.TypeRequired, Code: errs.CodeNeighborRequired, Message: "previous_uuid or next_uuid is required"},
+			}},
+		},
+		{
+			"Move next to itself",
+			movedUUID,
+			&movedUUID,
+			nil,
+			"",
+			false,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "previous_uuid", Type: errs.TypeInvalid, Code: errs.CodeSelfNeighbor, Message: "task cannot be its own neighbor"},
+			}},
+		},
+		{
+			"Move next to a missing task",
+			movedUUID,
+			nil,
+			&missingUUID,
+			"",
+			false,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "next_uuid", Type: errs.TypeNotFound, Code: errs.CodeTaskNotFound, Message: "task not found"},
+			}},
+		},
+		{
+			"Move next to a task in another status",
+			movedUUID,
+			&inProgressUUID,
+			nil,
+			"",
+			false,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "previous_uuid", Type: errs.TypeInvalid, Code: errs.CodeNeighborNotInColumn, Message: "task must be in the same team and status"},
+			}},
+		},
+		{
+			"Move between reversed neighbors",
+			movedUUID,
+			&secondUUID,
+			&firstUUID,
+			"",
+			false,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "previous_uuid", Type: errs.TypeInvalid, Code: errs.CodeNeighborsOutOfOrder, Message: "previous task must sort before next task"},
+			}},
+		},
+		{
+			"Move missing task",
+			missingUUID,
+			&firstUUID,
+			nil,
+			"",
+			false,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer taskRepo.SetPersist(originalPersist)
+
+			var gotRank string
+			var gotRebalanced bool
+			taskRepo.SetPersist(mockColumn(&gotRank, &gotRebalanced))
+
+			got, err := Move(context.Background(), tt.taskUUID, tt.previousUUID, tt.nextUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Move() error diff: %s", diff)
+				return
+			}
+			if gotRank != tt.wantRank {
+				t.Errorf("Move() rank = %q, want %q", gotRank, tt.wantRank)
+			}
+			if got != nil && got.Rank != tt.wantRank {
+				t.Errorf("Move() task rank = %q, want %q", got.Rank, tt.wantRank)
+			}
+			if gotRebalanced != tt.wantRebalanced {
+				t.Errorf("Move() rebalanced = %t, want %t", gotRebalanced, tt.wantRebalanced)
+			}
+		})
+	}
+}
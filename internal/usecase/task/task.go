@@ -2,16 +2,26 @@ package task
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
 	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
+	webhookEntity "taskmanager/internal/entity/webhook"
+	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
+	"taskmanager/internal/platform/rank"
 	taskRepo "taskmanager/internal/repository/task"
+	teamRepo "taskmanager/internal/repository/team"
+	webhookUsecase "taskmanager/internal/usecase/webhook"
 )
 
 // Create creates a new task with business rules
+// The task is ranked after every other task, under the rank lock so concurrent creates, imports and
+// moves never give two tasks the same rank. Publishes task.created once the transaction commits
 func Create(ctx context.Context, t *taskEntity.Task) error {
 	if err := t.Validate(); err != nil {
 		return err
@@ -21,7 +31,26 @@ func Create(ctx context.Context, t *taskEntity.Task) error {
 	t.Title = strings.TrimSpace(t.Title)
 	t.Description = strings.TrimSpace(t.Description)
 
-	return taskRepo.Persist().Create(ctx, t)
+	if err := taskRepo.Persist().LockRanks(ctx); err != nil {
+		return err
+	}
+
+	lastRank, err := taskRepo.Persist().LastRank(ctx)
+	if err != nil {
+		return err
+	}
+	t.Rank = rank.After(lastRank)
+
+	if err := taskRepo.Persist().Create(ctx, t); err != nil {
+		return err
+	}
+
+	teamUUID, err := taskTeamUUID(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	return webhookUsecase.Publish(ctx, webhookEntity.EventTaskCreated, webhookEntity.NewTaskData(*t, teamUUID))
 }
 
 // RetrieveByUUID retrieves a task by UUID
@@ -29,61 +58,150 @@ func RetrieveByUUID(ctx context.Context, taskUUID uuid.UUID) (*taskEntity.Task,
 	return taskRepo.Persist().RetrieveByUUID(ctx, taskUUID)
 }
 
-// Update updates an existing task
-func Update(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) (*taskEntity.Task, error) {
+// Update updates the fields of an existing task present in updates (see Patch)
+func Update(ctx context.Context, taskUUID uuid.UUID, updates map[string]any, ifMatch *int) (*taskEntity.Task, error) {
+	return Patch(ctx, taskUUID, func(t *taskEntity.Task) error {
+		if title, ok := updates["title"].(string); ok {
+			t.Title = title
+		}
+		if description, ok := updates["description"].(string); ok {
+			t.Description = description
+		}
+		if estimate, ok := updates["estimate"].(*int); ok {
+			t.Estimate = estimate
+		}
+		return nil
+	}, ifMatch)
+}
+
+// Patch changes the editable fields of an existing task with apply, then validates and saves it
+// apply runs on the task read in the transaction, so a JSON Patch test sees the stored values
+// ifMatch is the version the client expects the task to be at, nil for any. Publishes task.updated
+// once the transaction commits
+func Patch(ctx context.Context, taskUUID uuid.UUID, apply func(*taskEntity.Task) error, ifMatch *int) (*taskEntity.Task, error) {
 	t, err := taskRepo.Persist().RetrieveByUUID(ctx, taskUUID)
 	if err != nil {
 		return nil, err
 	}
 
-	if title, ok := updates["title"].(string); ok {
-		t.Title = strings.TrimSpace(title)
+	if err := t.CheckVersion(ifMatch); err != nil {
+		return nil, err
 	}
-	if description, ok := updates["description"].(string); ok {
-		t.Description = strings.TrimSpace(description)
+
+	if err := apply(t); err != nil {
+		return nil, err
 	}
 
 	if err := t.Validate(); err != nil {
 		return nil, err
 	}
 
+	t.Title = strings.TrimSpace(t.Title)
+	t.Description = strings.TrimSpace(t.Description)
+
 	err = taskRepo.Persist().Update(ctx, taskUUID, t)
 	if err != nil {
 		return nil, err
 	}
 
+	teamUUID, err := taskTeamUUID(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := webhookUsecase.Publish(ctx, webhookEntity.EventTaskUpdated, webhookEntity.NewTaskData(*t, teamUUID)); err != nil {
+		return nil, err
+	}
+
 	return t, nil
 }
 
 // Delete performs a soft delete of a task
-func Delete(ctx context.Context, taskUUID uuid.UUID) error {
-	return taskRepo.Persist().Delete(ctx, taskUUID)
+// ifMatch is the version the client expects the task to be at, nil for any. Publishes task.deleted
+// once the transaction commits
+func Delete(ctx context.Context, taskUUID uuid.UUID, ifMatch *int) error {
+	t, err := taskRepo.Persist().RetrieveByUUID(ctx, taskUUID)
+	if err != nil {
+		return err
+	}
+
+	if err := t.CheckVersion(ifMatch); err != nil {
+		return err
+	}
+
+	// The team is read before the soft delete hides the task
+	teamUUID, err := taskTeamUUID(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	if err := taskRepo.Persist().Delete(ctx, taskUUID, t.Version); err != nil {
+		return err
+	}
+
+	return webhookUsecase.Publish(ctx, webhookEntity.EventTaskDeleted, webhookEntity.TaskDeletedData{UUID: taskUUID, TeamUUID: teamUUID})
 }
 
 // ListPaginated lists tasks with pagination and optional filters
-func ListPaginated(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
-	if limit <= 0 {
-		limit = Config.ListDefaultLimit
+func ListPaginated(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
+	return taskRepo.Persist().ListPaginated(ctx, statusFilter, sort, page, listLimit(limit))
+}
+
+// ListByCursor lists tasks with keyset (cursor) pagination and optional filters
+// The total count is skipped when withTotal is false
+func ListByCursor(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, cursor *pagination.Cursor, limit int, withTotal bool) (*taskEntity.ListTasks, error) {
+	return taskRepo.Persist().ListByCursor(ctx, statusFilter, sort, cursor, listLimit(limit), withTotal)
+}
+
+// Search performs a full-text search over tasks with pagination
+func Search(ctx context.Context, term string, page, limit int) (*taskEntity.ListSearchResults, error) {
+	return taskRepo.Persist().Search(ctx, strings.TrimSpace(term), Config.SearchLanguage, page, listLimit(limit))
+}
+
+// CheckSearchLanguage checks that Config.SearchLanguage is the text search configuration the
+// search_vector column is generated with, as search would otherwise silently match less
+func CheckSearchLanguage(ctx context.Context) error {
+	language, err := taskRepo.Persist().SearchLanguage(ctx)
+	if err != nil {
+		return err
 	}
 
-	if limit > Config.ListMaxLimit {
-		limit = Config.ListMaxLimit
+	if language != Config.SearchLanguage {
+		return fmt.Errorf("search language %q does not match %q of the search_vector column", Config.SearchLanguage, language)
 	}
 
-	return taskRepo.Persist().ListPaginated(ctx, statusFilter, page, limit)
+	return nil
+}
+
+// Export reads the tasks matching the filters, in the list order of sort, in batches of Config.ExportBatchSize
+// and passes each batch to fn. Batches are streamed from the database so the full result is never held in memory
+func Export(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, fn func([]taskEntity.ExportItem) error) error {
+	return taskRepo.Persist().ExportInBatches(ctx, statusFilter, sort, Config.ExportBatchSize, fn)
 }
 
 // UpdateStatus updates the status of a task with transition validation
-func UpdateStatus(ctx context.Context, taskUUID uuid.UUID, newStatus taskEntity.TaskStatus) error {
+// Tasks of a team cannot enter a status already at its WIP limit unless overrideWIPLimit is set,
+// in which case the override is recorded. ifMatch is the version the client expects the task to be
+// at, nil for any. Publishes task.status_changed once the transaction commits
+func UpdateStatus(ctx context.Context, taskUUID uuid.UUID, newStatus taskEntity.TaskStatus, overrideWIPLimit bool, ifMatch *int) error {
 	task, err := taskRepo.Persist().RetrieveByUUID(ctx, taskUUID)
 	if err != nil {
 		return err
 	}
 
+	if err := task.CheckVersion(ifMatch); err != nil {
+		return err
+	}
+
 	if err := task.Status.ValidateTransitionTo(newStatus); err != nil {
 		return err
 	}
 
+	override, err := checkWIPLimit(ctx, task, newStatus, overrideWIPLimit)
+	if err != nil {
+		return err
+	}
+
 	timestamp := time.Now()
 	task.EnsureTimestampsForStatus(newStatus, &timestamp)
 
@@ -93,5 +211,94 @@ func UpdateStatus(ctx context.Context, taskUUID uuid.UUID, newStatus taskEntity.
 		"finished_at": task.FinishedAt,
 	}
 
-	return taskRepo.Persist().UpdateStatus(ctx, taskUUID, updates)
+	if err := taskRepo.Persist().UpdateStatus(ctx, taskUUID, task.Version, updates); err != nil {
+		return err
+	}
+
+	if override != nil {
+		if err := teamRepo.Persist().CreateWIPLimitOverride(ctx, override); err != nil {
+			return err
+		}
+	}
+
+	previousStatus := task.Status
+	task.Status = newStatus
+
+	teamUUID, err := taskTeamUUID(ctx, task)
+	if err != nil {
+		return err
+	}
+
+	return webhookUsecase.Publish(ctx, webhookEntity.EventTaskStatusChanged, webhookEntity.TaskStatusChangedData{
+		Task:               webhookEntity.NewTaskData(*task, teamUUID),
+		From:               string(previousStatus),
+		To:                 string(newStatus),
+		WIPLimitOverridden: override != nil,
+	})
+}
+
+// checkWIPLimit refuses moving a team task into a status already at its WIP limit
+// Returns the override to record when the limit is reached and overrideWIPLimit is set.
+// The team row stays locked until the transaction ends, so concurrent moves cannot both take the last slot
+func checkWIPLimit(ctx context.Context, task *taskEntity.Task, newStatus taskEntity.TaskStatus, overrideWIPLimit bool) (*teamEntity.WIPLimitOverride, error) {
+	if task.TeamID == nil {
+		return nil, nil
+	}
+
+	limits, err := teamRepo.Persist().LockWIPLimits(ctx, *task.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := limits.Limit(newStatus)
+	if limit == nil {
+		return nil, nil
+	}
+
+	counts, err := taskRepo.Persist().CountByTeamGroupedByStatus(ctx, *task.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	count := counts[newStatus]
+	if count < *limit {
+		return nil, nil
+	}
+
+	if !overrideWIPLimit {
+		return nil, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
+			apperrors.NewValidationError("status", apperrors.CodeWIPLimitReached, map[string]any{"status": newStatus, "limit": *limit, "count": count}),
+		}}
+	}
+
+	return &teamEntity.WIPLimitOverride{
+		TeamID:    *task.TeamID,
+		TaskID:    task.ID,
+		Status:    newStatus,
+		WIPLimit:  *limit,
+		TaskCount: count,
+	}, nil
+}
+
+// taskTeamUUID retrieves the UUID of the team of a task for its event payloads
+// Tasks without a team skip the lookup
+func taskTeamUUID(ctx context.Context, t *taskEntity.Task) (*uuid.UUID, error) {
+	if t.TeamID == nil {
+		return nil, nil
+	}
+
+	return teamRepo.Persist().RetrieveTaskTeamUUID(ctx, t.UUID)
+}
+
+// listLimit applies the configured default and maximum to a requested page size
+func listLimit(limit int) int {
+	if limit <= 0 {
+		return Config.ListDefaultLimit
+	}
+
+	if limit > Config.ListMaxLimit {
+		return Config.ListMaxLimit
+	}
+
+	return limit
 }
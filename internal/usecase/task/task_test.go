@@ -7,12 +7,16 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	taskEntity "taskmanager/internal/entity/task"
+	teamEntity "taskmanager/internal/entity/team"
 	"taskmanager/internal/platform/database"
 	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 	"taskmanager/internal/platform/testing/assert"
 	taskRepo "taskmanager/internal/repository/task"
+	teamRepo "taskmanager/internal/repository/team"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
@@ -58,6 +62,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -79,6 +85,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -100,6 +108,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -121,6 +131,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -142,10 +154,14 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -167,10 +183,14 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -192,6 +212,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -213,6 +235,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -246,6 +270,64 @@ func TestCreate(t *testing.T) {
 			},
 			errors.New("database connection failed"),
 		},
+		{
+			"Create task ranked after the last task",
+			func() {
+				locked := false
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnLockRanks: func(ctx context.Context) error {
+						locked = true
+						return nil
+					},
+					FnLastRank: func(ctx context.Context) (string, error) {
+						if !locked {
+							return "", errors.New("last rank read without the rank lock")
+						}
+						return "00000005", nil
+					},
+					FnCreate: func(ctx context.Context, t *taskEntity.Task) error {
+						if t.Rank != "00000006" {
+							return errors.New("unexpected rank " + t.Rank)
+						}
+						return nil
+					},
+				})
+			},
+			context.Background(),
+			&taskEntity.Task{
+				Title:       "Nova tarefa",
+				Description: "Descrição válida",
+			},
+			nil,
+		},
+		{
+			"Create task with rank lock error",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnLockRanks: func(ctx context.Context) error { return database.ErrContextDatabase },
+				})
+			},
+			context.Background(),
+			&taskEntity.Task{
+				Title:       "Nova tarefa",
+				Description: "Descrição válida",
+			},
+			database.ErrContextDatabase,
+		},
+		{
+			"Create task with last rank error",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnLastRank: func(ctx context.Context) (string, error) { return "", database.ErrContextDatabase },
+				})
+			},
+			context.Background(),
+			&taskEntity.Task{
+				Title:       "Nova tarefa",
+				Description: "Descrição válida",
+			},
+			database.ErrContextDatabase,
+		},
 		{
 			"Create task with title exceeding 255 characters",
 			func() {
@@ -262,7 +344,10 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeMaxLength,
+						Code:    errs.CodeMaxLength,
 						Message: "title must not exceed 255 characters",
+						Params:  map[string]any{"max": 255},
 					},
 				},
 			},
@@ -470,6 +555,7 @@ func TestRetrieveByUUID(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	originalPersist := taskRepo.Persist()
+	estimate := 5
 
 	tests := []struct {
 		name     string
@@ -511,6 +597,37 @@ func TestUpdate(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"Update task with success - estimate",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, taskUUID uuid.UUID) (*taskEntity.Task, error) {
+						return &taskEntity.Task{
+							UUID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+							Title:       "Título original",
+							Description: "Descrição original",
+							Status:      taskEntity.StatusTodo,
+						}, nil
+					},
+					FnUpdate: func(ctx context.Context, taskUUID uuid.UUID, t *taskEntity.Task) error {
+						return nil
+					},
+				})
+			},
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			map[string]any{
+				"estimate": &estimate,
+			},
+			&taskEntity.Task{
+				UUID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				Title:       "Título original",
+				Description: "Descrição original",
+				Status:      taskEntity.StatusTodo,
+				Estimate:    &estimate,
+			},
+			nil,
+		},
 		{
 			"Update task with success - only title",
 			func() {
@@ -629,6 +746,8 @@ func TestUpdate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -661,6 +780,8 @@ func TestUpdate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -694,10 +815,14 @@ func TestUpdate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -730,6 +855,8 @@ func TestUpdate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -762,6 +889,8 @@ func TestUpdate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -878,7 +1007,10 @@ func TestUpdate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeMaxLength,
+						Code:    errs.CodeMaxLength,
 						Message: "title must not exceed 255 characters",
+						Params:  map[string]any{"max": 255},
 					},
 				},
 			},
@@ -968,6 +1100,8 @@ func TestUpdate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "title",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "title is required",
 					},
 				},
@@ -983,7 +1117,7 @@ func TestUpdate(t *testing.T) {
 				tt.setup()
 			}
 
-			got, err := Update(tt.ctx, tt.taskUUID, tt.updates)
+			got, err := Update(tt.ctx, tt.taskUUID, tt.updates, nil)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("Update() error diff: %s", diff)
 				return
@@ -998,18 +1132,26 @@ func TestUpdate(t *testing.T) {
 func TestDelete(t *testing.T) {
 	originalPersist := taskRepo.Persist()
 
+	current, stale := 2, 1
+
+	retrieveTask := func(ctx context.Context, taskUUID uuid.UUID) (*taskEntity.Task, error) {
+		return &taskEntity.Task{UUID: taskUUID, Version: current}, nil
+	}
+
 	tests := []struct {
 		name     string
 		setup    func()
 		ctx      context.Context
 		taskUUID uuid.UUID
+		ifMatch  *int
 		wantErr  error
 	}{
 		{
 			"Delete task with success",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnDelete: func(ctx context.Context, taskUUID uuid.UUID) error {
+					FnRetrieveByUUID: retrieveTask,
+					FnDelete: func(ctx context.Context, taskUUID uuid.UUID, version int) error {
 						return nil
 					},
 				})
@@ -1017,38 +1159,44 @@ func TestDelete(t *testing.T) {
 			context.Background(),
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
 			nil,
+			nil,
 		},
 		{
 			"Delete task with context database error",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnDelete: func(ctx context.Context, taskUUID uuid.UUID) error {
+					FnRetrieveByUUID: retrieveTask,
+					FnDelete: func(ctx context.Context, taskUUID uuid.UUID, version int) error {
 						return database.ErrContextDatabase
 					},
 				})
 			},
 			context.Background(),
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			nil,
 			database.ErrContextDatabase,
 		},
 		{
 			"Delete task with generic persist error",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnDelete: func(ctx context.Context, taskUUID uuid.UUID) error {
+					FnRetrieveByUUID: retrieveTask,
+					FnDelete: func(ctx context.Context, taskUUID uuid.UUID, version int) error {
 						return errors.New("database connection failed")
 					},
 				})
 			},
 			context.Background(),
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			nil,
 			errors.New("database connection failed"),
 		},
 		{
 			"Delete task with cached persist",
 			func() {
 				taskRepo.SetPersist(taskRepo.NewMockCachedPersist(&taskRepo.MockPersistent{
-					FnDelete: func(ctx context.Context, taskUUID uuid.UUID) error {
+					FnRetrieveByUUID: retrieveTask,
+					FnDelete: func(ctx context.Context, taskUUID uuid.UUID, version int) error {
 						return nil
 					},
 				}))
@@ -1056,20 +1204,67 @@ func TestDelete(t *testing.T) {
 			context.Background(),
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
 			nil,
+			nil,
 		},
 		{
 			"Delete task with cached persist error",
 			func() {
 				taskRepo.SetPersist(taskRepo.NewMockCachedPersist(&taskRepo.MockPersistent{
-					FnDelete: func(ctx context.Context, taskUUID uuid.UUID) error {
+					FnRetrieveByUUID: retrieveTask,
+					FnDelete: func(ctx context.Context, taskUUID uuid.UUID, version int) error {
 						return errors.New("database connection failed")
 					},
 				}))
 			},
 			context.Background(),
 			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			nil,
 			errors.New("database connection failed"),
 		},
+		{
+			"Delete task with If-Match of the current version",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnRetrieveByUUID: retrieveTask,
+					FnDelete: func(ctx context.Context, taskUUID uuid.UUID, version int) error {
+						if version != current {
+							return errors.New("deleted at a stale version")
+						}
+						return nil
+					},
+				})
+			},
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			&current,
+			nil,
+		},
+		{
+			"Delete task with a stale If-Match",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnRetrieveByUUID: retrieveTask,
+				})
+			},
+			context.Background(),
+			uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			&stale,
+			&errs.PreconditionFailedError{Message: "task is at version 2, not 1"},
+		},
+		{
+			"Delete task not found",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, taskUUID uuid.UUID) (*taskEntity.Task, error) {
+						return nil, errs.ErrNotFound
+					},
+				})
+			},
+			context.Background(),
+			uuid.MustParse("00000000-0000-0000-0000-000000000000"),
+			nil,
+			errs.ErrNotFound,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1080,7 +1275,7 @@ func TestDelete(t *testing.T) {
 				tt.setup()
 			}
 
-			err := Delete(tt.ctx, tt.taskUUID)
+			err := Delete(tt.ctx, tt.taskUUID, tt.ifMatch)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("Delete() error diff: %s", diff)
 				return
@@ -1112,7 +1307,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated all tasks with success",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  1,
 							Limit: 10,
@@ -1164,7 +1359,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated filtered by status to_do with success",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  1,
 							Limit: 10,
@@ -1204,7 +1399,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated filtered by status in_progress with success",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  1,
 							Limit: 10,
@@ -1244,7 +1439,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated filtered by status done with success",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  1,
 							Limit: 10,
@@ -1284,7 +1479,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated filtered by status canceled with success",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  1,
 							Limit: 10,
@@ -1324,7 +1519,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated with invalid status (validation moved to controller)",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						// Domain no longer validates status, it just passes it through
 						return &taskEntity.ListTasks{
 							Page:       1,
@@ -1351,7 +1546,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated with context database error",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return nil, database.ErrContextDatabase
 					},
 				})
@@ -1367,7 +1562,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated with generic persist error",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return nil, errors.New("database connection failed")
 					},
 				})
@@ -1383,7 +1578,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated with empty result",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:       1,
 							Limit:      10,
@@ -1409,7 +1604,7 @@ func TestListPaginated(t *testing.T) {
 			"ListPaginated with pagination - page 2, limit 2",
 			func() {
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  2,
 							Limit: 2,
@@ -1462,7 +1657,7 @@ func TestListPaginated(t *testing.T) {
 			func() {
 				Config.ListDefaultLimit = 15
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  1,
 							Limit: 15,
@@ -1503,7 +1698,7 @@ func TestListPaginated(t *testing.T) {
 			func() {
 				Config.ListDefaultLimit = 15
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  1,
 							Limit: 15,
@@ -1545,7 +1740,7 @@ func TestListPaginated(t *testing.T) {
 				Config.ListDefaultLimit = 20
 				Config.ListMaxLimit = 50
 				taskRepo.SetPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						return &taskEntity.ListTasks{
 							Page:  1,
 							Limit: 50,
@@ -1588,7 +1783,7 @@ func TestListPaginated(t *testing.T) {
 				Config.ListMaxLimit = 50
 				callCount := 0
 				taskRepo.SetPersist(taskRepo.NewMockCachedPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						callCount++
 						return &taskEntity.ListTasks{
 							Page:       1,
@@ -1599,7 +1794,7 @@ func TestListPaginated(t *testing.T) {
 					},
 				}))
 				// Pre-populate cache (callCount becomes 1, TotalItems: 100)
-				ListPaginated(context.Background(), nil, 1, 10)
+				ListPaginated(context.Background(), nil, taskEntity.SortCreatedAt, 1, 10)
 			},
 			context.Background(),
 			nil,
@@ -1621,7 +1816,7 @@ func TestListPaginated(t *testing.T) {
 				Config.ListMaxLimit = 50
 				callCount := 0
 				taskRepo.SetPersist(taskRepo.NewMockCachedPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						callCount++
 						return &taskEntity.ListTasks{
 							Page:       1,
@@ -1633,7 +1828,7 @@ func TestListPaginated(t *testing.T) {
 					FnCreate: func(ctx context.Context, t *taskEntity.Task) error { return nil },
 				}))
 				// Pre-populate cache (callCount=1, TotalItems=100)
-				ListPaginated(context.Background(), nil, 1, 10)
+				ListPaginated(context.Background(), nil, taskEntity.SortCreatedAt, 1, 10)
 				// Create invalidates cache
 				Create(context.Background(), &taskEntity.Task{Title: "Nova tarefa", Description: "Descrição"})
 			},
@@ -1657,7 +1852,7 @@ func TestListPaginated(t *testing.T) {
 				Config.ListMaxLimit = 50
 				callCount := 0
 				taskRepo.SetPersist(taskRepo.NewMockCachedPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						callCount++
 						return &taskEntity.ListTasks{
 							Page:       1,
@@ -1666,10 +1861,13 @@ func TestListPaginated(t *testing.T) {
 							TotalItems: callCount * 100,
 						}, nil
 					},
-					FnDelete: func(ctx context.Context, taskUUID uuid.UUID) error { return nil },
+					FnRetrieveByUUID: func(ctx context.Context, taskUUID uuid.UUID) (*taskEntity.Task, error) {
+						return &taskEntity.Task{UUID: taskUUID}, nil
+					},
+					FnDelete: func(ctx context.Context, taskUUID uuid.UUID, version int) error { return nil },
 				}))
-				ListPaginated(context.Background(), nil, 1, 10)
-				Delete(context.Background(), uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"))
+				ListPaginated(context.Background(), nil, taskEntity.SortCreatedAt, 1, 10)
+				Delete(context.Background(), uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), nil)
 			},
 			context.Background(),
 			nil,
@@ -1690,7 +1888,7 @@ func TestListPaginated(t *testing.T) {
 				Config.ListMaxLimit = 50
 				callCount := 0
 				taskRepo.SetPersist(taskRepo.NewMockCachedPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						callCount++
 						return &taskEntity.ListTasks{
 							Page:       1,
@@ -1707,12 +1905,12 @@ func TestListPaginated(t *testing.T) {
 							Status:      taskEntity.StatusTodo,
 						}, nil
 					},
-					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 						return nil
 					},
 				}))
-				ListPaginated(context.Background(), nil, 1, 10)
-				UpdateStatus(context.Background(), uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), taskEntity.StatusInProgress)
+				ListPaginated(context.Background(), nil, taskEntity.SortCreatedAt, 1, 10)
+				UpdateStatus(context.Background(), uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), taskEntity.StatusInProgress, false, nil)
 			},
 			context.Background(),
 			nil,
@@ -1733,7 +1931,7 @@ func TestListPaginated(t *testing.T) {
 				Config.ListMaxLimit = 50
 				callCount := 0
 				taskRepo.SetPersist(taskRepo.NewMockCachedPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						callCount++
 						return &taskEntity.ListTasks{
 							Page:       1,
@@ -1744,7 +1942,7 @@ func TestListPaginated(t *testing.T) {
 					},
 				}))
 				// Pre-populate cache with limit=0 (normalized to default 10)
-				ListPaginated(context.Background(), nil, 1, 0)
+				ListPaginated(context.Background(), nil, taskEntity.SortCreatedAt, 1, 0)
 			},
 			context.Background(),
 			nil,
@@ -1766,7 +1964,7 @@ func TestListPaginated(t *testing.T) {
 				Config.ListMaxLimit = 50
 				callCount := 0
 				taskRepo.SetPersist(taskRepo.NewMockCachedPersist(&taskRepo.MockPersistent{
-					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, page, limit int) (*taskEntity.ListTasks, error) {
+					FnListPaginated: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, page, limit int) (*taskEntity.ListTasks, error) {
 						callCount++
 						if callCount == 1 {
 							return nil, errors.New("database connection failed")
@@ -1780,7 +1978,7 @@ func TestListPaginated(t *testing.T) {
 					},
 				}))
 				// First call fails (error not cached)
-				ListPaginated(context.Background(), nil, 1, 10)
+				ListPaginated(context.Background(), nil, taskEntity.SortCreatedAt, 1, 10)
 			},
 			context.Background(),
 			nil,
@@ -1806,7 +2004,7 @@ func TestListPaginated(t *testing.T) {
 				tt.setup()
 			}
 
-			got, err := ListPaginated(tt.ctx, tt.statusFilter, tt.page, tt.limit)
+			got, err := ListPaginated(tt.ctx, tt.statusFilter, taskEntity.SortCreatedAt, tt.page, tt.limit)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("ListPaginated() error diff: %s", diff)
 				return
@@ -1841,7 +2039,7 @@ func TestUpdateStatus(t *testing.T) {
 							Status:      taskEntity.StatusTodo,
 						}, nil
 					},
-					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 						return nil
 					},
 				})
@@ -1863,7 +2061,7 @@ func TestUpdateStatus(t *testing.T) {
 							Status:      taskEntity.StatusTodo,
 						}, nil
 					},
-					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 						return nil
 					},
 				})
@@ -1885,7 +2083,7 @@ func TestUpdateStatus(t *testing.T) {
 							Status:      taskEntity.StatusInProgress,
 						}, nil
 					},
-					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 						return nil
 					},
 				})
@@ -1907,7 +2105,7 @@ func TestUpdateStatus(t *testing.T) {
 							Status:      taskEntity.StatusInProgress,
 						}, nil
 					},
-					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 						return nil
 					},
 				})
@@ -1938,6 +2136,8 @@ func TestUpdateStatus(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "status",
+						Type:    errs.TypeState,
+						Code:    errs.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -1964,6 +2164,8 @@ func TestUpdateStatus(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "status",
+						Type:    errs.TypeState,
+						Code:    errs.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -1990,6 +2192,8 @@ func TestUpdateStatus(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "status",
+						Type:    errs.TypeState,
+						Code:    errs.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -2016,6 +2220,8 @@ func TestUpdateStatus(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "status",
+						Type:    errs.TypeState,
+						Code:    errs.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -2042,6 +2248,8 @@ func TestUpdateStatus(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "status",
+						Type:    errs.TypeInvalid,
+						Code:    errs.CodeInvalidValue,
 						Message: "invalid status value",
 					},
 				},
@@ -2087,7 +2295,7 @@ func TestUpdateStatus(t *testing.T) {
 							Status:      taskEntity.StatusTodo,
 						}, nil
 					},
-					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 						return database.ErrContextDatabase
 					},
 				})
@@ -2109,7 +2317,7 @@ func TestUpdateStatus(t *testing.T) {
 							Status:      taskEntity.StatusTodo,
 						}, nil
 					},
-					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 						return errors.New("database connection failed")
 					},
 				})
@@ -2131,7 +2339,7 @@ func TestUpdateStatus(t *testing.T) {
 							Status:      taskEntity.StatusTodo,
 						}, nil
 					},
-					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, updates map[string]any) error {
+					FnUpdateStatus: func(ctx context.Context, taskUUID uuid.UUID, version int, updates map[string]any) error {
 						return nil
 					},
 				}))
@@ -2162,6 +2370,8 @@ func TestUpdateStatus(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "status",
+						Type:    errs.TypeState,
+						Code:    errs.CodeInvalidTransition,
 						Message: "invalid status transition",
 					},
 				},
@@ -2177,11 +2387,686 @@ func TestUpdateStatus(t *testing.T) {
 				tt.setup()
 			}
 
-			err := UpdateStatus(tt.ctx, tt.taskUUID, tt.newStatus)
+			err := UpdateStatus(tt.ctx, tt.taskUUID, tt.newStatus, false, nil)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("UpdateStatus() error diff: %s", diff)
+				return
+			}
+		})
+	}
+}
+
+func TestUpdateStatus_WIPLimit(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+	originalTeamPersist := teamRepo.Persist()
+
+	teamID := uint(1)
+	taskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	// mockTeamTask persists a to_do task of team 1 with taskCount tasks already in progress
+	mockTeamTask := func(taskCount int) *taskRepo.MockPersistent {
+		return &taskRepo.MockPersistent{
+			FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*taskEntity.Task, error) {
+				task := &taskEntity.Task{UUID: id, Status: taskEntity.StatusTodo, TeamID: &teamID}
+				task.ID = 7
+				return task, nil
+			},
+			FnCountByTeamGroupedByStatus: func(ctx context.Context, id uint) (map[taskEntity.TaskStatus]int, error) {
+				return map[taskEntity.TaskStatus]int{taskEntity.StatusInProgress: taskCount}, nil
+			},
+			FnUpdateStatus: func(ctx context.Context, id uuid.UUID, version int, updates map[string]any) error {
+				return nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		limits           teamEntity.WIPLimits
+		taskCount        int
+		overrideWIPLimit bool
+		wantOverride     *teamEntity.WIPLimitOverride
+		wantErr          error
+	}{
+		{
+			"UpdateStatus without limit on the status",
+			teamEntity.WIPLimits{taskEntity.StatusDone: 1},
+			5,
+			false,
+			nil,
+			nil,
+		},
+		{
+			"UpdateStatus below the limit",
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			2,
+			false,
+			nil,
+			nil,
+		},
+		{
+			"UpdateStatus with the limit reached",
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			3,
+			false,
+			nil,
+			&errs.ValidationErrors{
+				Errors: []errs.ValidationError{
+					{
+						Field:   "status",
+						Type:    errs.TypeConflict,
+						Code:    errs.CodeWIPLimitReached,
+						Message: "status in_progress has reached its wip limit of 3 tasks",
+						Params:  map[string]any{"status": taskEntity.StatusInProgress, "limit": 3, "count": 3},
+					},
+				},
+			},
+		},
+		{
+			"UpdateStatus with the limit reached and overridden",
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			3,
+			true,
+			&teamEntity.WIPLimitOverride{TeamID: 1, TaskID: 7, Status: taskEntity.StatusInProgress, WIPLimit: 3, TaskCount: 3},
+			nil,
+		},
+		{
+			"UpdateStatus below the limit does not record the override flag",
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			1,
+			true,
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				taskRepo.SetPersist(originalPersist)
+				teamRepo.SetPersist(originalTeamPersist)
+			}()
+
+			var gotOverride *teamEntity.WIPLimitOverride
+			taskRepo.SetPersist(mockTeamTask(tt.taskCount))
+			teamRepo.SetPersist(&teamRepo.MockPersistent{
+				FnLockWIPLimits: func(ctx context.Context, id uint) (teamEntity.WIPLimits, error) {
+					return tt.limits, nil
+				},
+				FnCreateWIPLimitOverride: func(ctx context.Context, o *teamEntity.WIPLimitOverride) error {
+					gotOverride = o
+					return nil
+				},
+				FnRetrieveTaskTeamUUID: func(ctx context.Context, id uuid.UUID) (*uuid.UUID, error) {
+					return nil, nil
+				},
+			})
+
+			err := UpdateStatus(context.Background(), taskUUID, taskEntity.StatusInProgress, tt.overrideWIPLimit, nil)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("UpdateStatus() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(gotOverride, tt.wantOverride); diff != "" {
+				t.Errorf("UpdateStatus() override diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate_IfMatch(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+
+	taskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	current, stale := 3, 2
+
+	tests := []struct {
+		name        string
+		ifMatch     *int
+		wantVersion int
+		wantErr     error
+	}{
+		{"Update task with If-Match of the current version", &current, 3, nil},
+		{"Update task without If-Match", nil, 3, nil},
+		{"Update task with a stale If-Match", &stale, 0, &errs.PreconditionFailedError{Message: "task is at version 3, not 2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer taskRepo.SetPersist(originalPersist)
+
+			var gotVersion int
+			taskRepo.SetPersist(&taskRepo.MockPersistent{
+				FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*taskEntity.Task, error) {
+					return &taskEntity.Task{UUID: id, Title: "Título", Description: "Descrição", Version: current}, nil
+				},
+				FnUpdate: func(ctx context.Context, id uuid.UUID, task *taskEntity.Task) error {
+					gotVersion = task.Version
+					return nil
+				},
+			})
+
+			_, err := Update(context.Background(), taskUUID, map[string]any{"title": "Título atualizado"}, tt.ifMatch)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Update() error diff: %s", diff)
+				return
+			}
+			if gotVersion != tt.wantVersion {
+				t.Errorf("Update() written at version %d, want %d", gotVersion, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestPatch(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+
+	taskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	estimate := 5
+	applyErr := &errs.BadRequestError{Message: "invalid JSON syntax"}
+
+	tests := []struct {
+		name    string
+		apply   func(*taskEntity.Task) error
+		want    *taskEntity.Task
+		wantErr error
+	}{
+		{
+			"Patch task with success",
+			func(task *taskEntity.Task) error {
+				task.Title = "  Título atualizado  "
+				task.Estimate = nil
+				return nil
+			},
+			&taskEntity.Task{UUID: taskUUID, Title: "Título atualizado", Description: "Descrição", Version: 2},
+			nil,
+		},
+		{
+			"Patch task that fails validation",
+			func(task *taskEntity.Task) error {
+				task.Description = ""
+				return nil
+			},
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "description", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "description is required"},
+			}},
+		},
+		{
+			"Patch task with an apply error",
+			func(task *taskEntity.Task) error {
+				return applyErr
+			},
+			nil,
+			applyErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer taskRepo.SetPersist(originalPersist)
+
+			taskRepo.SetPersist(&taskRepo.MockPersistent{
+				FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*taskEntity.Task, error) {
+					return &taskEntity.Task{UUID: id, Title: "Título", Description: "Descrição", Estimate: &estimate, Version: 1}, nil
+				},
+				FnUpdate: func(ctx context.Context, id uuid.UUID, task *taskEntity.Task) error {
+					task.Version++
+					return nil
+				},
+			})
+
+			got, err := Patch(context.Background(), taskUUID, tt.apply, nil)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Patch() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Patch() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateStatus_IfMatch(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+
+	taskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	current, stale := 3, 2
+
+	tests := []struct {
+		name        string
+		ifMatch     *int
+		wantVersion int
+		wantErr     error
+	}{
+		{"UpdateStatus with If-Match of the current version", &current, 3, nil},
+		{"UpdateStatus without If-Match", nil, 3, nil},
+		{"UpdateStatus with a stale If-Match", &stale, 0, &errs.PreconditionFailedError{Message: "task is at version 3, not 2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer taskRepo.SetPersist(originalPersist)
+
+			var gotVersion int
+			taskRepo.SetPersist(&taskRepo.MockPersistent{
+				FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*taskEntity.Task, error) {
+					return &taskEntity.Task{UUID: id, Status: taskEntity.StatusTodo, Version: current}, nil
+				},
+				FnUpdateStatus: func(ctx context.Context, id uuid.UUID, version int, updates map[string]any) error {
+					gotVersion = version
+					return nil
+				},
+			})
+
+			err := UpdateStatus(context.Background(), taskUUID, taskEntity.StatusInProgress, false, tt.ifMatch)
 			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
 				t.Errorf("UpdateStatus() error diff: %s", diff)
 				return
 			}
+			if gotVersion != tt.wantVersion {
+				t.Errorf("UpdateStatus() written at version %d, want %d", gotVersion, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		term    string
+		page    int
+		limit   int
+		want    *taskEntity.ListSearchResults
+		wantErr error
+	}{
+		{
+			"Search with success",
+			func() {
+				Config.ListDefaultLimit = 20
+				Config.ListMaxLimit = 50
+				Config.SearchLanguage = "portuguese"
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnSearch: func(ctx context.Context, term, language string, page, limit int) (*taskEntity.ListSearchResults, error) {
+						if term != "autenticação" || language != "portuguese" {
+							return nil, errors.New("unexpected search arguments")
+						}
+						return &taskEntity.ListSearchResults{
+							Page:  page,
+							Limit: limit,
+							Results: []taskEntity.SearchResult{
+								{
+									Task: taskEntity.Task{
+										UUID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+										Title:       "Implementar autenticação",
+										Description: "Criar sistema de autenticação JWT para a API",
+										Status:      taskEntity.StatusTodo,
+									},
+									Rank:                 0.5,
+									TitleHighlight:       "Implementar <mark>autenticação</mark>",
+									DescriptionHighlight: "Criar sistema de <mark>autenticação</mark> JWT para a API",
+								},
+							},
+							TotalItems: 1,
+						}, nil
+					},
+				})
+			},
+			context.Background(),
+			"  autenticação  ",
+			1,
+			10,
+			&taskEntity.ListSearchResults{
+				Page:  1,
+				Limit: 10,
+				Results: []taskEntity.SearchResult{
+					{
+						Task: taskEntity.Task{
+							UUID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+							Title:       "Implementar autenticação",
+							Description: "Criar sistema de autenticação JWT para a API",
+							Status:      taskEntity.StatusTodo,
+						},
+						Rank:                 0.5,
+						TitleHighlight:       "Implementar <mark>autenticação</mark>",
+						DescriptionHighlight: "Criar sistema de <mark>autenticação</mark> JWT para a API",
+					},
+				},
+				TotalItems: 1,
+			},
+			nil,
+		},
+		{
+			"Search with limit 0 uses default limit",
+			func() {
+				Config.ListDefaultLimit = 15
+				Config.ListMaxLimit = 50
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnSearch: func(ctx context.Context, term, language string, page, limit int) (*taskEntity.ListSearchResults, error) {
+						return &taskEntity.ListSearchResults{Page: page, Limit: limit, Results: []taskEntity.SearchResult{}}, nil
+					},
+				})
+			},
+			context.Background(),
+			"deploy",
+			1,
+			0,
+			&taskEntity.ListSearchResults{Page: 1, Limit: 15, Results: []taskEntity.SearchResult{}},
+			nil,
+		},
+		{
+			"Search with limit above max uses max limit",
+			func() {
+				Config.ListDefaultLimit = 20
+				Config.ListMaxLimit = 50
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnSearch: func(ctx context.Context, term, language string, page, limit int) (*taskEntity.ListSearchResults, error) {
+						return &taskEntity.ListSearchResults{Page: page, Limit: limit, Results: []taskEntity.SearchResult{}}, nil
+					},
+				})
+			},
+			context.Background(),
+			"deploy",
+			2,
+			100,
+			&taskEntity.ListSearchResults{Page: 2, Limit: 50, Results: []taskEntity.SearchResult{}},
+			nil,
+		},
+		{
+			"Search with repository error",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnSearch: func(ctx context.Context, term, language string, page, limit int) (*taskEntity.ListSearchResults, error) {
+						return nil, errors.New("database connection failed")
+					},
+				})
+			},
+			context.Background(),
+			"deploy",
+			1,
+			10,
+			nil,
+			errors.New("database connection failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				taskRepo.SetPersist(originalPersist)
+			}()
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := Search(tt.ctx, tt.term, tt.page, tt.limit)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Search() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Search() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckSearchLanguage(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+
+	tests := []struct {
+		name        string
+		configured  string
+		language    string
+		languageErr error
+		wantErr     error
+	}{
+		{"Check a matching language", "portuguese", "portuguese", nil, nil},
+		{
+			"Check a language other than the column's",
+			"english", "portuguese", nil,
+			errors.New(`search language "english" does not match "portuguese" of the search_vector column`),
+		},
+		{"Check with repository error", "portuguese", "", errors.New("database connection failed"), errors.New("database connection failed")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				taskRepo.SetPersist(originalPersist)
+			}()
+
+			Config.SearchLanguage = tt.configured
+			taskRepo.SetPersist(&taskRepo.MockPersistent{
+				FnSearchLanguage: func(ctx context.Context) (string, error) {
+					return tt.language, tt.languageErr
+				},
+			})
+
+			err := CheckSearchLanguage(context.Background())
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("CheckSearchLanguage() error diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListByCursor(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+	statusDone := taskEntity.StatusDone
+	cursor := pagination.NewCursor(time.Date(2025, 12, 1, 18, 21, 6, 0, time.UTC), 5, pagination.DirectionNext)
+
+	tests := []struct {
+		name         string
+		setup        func()
+		ctx          context.Context
+		statusFilter *taskEntity.TaskStatus
+		cursor       *pagination.Cursor
+		limit        int
+		withTotal    bool
+		want         *taskEntity.ListTasks
+		wantErr      error
+	}{
+		{
+			"List by cursor with success",
+			func() {
+				Config.ListDefaultLimit = 20
+				Config.ListMaxLimit = 50
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnListByCursor: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, c *pagination.Cursor, limit int, withTotal bool) (*taskEntity.ListTasks, error) {
+						if statusFilter == nil || *statusFilter != taskEntity.StatusDone || c != cursor || !withTotal {
+							return nil, errors.New("unexpected list arguments")
+						}
+						return &taskEntity.ListTasks{
+							Tasks: []taskEntity.Task{
+								{
+									UUID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+									Title:  "Configurar CI/CD",
+									Status: taskEntity.StatusDone,
+								},
+							},
+							TotalItems: 3,
+							Limit:      limit,
+							NextCursor: pagination.NewCursor(time.Date(2025, 11, 24, 18, 21, 6, 0, time.UTC), 7, pagination.DirectionNext),
+						}, nil
+					},
+				})
+			},
+			context.Background(),
+			&statusDone,
+			cursor,
+			10,
+			true,
+			&taskEntity.ListTasks{
+				Tasks: []taskEntity.Task{
+					{
+						UUID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+						Title:  "Configurar CI/CD",
+						Status: taskEntity.StatusDone,
+					},
+				},
+				TotalItems: 3,
+				Limit:      10,
+				NextCursor: pagination.NewCursor(time.Date(2025, 11, 24, 18, 21, 6, 0, time.UTC), 7, pagination.DirectionNext),
+			},
+			nil,
+		},
+		{
+			"List by cursor with limit 0 uses default limit",
+			func() {
+				Config.ListDefaultLimit = 15
+				Config.ListMaxLimit = 50
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnListByCursor: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, c *pagination.Cursor, limit int, withTotal bool) (*taskEntity.ListTasks, error) {
+						return &taskEntity.ListTasks{Tasks: []taskEntity.Task{}, Limit: limit}, nil
+					},
+				})
+			},
+			context.Background(),
+			nil,
+			nil,
+			0,
+			false,
+			&taskEntity.ListTasks{Tasks: []taskEntity.Task{}, Limit: 15},
+			nil,
+		},
+		{
+			"List by cursor with limit above max uses max limit",
+			func() {
+				Config.ListDefaultLimit = 20
+				Config.ListMaxLimit = 50
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnListByCursor: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, c *pagination.Cursor, limit int, withTotal bool) (*taskEntity.ListTasks, error) {
+						return &taskEntity.ListTasks{Tasks: []taskEntity.Task{}, Limit: limit}, nil
+					},
+				})
+			},
+			context.Background(),
+			nil,
+			nil,
+			100,
+			false,
+			&taskEntity.ListTasks{Tasks: []taskEntity.Task{}, Limit: 50},
+			nil,
+		},
+		{
+			"List by cursor with repository error",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnListByCursor: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, c *pagination.Cursor, limit int, withTotal bool) (*taskEntity.ListTasks, error) {
+						return nil, errors.New("database connection failed")
+					},
+				})
+			},
+			context.Background(),
+			nil,
+			nil,
+			10,
+			true,
+			nil,
+			errors.New("database connection failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				taskRepo.SetPersist(originalPersist)
+			}()
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := ListByCursor(tt.ctx, tt.statusFilter, taskEntity.SortCreatedAt, tt.cursor, tt.limit, tt.withTotal)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("ListByCursor() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("ListByCursor() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	originalPersist := taskRepo.Persist()
+
+	statusDone := taskEntity.StatusDone
+	items := []taskEntity.ExportItem{
+		{
+			Task: taskEntity.Task{
+				UUID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+				Title:  "Configurar CI/CD",
+				Status: taskEntity.StatusDone,
+			},
+			TeamName: "Time de DevOps",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		ctx          context.Context
+		statusFilter *taskEntity.TaskStatus
+		want         []taskEntity.ExportItem
+		wantErr      error
+	}{
+		{
+			"Export with success",
+			func() {
+				Config.ExportBatchSize = 250
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnExportInBatches: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, batchSize int, fn func([]taskEntity.ExportItem) error) error {
+						if statusFilter == nil || *statusFilter != taskEntity.StatusDone || sort != taskEntity.SortRank || batchSize != 250 {
+							return errors.New("unexpected export arguments")
+						}
+						return fn(items)
+					},
+				})
+			},
+			context.Background(),
+			&statusDone,
+			items,
+			nil,
+		},
+		{
+			"Export with repository error",
+			func() {
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnExportInBatches: func(ctx context.Context, statusFilter *taskEntity.TaskStatus, sort taskEntity.ListSort, batchSize int, fn func([]taskEntity.ExportItem) error) error {
+						return errors.New("database connection failed")
+					},
+				})
+			},
+			context.Background(),
+			nil,
+			nil,
+			errors.New("database connection failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				taskRepo.SetPersist(originalPersist)
+			}()
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			var got []taskEntity.ExportItem
+			err := Export(tt.ctx, tt.statusFilter, taskEntity.SortRank, func(batch []taskEntity.ExportItem) error {
+				got = append(got, batch...)
+				return nil
+			})
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Export() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Export() diff: %s", diff)
+			}
 		})
 	}
 }
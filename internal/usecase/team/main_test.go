@@ -3,13 +3,16 @@
 package team
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
+	outboxEntity "taskmanager/internal/entity/outbox"
 	"taskmanager/internal/paths"
 	"taskmanager/internal/platform/database"
 	"taskmanager/internal/platform/testing/dbtest"
+	outboxRepo "taskmanager/internal/repository/outbox"
 	"taskmanager/internal/testing/configtest"
 )
 
@@ -26,6 +29,13 @@ func TestMain(m *testing.M) {
 			log.Fatalf("Error on load config on struct. Err: %s", err)
 		}
 
+		// Events are recorded in the outbox, which these tests do not persist
+		outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+			FnCreate: func(ctx context.Context, m *outboxEntity.Message) error {
+				return nil
+			},
+		})
+
 		return m.Run()
 	}(m))
 }
@@ -2,15 +2,21 @@ package team
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"strings"
 
 	"github.com/google/uuid"
 
+	taskEntity "taskmanager/internal/entity/task"
 	teamEntity "taskmanager/internal/entity/team"
+	webhookEntity "taskmanager/internal/entity/webhook"
 	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 	taskRepo "taskmanager/internal/repository/task"
 	teamRepo "taskmanager/internal/repository/team"
+	webhookUsecase "taskmanager/internal/usecase/webhook"
 )
 
 // Create creates a new team with business rules
@@ -42,37 +48,199 @@ func RetrieveByUUIDWithTasks(ctx context.Context, teamUUID uuid.UUID) (*teamEnti
 	return t, nil
 }
 
-// ListPaginated lists teams with pagination
-func ListPaginated(ctx context.Context, page, limit int) (*teamEntity.ListTeams, error) {
-	if limit <= 0 {
-		limit = Config.ListDefaultLimit
+// RetrieveCalendar retrieves a team with its tasks for the calendar feed
+// Returns ErrNotFound when the token does not match, so feeds cannot be probed
+func RetrieveCalendar(ctx context.Context, teamUUID uuid.UUID, token string) (*teamEntity.Team, error) {
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return nil, err
 	}
 
-	if limit > Config.ListMaxLimit {
-		limit = Config.ListMaxLimit
+	if !t.CalendarTokenMatches(token) {
+		return nil, apperrors.ErrNotFound
+	}
+
+	tasks, err := taskRepo.Persist().ListByTeamID(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Tasks = tasks
+
+	return t, nil
+}
+
+// RotateCalendarToken generates a new calendar feed token for a team, revoking the previous one
+// Only the token hash is stored, so the returned token cannot be retrieved again
+func RotateCalendarToken(ctx context.Context, teamUUID uuid.UUID) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
 	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
 
-	return teamRepo.Persist().ListPaginated(ctx, page, limit)
+	if err := teamRepo.Persist().UpdateCalendarTokenHash(ctx, teamUUID, teamEntity.HashCalendarToken(token)); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// RetrieveBoard retrieves the kanban board of a team: one column per status, each with a page of its tasks
+// When status is set only its column is returned, which is how a column is paged with the cursor
+func RetrieveBoard(ctx context.Context, teamUUID uuid.UUID, status *taskEntity.TaskStatus, sort taskEntity.ListSort, cursor *pagination.Cursor, limit int) (*teamEntity.Board, error) {
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := taskRepo.Persist().CountByTeamGroupedByStatus(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := taskEntity.Statuses
+	if status != nil {
+		statuses = []taskEntity.TaskStatus{*status}
+	}
+
+	board := &teamEntity.Board{Team: *t, Columns: make([]teamEntity.BoardColumn, 0, len(statuses))}
+	for _, s := range statuses {
+		tasks, err := taskRepo.Persist().ListByTeamAndStatus(ctx, t.ID, s, sort, cursor, listLimit(limit))
+		if err != nil {
+			return nil, err
+		}
+
+		board.Columns = append(board.Columns, teamEntity.BoardColumn{
+			Status:     s,
+			Count:      counts[s],
+			WIPLimit:   t.WIPLimits.Limit(s),
+			Tasks:      tasks.Tasks,
+			Limit:      tasks.Limit,
+			NextCursor: tasks.NextCursor,
+			PrevCursor: tasks.PrevCursor,
+		})
+	}
+
+	return board, nil
+}
+
+// UpdateWIPLimits replaces the WIP limits of a team; statuses left out have no limit
+// Tasks already above a new limit stay where they are, only further moves into the status are refused.
+// ifMatch is the version the client expects the team to be at, nil for any
+func UpdateWIPLimits(ctx context.Context, teamUUID uuid.UUID, limits teamEntity.WIPLimits, ifMatch *int) (teamEntity.WIPLimits, error) {
+	if err := limits.Validate(); err != nil {
+		return nil, err
+	}
+
+	if limits == nil {
+		limits = teamEntity.WIPLimits{}
+	}
+
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.CheckVersion(ifMatch); err != nil {
+		return nil, err
+	}
+
+	if err := teamRepo.Persist().UpdateWIPLimits(ctx, teamUUID, t.Version, limits); err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+// Patch changes the name, description and WIP limits of an existing team with apply, then validates
+// and saves it
+// apply runs on the team read in the transaction, so a JSON Patch test sees the stored values
+// ifMatch is the version the client expects the team to be at, nil for any
+func Patch(ctx context.Context, teamUUID uuid.UUID, apply func(*teamEntity.Team) error, ifMatch *int) (*teamEntity.Team, error) {
+	t, err := teamRepo.Persist().RetrieveByUUID(ctx, teamUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.CheckVersion(ifMatch); err != nil {
+		return nil, err
+	}
+
+	if err := apply(t); err != nil {
+		return nil, err
+	}
+
+	errs := t.Validate()
+	if limitErrs := t.WIPLimits.Validate(); limitErrs != nil {
+		if errs == nil {
+			errs = limitErrs
+		} else {
+			errs.Errors = append(errs.Errors, limitErrs.Errors...)
+		}
+	}
+	if errs != nil {
+		return nil, errs
+	}
+
+	t.Name = strings.TrimSpace(t.Name)
+	t.Description = strings.TrimSpace(t.Description)
+	if t.WIPLimits == nil {
+		t.WIPLimits = teamEntity.WIPLimits{}
+	}
+
+	if err := teamRepo.Persist().Update(ctx, teamUUID, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// ListPaginated lists teams with pagination
+func ListPaginated(ctx context.Context, page, limit int) (*teamEntity.ListTeams, error) {
+	return teamRepo.Persist().ListPaginated(ctx, page, listLimit(limit))
+}
+
+// ListByCursor lists teams with keyset (cursor) pagination
+// The total count is skipped when withTotal is false
+func ListByCursor(ctx context.Context, cursor *pagination.Cursor, limit int, withTotal bool) (*teamEntity.ListTeams, error) {
+	return teamRepo.Persist().ListByCursor(ctx, cursor, listLimit(limit), withTotal)
 }
 
 // AssociateTask associates a task with a team
+// Publishes team.task_associated once the transaction commits
 func AssociateTask(ctx context.Context, teamUUID, taskUUID uuid.UUID) error {
 	team, err := validateAssociateTask(ctx, teamUUID, taskUUID)
 	if err != nil {
 		return err
 	}
 
-	return teamRepo.Persist().UpdateTaskTeamID(ctx, taskUUID, &team.ID)
+	if err := teamRepo.Persist().UpdateTaskTeamID(ctx, taskUUID, &team.ID); err != nil {
+		return err
+	}
+
+	return webhookUsecase.Publish(ctx, webhookEntity.EventTeamTaskAssociated, webhookEntity.TeamTaskData{
+		TeamUUID: teamUUID,
+		TaskUUID: taskUUID,
+	})
 }
 
 // DisassociateTask disassociates a task from a team
+// Publishes team.task_disassociated once the transaction commits
 func DisassociateTask(ctx context.Context, teamUUID, taskUUID uuid.UUID) error {
 	err := validateDisassociateTask(ctx, teamUUID, taskUUID)
 	if err != nil {
 		return err
 	}
 
-	return teamRepo.Persist().UpdateTaskTeamID(ctx, taskUUID, nil)
+	if err := teamRepo.Persist().UpdateTaskTeamID(ctx, taskUUID, nil); err != nil {
+		return err
+	}
+
+	return webhookUsecase.Publish(ctx, webhookEntity.EventTeamTaskDisassociated, webhookEntity.TeamTaskData{
+		TeamUUID: teamUUID,
+		TaskUUID: taskUUID,
+	})
 }
 
 // validateAssociateTask validates team and task exist and that task is not already associated with another team
@@ -84,7 +252,7 @@ func validateAssociateTask(ctx context.Context, teamUUID, taskUUID uuid.UUID) (*
 
 	if taskTeamID != nil && *taskTeamID != team.ID {
 		return nil, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
-			{Field: "task", Message: "task is already associated with another team"},
+			apperrors.NewValidationError("task", apperrors.CodeTaskInAnotherTeam, nil),
 		}}
 	}
 
@@ -100,7 +268,7 @@ func validateDisassociateTask(ctx context.Context, teamUUID, taskUUID uuid.UUID)
 
 	if taskTeamID == nil || *taskTeamID != team.ID {
 		return &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
-			{Field: "task", Message: "task is not associated with this team"},
+			apperrors.NewValidationError("task", apperrors.CodeTaskNotInTeam, nil),
 		}}
 	}
 
@@ -113,7 +281,7 @@ func validateTeamAndTask(ctx context.Context, teamUUID, taskUUID uuid.UUID) (*te
 	if err != nil {
 		if errors.Is(err, apperrors.ErrNotFound) {
 			return nil, nil, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
-				{Field: "team", Message: "team not found"},
+				apperrors.NewValidationError("team", apperrors.CodeTeamNotFound, nil),
 			}}
 		}
 		return nil, nil, err
@@ -123,7 +291,7 @@ func validateTeamAndTask(ctx context.Context, teamUUID, taskUUID uuid.UUID) (*te
 	if err != nil {
 		if errors.Is(err, apperrors.ErrNotFound) {
 			return nil, nil, &apperrors.ValidationErrors{Errors: []apperrors.ValidationError{
-				{Field: "task", Message: "task not found"},
+				apperrors.NewValidationError("task", apperrors.CodeTaskNotFound, nil),
 			}}
 		}
 		return nil, nil, err
@@ -131,3 +299,16 @@ func validateTeamAndTask(ctx context.Context, teamUUID, taskUUID uuid.UUID) (*te
 
 	return team, taskTeamID, nil
 }
+
+// listLimit applies the configured default and maximum to a requested page size
+func listLimit(limit int) int {
+	if limit <= 0 {
+		return Config.ListDefaultLimit
+	}
+
+	if limit > Config.ListMaxLimit {
+		return Config.ListMaxLimit
+	}
+
+	return limit
+}
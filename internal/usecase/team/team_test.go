@@ -7,11 +7,13 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	taskEntity "taskmanager/internal/entity/task"
 	teamEntity "taskmanager/internal/entity/team"
 	"taskmanager/internal/platform/database"
 	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/pagination"
 	"taskmanager/internal/platform/testing/assert"
 	taskRepo "taskmanager/internal/repository/task"
 	teamRepo "taskmanager/internal/repository/team"
@@ -61,6 +63,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "name",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "name is required",
 					},
 				},
@@ -82,6 +86,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -103,6 +109,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "name",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "name is required",
 					},
 				},
@@ -124,6 +132,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -145,10 +155,14 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "name",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "name is required",
 					},
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -170,10 +184,14 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "name",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "name is required",
 					},
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -195,6 +213,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "name",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "name is required",
 					},
 				},
@@ -216,6 +236,8 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "description",
+						Type:    errs.TypeRequired,
+						Code:    errs.CodeRequired,
 						Message: "description is required",
 					},
 				},
@@ -265,7 +287,10 @@ func TestCreate(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "name",
+						Type:    errs.TypeMaxLength,
+						Code:    errs.CodeMaxLength,
 						Message: "name must not exceed 255 characters",
+						Params:  map[string]any{"max": 255},
 					},
 				},
 			},
@@ -499,6 +524,535 @@ func TestRetrieveByUUIDWithTasks(t *testing.T) {
 	}
 }
 
+func TestRetrieveCalendar(t *testing.T) {
+	originalPersist := teamRepo.Persist()
+	originalTaskPersist := taskRepo.Persist()
+
+	teamUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	teamID := uint(1)
+	calendarTeam := func() *teamEntity.Team {
+		return &teamEntity.Team{
+			UUID:              teamUUID,
+			Name:              "Time de Desenvolvimento",
+			Description:       "Time responsável pelo desenvolvimento",
+			Model:             gorm.Model{ID: teamID},
+			CalendarTokenHash: teamEntity.HashCalendarToken("secret"),
+		}
+	}
+	tasks := []taskEntity.Task{
+		{
+			UUID:        uuid.MustParse("223e4567-e89b-12d3-a456-426614174000"),
+			Title:       "Tarefa 1 do Team",
+			Description: "Descrição da tarefa 1",
+			Status:      taskEntity.StatusTodo,
+			TeamID:      &teamID,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		token    string
+		wantTeam *teamEntity.Team
+		wantErr  error
+	}{
+		{
+			"RetrieveCalendar with success",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, teamUUID uuid.UUID) (*teamEntity.Team, error) {
+						return calendarTeam(), nil
+					},
+				})
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnListByTeamID: func(ctx context.Context, teamID uint) ([]taskEntity.Task, error) {
+						return tasks, nil
+					},
+				})
+			},
+			"secret",
+			func() *teamEntity.Team {
+				t := calendarTeam()
+				t.Tasks = tasks
+				return t
+			}(),
+			nil,
+		},
+		{
+			"RetrieveCalendar with wrong token",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, teamUUID uuid.UUID) (*teamEntity.Team, error) {
+						return calendarTeam(), nil
+					},
+				})
+			},
+			"other",
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"RetrieveCalendar without token",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, teamUUID uuid.UUID) (*teamEntity.Team, error) {
+						return calendarTeam(), nil
+					},
+				})
+			},
+			"",
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"RetrieveCalendar with team not found",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, teamUUID uuid.UUID) (*teamEntity.Team, error) {
+						return nil, errs.ErrNotFound
+					},
+				})
+			},
+			"secret",
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"RetrieveCalendar with task.ListByTeamID error",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, teamUUID uuid.UUID) (*teamEntity.Team, error) {
+						return calendarTeam(), nil
+					},
+				})
+				taskRepo.SetPersist(&taskRepo.MockPersistent{
+					FnListByTeamID: func(ctx context.Context, teamID uint) ([]taskEntity.Task, error) {
+						return nil, errors.New("database connection failed")
+					},
+				})
+			},
+			"secret",
+			nil,
+			errors.New("database connection failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				teamRepo.SetPersist(originalPersist)
+				taskRepo.SetPersist(originalTaskPersist)
+			}()
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			gotTeam, err := RetrieveCalendar(context.Background(), teamUUID, tt.token)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("RetrieveCalendar() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(gotTeam, tt.wantTeam); diff != "" {
+				t.Errorf("RetrieveCalendar() gotTeam diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRotateCalendarToken(t *testing.T) {
+	originalPersist := teamRepo.Persist()
+
+	teamUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := []struct {
+		name    string
+		setup   func(storedHash *string)
+		wantErr error
+	}{
+		{
+			"RotateCalendarToken with success",
+			func(storedHash *string) {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnUpdateCalendarTokenHash: func(ctx context.Context, teamUUID uuid.UUID, hash string) error {
+						*storedHash = hash
+						return nil
+					},
+				})
+			},
+			nil,
+		},
+		{
+			"RotateCalendarToken with team not found",
+			func(storedHash *string) {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnUpdateCalendarTokenHash: func(ctx context.Context, teamUUID uuid.UUID, hash string) error {
+						return errs.ErrNotFound
+					},
+				})
+			},
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer teamRepo.SetPersist(originalPersist)
+
+			var storedHash string
+			tt.setup(&storedHash)
+
+			token, err := RotateCalendarToken(context.Background(), teamUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("RotateCalendarToken() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				if token != "" {
+					t.Errorf("RotateCalendarToken() token = %q, want empty", token)
+				}
+				return
+			}
+			if len(token) != 43 {
+				t.Errorf("RotateCalendarToken() token length = %d, want 43", len(token))
+			}
+			if storedHash != teamEntity.HashCalendarToken(token) {
+				t.Errorf("RotateCalendarToken() stored hash = %q, want hash of returned token", storedHash)
+			}
+		})
+	}
+}
+
+func TestRetrieveBoard(t *testing.T) {
+	originalPersist := teamRepo.Persist()
+	originalTaskPersist := taskRepo.Persist()
+	originalConfig := Config
+
+	teamUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	todoUUID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	inProgressUUID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	inProgress := taskEntity.StatusInProgress
+	limit := 2
+	next := pagination.NewCursor(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 1, pagination.DirectionNext)
+
+	team := &teamEntity.Team{
+		Model:     gorm.Model{ID: 1},
+		UUID:      teamUUID,
+		WIPLimits: teamEntity.WIPLimits{taskEntity.StatusInProgress: 2},
+	}
+
+	// mockTasks persists one to_do and one in_progress task, the latter with a next page
+	mockTasks := func(gotLimit *int) *taskRepo.MockPersistent {
+		return &taskRepo.MockPersistent{
+			FnCountByTeamGroupedByStatus: func(ctx context.Context, teamID uint) (map[taskEntity.TaskStatus]int, error) {
+				return map[taskEntity.TaskStatus]int{taskEntity.StatusTodo: 1, taskEntity.StatusInProgress: 3}, nil
+			},
+			FnListByTeamAndStatus: func(ctx context.Context, teamID uint, status taskEntity.TaskStatus, sort taskEntity.ListSort, cursor *pagination.Cursor, limit int) (*taskEntity.ListTasks, error) {
+				*gotLimit = limit
+				switch status {
+				case taskEntity.StatusTodo:
+					return &taskEntity.ListTasks{Tasks: []taskEntity.Task{{UUID: todoUUID, Status: status}}, Limit: limit}, nil
+				case taskEntity.StatusInProgress:
+					return &taskEntity.ListTasks{Tasks: []taskEntity.Task{{UUID: inProgressUUID, Status: status}}, Limit: limit, NextCursor: next}, nil
+				}
+				return &taskEntity.ListTasks{Tasks: []taskEntity.Task{}, Limit: limit}, nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		status    *taskEntity.TaskStatus
+		limit     int
+		wantLimit int
+		want      []teamEntity.BoardColumn
+		wantErr   error
+	}{
+		{
+			"RetrieveBoard with every column",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+						return team, nil
+					},
+				})
+			},
+			nil,
+			0,
+			10,
+			[]teamEntity.BoardColumn{
+				{Status: taskEntity.StatusTodo, Count: 1, Tasks: []taskEntity.Task{{UUID: todoUUID, Status: taskEntity.StatusTodo}}, Limit: 10},
+				{Status: taskEntity.StatusInProgress, Count: 3, WIPLimit: &limit, Tasks: []taskEntity.Task{{UUID: inProgressUUID, Status: taskEntity.StatusInProgress}}, Limit: 10, NextCursor: next},
+				{Status: taskEntity.StatusDone, Tasks: []taskEntity.Task{}, Limit: 10},
+				{Status: taskEntity.StatusCanceled, Tasks: []taskEntity.Task{}, Limit: 10},
+			},
+			nil,
+		},
+		{
+			"RetrieveBoard with a single column and limit exceeding max",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+						return team, nil
+					},
+				})
+			},
+			&inProgress,
+			500,
+			50,
+			[]teamEntity.BoardColumn{
+				{Status: taskEntity.StatusInProgress, Count: 3, WIPLimit: &limit, Tasks: []taskEntity.Task{{UUID: inProgressUUID, Status: taskEntity.StatusInProgress}}, Limit: 50, NextCursor: next},
+			},
+			nil,
+		},
+		{
+			"RetrieveBoard team not found",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+						return nil, errs.ErrNotFound
+					},
+				})
+			},
+			nil,
+			0,
+			0,
+			nil,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				teamRepo.SetPersist(originalPersist)
+				taskRepo.SetPersist(originalTaskPersist)
+				Config = originalConfig
+			}()
+
+			Config.ListDefaultLimit = 10
+			Config.ListMaxLimit = 50
+			var gotLimit int
+			taskRepo.SetPersist(mockTasks(&gotLimit))
+			tt.setup()
+
+			got, err := RetrieveBoard(context.Background(), teamUUID, tt.status, taskEntity.SortCreatedAt, nil, tt.limit)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("RetrieveBoard() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if gotLimit != tt.wantLimit {
+				t.Errorf("RetrieveBoard() limit = %d, want %d", gotLimit, tt.wantLimit)
+			}
+			if diff := cmp.Diff(got.Columns, tt.want); diff != "" {
+				t.Errorf("RetrieveBoard() columns diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateWIPLimits(t *testing.T) {
+	originalPersist := teamRepo.Persist()
+
+	teamUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	current, stale := 2, 1
+
+	retrieveTeam := func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+		return &teamEntity.Team{UUID: id, Version: current}, nil
+	}
+
+	tests := []struct {
+		name       string
+		setup      func(stored *teamEntity.WIPLimits)
+		limits     teamEntity.WIPLimits
+		ifMatch    *int
+		want       teamEntity.WIPLimits
+		wantStored teamEntity.WIPLimits
+		wantErr    error
+	}{
+		{
+			"UpdateWIPLimits with success",
+			func(stored *teamEntity.WIPLimits) {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: retrieveTeam,
+					FnUpdateWIPLimits: func(ctx context.Context, id uuid.UUID, version int, limits teamEntity.WIPLimits) error {
+						*stored = limits
+						return nil
+					},
+				})
+			},
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			&current,
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			nil,
+		},
+		{
+			"UpdateWIPLimits without limits clears them",
+			func(stored *teamEntity.WIPLimits) {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: retrieveTeam,
+					FnUpdateWIPLimits: func(ctx context.Context, id uuid.UUID, version int, limits teamEntity.WIPLimits) error {
+						*stored = limits
+						return nil
+					},
+				})
+			},
+			nil,
+			nil,
+			teamEntity.WIPLimits{},
+			teamEntity.WIPLimits{},
+			nil,
+		},
+		{
+			"UpdateWIPLimits with invalid limits",
+			func(stored *teamEntity.WIPLimits) {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{})
+			},
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 0},
+			nil,
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "wip_limits.in_progress", Type: errs.TypeMin, Code: errs.CodeWIPLimitNotPositive, Message: "wip limit must be greater than zero"},
+			}},
+		},
+		{
+			"UpdateWIPLimits with team not found",
+			func(stored *teamEntity.WIPLimits) {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+						return nil, errs.ErrNotFound
+					},
+				})
+			},
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			nil,
+			nil,
+			nil,
+			errs.ErrNotFound,
+		},
+		{
+			"UpdateWIPLimits with a stale If-Match",
+			func(stored *teamEntity.WIPLimits) {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnRetrieveByUUID: retrieveTeam,
+				})
+			},
+			teamEntity.WIPLimits{taskEntity.StatusInProgress: 3},
+			&stale,
+			nil,
+			nil,
+			&errs.PreconditionFailedError{Message: "team is at version 2, not 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer teamRepo.SetPersist(originalPersist)
+
+			var stored teamEntity.WIPLimits
+			tt.setup(&stored)
+
+			got, err := UpdateWIPLimits(context.Background(), teamUUID, tt.limits, tt.ifMatch)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("UpdateWIPLimits() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("UpdateWIPLimits() diff: %s", diff)
+			}
+			if diff := cmp.Diff(stored, tt.wantStored); diff != "" {
+				t.Errorf("UpdateWIPLimits() stored diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPatch(t *testing.T) {
+	originalPersist := teamRepo.Persist()
+
+	teamUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	current, stale := 2, 1
+
+	tests := []struct {
+		name    string
+		apply   func(*teamEntity.Team) error
+		ifMatch *int
+		want    *teamEntity.Team
+		wantErr error
+	}{
+		{
+			"Patch team with success",
+			func(team *teamEntity.Team) error {
+				team.Name = "  Time renomeado  "
+				team.WIPLimits = teamEntity.WIPLimits{taskEntity.StatusDone: 4}
+				return nil
+			},
+			&current,
+			&teamEntity.Team{UUID: teamUUID, Name: "Time renomeado", Description: "Descrição", WIPLimits: teamEntity.WIPLimits{taskEntity.StatusDone: 4}, Version: 3},
+			nil,
+		},
+		{
+			"Patch team removing the WIP limits",
+			func(team *teamEntity.Team) error {
+				team.WIPLimits = nil
+				return nil
+			},
+			nil,
+			&teamEntity.Team{UUID: teamUUID, Name: "Time", Description: "Descrição", WIPLimits: teamEntity.WIPLimits{}, Version: 3},
+			nil,
+		},
+		{
+			"Patch team that fails validation",
+			func(team *teamEntity.Team) error {
+				team.Name = ""
+				team.WIPLimits = teamEntity.WIPLimits{taskEntity.StatusInProgress: 0}
+				return nil
+			},
+			nil,
+			nil,
+			&errs.ValidationErrors{Errors: []errs.ValidationError{
+				{Field: "name", Type: errs.TypeRequired, Code: errs.CodeRequired, Message: "name is required"},
+				{Field: "wip_limits.in_progress", Type: errs.TypeMin, Code: errs.CodeWIPLimitNotPositive, Message: "wip limit must be greater than zero"},
+			}},
+		},
+		{
+			"Patch team with a stale If-Match",
+			func(team *teamEntity.Team) error {
+				return nil
+			},
+			&stale,
+			nil,
+			&errs.PreconditionFailedError{Message: "team is at version 2, not 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer teamRepo.SetPersist(originalPersist)
+
+			teamRepo.SetPersist(&teamRepo.MockPersistent{
+				FnRetrieveByUUID: func(ctx context.Context, id uuid.UUID) (*teamEntity.Team, error) {
+					return &teamEntity.Team{UUID: id, Name: "Time", Description: "Descrição", WIPLimits: teamEntity.WIPLimits{taskEntity.StatusInProgress: 3}, Version: current}, nil
+				},
+				FnUpdate: func(ctx context.Context, id uuid.UUID, team *teamEntity.Team) error {
+					team.Version++
+					return nil
+				},
+			})
+
+			got, err := Patch(context.Background(), teamUUID, tt.apply, tt.ifMatch)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Patch() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Patch() diff: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListPaginated(t *testing.T) {
 	originalPersist := teamRepo.Persist()
 	originalConfig := Config
@@ -853,6 +1407,8 @@ func TestAssociateTask(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "team",
+						Type:    errs.TypeNotFound,
+						Code:    errs.CodeTeamNotFound,
 						Message: "team not found",
 					},
 				},
@@ -883,6 +1439,8 @@ func TestAssociateTask(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "task",
+						Type:    errs.TypeNotFound,
+						Code:    errs.CodeTaskNotFound,
 						Message: "task not found",
 					},
 				},
@@ -914,6 +1472,8 @@ func TestAssociateTask(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "task",
+						Type:    errs.TypeConflict,
+						Code:    errs.CodeTaskInAnotherTeam,
 						Message: "task is already associated with another team",
 					},
 				},
@@ -1081,6 +1641,8 @@ func TestDisassociateTask(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "team",
+						Type:    errs.TypeNotFound,
+						Code:    errs.CodeTeamNotFound,
 						Message: "team not found",
 					},
 				},
@@ -1111,6 +1673,8 @@ func TestDisassociateTask(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "task",
+						Type:    errs.TypeNotFound,
+						Code:    errs.CodeTaskNotFound,
 						Message: "task not found",
 					},
 				},
@@ -1142,6 +1706,8 @@ func TestDisassociateTask(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "task",
+						Type:    errs.TypeState,
+						Code:    errs.CodeTaskNotInTeam,
 						Message: "task is not associated with this team",
 					},
 				},
@@ -1172,6 +1738,8 @@ func TestDisassociateTask(t *testing.T) {
 				Errors: []errs.ValidationError{
 					{
 						Field:   "task",
+						Type:    errs.TypeState,
+						Code:    errs.CodeTaskNotInTeam,
 						Message: "task is not associated with this team",
 					},
 				},
@@ -1285,3 +1853,131 @@ func TestDisassociateTask(t *testing.T) {
 		})
 	}
 }
+
+func TestListByCursor(t *testing.T) {
+	originalPersist := teamRepo.Persist()
+	cursor := pagination.NewCursor(time.Date(2025, 12, 1, 18, 20, 0, 0, time.UTC), 3, pagination.DirectionNext)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		ctx       context.Context
+		cursor    *pagination.Cursor
+		limit     int
+		withTotal bool
+		want      *teamEntity.ListTeams
+		wantErr   error
+	}{
+		{
+			"List by cursor with success",
+			func() {
+				Config.ListDefaultLimit = 20
+				Config.ListMaxLimit = 50
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnListByCursor: func(ctx context.Context, c *pagination.Cursor, limit int, withTotal bool) (*teamEntity.ListTeams, error) {
+						if c != cursor || withTotal {
+							return nil, errors.New("unexpected list arguments")
+						}
+						return &teamEntity.ListTeams{
+							Teams: []teamEntity.Team{
+								{
+									UUID: uuid.MustParse("222e4567-e89b-12d3-a456-426614174000"),
+									Name: "Time de DevOps",
+								},
+							},
+							Limit:      limit,
+							PrevCursor: pagination.NewCursor(time.Date(2025, 12, 1, 18, 20, 0, 0, time.UTC), 2, pagination.DirectionPrev),
+						}, nil
+					},
+				})
+			},
+			context.Background(),
+			cursor,
+			1,
+			false,
+			&teamEntity.ListTeams{
+				Teams: []teamEntity.Team{
+					{
+						UUID: uuid.MustParse("222e4567-e89b-12d3-a456-426614174000"),
+						Name: "Time de DevOps",
+					},
+				},
+				Limit:      1,
+				PrevCursor: pagination.NewCursor(time.Date(2025, 12, 1, 18, 20, 0, 0, time.UTC), 2, pagination.DirectionPrev),
+			},
+			nil,
+		},
+		{
+			"List by cursor with limit 0 uses default limit",
+			func() {
+				Config.ListDefaultLimit = 15
+				Config.ListMaxLimit = 50
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnListByCursor: func(ctx context.Context, c *pagination.Cursor, limit int, withTotal bool) (*teamEntity.ListTeams, error) {
+						return &teamEntity.ListTeams{Teams: []teamEntity.Team{}, Limit: limit}, nil
+					},
+				})
+			},
+			context.Background(),
+			nil,
+			0,
+			true,
+			&teamEntity.ListTeams{Teams: []teamEntity.Team{}, Limit: 15},
+			nil,
+		},
+		{
+			"List by cursor with limit above max uses max limit",
+			func() {
+				Config.ListDefaultLimit = 20
+				Config.ListMaxLimit = 50
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnListByCursor: func(ctx context.Context, c *pagination.Cursor, limit int, withTotal bool) (*teamEntity.ListTeams, error) {
+						return &teamEntity.ListTeams{Teams: []teamEntity.Team{}, Limit: limit}, nil
+					},
+				})
+			},
+			context.Background(),
+			nil,
+			100,
+			true,
+			&teamEntity.ListTeams{Teams: []teamEntity.Team{}, Limit: 50},
+			nil,
+		},
+		{
+			"List by cursor with repository error",
+			func() {
+				teamRepo.SetPersist(&teamRepo.MockPersistent{
+					FnListByCursor: func(ctx context.Context, c *pagination.Cursor, limit int, withTotal bool) (*teamEntity.ListTeams, error) {
+						return nil, errors.New("database connection failed")
+					},
+				})
+			},
+			context.Background(),
+			nil,
+			10,
+			true,
+			nil,
+			errors.New("database connection failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				teamRepo.SetPersist(originalPersist)
+			}()
+
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			got, err := ListByCursor(tt.ctx, tt.cursor, tt.limit, tt.withTotal)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("ListByCursor() error diff: %s", diff)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("ListByCursor() diff: %s", diff)
+			}
+		})
+	}
+}
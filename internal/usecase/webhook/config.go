@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"log"
+	"time"
+
+	"taskmanager/internal/platform/retry"
+	webhookPlatform "taskmanager/internal/platform/webhook"
+)
+
+var Config Configuration
+
+type Configuration struct {
+	ListDefaultLimit          int `toml:"list_default_limit"`
+	ListMaxLimit              int `toml:"list_max_limit"`
+	MaxAttempts               int `toml:"max_attempts"`
+	BackoffBaseSeconds        int `toml:"backoff_base_seconds"`
+	BackoffMaxSeconds         int `toml:"backoff_max_seconds"`
+	TimeoutSeconds            int `toml:"timeout_seconds"`
+	WorkerPollIntervalSeconds int `toml:"worker_poll_interval_seconds"`
+}
+
+func LoadConfig(cfg *Configuration) error {
+	Config = *cfg
+
+	if Config.ListDefaultLimit == 0 {
+		log.Fatal("List default limit is required")
+	}
+
+	if Config.ListMaxLimit == 0 {
+		log.Fatal("List max limit is required")
+	}
+
+	if Config.MaxAttempts == 0 {
+		log.Fatal("Webhook max attempts is required")
+	}
+
+	if Config.BackoffBaseSeconds == 0 {
+		log.Fatal("Webhook backoff base is required")
+	}
+
+	if Config.BackoffMaxSeconds == 0 {
+		log.Fatal("Webhook backoff max is required")
+	}
+
+	if Config.TimeoutSeconds == 0 {
+		log.Fatal("Webhook timeout is required")
+	}
+
+	if Config.WorkerPollIntervalSeconds == 0 {
+		log.Fatal("Webhook worker poll interval is required")
+	}
+
+	client = webhookPlatform.NewClient(time.Duration(Config.TimeoutSeconds) * time.Second)
+
+	return nil
+}
+
+// WorkerPollInterval returns the interval between polls for due deliveries
+func (c Configuration) WorkerPollInterval() time.Duration {
+	return time.Duration(c.WorkerPollIntervalSeconds) * time.Second
+}
+
+// backoff returns the wait before the next attempt after the given number of failed attempts
+func (c Configuration) backoff(attempts int) time.Duration {
+	return retry.Backoff(attempts, time.Duration(c.BackoffBaseSeconds)*time.Second, time.Duration(c.BackoffMaxSeconds)*time.Second)
+}
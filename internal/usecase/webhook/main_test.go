@@ -0,0 +1,32 @@
+//go:build test
+
+package webhook
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"taskmanager/internal/paths"
+	"taskmanager/internal/testing/configtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(func(m *testing.M) int {
+		appConfig := struct {
+			Webhook Configuration `toml:"webhook"`
+		}{}
+
+		// Loading configs
+		if err := configtest.Load(paths.TestConfigPath(), paths.TestEnvPath(), &appConfig); err != nil {
+			log.Fatalf("Error on load config on struct. Err: %s", err)
+		}
+
+		// Load webhook config
+		if err := LoadConfig(&appConfig.Webhook); err != nil {
+			log.Fatalf("Error on load webhook config. Err: %s", err)
+		}
+
+		return m.Run()
+	}(m))
+}
@@ -0,0 +1,237 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	webhookEntity "taskmanager/internal/entity/webhook"
+	"taskmanager/internal/platform/publisher"
+	webhookPlatform "taskmanager/internal/platform/webhook"
+	webhookRepo "taskmanager/internal/repository/webhook"
+	"taskmanager/internal/usecase/outbox"
+)
+
+// Headers identifying the event and delivery of a webhook request
+const (
+	headerEvent    = "X-Webhook-Event"
+	headerEventID  = "X-Webhook-ID"
+	headerDelivery = "X-Webhook-Delivery"
+)
+
+// client sends the deliveries to the subscribers
+var client *webhookPlatform.Client
+
+// Publisher fans the events relayed from the outbox out to the webhook subscriptions
+// The deliveries are written in the relay transaction, so an event is fanned out once
+type Publisher struct{}
+
+// Publish records an event in the outbox within the transaction in ctx
+// The outbox relay fans it out to the subscriptions once the transaction commits;
+// events of rolled-back transactions are never sent
+func Publish(ctx context.Context, eventType webhookEntity.EventType, data any) error {
+	event, err := webhookEntity.NewEvent(eventType, data, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return outbox.Record(ctx, event.ID, string(event.Type), event.Payload)
+}
+
+// Publish implements publisher.Publisher, creating the deliveries of a relayed event
+func (Publisher) Publish(ctx context.Context, m publisher.Message) error {
+	eventID, err := uuid.Parse(m.ID)
+	if err != nil {
+		return fmt.Errorf("invalid event id %q: %w", m.ID, err)
+	}
+
+	_, err = FanOut(ctx, webhookEntity.Event{
+		ID:      eventID,
+		Type:    webhookEntity.EventType(m.Type),
+		Payload: string(m.Payload),
+	})
+	return err
+}
+
+// CreateSubscription creates a webhook subscription, generating its secret when none is given
+func CreateSubscription(ctx context.Context, s *webhookEntity.Subscription) error {
+	s.URL = strings.TrimSpace(s.URL)
+
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	if s.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return err
+		}
+		s.Secret = hex.EncodeToString(secret)
+	}
+
+	return webhookRepo.Persist().CreateSubscription(ctx, s)
+}
+
+// RetrieveSubscription retrieves a webhook subscription by UUID
+func RetrieveSubscription(ctx context.Context, subscriptionUUID uuid.UUID) (*webhookEntity.Subscription, error) {
+	return webhookRepo.Persist().RetrieveSubscriptionByUUID(ctx, subscriptionUUID)
+}
+
+// ListSubscriptions lists webhook subscriptions with pagination
+func ListSubscriptions(ctx context.Context, page, limit int) (*webhookEntity.ListSubscriptions, error) {
+	return webhookRepo.Persist().ListSubscriptions(ctx, page, listLimit(limit))
+}
+
+// DeleteSubscription performs a soft delete of a webhook subscription
+// Its pending deliveries are marked as failed when the worker picks them up
+func DeleteSubscription(ctx context.Context, subscriptionUUID uuid.UUID) error {
+	return webhookRepo.Persist().DeleteSubscription(ctx, subscriptionUUID)
+}
+
+// ListDeliveries lists the delivery log of a subscription with pagination, newest first
+func ListDeliveries(ctx context.Context, subscriptionUUID uuid.UUID, page, limit int) (*webhookEntity.ListDeliveries, error) {
+	s, err := webhookRepo.Persist().RetrieveSubscriptionByUUID(ctx, subscriptionUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookRepo.Persist().ListDeliveries(ctx, s.ID, page, listLimit(limit))
+}
+
+// RetrieveDelivery retrieves a delivery of a subscription by UUID
+func RetrieveDelivery(ctx context.Context, subscriptionUUID, deliveryUUID uuid.UUID) (*webhookEntity.Delivery, error) {
+	s, err := webhookRepo.Persist().RetrieveSubscriptionByUUID(ctx, subscriptionUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookRepo.Persist().RetrieveDeliveryByUUID(ctx, s.ID, deliveryUUID)
+}
+
+// Redeliver schedules a new delivery of the event of an existing one, with the same event ID
+// and payload, so receivers can deduplicate it
+func Redeliver(ctx context.Context, subscriptionUUID, deliveryUUID uuid.UUID) (*webhookEntity.Delivery, error) {
+	original, err := RetrieveDelivery(ctx, subscriptionUUID, deliveryUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	d := &webhookEntity.Delivery{
+		SubscriptionID: original.SubscriptionID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         webhookEntity.DeliveryStatusPending,
+		NextAttemptAt:  &now,
+	}
+
+	if err := webhookRepo.Persist().CreateDelivery(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// FanOut creates a pending delivery of the event for each subscription receiving it
+// Returns the number of deliveries created
+func FanOut(ctx context.Context, event webhookEntity.Event) (int, error) {
+	subscriptions, err := webhookRepo.Persist().ListSubscriptionsByEvent(ctx, event.Type)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	for _, s := range subscriptions {
+		d := &webhookEntity.Delivery{
+			SubscriptionID: s.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        event.Payload,
+			Status:         webhookEntity.DeliveryStatusPending,
+			NextAttemptAt:  &now,
+		}
+		if err := webhookRepo.Persist().CreateDelivery(ctx, d); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(subscriptions), nil
+}
+
+// DeliverNext claims the oldest due delivery, sends it and records the attempt
+// A failed attempt is retried with exponential backoff until Config.MaxAttempts is reached
+// Returns ErrNotFound when no delivery is due
+func DeliverNext(ctx context.Context) (*webhookEntity.Delivery, error) {
+	d, err := webhookRepo.Persist().ClaimNextDueDelivery(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Subscription == nil {
+		d.Status = webhookEntity.DeliveryStatusFailed
+		d.NextAttemptAt = nil
+		d.LastError = "subscription deleted"
+		return d, webhookRepo.Persist().UpdateDelivery(ctx, d)
+	}
+
+	statusCode, sendErr := client.Send(ctx, webhookPlatform.Message{
+		URL:    d.Subscription.URL,
+		Secret: d.Subscription.Secret,
+		Headers: map[string]string{
+			headerEvent:    string(d.EventType),
+			headerEventID:  d.EventID.String(),
+			headerDelivery: d.UUID.String(),
+		},
+		Body: []byte(d.Payload),
+	})
+	recordAttempt(d, time.Now(), statusCode, sendErr)
+
+	return d, webhookRepo.Persist().UpdateDelivery(ctx, d)
+}
+
+// recordAttempt updates a delivery with the outcome of an attempt made at attemptedAt
+func recordAttempt(d *webhookEntity.Delivery, attemptedAt time.Time, statusCode int, sendErr error) {
+	d.Attempts++
+	d.LastAttemptAt = &attemptedAt
+	d.ResponseStatus = statusCode
+
+	switch {
+	case sendErr != nil:
+		d.LastError = sendErr.Error()
+	case statusCode < 200 || statusCode > 299:
+		d.LastError = fmt.Sprintf("unexpected response status %d", statusCode)
+	default:
+		d.Status = webhookEntity.DeliveryStatusSucceeded
+		d.LastError = ""
+		d.NextAttemptAt = nil
+		return
+	}
+
+	if d.Attempts >= Config.MaxAttempts {
+		d.Status = webhookEntity.DeliveryStatusFailed
+		d.NextAttemptAt = nil
+		return
+	}
+
+	nextAttemptAt := attemptedAt.Add(Config.backoff(d.Attempts))
+	d.NextAttemptAt = &nextAttemptAt
+}
+
+// listLimit applies the configured default and maximum to a requested page size
+func listLimit(limit int) int {
+	if limit <= 0 {
+		return Config.ListDefaultLimit
+	}
+
+	if limit > Config.ListMaxLimit {
+		return Config.ListMaxLimit
+	}
+
+	return limit
+}
@@ -0,0 +1,468 @@
+//go:build test
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	outboxEntity "taskmanager/internal/entity/outbox"
+	webhookEntity "taskmanager/internal/entity/webhook"
+	errs "taskmanager/internal/platform/errors"
+	"taskmanager/internal/platform/publisher"
+	"taskmanager/internal/platform/testing/assert"
+	webhookPlatform "taskmanager/internal/platform/webhook"
+	outboxRepo "taskmanager/internal/repository/outbox"
+	webhookRepo "taskmanager/internal/repository/webhook"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+var (
+	subscriptionUUID = uuid.MustParse("bbbe4567-e89b-12d3-a456-426614174000")
+	deliveryUUID     = uuid.MustParse("ccce4567-e89b-12d3-a456-426614174001")
+	eventUUID        = uuid.MustParse("ddde4567-e89b-12d3-a456-426614174001")
+)
+
+func TestCreateSubscription(t *testing.T) {
+	originalPersist := webhookRepo.Persist()
+
+	tests := []struct {
+		name         string
+		setup        func()
+		subscription *webhookEntity.Subscription
+		wantErr      error
+	}{
+		{
+			"Create subscription with given secret",
+			func() {
+				webhookRepo.SetPersist(&webhookRepo.MockPersistent{
+					FnCreateSubscription: func(ctx context.Context, s *webhookEntity.Subscription) error {
+						if s.Secret != "whsec_0123456789abcdef" {
+							t.Errorf("CreateSubscription() secret = %q, want given secret", s.Secret)
+						}
+						return nil
+					},
+				})
+			},
+			&webhookEntity.Subscription{
+				URL:    "  https://example.com/hooks  ",
+				Secret: "whsec_0123456789abcdef",
+				Events: []webhookEntity.EventType{webhookEntity.EventTaskCreated},
+			},
+			nil,
+		},
+		{
+			"Create subscription generating secret",
+			func() {
+				webhookRepo.SetPersist(&webhookRepo.MockPersistent{
+					FnCreateSubscription: func(ctx context.Context, s *webhookEntity.Subscription) error {
+						if len(s.Secret) != 64 {
+							t.Errorf("CreateSubscription() generated secret length = %d, want 64", len(s.Secret))
+						}
+						return nil
+					},
+				})
+			},
+			&webhookEntity.Subscription{
+				URL:    "https://example.com/hooks",
+				Events: []webhookEntity.EventType{webhookEntity.EventTaskCreated},
+			},
+			nil,
+		},
+		{
+			"Create subscription with invalid fields",
+			func() {
+				webhookRepo.SetPersist(&webhookRepo.MockPersistent{})
+			},
+			&webhookEntity.Subscription{
+				URL:    "example.com",
+				Events: []webhookEntity.EventType{"task.archived"},
+			},
+			&errs.ValidationErrors{
+				Errors: []errs.ValidationError{
+					{Field: "url", Type: errs.TypeFormat, Code: errs.CodeInvalidFormat, Message: "invalid url format"},
+					{Field: "events[0]", Type: errs.TypeInvalid, Code: errs.CodeInvalidEvent, Message: "invalid event type"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer webhookRepo.SetPersist(originalPersist)
+
+			err := CreateSubscription(context.Background(), tt.subscription)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("CreateSubscription() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr == nil && tt.subscription.URL != "https://example.com/hooks" {
+				t.Errorf("CreateSubscription() url = %q, want trimmed url", tt.subscription.URL)
+			}
+		})
+	}
+}
+
+func TestPublish(t *testing.T) {
+	originalPersist := outboxRepo.Persist()
+	defer outboxRepo.SetPersist(originalPersist)
+
+	var recorded *outboxEntity.Message
+	outboxRepo.SetPersist(&outboxRepo.MockPersistent{
+		FnCreate: func(ctx context.Context, m *outboxEntity.Message) error {
+			recorded = m
+			return nil
+		},
+	})
+
+	if err := Publish(context.Background(), webhookEntity.EventTaskDeleted, webhookEntity.TaskDeletedData{UUID: eventUUID}); err != nil {
+		t.Fatalf("Publish() error: %v", err)
+	}
+
+	if recorded == nil {
+		t.Fatalf("Publish() did not record the event in the outbox")
+	}
+	if recorded.EventType != string(webhookEntity.EventTaskDeleted) {
+		t.Errorf("Publish() recorded type = %v, want %v", recorded.EventType, webhookEntity.EventTaskDeleted)
+	}
+	if !strings.Contains(recorded.Payload, `"id":"`+recorded.UUID.String()+`"`) {
+		t.Errorf("Publish() payload %s does not carry the outbox UUID %v", recorded.Payload, recorded.UUID)
+	}
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	originalPersist := webhookRepo.Persist()
+
+	tests := []struct {
+		name        string
+		message     publisher.Message
+		wantCreated int
+		wantErr     bool
+	}{
+		{
+			"Publish relayed event creating deliveries",
+			publisher.Message{ID: eventUUID.String(), Type: "task.updated", Payload: []byte(`{"type":"task.updated"}`)},
+			1,
+			false,
+		},
+		{
+			"Publish relayed event with invalid ID",
+			publisher.Message{ID: "invalid", Type: "task.updated", Payload: []byte(`{}`)},
+			0,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created []*webhookEntity.Delivery
+			webhookRepo.SetPersist(&webhookRepo.MockPersistent{
+				FnListSubscriptionsByEvent: func(ctx context.Context, eventType webhookEntity.EventType) ([]webhookEntity.Subscription, error) {
+					s := webhookEntity.Subscription{}
+					s.ID = 1
+					return []webhookEntity.Subscription{s}, nil
+				},
+				FnCreateDelivery: func(ctx context.Context, d *webhookEntity.Delivery) error {
+					created = append(created, d)
+					return nil
+				},
+			})
+			defer webhookRepo.SetPersist(originalPersist)
+
+			err := Publisher{}.Publish(context.Background(), tt.message)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Publisher.Publish() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(created) != tt.wantCreated {
+				t.Fatalf("Publisher.Publish() created %d deliveries, want %d", len(created), tt.wantCreated)
+			}
+			if tt.wantCreated > 0 && (created[0].EventID != eventUUID || created[0].Payload != string(tt.message.Payload)) {
+				t.Errorf("Publisher.Publish() delivery = %+v", created[0])
+			}
+		})
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	originalPersist := webhookRepo.Persist()
+	defer webhookRepo.SetPersist(originalPersist)
+
+	event := webhookEntity.Event{ID: eventUUID, Type: webhookEntity.EventTaskUpdated, Payload: `{"type":"task.updated"}`}
+
+	var created []*webhookEntity.Delivery
+	webhookRepo.SetPersist(&webhookRepo.MockPersistent{
+		FnListSubscriptionsByEvent: func(ctx context.Context, eventType webhookEntity.EventType) ([]webhookEntity.Subscription, error) {
+			first, second := webhookEntity.Subscription{}, webhookEntity.Subscription{}
+			first.ID, second.ID = 1, 3
+			return []webhookEntity.Subscription{first, second}, nil
+		},
+		FnCreateDelivery: func(ctx context.Context, d *webhookEntity.Delivery) error {
+			created = append(created, d)
+			return nil
+		},
+	})
+
+	got, err := FanOut(context.Background(), event)
+	if err != nil {
+		t.Fatalf("FanOut() error: %v", err)
+	}
+	if got != 2 || len(created) != 2 {
+		t.Fatalf("FanOut() = %d with %d deliveries, want 2", got, len(created))
+	}
+	for i, wantSubscriptionID := range []uint{1, 3} {
+		d := created[i]
+		if d.SubscriptionID != wantSubscriptionID || d.EventID != event.ID || d.Payload != event.Payload ||
+			d.Status != webhookEntity.DeliveryStatusPending || d.NextAttemptAt == nil {
+			t.Errorf("FanOut() delivery %d = %+v", i, d)
+		}
+	}
+}
+
+func TestRedeliver(t *testing.T) {
+	originalPersist := webhookRepo.Persist()
+
+	subscription := &webhookEntity.Subscription{UUID: subscriptionUUID}
+	subscription.ID = 1
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *webhookEntity.Delivery
+		wantErr error
+	}{
+		{
+			"Redeliver with success",
+			func() {
+				webhookRepo.SetPersist(&webhookRepo.MockPersistent{
+					FnRetrieveSubscriptionByUUID: func(ctx context.Context, id uuid.UUID) (*webhookEntity.Subscription, error) {
+						return subscription, nil
+					},
+					FnRetrieveDeliveryByUUID: func(ctx context.Context, subscriptionID uint, id uuid.UUID) (*webhookEntity.Delivery, error) {
+						return &webhookEntity.Delivery{
+							UUID:           deliveryUUID,
+							SubscriptionID: subscriptionID,
+							EventID:        eventUUID,
+							EventType:      webhookEntity.EventTaskStatusChanged,
+							Payload:        `{"type":"task.status_changed"}`,
+							Status:         webhookEntity.DeliveryStatusFailed,
+							Attempts:       3,
+							ResponseStatus: 500,
+							LastError:      "unexpected response status 500",
+						}, nil
+					},
+					FnCreateDelivery: func(ctx context.Context, d *webhookEntity.Delivery) error {
+						return nil
+					},
+				})
+			},
+			&webhookEntity.Delivery{
+				SubscriptionID: 1,
+				EventID:        eventUUID,
+				EventType:      webhookEntity.EventTaskStatusChanged,
+				Payload:        `{"type":"task.status_changed"}`,
+				Status:         webhookEntity.DeliveryStatusPending,
+			},
+			nil,
+		},
+		{
+			"Redeliver of unknown subscription",
+			func() {
+				webhookRepo.SetPersist(&webhookRepo.MockPersistent{
+					FnRetrieveSubscriptionByUUID: func(ctx context.Context, id uuid.UUID) (*webhookEntity.Subscription, error) {
+						return nil, errs.ErrNotFound
+					},
+				})
+			},
+			nil,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer webhookRepo.SetPersist(originalPersist)
+
+			got, err := Redeliver(context.Background(), subscriptionUUID, deliveryUUID)
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("Redeliver() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.NextAttemptAt == nil {
+				t.Errorf("Redeliver() next attempt is nil")
+			}
+			got.NextAttemptAt = nil
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Redeliver() diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeliverNext(t *testing.T) {
+	originalPersist := webhookRepo.Persist()
+
+	const secret = "whsec_0123456789abcdef"
+	const payload = `{"id":"ddde4567-e89b-12d3-a456-426614174001","type":"task.status_changed"}`
+
+	var responseStatus int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(webhookPlatform.HeaderTimestamp), 10, 64)
+
+		if got, want := r.Header.Get(webhookPlatform.HeaderSignature), webhookPlatform.Sign(secret, timestamp, body); got != want {
+			t.Errorf("DeliverNext() signature = %q, want %q", got, want)
+		}
+		if got := r.Header.Get("X-Webhook-Event"); got != "task.status_changed" {
+			t.Errorf("DeliverNext() event header = %q", got)
+		}
+		if got := r.Header.Get("X-Webhook-ID"); got != eventUUID.String() {
+			t.Errorf("DeliverNext() event id header = %q", got)
+		}
+		if string(body) != payload {
+			t.Errorf("DeliverNext() body = %s", body)
+		}
+		w.WriteHeader(responseStatus)
+	}))
+	defer server.Close()
+
+	newDelivery := func(attempts int, withSubscription bool) *webhookEntity.Delivery {
+		d := &webhookEntity.Delivery{
+			UUID:           deliveryUUID,
+			SubscriptionID: 1,
+			EventID:        eventUUID,
+			EventType:      webhookEntity.EventTaskStatusChanged,
+			Payload:        payload,
+			Status:         webhookEntity.DeliveryStatusPending,
+			Attempts:       attempts,
+		}
+		if withSubscription {
+			d.Subscription = &webhookEntity.Subscription{URL: server.URL, Secret: secret}
+		}
+		return d
+	}
+
+	tests := []struct {
+		name              string
+		responseStatus    int
+		delivery          *webhookEntity.Delivery
+		claimErr          error
+		wantStatus        webhookEntity.DeliveryStatus
+		wantAttempts      int
+		wantLastError     string
+		wantNextAttemptIn time.Duration
+		wantErr           error
+	}{
+		{
+			"Deliver with success",
+			http.StatusNoContent,
+			newDelivery(0, true),
+			nil,
+			webhookEntity.DeliveryStatusSucceeded,
+			1,
+			"",
+			0,
+			nil,
+		},
+		{
+			"Deliver with server error schedules retry",
+			http.StatusInternalServerError,
+			newDelivery(1, true),
+			nil,
+			webhookEntity.DeliveryStatusPending,
+			2,
+			"unexpected response status 500",
+			20 * time.Second,
+			nil,
+		},
+		{
+			"Deliver last attempt with server error fails",
+			http.StatusServiceUnavailable,
+			newDelivery(2, true),
+			nil,
+			webhookEntity.DeliveryStatusFailed,
+			3,
+			"unexpected response status 503",
+			0,
+			nil,
+		},
+		{
+			"Deliver of deleted subscription fails",
+			http.StatusOK,
+			newDelivery(0, false),
+			nil,
+			webhookEntity.DeliveryStatusFailed,
+			0,
+			"subscription deleted",
+			0,
+			nil,
+		},
+		{
+			"Deliver without due deliveries",
+			http.StatusOK,
+			nil,
+			errs.ErrNotFound,
+			"",
+			0,
+			"",
+			0,
+			errs.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responseStatus = tt.responseStatus
+
+			var updated *webhookEntity.Delivery
+			webhookRepo.SetPersist(&webhookRepo.MockPersistent{
+				FnClaimNextDueDelivery: func(ctx context.Context, now time.Time) (*webhookEntity.Delivery, error) {
+					return tt.delivery, tt.claimErr
+				},
+				FnUpdateDelivery: func(ctx context.Context, d *webhookEntity.Delivery) error {
+					updated = d
+					return nil
+				},
+			})
+			defer webhookRepo.SetPersist(originalPersist)
+
+			_, err := DeliverNext(context.Background())
+			if diff := assert.CompareErrors(err, tt.wantErr); diff != "" {
+				t.Errorf("DeliverNext() error diff: %s", diff)
+				return
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if updated == nil {
+				t.Fatalf("DeliverNext() did not update the delivery")
+			}
+			if updated.Status != tt.wantStatus || updated.Attempts != tt.wantAttempts || updated.LastError != tt.wantLastError {
+				t.Errorf("DeliverNext() status=%v attempts=%d last_error=%q, want %v %d %q",
+					updated.Status, updated.Attempts, updated.LastError, tt.wantStatus, tt.wantAttempts, tt.wantLastError)
+			}
+
+			if tt.wantNextAttemptIn == 0 {
+				if updated.NextAttemptAt != nil {
+					t.Errorf("DeliverNext() next attempt = %v, want nil", updated.NextAttemptAt)
+				}
+				return
+			}
+			if updated.NextAttemptAt == nil || updated.LastAttemptAt == nil {
+				t.Fatalf("DeliverNext() next attempt or last attempt is nil")
+			}
+			if got := updated.NextAttemptAt.Sub(*updated.LastAttemptAt); got != tt.wantNextAttemptIn {
+				t.Errorf("DeliverNext() next attempt in %v, want %v", got, tt.wantNextAttemptIn)
+			}
+		})
+	}
+}
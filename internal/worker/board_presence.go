@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"taskmanager/internal/platform/publisher"
+	"taskmanager/internal/usecase/board"
+)
+
+// BoardPresenceWorker pushes the presence changes announced by every replica to the board sessions of this one
+type BoardPresenceWorker struct {
+	source *publisher.RedisPubSub
+}
+
+// NewBoardPresenceWorker creates a BoardPresenceWorker receiving the changes from the given pub/sub channel
+func NewBoardPresenceWorker(source *publisher.RedisPubSub) *BoardPresenceWorker {
+	return &BoardPresenceWorker{source: source}
+}
+
+// Run pushes the received presence changes until the context is canceled
+func (w *BoardPresenceWorker) Run(ctx context.Context) {
+	slog.Info("Board presence worker started")
+
+	for m := range w.source.Subscribe(ctx) {
+		if err := board.ReceivePresence(ctx, m); err != nil {
+			slog.Warn("Discarding board presence message", "error", err)
+		}
+	}
+
+	slog.Info("Board presence worker stopped")
+}
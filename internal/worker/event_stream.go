@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+
+	"taskmanager/internal/platform/publisher"
+	"taskmanager/internal/usecase/eventstream"
+)
+
+// EventStreamWorker broadcasts the events relayed by every replica to the stream subscribers of this one
+type EventStreamWorker struct {
+	source *publisher.RedisPubSub
+}
+
+// NewEventStreamWorker creates an EventStreamWorker receiving the events from the given pub/sub channel
+func NewEventStreamWorker(source *publisher.RedisPubSub) *EventStreamWorker {
+	return &EventStreamWorker{source: source}
+}
+
+// Run broadcasts the received events until the context is canceled
+func (w *EventStreamWorker) Run(ctx context.Context) {
+	slog.Info("Event stream worker started")
+
+	for m := range w.source.Subscribe(ctx) {
+		if err := eventstream.Receive(m); err != nil {
+			slog.Warn("Discarding event stream message", "error", err)
+		}
+	}
+
+	slog.Info("Event stream worker stopped")
+}
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"taskmanager/internal/platform/database"
+	"taskmanager/internal/usecase/idempotency"
+)
+
+// IdempotencyWorker removes the expired idempotency keys
+type IdempotencyWorker struct {
+	dbConnector database.Connector
+	interval    time.Duration
+}
+
+// NewIdempotencyWorker creates an IdempotencyWorker purging the expired keys at the given interval
+func NewIdempotencyWorker(dbConnector database.Connector, interval time.Duration) *IdempotencyWorker {
+	return &IdempotencyWorker{
+		dbConnector: dbConnector,
+		interval:    interval,
+	}
+}
+
+// Run purges the expired keys on each tick until the context is canceled
+// Expired keys are already replaced when reused, so purging only bounds the size of the table
+func (w *IdempotencyWorker) Run(ctx context.Context) {
+	slog.Info("Idempotency worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Idempotency worker stopped")
+			return
+		case <-ticker.C:
+			w.purge(ctx)
+		}
+	}
+}
+
+// purge removes the expired keys in its own transaction
+func (w *IdempotencyWorker) purge(ctx context.Context) {
+	txCtx, err := w.dbConnector.InjectDBsIntoContext(ctx, database.WithDBTransaction())
+	if err != nil {
+		slog.Error("Failed to inject database transaction for idempotency worker", "error", err)
+		return
+	}
+
+	count, err := idempotency.Purge(txCtx)
+	if err != nil {
+		slog.Error("Error purging idempotency keys", "error", err)
+		if rollbackErr := w.dbConnector.Rollback(txCtx); rollbackErr != nil {
+			slog.Error("Error on rollback idempotency transaction", "error", rollbackErr)
+		}
+		return
+	}
+
+	if err := w.dbConnector.Commit(txCtx); err != nil {
+		slog.Error("Error on commit idempotency transaction", "error", err)
+		return
+	}
+
+	slog.Debug("Idempotency keys purged", "count", count)
+}
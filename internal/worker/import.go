@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"taskmanager/internal/platform/database"
+	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/usecase/importjob"
+)
+
+// ImportWorker processes pending import jobs in the background
+type ImportWorker struct {
+	dbConnector database.Connector
+	interval    time.Duration
+}
+
+// NewImportWorker creates an ImportWorker polling for pending jobs at the given interval
+func NewImportWorker(dbConnector database.Connector, interval time.Duration) *ImportWorker {
+	return &ImportWorker{
+		dbConnector: dbConnector,
+		interval:    interval,
+	}
+}
+
+// Run processes pending import jobs until the context is canceled
+// All pending jobs are drained before waiting for the next poll
+func (w *ImportWorker) Run(ctx context.Context) {
+	slog.Info("Import worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil && w.processNext(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Import worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// processNext processes one pending job in its own transaction
+// Returns true when a job left the queue, so the caller keeps draining it; a job whose outcome
+// could not be committed stays pending and is retried on the next poll
+func (w *ImportWorker) processNext(ctx context.Context) bool {
+	txCtx, err := w.dbConnector.InjectDBsIntoContext(ctx, database.WithDBTransaction())
+	if err != nil {
+		slog.Error("Failed to inject database transaction for import worker", "error", err)
+		return false
+	}
+
+	j, err := importjob.ProcessNext(txCtx)
+	if err != nil {
+		if rollbackErr := w.dbConnector.Rollback(txCtx); rollbackErr != nil {
+			slog.Error("Error on rollback import transaction", "error", rollbackErr)
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return false
+		}
+
+		slog.Error("Error processing import job", "error", err)
+		if j == nil {
+			return false
+		}
+		return w.markFailed(ctx, j.UUID, err)
+	}
+
+	if err := w.dbConnector.Commit(txCtx); err != nil {
+		slog.Error("Error on commit import transaction", "uuid", j.UUID, "error", err)
+		return false
+	}
+
+	slog.Info("Import job processed", "uuid", j.UUID, "status", j.Status, "imported_rows", j.ImportedRows, "failed_rows", j.FailedRows)
+	return true
+}
+
+// markFailed records a processing failure in a new transaction, after the job's own was rolled back
+// Returns false when the failure could not be recorded, leaving the job pending
+func (w *ImportWorker) markFailed(ctx context.Context, jobUUID uuid.UUID, cause error) bool {
+	txCtx, err := w.dbConnector.InjectDBsIntoContext(ctx, database.WithDBTransaction())
+	if err != nil {
+		slog.Error("Failed to inject database transaction for import worker", "error", err)
+		return false
+	}
+
+	if err := importjob.MarkFailed(txCtx, jobUUID, cause); err != nil {
+		slog.Error("Error marking import job as failed", "uuid", jobUUID, "error", err)
+		if rollbackErr := w.dbConnector.Rollback(txCtx); rollbackErr != nil {
+			slog.Error("Error on rollback import transaction", "error", rollbackErr)
+		}
+		return false
+	}
+
+	if err := w.dbConnector.Commit(txCtx); err != nil {
+		slog.Error("Error on commit import transaction", "uuid", jobUUID, "error", err)
+		return false
+	}
+	return true
+}
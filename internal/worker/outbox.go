@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"taskmanager/internal/platform/database"
+	"taskmanager/internal/usecase/outbox"
+)
+
+// outboxPurgeInterval is how often published messages past the retention are removed
+const outboxPurgeInterval = time.Hour
+
+// OutboxWorker relays the committed outbox messages to the publishers
+type OutboxWorker struct {
+	dbConnector database.Connector
+	interval    time.Duration
+}
+
+// NewOutboxWorker creates an OutboxWorker polling for due messages at the given interval
+func NewOutboxWorker(dbConnector database.Connector, interval time.Duration) *OutboxWorker {
+	return &OutboxWorker{
+		dbConnector: dbConnector,
+		interval:    interval,
+	}
+}
+
+// Run relays outbox messages until the context is canceled
+// Committed messages are relayed as soon as the outbox is woken; retries are picked up on each poll
+func (w *OutboxWorker) Run(ctx context.Context) {
+	slog.Info("Outbox worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	purgeTicker := time.NewTicker(outboxPurgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		for ctx.Err() == nil && w.relayBatch(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Outbox worker stopped")
+			return
+		case <-outbox.Wake():
+		case <-ticker.C:
+		case <-purgeTicker.C:
+			w.purge(ctx)
+		}
+	}
+}
+
+// relayBatch relays a batch of due messages in its own transaction
+// Returns true when messages were claimed, so the caller keeps draining the outbox
+func (w *OutboxWorker) relayBatch(ctx context.Context) bool {
+	txCtx, err := w.dbConnector.InjectDBsIntoContext(ctx, database.WithDBTransaction())
+	if err != nil {
+		slog.Error("Failed to inject database transaction for outbox worker", "error", err)
+		return false
+	}
+
+	count, err := outbox.RelayBatch(txCtx)
+	if err != nil {
+		slog.Error("Error relaying outbox messages", "error", err)
+		if rollbackErr := w.dbConnector.Rollback(txCtx); rollbackErr != nil {
+			slog.Error("Error on rollback outbox transaction", "error", rollbackErr)
+		}
+		return false
+	}
+
+	if err := w.dbConnector.Commit(txCtx); err != nil {
+		slog.Error("Error on commit outbox transaction", "error", err)
+		return false
+	}
+
+	if count > 0 {
+		slog.Debug("Outbox messages relayed", "count", count)
+	}
+	return count > 0
+}
+
+// purge removes the published messages past the retention in its own transaction
+func (w *OutboxWorker) purge(ctx context.Context) {
+	txCtx, err := w.dbConnector.InjectDBsIntoContext(ctx, database.WithDBTransaction())
+	if err != nil {
+		slog.Error("Failed to inject database transaction for outbox worker", "error", err)
+		return
+	}
+
+	count, err := outbox.Purge(txCtx)
+	if err != nil {
+		slog.Error("Error purging outbox messages", "error", err)
+		if rollbackErr := w.dbConnector.Rollback(txCtx); rollbackErr != nil {
+			slog.Error("Error on rollback outbox transaction", "error", rollbackErr)
+		}
+		return
+	}
+
+	if err := w.dbConnector.Commit(txCtx); err != nil {
+		slog.Error("Error on commit outbox transaction", "error", err)
+		return
+	}
+
+	slog.Info("Outbox messages purged", "count", count)
+}
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"taskmanager/internal/platform/database"
+	"taskmanager/internal/usecase/analytics"
+)
+
+// SnapshotWorker records the daily snapshots behind the cumulative flow and burndown charts
+type SnapshotWorker struct {
+	dbConnector database.Connector
+	interval    time.Duration
+}
+
+// NewSnapshotWorker creates a SnapshotWorker refreshing the snapshots of the day at the given interval
+func NewSnapshotWorker(dbConnector database.Connector, interval time.Duration) *SnapshotWorker {
+	return &SnapshotWorker{
+		dbConnector: dbConnector,
+		interval:    interval,
+	}
+}
+
+// Run records the snapshots on start and then on each tick until the context is canceled
+// Each run replaces the snapshots of the day, so the last one of a day holds its end state
+func (w *SnapshotWorker) Run(ctx context.Context) {
+	slog.Info("Snapshot worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.record(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Snapshot worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// record records the snapshots of the day in its own transaction
+func (w *SnapshotWorker) record(ctx context.Context) {
+	txCtx, err := w.dbConnector.InjectDBsIntoContext(ctx, database.WithDBTransaction())
+	if err != nil {
+		slog.Error("Failed to inject database transaction for snapshot worker", "error", err)
+		return
+	}
+
+	if err := analytics.RecordSnapshots(txCtx, time.Now()); err != nil {
+		slog.Error("Error recording snapshots", "error", err)
+		if rollbackErr := w.dbConnector.Rollback(txCtx); rollbackErr != nil {
+			slog.Error("Error on rollback snapshot transaction", "error", rollbackErr)
+		}
+		return
+	}
+
+	if err := w.dbConnector.Commit(txCtx); err != nil {
+		slog.Error("Error on commit snapshot transaction", "error", err)
+		return
+	}
+
+	slog.Debug("Snapshots recorded")
+}
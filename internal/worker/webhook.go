@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"taskmanager/internal/platform/database"
+	apperrors "taskmanager/internal/platform/errors"
+	"taskmanager/internal/usecase/webhook"
+)
+
+// WebhookWorker sends the due webhook deliveries in the background
+type WebhookWorker struct {
+	dbConnector database.Connector
+	interval    time.Duration
+}
+
+// NewWebhookWorker creates a WebhookWorker polling for due deliveries at the given interval
+func NewWebhookWorker(dbConnector database.Connector, interval time.Duration) *WebhookWorker {
+	return &WebhookWorker{
+		dbConnector: dbConnector,
+		interval:    interval,
+	}
+}
+
+// Run delivers webhooks until the context is canceled
+// All due deliveries are drained before waiting for the next poll
+func (w *WebhookWorker) Run(ctx context.Context) {
+	slog.Info("Webhook worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil && w.deliverNext(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Webhook worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverNext sends one due delivery in its own transaction
+// Returns true when a delivery was claimed, so the caller keeps draining the due ones
+func (w *WebhookWorker) deliverNext(ctx context.Context) bool {
+	txCtx, err := w.dbConnector.InjectDBsIntoContext(ctx, database.WithDBTransaction())
+	if err != nil {
+		slog.Error("Failed to inject database transaction for webhook worker", "error", err)
+		return false
+	}
+
+	d, err := webhook.DeliverNext(txCtx)
+	if err != nil {
+		if rollbackErr := w.dbConnector.Rollback(txCtx); rollbackErr != nil {
+			slog.Error("Error on rollback webhook transaction", "error", rollbackErr)
+		}
+		if !errors.Is(err, apperrors.ErrNotFound) {
+			slog.Error("Error delivering webhook", "error", err)
+		}
+		return false
+	}
+
+	if err := w.dbConnector.Commit(txCtx); err != nil {
+		slog.Error("Error on commit webhook transaction", "uuid", d.UUID, "error", err)
+		return false
+	}
+
+	slog.Info("Webhook delivery attempted", "uuid", d.UUID, "event", d.EventType, "status", d.Status, "attempts", d.Attempts, "response_status", d.ResponseStatus)
+	return true
+}
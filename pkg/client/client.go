@@ -0,0 +1,271 @@
+// Package client is a typed Go client of the Task Manager API
+// Requests and responses are aliases of the dto types of the server, declared in types.go; error
+// responses are decoded into *ValidationErrors (422), *BadRequestError (400) and *Error (other statuses)
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// jsonContentType is the media type of request bodies other than patches
+const jsonContentType = "application/json"
+
+// emptyBody is the body of the routes without one that still require a JSON Content-Type
+var emptyBody = struct{}{}
+
+// Defaults of the retries of a Client (see WithRetries)
+const (
+	DefaultMaxRetries = 2
+	DefaultBackoff    = 100 * time.Millisecond
+)
+
+// Client calls the Task Manager API at a base URL
+// A Client is safe for concurrent use
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	maxRetries int
+	backoff    time.Duration
+	language   string
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sends the requests with httpClient instead of http.DefaultClient
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetries retries a request up to maxRetries times after a network error or a 429, 502, 503
+// or 504 response, waiting backoff doubled at each retry (or the Retry-After of the response).
+// Only requests safe to repeat are retried: GET, PUT and DELETE, and POST with an Idempotency-Key
+func WithRetries(maxRetries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.backoff = backoff
+	}
+}
+
+// WithLanguage sends Accept-Language, so the messages of ValidationErrors come in that language
+func WithLanguage(language string) Option {
+	return func(c *Client) {
+		c.language = language
+	}
+}
+
+// New returns a Client of the API at baseURL (such as http://localhost:8080)
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		maxRetries: DefaultMaxRetries,
+		backoff:    DefaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// RequestOption configures a single request
+type RequestOption func(*requestOptions)
+
+// requestOptions holds the headers sent with a request and where to store its ETag
+type requestOptions struct {
+	header http.Header
+	etag   *string
+}
+
+// WithIfMatch sends If-Match, so the write is refused with ErrPreconditionFailed when the
+// resource is no longer at the version of etag
+func WithIfMatch(etag string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set("If-Match", etag)
+	}
+}
+
+// WithIdempotencyKey sends Idempotency-Key, so the server replays the response to retries of the
+// POST with the same key instead of running it again; it also makes the POST retryable
+func WithIdempotencyKey(key string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set("Idempotency-Key", key)
+	}
+}
+
+// CaptureETag stores the ETag of the response in etag, to be sent later with WithIfMatch
+func CaptureETag(etag *string) RequestOption {
+	return func(o *requestOptions) {
+		o.etag = etag
+	}
+}
+
+// request describes a call to the API; body is marshaled as JSON in contentType
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	contentType string
+	body        any
+	opts        []RequestOption
+}
+
+// do sends req and decodes the JSON response into out, which may be nil when there is no body
+func (c *Client) do(ctx context.Context, req request, out any) error {
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("client: decode %s %s response: %w", req.method, req.path, err)
+	}
+	return nil
+}
+
+// send sends req, retrying it when allowed, and returns the 2xx response, whose body the caller
+// closes. Error responses are returned as errors (see decodeError)
+func (c *Client) send(ctx context.Context, req request) (*http.Response, error) {
+	opts := requestOptions{header: http.Header{}}
+	for _, opt := range req.opts {
+		opt(&opts)
+	}
+
+	var body []byte
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return nil, fmt.Errorf("client: encode %s %s body: %w", req.method, req.path, err)
+		}
+	}
+
+	retryable := req.method != http.MethodPost && req.method != http.MethodPatch || opts.header.Get("Idempotency-Key") != ""
+	for attempt := 0; ; attempt++ {
+		resp, err := c.attempt(ctx, req, opts.header, body)
+		canRetry := retryable && attempt < c.maxRetries && ctx.Err() == nil
+		if err != nil {
+			if !canRetry {
+				return nil, err
+			}
+			if err := c.wait(ctx, attempt, nil); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if resp.StatusCode < http.StatusBadRequest {
+			if opts.etag != nil {
+				*opts.etag = resp.Header.Get("ETag")
+			}
+			return resp, nil
+		}
+
+		if canRetry && retryableStatus(resp.StatusCode) {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			if err := c.wait(ctx, attempt, resp); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		err = decodeError(resp)
+		resp.Body.Close()
+		return nil, err
+	}
+}
+
+// attempt sends req once with header and the encoded body
+func (c *Client) attempt(ctx context.Context, req request, header http.Header, body []byte) (*http.Response, error) {
+	target := c.baseURL + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, target, reader)
+	if err != nil {
+		return nil, fmt.Errorf("client: build %s %s request: %w", req.method, req.path, err)
+	}
+
+	httpReq.Header = header.Clone()
+	httpReq.Header.Set("Accept", "application/json")
+	if body != nil {
+		httpReq.Header.Set("Content-Type", req.contentType)
+	}
+	if c.language != "" {
+		httpReq.Header.Set("Accept-Language", c.language)
+	}
+
+	return c.httpClient.Do(httpReq)
+}
+
+// wait waits before the retry that follows attempt: the Retry-After seconds of resp, when
+// present, or the backoff doubled at each attempt. Returns the error of ctx when it ends first
+func (c *Client) wait(ctx context.Context, attempt int, resp *http.Response) error {
+	delay := c.backoff << attempt
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		}
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryableStatus reports whether a response with statusCode may succeed when the request is repeated
+func retryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// pageQuery returns the page and limit query params, leaving zero values to the server defaults
+func pageQuery(page, limit int) url.Values {
+	query := url.Values{}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	return query
+}
+
+// cursorQuery returns the query params of a cursor page: cursor mode, the cursor when it is not
+// the first page and the limit
+func cursorQuery(cursor string, limit int) url.Values {
+	query := pageQuery(0, limit)
+	query.Set("pagination", "cursor")
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	return query
+}
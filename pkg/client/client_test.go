@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestClient_Retries(t *testing.T) {
+	tests := []struct {
+		name         string
+		call         func(c *Client) error
+		failures     int32
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			"GET is retried until it succeeds",
+			func(c *Client) error { _, err := c.ListTeams(context.Background(), 0, 0); return err },
+			2, 3, false,
+		},
+		{
+			"GET gives up after the retries",
+			func(c *Client) error { _, err := c.ListTeams(context.Background(), 0, 0); return err },
+			5, 3, true,
+		},
+		{
+			"POST without Idempotency-Key is not retried",
+			func(c *Client) error {
+				_, err := c.CreateTeam(context.Background(), CreateTeamRequest{Name: "Ops"})
+				return err
+			},
+			1, 1, true,
+		},
+		{
+			"POST with Idempotency-Key is retried",
+			func(c *Client) error {
+				_, err := c.CreateTeam(context.Background(), CreateTeamRequest{Name: "Ops"}, WithIdempotencyKey("key-1"))
+				return err
+			},
+			1, 2, false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) <= tt.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			err := tt.call(New(server.URL, WithRetries(2, time.Millisecond)))
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("call error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestClient_RetriesStopWithContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := New(server.URL, WithRetries(5, time.Hour)).RetrieveTask(ctx, uuid.New())
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RetrieveTask() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		contentType string
+		body        string
+		check       func(t *testing.T, err error)
+	}{
+		{
+			"422 is ValidationErrors",
+			http.StatusUnprocessableEntity,
+			"application/problem+json",
+			`{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"title is required","errors":[{"field":"title","type":"required","code":"REQUIRED","message":"title is required"}]}`,
+			func(t *testing.T, err error) {
+				var validErr *ValidationErrors
+				if !errors.As(err, &validErr) || len(validErr.Errors) != 1 || validErr.Errors[0].Code != "REQUIRED" || validErr.Errors[0].Field != "title" {
+					t.Errorf("error = %#v, want ValidationErrors with REQUIRED on title", err)
+				}
+			},
+		},
+		{
+			"400 is BadRequestError with the field",
+			http.StatusBadRequest,
+			"application/problem+json",
+			`{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid limit value","errors":[{"field":"limit","type":"","code":"","message":"invalid limit value"}]}`,
+			func(t *testing.T, err error) {
+				var badReqErr *BadRequestError
+				if !errors.As(err, &badReqErr) || badReqErr.Message != "invalid limit value" || badReqErr.Field != "limit" {
+					t.Errorf("error = %#v, want BadRequestError on limit", err)
+				}
+			},
+		},
+		{
+			"404 wraps ErrNotFound",
+			http.StatusNotFound,
+			"application/problem+json",
+			`{"type":"about:blank","title":"Not Found","status":404,"request_id":"req-1"}`,
+			func(t *testing.T, err error) {
+				var apiErr *Error
+				if !errors.Is(err, ErrNotFound) || !errors.As(err, &apiErr) || apiErr.RequestID != "req-1" {
+					t.Errorf("error = %#v, want Error wrapping ErrNotFound", err)
+				}
+			},
+		},
+		{
+			"412 wraps ErrPreconditionFailed",
+			http.StatusPreconditionFailed,
+			"application/problem+json",
+			`{"type":"about:blank","title":"Precondition Failed","status":412,"detail":"task is at version 2, not 1"}`,
+			func(t *testing.T, err error) {
+				if !errors.Is(err, ErrPreconditionFailed) || err.Error() != "412 Precondition Failed: task is at version 2, not 1" {
+					t.Errorf("error = %v, want ErrPreconditionFailed with the detail", err)
+				}
+			},
+		},
+		{
+			"Body that is not problem details",
+			http.StatusInternalServerError,
+			"text/plain",
+			`upstream failed`,
+			func(t *testing.T, err error) {
+				var apiErr *Error
+				if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError || apiErr.Detail != "" {
+					t.Errorf("error = %#v, want Error with status 500", err)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := New(server.URL).RetrieveTask(context.Background(), uuid.New())
+
+			tt.check(t, err)
+		})
+	}
+}
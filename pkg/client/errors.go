@@ -0,0 +1,108 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	appErrors "taskmanager/internal/platform/errors"
+)
+
+// Errors wrapped by *Error for the statuses a caller usually handles
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
+
+// ValidationError is a field of a 422 response, or of a failed BulkOperationResultItem, that failed validation
+// Code identifies the rule programmatically; Message is rendered in the language of the client
+type ValidationError = appErrors.ValidationError
+
+// ValidationErrors is a 422 response: the fields that failed validation
+type ValidationErrors struct {
+	Errors []ValidationError `json:"errors"`
+}
+
+// Error returns the messages of the errors separated by semicolons
+func (v *ValidationErrors) Error() string {
+	messages := make([]string, len(v.Errors))
+	for i, err := range v.Errors {
+		messages[i] = err.Message
+	}
+	return strings.Join(messages, ";")
+}
+
+// BadRequestError is a 400 response: a malformed request, with the field at fault when there is one
+// Field is a query or path param, or the path of a body member (such as operations[0].op)
+type BadRequestError struct {
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+// Error returns the field and the message of the error
+func (b *BadRequestError) Error() string {
+	return fmt.Sprintf("field: %s, error: %s", b.Field, b.Message)
+}
+
+// Error is an error response other than 400 and 422
+// It wraps ErrNotFound, ErrUnauthorized or ErrPreconditionFailed for those statuses
+type Error struct {
+	StatusCode int
+	Detail     string
+	RequestID  string
+}
+
+// Error returns the status and the detail of the response
+func (e *Error) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Detail)
+}
+
+// Unwrap returns the sentinel error of the status, nil for the others
+func (e *Error) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+	}
+	return nil
+}
+
+// problem is the body of error responses: problem details (RFC 9457)
+type problem struct {
+	Status    int               `json:"status"`
+	Detail    string            `json:"detail"`
+	RequestID string            `json:"request_id"`
+	Errors    []ValidationError `json:"errors"`
+}
+
+// decodeError returns the typed error of an error response
+// Bodies that are not problem details, as those of proxies, give an *Error with the status only
+func decodeError(resp *http.Response) error {
+	var p problem
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || json.Unmarshal(body, &p) != nil || p.Status != resp.StatusCode {
+		return &Error{StatusCode: resp.StatusCode}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusUnprocessableEntity:
+		return &ValidationErrors{Errors: p.Errors}
+	case http.StatusBadRequest:
+		badReqErr := &BadRequestError{Message: p.Detail}
+		if len(p.Errors) > 0 {
+			badReqErr.Field = p.Errors[0].Field
+		}
+		return badReqErr
+	}
+	return &Error{StatusCode: resp.StatusCode, Detail: p.Detail, RequestID: p.RequestID}
+}
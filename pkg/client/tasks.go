@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// TaskListParams filters and orders a list of tasks; empty values are left to the server defaults
+// Status is one of to_do, in_progress, done and canceled; Sort is created_at or rank
+type TaskListParams struct {
+	Status string
+	Sort   string
+	Limit  int
+}
+
+// query returns the status and sort query params, the limit being sent by the caller as it pages
+func (p TaskListParams) query() url.Values {
+	query := url.Values{}
+	if p.Status != "" {
+		query.Set("status", p.Status)
+	}
+	if p.Sort != "" {
+		query.Set("sort", p.Sort)
+	}
+	return query
+}
+
+// CreateTask creates a task (POST /api/tasks)
+func (c *Client) CreateTask(ctx context.Context, req CreateTaskRequest, opts ...RequestOption) (*TaskResponse, error) {
+	var resp TaskResponse
+	err := c.do(ctx, request{method: http.MethodPost, path: "/api/tasks", contentType: jsonContentType, body: req, opts: opts}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RetrieveTask retrieves a task (GET /api/tasks/{uuid})
+func (c *Client) RetrieveTask(ctx context.Context, taskUUID uuid.UUID, opts ...RequestOption) (*TaskResponse, error) {
+	var resp TaskResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: taskPath(taskUUID), opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateTask replaces the title, description and estimate of a task (PUT /api/tasks/{uuid})
+func (c *Client) UpdateTask(ctx context.Context, taskUUID uuid.UUID, req UpdateTaskRequest, opts ...RequestOption) (*TaskResponse, error) {
+	var resp TaskResponse
+	err := c.do(ctx, request{method: http.MethodPut, path: taskPath(taskUUID), contentType: jsonContentType, body: req, opts: opts}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MergePatchTask changes the members of doc in the title, description and estimate of a task with a
+// JSON Merge Patch (PATCH /api/tasks/{uuid}); a nil member removes it
+func (c *Client) MergePatchTask(ctx context.Context, taskUUID uuid.UUID, doc map[string]any, opts ...RequestOption) (*TaskResponse, error) {
+	return c.patchTask(ctx, taskUUID, mergePatchType, doc, opts)
+}
+
+// JSONPatchTask applies the operations of a JSON Patch to the title, description and estimate of a
+// task (PATCH /api/tasks/{uuid}), all or none
+func (c *Client) JSONPatchTask(ctx context.Context, taskUUID uuid.UUID, ops JSONPatch, opts ...RequestOption) (*TaskResponse, error) {
+	return c.patchTask(ctx, taskUUID, jsonPatchType, ops, opts)
+}
+
+// patchTask sends a patch of contentType to a task
+func (c *Client) patchTask(ctx context.Context, taskUUID uuid.UUID, contentType string, body any, opts []RequestOption) (*TaskResponse, error) {
+	var resp TaskResponse
+	err := c.do(ctx, request{method: http.MethodPatch, path: taskPath(taskUUID), contentType: contentType, body: body, opts: opts}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteTask deletes a task (DELETE /api/tasks/{uuid})
+func (c *Client) DeleteTask(ctx context.Context, taskUUID uuid.UUID, opts ...RequestOption) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: taskPath(taskUUID), contentType: jsonContentType, body: emptyBody, opts: opts}, nil)
+}
+
+// ListTasks lists a page of tasks (GET /api/tasks); page 0 is the first page
+func (c *Client) ListTasks(ctx context.Context, params TaskListParams, page int, opts ...RequestOption) (*PaginatedTasksResponse, error) {
+	query := pageQuery(page, params.Limit)
+	for key, values := range params.query() {
+		query[key] = values
+	}
+
+	var resp PaginatedTasksResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/api/tasks", query: query, opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListTasksByCursor lists a page of tasks by keyset pagination (GET /api/tasks?pagination=cursor)
+// cursor is the NextCursor or PrevCursor of the previous page, empty for the first page
+func (c *Client) ListTasksByCursor(ctx context.Context, params TaskListParams, cursor string, opts ...RequestOption) (*CursorTasksResponse, error) {
+	query := cursorQuery(cursor, params.Limit)
+	for key, values := range params.query() {
+		query[key] = values
+	}
+
+	var resp CursorTasksResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/api/tasks", query: query, opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SearchTasks searches tasks by full text (GET /api/tasks/search); page and limit 0 use the defaults
+func (c *Client) SearchTasks(ctx context.Context, terms string, page, limit int, opts ...RequestOption) (*PaginatedTaskSearchResponse, error) {
+	query := pageQuery(page, limit)
+	query.Set("q", terms)
+
+	var resp PaginatedTaskSearchResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/api/tasks/search", query: query, opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ExportTasks streams the tasks with status, all when empty, as a file in format
+// (GET /api/tasks/export); the caller closes the returned file
+func (c *Client) ExportTasks(ctx context.Context, format ExportFormat, status string, opts ...RequestOption) (io.ReadCloser, error) {
+	query := url.Values{}
+	if format != "" {
+		query.Set("format", string(format))
+	}
+	if status != "" {
+		query.Set("status", status)
+	}
+
+	resp, err := c.send(ctx, request{method: http.MethodGet, path: "/api/tasks/export", query: query, opts: opts})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// UpdateTaskStatus moves a task to a status (POST /api/tasks/{uuid}/status)
+func (c *Client) UpdateTaskStatus(ctx context.Context, taskUUID uuid.UUID, req StatusUpdateRequest, opts ...RequestOption) error {
+	return c.do(ctx, request{method: http.MethodPost, path: taskPath(taskUUID) + "/status", contentType: jsonContentType, body: req, opts: opts}, nil)
+}
+
+// MoveTask ranks a task between two neighbors of its status column (POST /api/tasks/{uuid}/move)
+func (c *Client) MoveTask(ctx context.Context, taskUUID uuid.UUID, req MoveTaskRequest, opts ...RequestOption) (*TaskResponse, error) {
+	var resp TaskResponse
+	err := c.do(ctx, request{method: http.MethodPost, path: taskPath(taskUUID) + "/move", contentType: jsonContentType, body: req, opts: opts}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BulkTasks applies operations to several tasks (POST /api/tasks/bulk)
+// In partial mode the failures of the operations are in the response, not in the error
+func (c *Client) BulkTasks(ctx context.Context, req BulkTaskRequest, opts ...RequestOption) (*BulkTaskResponse, error) {
+	var resp BulkTaskResponse
+	err := c.do(ctx, request{method: http.MethodPost, path: "/api/tasks/bulk", contentType: jsonContentType, body: req, opts: opts}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// taskPath returns the path of a task
+func taskPath(taskUUID uuid.UUID) string {
+	return "/api/tasks/" + taskUUID.String()
+}
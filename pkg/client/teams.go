@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// CreateTeam creates a team (POST /api/teams)
+func (c *Client) CreateTeam(ctx context.Context, req CreateTeamRequest, opts ...RequestOption) (*TeamResponse, error) {
+	var resp TeamResponse
+	err := c.do(ctx, request{method: http.MethodPost, path: "/api/teams", contentType: jsonContentType, body: req, opts: opts}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListTeams lists a page of teams (GET /api/teams); page and limit 0 use the defaults
+func (c *Client) ListTeams(ctx context.Context, page, limit int, opts ...RequestOption) (*PaginatedTeamsResponse, error) {
+	var resp PaginatedTeamsResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/api/teams", query: pageQuery(page, limit), opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListTeamsByCursor lists a page of teams by keyset pagination (GET /api/teams?pagination=cursor)
+// cursor is the NextCursor or PrevCursor of the previous page, empty for the first page
+func (c *Client) ListTeamsByCursor(ctx context.Context, cursor string, limit int, opts ...RequestOption) (*CursorTeamsResponse, error) {
+	var resp CursorTeamsResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/api/teams", query: cursorQuery(cursor, limit), opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RetrieveTeam retrieves a team with its tasks (GET /api/teams/{uuid})
+func (c *Client) RetrieveTeam(ctx context.Context, teamUUID uuid.UUID, opts ...RequestOption) (*TeamWithTasksResponse, error) {
+	var resp TeamWithTasksResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: teamPath(teamUUID), opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MergePatchTeam changes the members of doc in the name, description and WIP limits of a team with a
+// JSON Merge Patch (PATCH /api/teams/{uuid}); a nil member removes it
+func (c *Client) MergePatchTeam(ctx context.Context, teamUUID uuid.UUID, doc map[string]any, opts ...RequestOption) (*PatchedTeamResponse, error) {
+	return c.patchTeam(ctx, teamUUID, mergePatchType, doc, opts)
+}
+
+// JSONPatchTeam applies the operations of a JSON Patch to the name, description and WIP limits of a
+// team (PATCH /api/teams/{uuid}), all or none
+func (c *Client) JSONPatchTeam(ctx context.Context, teamUUID uuid.UUID, ops JSONPatch, opts ...RequestOption) (*PatchedTeamResponse, error) {
+	return c.patchTeam(ctx, teamUUID, jsonPatchType, ops, opts)
+}
+
+// patchTeam sends a patch of contentType to a team
+func (c *Client) patchTeam(ctx context.Context, teamUUID uuid.UUID, contentType string, body any, opts []RequestOption) (*PatchedTeamResponse, error) {
+	var resp PatchedTeamResponse
+	err := c.do(ctx, request{method: http.MethodPatch, path: teamPath(teamUUID), contentType: contentType, body: body, opts: opts}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AssociateTaskToTeam associates a task with a team (POST /api/teams/{uuid}/tasks)
+func (c *Client) AssociateTaskToTeam(ctx context.Context, teamUUID, taskUUID uuid.UUID, opts ...RequestOption) error {
+	req := TaskAssociationRequest{TaskUUID: taskUUID.String()}
+	return c.do(ctx, request{method: http.MethodPost, path: teamPath(teamUUID) + "/tasks", contentType: jsonContentType, body: req, opts: opts}, nil)
+}
+
+// DisassociateTaskFromTeam disassociates a task from a team (DELETE /api/teams/{uuid}/tasks/{task_uuid})
+func (c *Client) DisassociateTaskFromTeam(ctx context.Context, teamUUID, taskUUID uuid.UUID, opts ...RequestOption) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: teamPath(teamUUID) + "/tasks/" + taskUUID.String(), contentType: jsonContentType, body: emptyBody, opts: opts}, nil)
+}
+
+// RetrieveTeamCalendar streams the tasks of a team as an iCalendar feed, granted by the calendar
+// token of the team (GET /api/teams/{uuid}/calendar.ics); the caller closes the returned feed
+func (c *Client) RetrieveTeamCalendar(ctx context.Context, teamUUID uuid.UUID, token string, opts ...RequestOption) (io.ReadCloser, error) {
+	query := url.Values{"token": {token}}
+	resp, err := c.send(ctx, request{method: http.MethodGet, path: teamPath(teamUUID) + "/calendar.ics", query: query, opts: opts})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// RotateTeamCalendarToken generates a new calendar token for a team, revoking the previous one
+// (POST /api/teams/{uuid}/calendar/token)
+func (c *Client) RotateTeamCalendarToken(ctx context.Context, teamUUID uuid.UUID, opts ...RequestOption) (*CalendarTokenResponse, error) {
+	var resp CalendarTokenResponse
+	if err := c.do(ctx, request{method: http.MethodPost, path: teamPath(teamUUID) + "/calendar/token", contentType: jsonContentType, body: emptyBody, opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RetrieveTeamBoard retrieves the board columns of a team (GET /api/teams/{uuid}/board)
+// A column is paged by setting params.Status and the cursor of the column; cursor is empty otherwise
+func (c *Client) RetrieveTeamBoard(ctx context.Context, teamUUID uuid.UUID, params TaskListParams, cursor string, opts ...RequestOption) (*BoardResponse, error) {
+	query := pageQuery(0, params.Limit)
+	for key, values := range params.query() {
+		query[key] = values
+	}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	var resp BoardResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: teamPath(teamUUID) + "/board", query: query, opts: opts}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateTeamWIPLimits replaces the WIP limits of a team by status (PUT /api/teams/{uuid}/wip-limits)
+func (c *Client) UpdateTeamWIPLimits(ctx context.Context, teamUUID uuid.UUID, req WIPLimitsRequest, opts ...RequestOption) (*WIPLimitsResponse, error) {
+	var resp WIPLimitsResponse
+	err := c.do(ctx, request{method: http.MethodPut, path: teamPath(teamUUID) + "/wip-limits", contentType: jsonContentType, body: req, opts: opts}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// teamPath returns the path of a team
+func teamPath(teamUUID uuid.UUID) string {
+	return "/api/teams/" + teamUUID.String()
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	taskEntity "taskmanager/internal/entity/task"
+	"taskmanager/internal/platform/patch"
+	"taskmanager/internal/transport/dto"
+)
+
+// Requests and responses of the task routes
+// They are aliases of the dto types of the server, which other modules cannot import
+type (
+	CreateTaskRequest           = dto.CreateTaskRequest
+	UpdateTaskRequest           = dto.UpdateTaskRequest
+	StatusUpdateRequest         = dto.StatusUpdateRequest
+	MoveTaskRequest             = dto.MoveTaskRequest
+	BulkTaskRequest             = dto.BulkTaskRequest
+	BulkOperationRequest        = dto.BulkOperationRequest
+	TaskResponse                = dto.TaskResponse
+	PaginatedTasksResponse      = dto.PaginatedTasksResponse
+	CursorTasksResponse         = dto.CursorTasksResponse
+	PaginatedTaskSearchResponse = dto.PaginatedTaskSearchResponse
+	TaskSearchResultResponse    = dto.TaskSearchResultResponse
+	TaskHighlightResponse       = dto.TaskHighlightResponse
+	BulkTaskResponse            = dto.BulkTaskResponse
+	BulkOperationResultItem     = dto.BulkOperationResultItem
+)
+
+// Requests and responses of the team routes
+type (
+	CreateTeamRequest      = dto.CreateTeamRequest
+	WIPLimitsRequest       = dto.WIPLimitsRequest
+	TaskAssociationRequest = dto.TaskAssociationRequest
+	TeamResponse           = dto.TeamResponse
+	PaginatedTeamsResponse = dto.PaginatedTeamsResponse
+	CursorTeamsResponse    = dto.CursorTeamsResponse
+	TeamWithTasksResponse  = dto.TeamWithTasksResponse
+	PatchedTeamResponse    = dto.PatchedTeamResponse
+	CalendarTokenResponse  = dto.CalendarTokenResponse
+	BoardResponse          = dto.BoardResponse
+	BoardColumnResponse    = dto.BoardColumnResponse
+	WIPLimitsResponse      = dto.WIPLimitsResponse
+)
+
+// TaskStatus is the status of a task, the key of the WIP limits and of the board columns
+type TaskStatus = taskEntity.TaskStatus
+
+// Statuses of a task
+const (
+	StatusTodo       = taskEntity.StatusTodo
+	StatusInProgress = taskEntity.StatusInProgress
+	StatusCanceled   = taskEntity.StatusCanceled
+	StatusDone       = taskEntity.StatusDone
+)
+
+// ExportFormat is the output format of ExportTasks
+type ExportFormat = dto.ExportFormat
+
+// Formats of ExportTasks
+const (
+	ExportFormatCSV      = dto.ExportFormatCSV
+	ExportFormatNDJSON   = dto.ExportFormatNDJSON
+	ExportFormatMarkdown = dto.ExportFormatMarkdown
+)
+
+// JSONPatch is a JSON Patch (RFC 6902) sent by JSONPatchTask and JSONPatchTeam
+type JSONPatch = patch.JSONPatch
+
+// JSONPatchOperation is one operation of a JSONPatch
+type JSONPatchOperation = patch.Operation
+
+// Media types of the patch methods
+const (
+	mergePatchType = patch.MergePatchType
+	jsonPatchType  = patch.JSONPatchType
+)
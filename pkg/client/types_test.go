@@ -0,0 +1,129 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"taskmanager/pkg/client"
+)
+
+// TestClient_PublicTypes calls the client from outside the package, with arguments built only
+// from the client types, as a module that cannot import the internal packages of the server does
+func TestClient_PublicTypes(t *testing.T) {
+	taskUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	teamUUID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	title := "Bulk title"
+
+	tests := []struct {
+		name            string
+		call            func(c *client.Client) error
+		wantMethod      string
+		wantPath        string
+		wantContentType string
+		wantBody        string
+		response        string
+	}{
+		{
+			"CreateTask sends a CreateTaskRequest",
+			func(c *client.Client) error {
+				got, err := c.CreateTask(context.Background(), client.CreateTaskRequest{Title: "Task", Description: "Public types"})
+				if err == nil && got.UUID != taskUUID {
+					t.Errorf("CreateTask() = %+v, want task %s", got, taskUUID)
+				}
+				return err
+			},
+			http.MethodPost, "/api/tasks", "application/json",
+			`{"title":"Task","description":"Public types","estimate":null}`,
+			`{"uuid":"123e4567-e89b-12d3-a456-426614174000","title":"Task"}`,
+		},
+		{
+			"JSONPatchTask sends JSONPatch operations",
+			func(c *client.Client) error {
+				ops := client.JSONPatch{
+					client.JSONPatchOperation{Op: "replace", Path: "/title", Value: json.RawMessage(`"Patched"`)},
+				}
+				_, err := c.JSONPatchTask(context.Background(), taskUUID, ops)
+				return err
+			},
+			http.MethodPatch, "/api/tasks/" + taskUUID.String(), "application/json-patch+json",
+			`[{"op":"replace","path":"/title","from":"","value":"Patched"}]`,
+			`{"uuid":"123e4567-e89b-12d3-a456-426614174000","title":"Patched"}`,
+		},
+		{
+			"BulkTasks decodes the validation errors of the results",
+			func(c *client.Client) error {
+				req := client.BulkTaskRequest{Mode: "partial", Operations: []client.BulkOperationRequest{
+					{Op: "update", TaskUUID: taskUUID.String(), Title: &title},
+				}}
+				got, err := c.BulkTasks(context.Background(), req)
+				if err != nil {
+					return err
+				}
+				var errs []client.ValidationError = got.Results[0].Errors
+				if got.Failed != 1 || len(errs) != 1 || errs[0].Code != "REQUIRED" {
+					t.Errorf("BulkTasks() = %+v, want one failed result with REQUIRED", got)
+				}
+				return nil
+			},
+			http.MethodPost, "/api/tasks/bulk", "application/json",
+			`{"mode":"partial","operations":[{"op":"update","task_uuid":"123e4567-e89b-12d3-a456-426614174000","status":"","override_wip_limit":false,"team_uuid":"","title":"Bulk title","description":null,"estimate":null}]}`,
+			`{"mode":"partial","succeeded":0,"failed":1,"results":[{"index":0,"op":"update","task_uuid":"123e4567-e89b-12d3-a456-426614174000","success":false,"errors":[{"field":"title","type":"required","code":"REQUIRED","message":"title is required"}]}]}`,
+		},
+		{
+			"UpdateTeamWIPLimits decodes the limits by TaskStatus",
+			func(c *client.Client) error {
+				got, err := c.UpdateTeamWIPLimits(context.Background(), teamUUID, client.WIPLimitsRequest{WIPLimits: map[string]int{"in_progress": 3}})
+				if err == nil && got.WIPLimits[client.StatusInProgress] != 3 {
+					t.Errorf("UpdateTeamWIPLimits() = %+v, want 3 in %s", got, client.StatusInProgress)
+				}
+				return err
+			},
+			http.MethodPut, "/api/teams/" + teamUUID.String() + "/wip-limits", "application/json",
+			`{"wip_limits":{"in_progress":3}}`,
+			`{"team_uuid":"223e4567-e89b-12d3-a456-426614174000","wip_limits":{"in_progress":3}}`,
+		},
+		{
+			"ExportTasks sends an ExportFormat",
+			func(c *client.Client) error {
+				export, err := c.ExportTasks(context.Background(), client.ExportFormatNDJSON, "")
+				if err != nil {
+					return err
+				}
+				return export.Close()
+			},
+			http.MethodGet, "/api/tasks/export", "",
+			"",
+			`{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != tt.wantMethod || r.URL.Path != tt.wantPath {
+					t.Errorf("request = %s %s, want %s %s", r.Method, r.URL.Path, tt.wantMethod, tt.wantPath)
+				}
+				if got := r.Header.Get("Content-Type"); tt.wantContentType != "" && got != tt.wantContentType {
+					t.Errorf("Content-Type = %q, want %q", got, tt.wantContentType)
+				}
+				if tt.wantBody != "" && string(body) != tt.wantBody {
+					t.Errorf("body = %s, want %s", body, tt.wantBody)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			if err := tt.call(client.New(server.URL)); err != nil {
+				t.Errorf("call error = %v", err)
+			}
+		})
+	}
+}